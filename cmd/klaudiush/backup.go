@@ -4,16 +4,21 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/smykla-skalski/klaudiush/internal/backup"
+	internalcolor "github.com/smykla-skalski/klaudiush/internal/color"
 	internalconfig "github.com/smykla-skalski/klaudiush/internal/config"
+	"github.com/smykla-skalski/klaudiush/internal/prompt"
 	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
 )
@@ -29,6 +34,12 @@ var (
 	backupForce       bool
 	backupJSON        bool
 	backupLimit       int
+	backupSince       string
+	backupUntil       string
+	backupPath        string
+	backupType        string
+	backupStdin       bool
+	backupInteractive bool
 )
 
 var backupCmd = &cobra.Command{
@@ -44,6 +55,7 @@ Subcommands:
   restore  Restore a backup snapshot
   delete   Delete a backup snapshot
   prune    Remove old backups according to retention policy
+  gc       Remove storage files and index entries that don't match
   status   Show backup system status`,
 }
 
@@ -58,6 +70,9 @@ Examples:
   klaudiush backup list --project /path        # List project config backups
   klaudiush backup list --all                  # List all backups (default)
   klaudiush backup list --limit 10             # Show last 10 backups
+  klaudiush backup list --since 2025-01-01     # Show backups since date
+  klaudiush backup list --until 2025-02-01     # Show backups up to date
+  klaudiush backup list --tag before-upgrade   # Show backups with tag
   klaudiush backup list --json                 # Output as JSON`,
 	RunE: runBackupList,
 }
@@ -67,27 +82,43 @@ var backupCreateCmd = &cobra.Command{
 	Short: "Create a manual backup",
 	Long: `Create a manual backup of a configuration file.
 
+Use --path to back up any file, such as a plugin manifest. Its config type
+is global when the file is in ~/.klaudiush and project otherwise, unless
+set with --type.
+
+Use --stdin to back up piped content that is not on disk. The snapshot is
+stored as project config unless set with --type, and identical content is
+deduplicated like any other backup. Stdin snapshots cannot be restored in
+place because they have no config path.
+
 Examples:
   klaudiush backup create                                  # Backup current project config
   klaudiush backup create --global                         # Backup global config
+  klaudiush backup create --path plugins/lint.toml         # Backup an arbitrary file
+  klaudiush backup create --path f.toml --type global      # Backup a file as global config
+  gen-config | klaudiush backup create --stdin             # Backup piped content
   klaudiush backup create --tag "before-change"            # Backup with tag
   klaudiush backup create --description "Testing feature"  # Backup with description`,
 	RunE: runBackupCreate,
 }
 
 var backupRestoreCmd = &cobra.Command{
-	Use:   "restore SNAPSHOT_ID",
+	Use:   "restore [SNAPSHOT_ID]",
 	Short: "Restore a backup snapshot",
 	Long: `Restore a configuration file from a backup snapshot.
 
 By default, creates a backup of the current config before restoring.
 Use --force to skip the safety backup.
 
+Use --interactive to pick the snapshot from a numbered list, newest first,
+instead of passing its ID. This needs a terminal on stdin.
+
 Examples:
   klaudiush backup restore abc123              # Restore with safety backup
   klaudiush backup restore abc123 --dry-run    # Preview restore operation
-  klaudiush backup restore abc123 --force      # Restore without safety backup`,
-	Args: cobra.ExactArgs(1),
+  klaudiush backup restore abc123 --force      # Restore without safety backup
+  klaudiush backup restore --interactive       # Pick the snapshot from a list`,
+	Args: validateBackupRestoreArgs,
 	RunE: runBackupRestore,
 }
 
@@ -115,6 +146,21 @@ Examples:
 	RunE: runBackupPrune,
 }
 
+var backupGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Reconcile backup storage with the index",
+	Long: `Reconcile backup storage with the snapshot index.
+
+Removes snapshot files that no index entry references and index entries
+whose snapshot file is missing, as interrupted backups can leave behind.
+
+Examples:
+  klaudiush backup gc               # Clean up all backups
+  klaudiush backup gc --dry-run     # Preview what would be removed
+  klaudiush backup gc --global      # Clean up global config backups`,
+	RunE: runBackupGC,
+}
+
 var backupStatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show backup system status",
@@ -162,6 +208,7 @@ func init() {
 	backupCmd.AddCommand(backupRestoreCmd)
 	backupCmd.AddCommand(backupDeleteCmd)
 	backupCmd.AddCommand(backupPruneCmd)
+	backupCmd.AddCommand(backupGCCmd)
 	backupCmd.AddCommand(backupStatusCmd)
 	backupCmd.AddCommand(backupAuditCmd)
 
@@ -169,6 +216,7 @@ func init() {
 	setupBackupCreateFlags()
 	setupBackupRestoreFlags()
 	setupBackupPruneFlags()
+	setupBackupGCFlags()
 	setupBackupAuditFlags()
 }
 
@@ -179,6 +227,11 @@ func setupBackupListFlags() {
 	backupListCmd.Flags().BoolVar(&backupAll, "all", false, "Show all backups (default)")
 	backupListCmd.Flags().
 		IntVar(&backupLimit, "limit", 0, "Limit number of backups to show (0 = all)")
+	backupListCmd.Flags().
+		StringVar(&backupSince, "since", "", "Show backups since this time (RFC3339 or YYYY-MM-DD)")
+	backupListCmd.Flags().
+		StringVar(&backupUntil, "until", "", "Show backups up to this time (RFC3339 or YYYY-MM-DD)")
+	backupListCmd.Flags().StringVar(&backupTag, "tag", "", "Show only backups with this tag")
 	backupListCmd.Flags().BoolVar(&backupJSON, "json", false, "Output backups as JSON")
 }
 
@@ -188,6 +241,14 @@ func setupBackupCreateFlags() {
 	backupCreateCmd.Flags().StringVar(&backupTag, "tag", "", "Optional tag for the backup")
 	backupCreateCmd.Flags().
 		StringVar(&backupDescription, "description", "", "Optional description for the backup")
+	backupCreateCmd.Flags().
+		StringVar(&backupPath, "path", "", "Backup this file instead of the resolved config")
+	backupCreateCmd.Flags().
+		StringVar(&backupType, "type", "", "Config type for --path or --stdin (global or project)")
+	backupCreateCmd.Flags().
+		BoolVar(&backupStdin, "stdin", false, "Backup content read from stdin")
+	backupCreateCmd.MarkFlagsMutuallyExclusive("stdin", "path")
+	backupCreateCmd.MarkFlagsMutuallyExclusive("stdin", "global")
 }
 
 func setupBackupRestoreFlags() {
@@ -195,6 +256,8 @@ func setupBackupRestoreFlags() {
 		BoolVar(&backupDryRun, "dry-run", false, "Preview restore operation without making changes")
 	backupRestoreCmd.Flags().
 		BoolVar(&backupForce, "force", false, "Skip safety backup before restore")
+	backupRestoreCmd.Flags().
+		BoolVarP(&backupInteractive, "interactive", "i", false, "Pick the snapshot from a list")
 }
 
 func setupBackupPruneFlags() {
@@ -207,11 +270,21 @@ func setupBackupPruneFlags() {
 	backupPruneCmd.Flags().BoolVar(&backupAll, "all", false, "Prune all backups (default)")
 }
 
+func setupBackupGCFlags() {
+	backupGCCmd.Flags().
+		BoolVar(&backupDryRun, "dry-run", false, "Preview what would be removed without making changes")
+	backupGCCmd.Flags().
+		BoolVar(&backupGlobal, "global", false, "Clean up only global config backups")
+	backupGCCmd.Flags().
+		StringVar(&backupProject, "project", "", "Clean up backups for specific project path")
+	backupGCCmd.Flags().BoolVar(&backupAll, "all", false, "Clean up all backups (default)")
+}
+
 func setupBackupAuditFlags() {
 	backupAuditCmd.Flags().
 		StringVar(&auditOperation, "operation", "", "Filter by operation type (create, restore, delete, prune)")
 	backupAuditCmd.Flags().
-		StringVar(&auditSince, "since", "", "Show entries since this time (RFC3339 format)")
+		StringVar(&auditSince, "since", "", "Show entries since this time (RFC3339 or YYYY-MM-DD)")
 	backupAuditCmd.Flags().
 		StringVar(&auditSnapshot, "snapshot", "", "Filter by snapshot ID")
 	backupAuditCmd.Flags().
@@ -236,10 +309,40 @@ func runBackupList(cmd *cobra.Command, _ []string) error {
 		"global", backupGlobal,
 		"all", backupAll,
 		"limit", backupLimit,
+		"since", backupSince,
+		"until", backupUntil,
+		"tag", backupTag,
 		"json", backupJSON,
 	)
 
-	// Collect snapshots from all relevant managers
+	filter, err := buildSnapshotFilter(backupSince, backupUntil, backupTag)
+	if err != nil {
+		return err
+	}
+
+	allSnapshots := collectSnapshots(managers, log)
+
+	// Apply time and tag filters before the limit cut
+	allSnapshots = filter.apply(allSnapshots)
+
+	// Apply limit
+	if backupLimit > 0 && len(allSnapshots) > backupLimit {
+		allSnapshots = allSnapshots[:backupLimit]
+	}
+
+	// Output
+	if backupJSON {
+		return outputBackupJSON(allSnapshots)
+	}
+
+	outputBackupTable(allSnapshots)
+
+	return nil
+}
+
+// collectSnapshots lists the snapshots of all managers, newest first.
+// Managers that fail to list are logged and skipped.
+func collectSnapshots(managers []*backup.Manager, log logger.Logger) []backup.Snapshot {
 	var allSnapshots []backup.Snapshot
 
 	for _, mgr := range managers {
@@ -266,35 +369,177 @@ func runBackupList(cmd *cobra.Command, _ []string) error {
 		return 0
 	})
 
-	// Apply limit
-	if backupLimit > 0 && len(allSnapshots) > backupLimit {
-		allSnapshots = allSnapshots[:backupLimit]
+	return allSnapshots
+}
+
+// snapshotFilter selects snapshots by creation time and tag.
+// Zero-value fields are ignored.
+type snapshotFilter struct {
+	since time.Time
+	until time.Time
+	tag   string
+}
+
+// buildSnapshotFilter parses the --since, --until, and --tag flag values.
+func buildSnapshotFilter(since, until, tag string) (snapshotFilter, error) {
+	filter := snapshotFilter{tag: tag}
+
+	if since != "" {
+		sinceTime, err := parseBackupTime(since, false)
+		if err != nil {
+			return filter, errors.Wrapf(err, "invalid since time format: %s", since)
+		}
+
+		filter.since = sinceTime
 	}
 
-	// Output
-	if backupJSON {
-		return outputBackupJSON(allSnapshots)
+	if until != "" {
+		untilTime, err := parseBackupTime(until, true)
+		if err != nil {
+			return filter, errors.Wrapf(err, "invalid until time format: %s", until)
+		}
+
+		filter.until = untilTime
 	}
 
-	outputBackupTable(allSnapshots)
+	if !filter.since.IsZero() && !filter.until.IsZero() && filter.until.Before(filter.since) {
+		return filter, errors.Newf("--until (%s) is before --since (%s)", until, since)
+	}
 
-	return nil
+	return filter, nil
 }
 
-func runBackupCreate(cmd *cobra.Command, _ []string) error {
-	log := loggerFromCmd(cmd)
+// apply returns the snapshots matching the filter, preserving order.
+func (f snapshotFilter) apply(snapshots []backup.Snapshot) []backup.Snapshot {
+	if f.since.IsZero() && f.until.IsZero() && f.tag == "" {
+		return snapshots
+	}
 
-	managers, err := setupBackupManagers(log)
+	filtered := make([]backup.Snapshot, 0, len(snapshots))
+
+	for _, snapshot := range snapshots {
+		if !f.since.IsZero() && snapshot.Timestamp.Before(f.since) {
+			continue
+		}
+
+		if !f.until.IsZero() && snapshot.Timestamp.After(f.until) {
+			continue
+		}
+
+		if f.tag != "" && snapshot.Metadata.Tag != f.tag {
+			continue
+		}
+
+		filtered = append(filtered, snapshot)
+	}
+
+	return filtered
+}
+
+// parseBackupTime parses an RFC3339 timestamp or a YYYY-MM-DD date.
+// A bare date resolves to the start of the day, or to its last instant when
+// endOfDay is set, so "--until 2025-01-31" includes backups made that day.
+func parseBackupTime(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
 	if err != nil {
-		return err
+		return time.Time{}, errors.Newf("expected RFC3339 or YYYY-MM-DD, got %q", value)
+	}
+
+	if endOfDay {
+		return t.Add(24*time.Hour - time.Nanosecond), nil
 	}
 
+	return t, nil
+}
+
+func runBackupCreate(cmd *cobra.Command, _ []string) error {
+	log := loggerFromCmd(cmd)
+
 	log.Info("backup create command invoked",
 		"global", backupGlobal,
+		"path", backupPath,
+		"type", backupType,
+		"stdin", backupStdin,
 		"tag", backupTag,
 		"description", backupDescription,
 	)
 
+	var (
+		configPath string
+		configType backup.ConfigType
+		manager    *backup.Manager
+		err        error
+	)
+
+	switch {
+	case backupStdin:
+		configPath = backup.StdinConfigPath
+		configType, manager, err = resolveBackupStdinTarget(log, backupType)
+	case backupPath != "":
+		configPath, configType, manager, err = resolveBackupPathTarget(log, backupPath, backupType)
+	default:
+		configPath, configType, manager, err = resolveBackupConfigTarget(log)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Create backup
+	opts := backup.CreateBackupOptions{
+		ConfigPath: configPath,
+		ConfigType: configType,
+		Trigger:    backup.TriggerManual,
+		Metadata: backup.SnapshotMetadata{
+			Command:     "backup create",
+			Tag:         backupTag,
+			Description: backupDescription,
+		},
+	}
+
+	var snapshot *backup.Snapshot
+
+	if backupStdin {
+		snapshot, err = manager.CreateBackupFromReader(cmd.InOrStdin(), opts)
+	} else {
+		snapshot, err = manager.CreateBackup(opts)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "failed to create backup")
+	}
+
+	fmt.Printf("✅ Backup created successfully\n")
+	fmt.Printf("   Snapshot ID: %s\n", snapshot.ID)
+	fmt.Printf("   Config Type: %s\n", configType)
+	fmt.Printf("   Config Path: %s\n", snapshot.ConfigPath)
+	fmt.Printf("   Size: %s\n", formatBytes(snapshot.Size))
+
+	if snapshot.Metadata.Tag != "" {
+		fmt.Printf("   Tag: %s\n", snapshot.Metadata.Tag)
+	}
+
+	if snapshot.Metadata.Description != "" {
+		fmt.Printf("   Description: %s\n", snapshot.Metadata.Description)
+	}
+
+	return nil
+}
+
+// resolveBackupConfigTarget returns the resolved project or global config
+// (--global) and the manager to back it up with.
+func resolveBackupConfigTarget(
+	log logger.Logger,
+) (string, backup.ConfigType, *backup.Manager, error) {
+	managers, err := setupBackupManagers(log)
+	if err != nil {
+		return "", "", nil, err
+	}
+
 	// Determine which config to backup
 	var configPath string
 
@@ -303,7 +548,7 @@ func runBackupCreate(cmd *cobra.Command, _ []string) error {
 	if backupGlobal {
 		homeDir, homeErr := os.UserHomeDir()
 		if homeErr != nil {
-			return errors.Wrap(homeErr, "failed to get home directory")
+			return "", "", nil, errors.Wrap(homeErr, "failed to get home directory")
 		}
 
 		configPath = filepath.Join(
@@ -315,7 +560,7 @@ func runBackupCreate(cmd *cobra.Command, _ []string) error {
 	} else {
 		workDir, workErr := os.Getwd()
 		if workErr != nil {
-			return errors.Wrap(workErr, "failed to get working directory")
+			return "", "", nil, errors.Wrap(workErr, "failed to get working directory")
 		}
 
 		configPath = filepath.Join(
@@ -328,7 +573,7 @@ func runBackupCreate(cmd *cobra.Command, _ []string) error {
 
 	// Check if config exists
 	if _, statErr := os.Stat(configPath); os.IsNotExist(statErr) {
-		return errors.Errorf("config file not found: %s", configPath)
+		return "", "", nil, errors.Errorf("config file not found: %s", configPath)
 	}
 
 	// Find the appropriate manager
@@ -341,44 +586,173 @@ func runBackupCreate(cmd *cobra.Command, _ []string) error {
 	}
 
 	if manager == nil {
-		return errors.New("no backup manager available")
+		return "", "", nil, errors.New("no backup manager available")
 	}
 
-	// Create backup
-	opts := backup.CreateBackupOptions{
-		ConfigPath: configPath,
-		Trigger:    backup.TriggerManual,
-		Metadata: backup.SnapshotMetadata{
-			Command:     "backup create",
-			Tag:         backupTag,
-			Description: backupDescription,
-		},
+	return configPath, configType, manager, nil
+}
+
+// resolveBackupPathTarget validates an arbitrary file passed with --path and
+// returns it with its config type and a manager using the matching storage.
+func resolveBackupPathTarget(
+	log logger.Logger,
+	path string,
+	typeFlag string,
+) (string, backup.ConfigType, *backup.Manager, error) {
+	configPath, err := validateBackupPath(path)
+	if err != nil {
+		return "", "", nil, err
 	}
 
-	snapshot, err := manager.CreateBackup(opts)
+	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return errors.Wrap(err, "failed to create backup")
+		return "", "", nil, errors.Wrap(err, "failed to get home directory")
 	}
 
-	fmt.Printf("✅ Backup created successfully\n")
-	fmt.Printf("   Snapshot ID: %s\n", snapshot.ID)
-	fmt.Printf("   Config Type: %s\n", configType)
-	fmt.Printf("   Config Path: %s\n", snapshot.ConfigPath)
-	fmt.Printf("   Size: %s\n", formatBytes(snapshot.Size))
+	configType, err := backupConfigType(configPath, typeFlag, homeDir)
+	if err != nil {
+		return "", "", nil, err
+	}
 
-	if snapshot.Metadata.Tag != "" {
-		fmt.Printf("   Tag: %s\n", snapshot.Metadata.Tag)
+	manager, err := newTypedBackupManager(log, configType, homeDir)
+	if err != nil {
+		return "", "", nil, err
 	}
 
-	if snapshot.Metadata.Description != "" {
-		fmt.Printf("   Description: %s\n", snapshot.Metadata.Description)
+	return configPath, configType, manager, nil
+}
+
+// resolveBackupStdinTarget returns the config type set with --type (project
+// by default) and a manager using the matching storage for --stdin content.
+func resolveBackupStdinTarget(
+	log logger.Logger,
+	typeFlag string,
+) (backup.ConfigType, *backup.Manager, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get home directory")
 	}
 
-	return nil
+	configType, err := backupConfigType(backup.StdinConfigPath, typeFlag, homeDir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	manager, err := newTypedBackupManager(log, configType, homeDir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return configType, manager, nil
+}
+
+// newTypedBackupManager returns a manager for the global storage or for the
+// current project's storage.
+func newTypedBackupManager(
+	log logger.Logger,
+	configType backup.ConfigType,
+	homeDir string,
+) (*backup.Manager, error) {
+	var (
+		projectPath string
+		err         error
+	)
+
+	if configType == backup.ConfigTypeProject {
+		projectPath, err = os.Getwd()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get working directory")
+		}
+	}
+
+	cfg, err := loadConfig(log, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load configuration")
+	}
+
+	storage, err := backup.NewFilesystemStorage(
+		filepath.Join(homeDir, internalconfig.GlobalConfigDir),
+		configType,
+		projectPath,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s storage", configType)
+	}
+
+	manager, err := backup.NewManager(storage, cfg.GetBackup())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s manager", configType)
+	}
+
+	return manager, nil
+}
+
+// validateBackupPath returns the absolute path of a readable regular file.
+func validateBackupPath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve path %s", path)
+	}
+
+	info, err := os.Stat(absPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", errors.Errorf("file not found: %s", absPath)
+		}
+
+		return "", errors.Wrapf(err, "failed to stat %s", absPath)
+	}
+
+	if info.IsDir() {
+		return "", errors.Errorf("not a file: %s", absPath)
+	}
+
+	f, err := os.Open(absPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "file is not readable: %s", absPath)
+	}
+
+	_ = f.Close()
+
+	return absPath, nil
+}
+
+// backupConfigType returns the config type set with --type, or infers it
+// from the path: files in ~/.klaudiush are global, all others are project.
+func backupConfigType(path, typeFlag, homeDir string) (backup.ConfigType, error) {
+	switch backup.ConfigType(typeFlag) {
+	case backup.ConfigTypeGlobal, backup.ConfigTypeProject:
+		return backup.ConfigType(typeFlag), nil
+	case "":
+	default:
+		return "", errors.Errorf("invalid --type %q: must be global or project", typeFlag)
+	}
+
+	globalDir := filepath.Join(homeDir, internalconfig.GlobalConfigDir)
+
+	rel, err := filepath.Rel(globalDir, path)
+	if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return backup.ConfigTypeGlobal, nil
+	}
+
+	return backup.ConfigTypeProject, nil
+}
+
+// validateBackupRestoreArgs requires a snapshot ID unless --interactive is
+// set, in which case no ID may be given.
+func validateBackupRestoreArgs(cmd *cobra.Command, args []string) error {
+	if backupInteractive {
+		if len(args) > 0 {
+			return errors.New("--interactive does not take a snapshot ID")
+		}
+
+		return nil
+	}
+
+	return cobra.ExactArgs(1)(cmd, args)
 }
 
 func runBackupRestore(cmd *cobra.Command, args []string) error {
-	snapshotID := args[0]
 	log := loggerFromCmd(cmd)
 
 	managers, err := setupBackupManagers(log)
@@ -386,6 +760,11 @@ func runBackupRestore(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	snapshotID, err := resolveRestoreSnapshotID(args, managers, log)
+	if err != nil {
+		return err
+	}
+
 	log.Info("backup restore command invoked",
 		"snapshotID", snapshotID,
 		"dryRun", backupDryRun,
@@ -455,6 +834,96 @@ func runBackupRestore(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// resolveRestoreSnapshotID returns the snapshot ID argument, or asks the
+// user to pick a snapshot when --interactive is set.
+func resolveRestoreSnapshotID(
+	args []string,
+	managers []*backup.Manager,
+	log logger.Logger,
+) (string, error) {
+	if !backupInteractive {
+		return args[0], nil
+	}
+
+	if !internalcolor.IsTerminal(os.Stdin) {
+		return "", errors.New(
+			"--interactive needs a terminal on stdin; pass a snapshot ID instead",
+		)
+	}
+
+	picked, err := pickSnapshot(
+		prompt.NewStdPrompter(),
+		os.Stdout,
+		collectSnapshots(managers, log),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return picked.ID, nil
+}
+
+// pickSnapshot prints snapshots as a numbered menu and asks for a choice
+// until a valid number is entered. Empty input cancels.
+func pickSnapshot(
+	p prompt.Prompter,
+	w io.Writer,
+	snapshots []backup.Snapshot,
+) (*backup.Snapshot, error) {
+	if len(snapshots) == 0 {
+		return nil, errors.New("no backups found")
+	}
+
+	for i, snapshot := range snapshots {
+		fmt.Fprintf(w, "%3d) %s  %s  %s\n",
+			i+1,
+			snapshot.ID[:8],
+			snapshot.Timestamp.Format("2006-01-02 15:04:05"),
+			snapshotLabel(snapshot),
+		)
+	}
+
+	fmt.Fprintln(w)
+
+	question := fmt.Sprintf("Backup to restore (1-%d, empty to cancel)", len(snapshots))
+
+	for {
+		answer, err := p.Input(question, "")
+		if errors.Is(err, prompt.ErrEmptyInput) {
+			return nil, errors.New("restore cancelled")
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		choice, convErr := strconv.Atoi(answer)
+		if convErr == nil && choice >= 1 && choice <= len(snapshots) {
+			return &snapshots[choice-1], nil
+		}
+
+		fmt.Fprintf(w, "Invalid choice %q\n", answer)
+	}
+}
+
+// snapshotLabel describes a snapshot by its config, tag and description.
+func snapshotLabel(snapshot backup.Snapshot) string {
+	parts := []string{"global"}
+	if snapshot.ConfigType == backup.ConfigTypeProject {
+		parts[0] = filepath.Dir(filepath.Dir(snapshot.ConfigPath))
+	}
+
+	if snapshot.Metadata.Tag != "" {
+		parts = append(parts, "["+snapshot.Metadata.Tag+"]")
+	}
+
+	if snapshot.Metadata.Description != "" {
+		parts = append(parts, snapshot.Metadata.Description)
+	}
+
+	return strings.Join(parts, "  ")
+}
+
 func runBackupDelete(cmd *cobra.Command, args []string) error {
 	snapshotID := args[0]
 	log := loggerFromCmd(cmd)
@@ -510,6 +979,12 @@ func runBackupDelete(cmd *cobra.Command, args []string) error {
 		return errors.Errorf("snapshot not found: %s", snapshotID)
 	}
 
+	unlock, err := targetStorage.Lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	// Load index
 	index, err := targetStorage.LoadIndex()
 	if err != nil {
@@ -671,6 +1146,77 @@ func runBackupPruneApply(
 	return nil
 }
 
+func runBackupGC(cmd *cobra.Command, _ []string) error {
+	log := loggerFromCmd(cmd)
+
+	managers, err := setupBackupManagers(log)
+	if err != nil {
+		return err
+	}
+
+	log.Info("backup gc command invoked",
+		"dryRun", backupDryRun,
+		"global", backupGlobal,
+		"project", backupProject,
+		"all", backupAll,
+	)
+
+	if backupDryRun {
+		fmt.Printf("📋 Dry run mode - no changes will be made\n\n")
+	}
+
+	orphaned := 0
+	dangling := 0
+	totalFreed := int64(0)
+
+	for _, mgr := range managers {
+		result, gcErr := mgr.GarbageCollect(backupDryRun)
+		if gcErr != nil {
+			if errors.Is(gcErr, backup.ErrBackupDisabled) {
+				return gcErr
+			}
+
+			log.Error("failed to collect garbage", "error", gcErr)
+
+			continue
+		}
+
+		for _, path := range result.OrphanedFiles {
+			fmt.Printf("Orphaned file: %s\n", path)
+		}
+
+		for _, id := range result.DanglingSnapshots {
+			fmt.Printf("Dangling snapshot: %s\n", id)
+		}
+
+		orphaned += len(result.OrphanedFiles)
+		dangling += len(result.DanglingSnapshots)
+		totalFreed += result.BytesFreed
+	}
+
+	if orphaned+dangling == 0 {
+		fmt.Printf("No orphaned files or dangling snapshots found\n")
+
+		return nil
+	}
+
+	fmt.Println("")
+
+	if backupDryRun {
+		fmt.Printf("Would remove %d orphaned files and %d dangling snapshots, freeing %s\n",
+			orphaned, dangling, formatBytes(totalFreed))
+
+		return nil
+	}
+
+	fmt.Printf("✅ Backup storage reconciled\n")
+	fmt.Printf("   Orphaned files removed: %d\n", orphaned)
+	fmt.Printf("   Dangling snapshots removed: %d\n", dangling)
+	fmt.Printf("   Space freed: %s\n", formatBytes(totalFreed))
+
+	return nil
+}
+
 func runBackupStatus(cmd *cobra.Command, _ []string) error {
 	log := loggerFromCmd(cmd)
 
@@ -836,7 +1382,7 @@ func runBackupAudit(cmd *cobra.Command, _ []string) error {
 
 	// Parse since time
 	if auditSince != "" {
-		sinceTime, parseErr := time.Parse(time.RFC3339, auditSince)
+		sinceTime, parseErr := parseBackupTime(auditSince, false)
 		if parseErr != nil {
 			return errors.Wrapf(parseErr, "invalid since time format: %s", auditSince)
 		}
@@ -945,7 +1491,7 @@ func setupBackupManagers(log logger.Logger) ([]*backup.Manager, error) {
 	baseDir := filepath.Join(homeDir, internalconfig.GlobalConfigDir)
 
 	// Create manager for global config
-	if !backupGlobal || backupAll || (!backupGlobal && backupProject == "") {
+	if backupGlobal || backupAll || backupProject == "" {
 		globalStorage, storageErr := backup.NewFilesystemStorage(
 			baseDir,
 			backup.ConfigTypeGlobal,
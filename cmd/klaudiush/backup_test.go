@@ -0,0 +1,226 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/backup"
+	"github.com/smykla-skalski/klaudiush/internal/prompt"
+)
+
+var _ = Describe("backup list filters", func() {
+	newSnapshot := func(id, timestamp, tag string) backup.Snapshot {
+		ts, err := time.Parse(time.RFC3339, timestamp)
+		Expect(err).NotTo(HaveOccurred())
+
+		return backup.Snapshot{
+			ID:        id,
+			Timestamp: ts,
+			Metadata:  backup.SnapshotMetadata{Tag: tag},
+		}
+	}
+
+	ids := func(snapshots []backup.Snapshot) []string {
+		result := make([]string, 0, len(snapshots))
+		for _, s := range snapshots {
+			result = append(result, s.ID)
+		}
+
+		return result
+	}
+
+	// Sorted newest first, as runBackupList does before filtering.
+	var snapshots []backup.Snapshot
+
+	BeforeEach(func() {
+		snapshots = []backup.Snapshot{
+			newSnapshot("d", "2025-03-01T09:00:00Z", "release"),
+			newSnapshot("c", "2025-02-01T23:30:00Z", ""),
+			newSnapshot("b", "2025-01-15T12:00:00Z", "release"),
+			newSnapshot("a", "2024-12-31T08:00:00Z", "before-upgrade"),
+		}
+	})
+
+	Describe("parseBackupTime", func() {
+		It("parses RFC3339 timestamps", func() {
+			t, err := parseBackupTime("2025-01-02T03:04:05Z", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t).To(Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)))
+		})
+
+		It("parses dates as start of day", func() {
+			t, err := parseBackupTime("2025-01-02", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t).To(Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("parses dates as end of day when requested", func() {
+			t, err := parseBackupTime("2025-01-02", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t).To(Equal(time.Date(2025, 1, 2, 23, 59, 59, 999999999, time.UTC)))
+		})
+
+		It("rejects other formats", func() {
+			_, err := parseBackupTime("01/02/2025", false)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("buildSnapshotFilter", func() {
+		It("returns an error for invalid since", func() {
+			_, err := buildSnapshotFilter("yesterday", "", "")
+			Expect(err).To(MatchError(ContainSubstring("invalid since time format")))
+		})
+
+		It("returns an error for invalid until", func() {
+			_, err := buildSnapshotFilter("", "tomorrow", "")
+			Expect(err).To(MatchError(ContainSubstring("invalid until time format")))
+		})
+
+		It("returns an error when until is before since", func() {
+			_, err := buildSnapshotFilter("2025-02-01", "2025-01-01", "")
+			Expect(err).To(MatchError(ContainSubstring("is before --since")))
+		})
+	})
+
+	Describe("snapshotFilter.apply", func() {
+		DescribeTable("filters snapshots",
+			func(since, until, tag string, expected []string) {
+				filter, err := buildSnapshotFilter(since, until, tag)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ids(filter.apply(snapshots))).To(Equal(expected))
+			},
+			Entry("no filters", "", "", "", []string{"d", "c", "b", "a"}),
+			Entry("since date", "2025-01-01", "", "", []string{"d", "c", "b"}),
+			Entry("since RFC3339", "2025-02-01T23:30:00Z", "", "", []string{"d", "c"}),
+			Entry("until date includes that day", "", "2025-02-01", "", []string{"c", "b", "a"}),
+			Entry("since and until", "2025-01-01", "2025-02-01", "", []string{"c", "b"}),
+			Entry("tag", "", "", "release", []string{"d", "b"}),
+			Entry("tag with since", "2025-02-01", "", "release", []string{"d"}),
+			Entry("tag with until", "", "2025-01-31", "release", []string{"b"}),
+			Entry("all filters with no match", "2025-01-01", "2025-02-28", "before-upgrade",
+				[]string{}),
+		)
+	})
+})
+
+var _ = Describe("backup create --path", func() {
+	Describe("backupConfigType", func() {
+		DescribeTable("resolves the config type",
+			func(path, typeFlag string, expected backup.ConfigType) {
+				configType, err := backupConfigType(path, typeFlag, "/home/user")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(configType).To(Equal(expected))
+			},
+			Entry("file in the global config dir",
+				"/home/user/.klaudiush/plugins/lint.toml", "", backup.ConfigTypeGlobal),
+			Entry("file in a project", "/repo/.klaudiush/config.toml", "", backup.ConfigTypeProject),
+			Entry("sibling of the global config dir",
+				"/home/user/.klaudiush-old/config.toml", "", backup.ConfigTypeProject),
+			Entry("explicit global", "/repo/plugin.toml", "global", backup.ConfigTypeGlobal),
+			Entry("explicit project",
+				"/home/user/.klaudiush/config.toml", "project", backup.ConfigTypeProject),
+		)
+
+		It("rejects an unknown type", func() {
+			_, err := backupConfigType("/repo/plugin.toml", "system", "/home/user")
+			Expect(err).To(MatchError(ContainSubstring(`invalid --type "system"`)))
+		})
+	})
+
+	Describe("validateBackupPath", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			tmpDir = GinkgoT().TempDir()
+		})
+
+		It("returns the absolute path of a readable file", func() {
+			path := filepath.Join(tmpDir, "plugin.toml")
+			Expect(os.WriteFile(path, []byte("name = \"lint\""), 0o600)).To(Succeed())
+
+			absPath, err := validateBackupPath(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(absPath).To(Equal(path))
+		})
+
+		It("rejects a missing file", func() {
+			_, err := validateBackupPath(filepath.Join(tmpDir, "missing.toml"))
+			Expect(err).To(MatchError(ContainSubstring("file not found")))
+		})
+
+		It("rejects a directory", func() {
+			_, err := validateBackupPath(tmpDir)
+			Expect(err).To(MatchError(ContainSubstring("not a file")))
+		})
+	})
+})
+
+var _ = Describe("backup restore picker", func() {
+	newSnapshot := func(id, tag, description string) backup.Snapshot {
+		return backup.Snapshot{
+			ID:         id,
+			ConfigType: backup.ConfigTypeGlobal,
+			Timestamp:  time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC),
+			Metadata:   backup.SnapshotMetadata{Tag: tag, Description: description},
+		}
+	}
+
+	snapshots := []backup.Snapshot{
+		newSnapshot("newest-snapshot", "release", "Before upgrade"),
+		newSnapshot("oldest-snapshot", "", ""),
+	}
+
+	pick := func(input string) (*backup.Snapshot, string, error) {
+		var out strings.Builder
+
+		picked, err := pickSnapshot(
+			prompt.NewPrompter(strings.NewReader(input), &out),
+			&out,
+			snapshots,
+		)
+
+		return picked, out.String(), err
+	}
+
+	It("lists snapshots with tag and description and returns the choice", func() {
+		picked, out, err := pick("2\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(picked.ID).To(Equal("oldest-snapshot"))
+		Expect(out).To(ContainSubstring(
+			"  1) newest-s  2025-03-01 09:00:00  global  [release]  Before upgrade",
+		))
+		Expect(out).To(ContainSubstring("  2) oldest-s"))
+	})
+
+	It("asks again after an invalid choice", func() {
+		picked, out, err := pick("0\nabc\n1\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(picked.ID).To(Equal("newest-snapshot"))
+		Expect(out).To(ContainSubstring(`Invalid choice "0"`))
+		Expect(out).To(ContainSubstring(`Invalid choice "abc"`))
+	})
+
+	It("cancels on empty input", func() {
+		_, _, err := pick("\n")
+		Expect(err).To(MatchError(ContainSubstring("restore cancelled")))
+	})
+
+	It("returns an error when input ends without a choice", func() {
+		_, _, err := pick("")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns an error when there are no snapshots", func() {
+		p := prompt.NewPrompter(strings.NewReader("1\n"), io.Discard)
+
+		_, err := pickSnapshot(p, io.Discard, nil)
+		Expect(err).To(MatchError(ContainSubstring("no backups found")))
+	})
+})
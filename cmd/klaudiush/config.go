@@ -0,0 +1,502 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+
+	"github.com/smykla-skalski/klaudiush/internal/backup"
+	internalconfig "github.com/smykla-skalski/klaudiush/internal/config"
+	"github.com/smykla-skalski/klaudiush/internal/config/factory"
+	"github.com/smykla-skalski/klaudiush/internal/plugin"
+	"github.com/smykla-skalski/klaudiush/internal/rules"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and validate configuration",
+	Long: `Inspect and validate configuration.
+
+Subcommands:
+  check    Validate the configuration, rules and plugins for CI
+  path     Show which config files are loaded and in what order
+  migrate  Rewrite deprecated keys to the current layout`,
+}
+
+var configCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Validate the configuration, rules and plugins for CI",
+	Long: `Load the merged configuration and check that it can be used:
+
+  - the configuration passes schema and semantic validation
+  - every rule pattern compiles (shadowed and conflicting rules are
+    reported as warnings)
+  - every enabled plugin loads, including approval when required
+
+No hook input is read. Unlike 'doctor', which checks the local machine,
+'config check' validates the configuration itself, so it fits pre-merge CI.
+
+Exits with code 1 when any error is found.
+
+Examples:
+  klaudiush config check`,
+	RunE: runConfigCheck,
+}
+
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Show which config files are loaded and in what order",
+	Long: `Print the absolute paths of the global and project config files and
+rules.d directories, marking missing ones with "(not found)", followed by
+the effective merge order.
+
+Discovery is the same as when validating, including --config.
+
+Examples:
+  klaudiush config path
+  klaudiush config path --config ./ci`,
+	RunE: runConfigPath,
+}
+
+var configMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Rewrite deprecated keys to the current layout",
+	Long: `Rewrite the keys of the project config (or the global config with
+--global) that are deprecated in the current config version, following the
+migration table, and set the config version to the latest.
+
+The original file is backed up first (see 'klaudiush backup list'). The
+migrated file is re-encoded, so comments are not kept. Keys whose value has no
+equivalent are kept and listed for manual review.
+
+Examples:
+  klaudiush config migrate --dry-run   # Show the diff without writing
+  klaudiush config migrate
+  klaudiush config migrate --global`,
+	RunE: runConfigMigrate,
+}
+
+var (
+	migrateGlobal bool
+	migrateDryRun bool
+)
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configCheckCmd)
+	configCmd.AddCommand(configPathCmd)
+	configCmd.AddCommand(configMigrateCmd)
+
+	configPathCmd.Flags().StringVarP(
+		&configPath,
+		"config",
+		"c",
+		"",
+		"Path to project configuration file or a directory containing one",
+	)
+
+	configMigrateCmd.Flags().
+		BoolVar(&migrateGlobal, "global", false, "Migrate the global config, not the project config")
+	configMigrateCmd.Flags().
+		BoolVar(&migrateDryRun, "dry-run", false, "Show the diff without writing the config")
+	configMigrateCmd.Flags().StringVarP(
+		&configPath,
+		"config",
+		"c",
+		"",
+		"Path to project configuration file or a directory containing one",
+	)
+}
+
+func runConfigMigrate(cmd *cobra.Command, _ []string) error {
+	log := loggerFromCmd(cmd)
+	log.Info("config migrate command invoked", "global", migrateGlobal, "dryRun", migrateDryRun)
+
+	path, configType, err := resolveMigrateTarget()
+	if err != nil {
+		return err
+	}
+
+	result, err := internalconfig.MigrateFile(path)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Changes) == 0 {
+		fmt.Printf("%s is up to date.\n", path)
+
+		return nil
+	}
+
+	fmt.Printf("Migrations for %s:\n", path)
+
+	for _, change := range result.Changes {
+		if change.Manual {
+			fmt.Printf("  ! %s\n", change)
+		} else {
+			fmt.Printf("  - %s\n", change)
+		}
+	}
+
+	if !result.Changed() {
+		fmt.Println("Nothing to rewrite, review the keys marked with ! manually.")
+
+		return nil
+	}
+
+	if migrateDryRun {
+		diff, diffErr := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(result.Original)),
+			B:        difflib.SplitLines(string(result.Migrated)),
+			FromFile: path,
+			ToFile:   path + " (migrated)",
+			Context:  configDiffContextLines,
+		})
+		if diffErr != nil {
+			return errors.Wrap(diffErr, "failed to render diff")
+		}
+
+		fmt.Println()
+		fmt.Print(diff)
+
+		return nil
+	}
+
+	if err := backupBeforeMigrate(log, path, configType); err != nil {
+		return err
+	}
+
+	if err := internalconfig.WriteMigration(result); err != nil {
+		return err
+	}
+
+	fmt.Printf("Migrated %s\n", path)
+
+	return nil
+}
+
+// resolveMigrateTarget returns the project config, or the global config with
+// --global, and its backup type.
+func resolveMigrateTarget() (string, backup.ConfigType, error) {
+	loader, err := internalconfig.NewKoanfLoader()
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to create config loader")
+	}
+
+	sources, err := loader.Sources(buildFlagsMap())
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to resolve config files")
+	}
+
+	path, configType := sources.ProjectConfig, backup.ConfigTypeProject
+	if migrateGlobal {
+		path, configType = sources.GlobalConfig, backup.ConfigTypeGlobal
+	}
+
+	if path == "" {
+		return "", "", errors.Errorf("%s config file not found", configType)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return "", "", errors.Errorf("config file not found: %s", path)
+	}
+
+	return path, configType, nil
+}
+
+// backupBeforeMigrate snapshots the config at path with the migration
+// trigger. Disabled backups only print a note.
+func backupBeforeMigrate(log logger.Logger, path string, configType backup.ConfigType) error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return errors.Wrap(err, "failed to get home directory")
+	}
+
+	manager, err := newTypedBackupManager(log, configType, homeDir)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := manager.CreateBackup(backup.CreateBackupOptions{
+		ConfigPath: path,
+		ConfigType: configType,
+		Trigger:    backup.TriggerMigration,
+		Metadata: backup.SnapshotMetadata{
+			Command: "config migrate",
+		},
+	})
+	if errors.Is(err, backup.ErrBackupDisabled) {
+		fmt.Println("Backups are disabled, migrating without a backup.")
+
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "failed to back up config before migrating")
+	}
+
+	fmt.Printf("Backed up %s as snapshot %s\n", path, snapshot.ID)
+
+	return nil
+}
+
+func runConfigPath(cmd *cobra.Command, _ []string) error {
+	log := loggerFromCmd(cmd)
+	log.Info("config path command invoked")
+
+	loader, err := internalconfig.NewKoanfLoader()
+	if err != nil {
+		return errors.Wrap(err, "failed to create config loader")
+	}
+
+	sources, err := loader.Sources(buildFlagsMap())
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve config files")
+	}
+
+	projectConfig := sources.ProjectConfig
+	if projectConfig == "" {
+		projectConfig = loader.ProjectConfigPaths()[0]
+	}
+
+	fmt.Printf("Global config:  %s\n", describeConfigPath(sources.GlobalConfig))
+	fmt.Printf("Global rules:   %s\n", describeConfigPath(sources.GlobalRulesDir))
+	fmt.Printf("Project config: %s\n", describeConfigPath(projectConfig))
+
+	if sources.ProjectRulesDir != "" {
+		fmt.Printf("Project rules:  %s\n", describeConfigPath(sources.ProjectRulesDir))
+	}
+
+	fmt.Println()
+	fmt.Println("Merge order (later overrides earlier):")
+
+	for i, source := range mergeOrder(sources) {
+		fmt.Printf("  %d. %s\n", i+1, source)
+	}
+
+	return nil
+}
+
+// describeConfigPath returns path, marked "(not found)" when it doesn't exist.
+func describeConfigPath(path string) string {
+	if _, err := os.Stat(path); err != nil {
+		return path + " (not found)"
+	}
+
+	return path
+}
+
+// mergeOrder lists the config sources that contribute to the merged
+// configuration, lowest priority first.
+func mergeOrder(sources *internalconfig.ConfigSources) []string {
+	order := []string{"built-in defaults"}
+
+	for _, path := range []string{
+		sources.GlobalConfig,
+		sources.GlobalRulesDir,
+		sources.ProjectConfig,
+		sources.ProjectRulesDir,
+	} {
+		if path == "" {
+			continue
+		}
+
+		if _, err := os.Stat(path); err == nil {
+			order = append(order, path)
+		}
+	}
+
+	profile := profileName
+	if profile == "" {
+		profile = os.Getenv(internalconfig.ProfileEnvVar)
+	}
+
+	if profile != "" {
+		order = append(order, "profile "+strings.TrimSpace(profile))
+	}
+
+	return append(order, "environment variables (KLAUDIUSH_*)", "command-line flags")
+}
+
+func runConfigCheck(cmd *cobra.Command, _ []string) error {
+	log := loggerFromCmd(cmd)
+	log.Info("config check command invoked")
+
+	loader, err := internalconfig.NewKoanfLoader()
+	if err != nil {
+		return errors.Wrap(err, "failed to create config loader")
+	}
+
+	cfg, err := loader.LoadWithoutValidation(buildFlagsMap())
+	if err != nil {
+		fmt.Println("config: failed to load")
+		printCheckLines("error", err)
+
+		return errors.New("config check failed: configuration could not be loaded")
+	}
+
+	failures := checkConfigValidity(cfg)
+	failures += checkConfigRules(cfg)
+	failures += checkConfigPlugins(cfg, log)
+
+	if failures > 0 {
+		return errors.Newf("config check found %d error(s)", failures)
+	}
+
+	fmt.Println("Config check passed.")
+
+	return nil
+}
+
+// checkConfigValidity reports schema and semantic validation errors.
+func checkConfigValidity(cfg *config.Config) int {
+	validationErrors := internalconfig.NewValidator().Errors(cfg)
+	if len(validationErrors) == 0 {
+		fmt.Println("config: valid")
+
+		return 0
+	}
+
+	fmt.Printf("config: %d invalid section(s)\n", len(validationErrors))
+
+	for _, err := range validationErrors {
+		printCheckLines("error", err)
+	}
+
+	return len(validationErrors)
+}
+
+// checkConfigRules reports rules whose patterns fail to compile as errors and
+// shadowed or conflicting rules, or rules with an unknown reference code, as
+// warnings.
+func checkConfigRules(cfg *config.Config) int {
+	rulesCfg := cfg.GetRules()
+	if rulesCfg == nil || len(rulesCfg.Rules) == 0 {
+		fmt.Println("rules: none configured")
+
+		return 0
+	}
+
+	ruleConfigs, err := factory.ResolvePatternAliases(rulesCfg.Rules, rulesCfg.Patterns)
+	if err != nil {
+		fmt.Println("rules: failed to resolve pattern aliases")
+		printCheckLines("error", err)
+
+		return 1
+	}
+
+	issues := rules.Lint(
+		factory.ConvertRules(ruleConfigs),
+		rules.WithLintAllowWins(rulesCfg.AllowWins),
+	)
+
+	var invalid, warnings []string
+
+	for _, issue := range issues {
+		if issue.Kind == rules.LintInvalidPattern {
+			invalid = append(invalid, issue.Message)
+		} else {
+			warnings = append(warnings, issue.String())
+		}
+	}
+
+	warnings = append(warnings, unknownRuleReferences(ruleConfigs)...)
+
+	if len(invalid) == 0 {
+		fmt.Printf("rules: %d rule(s) compiled\n", len(rulesCfg.Rules))
+	} else {
+		fmt.Printf(
+			"rules: %d of %d rule(s) failed to compile\n",
+			len(invalid),
+			len(rulesCfg.Rules),
+		)
+	}
+
+	for _, msg := range invalid {
+		fmt.Printf("  error: %s\n", msg)
+	}
+
+	for _, msg := range warnings {
+		fmt.Printf("  warning: %s\n", msg)
+	}
+
+	return len(invalid)
+}
+
+// unknownRuleReferences returns a warning for every rule whose action
+// reference is not a registered code.
+func unknownRuleReferences(ruleConfigs []config.RuleConfig) []string {
+	var warnings []string
+
+	for _, rule := range ruleConfigs {
+		if rule.Action == nil || rule.Action.Reference == "" {
+			continue
+		}
+
+		if _, ok := validator.LookupReference(rule.Action.Reference); !ok {
+			warnings = append(warnings, fmt.Sprintf(
+				"rule %q references unknown code %q",
+				rule.Name,
+				rule.Action.Reference,
+			))
+		}
+	}
+
+	return warnings
+}
+
+// checkConfigPlugins loads every enabled plugin and reports unreachable ones.
+func checkConfigPlugins(cfg *config.Config, log logger.Logger) int {
+	if cfg.Plugins == nil || !cfg.Plugins.IsEnabled() {
+		fmt.Println("plugins: disabled")
+
+		return 0
+	}
+
+	checks := plugin.CheckPlugins(cfg.Plugins, log)
+	if len(checks) == 0 {
+		fmt.Println("plugins: none enabled")
+
+		return 0
+	}
+
+	failed := 0
+
+	for _, check := range checks {
+		if check.Err != nil {
+			failed++
+		}
+	}
+
+	if failed == 0 {
+		fmt.Printf("plugins: %d plugin(s) reachable\n", len(checks))
+
+		return 0
+	}
+
+	fmt.Printf("plugins: %d of %d plugin(s) unreachable\n", failed, len(checks))
+
+	for _, check := range checks {
+		if check.Err != nil {
+			printCheckLines("error", check.Err)
+		}
+	}
+
+	return failed
+}
+
+// printCheckLines prints err indented under a check, one line per message line.
+func printCheckLines(level string, err error) {
+	for line := range strings.SplitSeq(err.Error(), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			fmt.Printf("  %s: %s\n", level, line)
+		}
+	}
+}
@@ -218,6 +218,7 @@ func displayRulesConfig(cfg *config.Config, filter string) {
 	// Rules engine status
 	fmt.Printf("Engine Enabled: %v\n", rules.IsEnabled())
 	fmt.Printf("Stop on First Match: %v\n", rules.ShouldStopOnFirstMatch())
+	fmt.Printf("Allow Wins: %v\n", rules.AllowWins)
 	fmt.Printf("Total Rules: %d\n", len(rules.Rules))
 	fmt.Println("")
 
@@ -299,6 +300,10 @@ func displayRule(index int, rule *config.RuleConfig) {
 		fmt.Printf("  Description: %s\n", rule.Description)
 	}
 
+	if len(rule.Tags) > 0 {
+		fmt.Printf("  Tags: %s\n", strings.Join(rule.Tags, ", "))
+	}
+
 	// Match conditions
 	if rule.Match != nil {
 		fmt.Println("  Match:")
@@ -314,11 +319,19 @@ func displayRule(index int, rule *config.RuleConfig) {
 			fmt.Printf("    Message: %s\n", rule.Action.Message)
 		}
 
+		if rule.Action.Severity != config.SeverityUnknown {
+			fmt.Printf("    Severity: %s\n", rule.Action.Severity)
+		}
+
 		if rule.Action.Reference != "" {
 			fmt.Printf("    Reference: %s\n", rule.Action.Reference)
 		}
 	}
 
+	if window := rule.RateLimit.GetWindow(); window > 0 {
+		fmt.Printf("  Rate Limit: once per %s\n", window)
+	}
+
 	fmt.Println("")
 }
 
@@ -351,6 +364,23 @@ func displayMatchCondition(indent string, match *config.RuleMatchConfig) {
 		fmt.Printf("%sCommand Pattern: %s\n", indent, match.CommandPattern)
 	}
 
+	if match.PromptPattern != "" {
+		fmt.Printf("%sPrompt Pattern: %s\n", indent, match.PromptPattern)
+	}
+
+	if match.CommitMessagePattern != "" {
+		fmt.Printf("%sCommit Message Pattern: %s\n", indent, match.CommitMessagePattern)
+	}
+
+	if match.MinSubjectLength > 0 || match.MaxSubjectLength > 0 {
+		fmt.Printf("%sSubject Length: min %d, max %d\n",
+			indent, match.MinSubjectLength, match.MaxSubjectLength)
+	}
+
+	if match.MinConsecutiveBlocks > 0 {
+		fmt.Printf("%sMin Consecutive Blocks: %d\n", indent, match.MinConsecutiveBlocks)
+	}
+
 	if match.ToolType != "" {
 		fmt.Printf("%sTool Type: %s\n", indent, match.ToolType)
 	}
@@ -358,6 +388,10 @@ func displayMatchCondition(indent string, match *config.RuleMatchConfig) {
 	if match.EventType != "" {
 		fmt.Printf("%sEvent Type: %s\n", indent, match.EventType)
 	}
+
+	if len(match.OS) > 0 {
+		fmt.Printf("%sOS: %s\n", indent, strings.Join(match.OS, ", "))
+	}
 }
 
 func runDebugExceptions(cmd *cobra.Command, _ []string) error {
@@ -800,6 +834,21 @@ func displayFullConfig(cfg *config.Config, filter string) {
 	fmt.Printf("  Use SDK Git: %v\n", useSDK)
 	fmt.Printf("  Default Timeout: %s\n", defaultTimeout)
 
+	if hookTimeout := cfg.Global.GetHookTimeout(); hookTimeout > 0 {
+		fmt.Printf("  Hook Timeout: %s (fail closed: %v)\n",
+			hookTimeout, cfg.Global.IsFailClosedOnTimeout())
+	} else {
+		fmt.Println("  Hook Timeout: none")
+	}
+
+	if debounce := cfg.Global.GetDebounce(); debounce > 0 {
+		fmt.Printf("  Debounce: %s\n", debounce)
+	}
+
+	if threshold := cfg.Global.GetLoopDetectionThreshold(); threshold > 0 {
+		fmt.Printf("  Loop Detection: after %d consecutive blocks\n", threshold)
+	}
+
 	fmt.Println("")
 
 	// Validators config
@@ -922,6 +971,10 @@ func displayGitPushConfig(git *config.GitConfig, filter string) {
 	if len(git.Push.AllowedRemotePriority) > 0 {
 		fmt.Printf("    Allowed Remote Priority: %v\n", git.Push.AllowedRemotePriority)
 	}
+
+	if len(git.Push.AllowedRemotes) > 0 {
+		fmt.Printf("    Allowed Remotes: %v\n", git.Push.AllowedRemotes)
+	}
 }
 
 func displayGitCommitConfig(git *config.GitConfig, filter string) {
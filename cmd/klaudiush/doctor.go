@@ -28,9 +28,10 @@ import (
 )
 
 var (
-	verboseFlag  bool
-	fixFlag      bool
-	categoryFlag []string
+	verboseFlag    bool
+	fixFlag        bool
+	categoryFlag   []string
+	doctorJSONFlag bool
 )
 
 var doctorCmd = &cobra.Command{
@@ -48,11 +49,16 @@ Checks:
 - XDG base directory compliance
 - Optional tool dependencies (shellcheck, terraform, etc.)
 
+With --json, prints an array of checks with name, category, status (ok,
+warn, fail or skipped), detail and required, for automation. The exit code
+is 1 when any required check fails.
+
 Examples:
   klaudiush doctor              # Run all checks
   klaudiush doctor --verbose    # Run with detailed output
   klaudiush doctor --fix        # Automatically fix issues
-  klaudiush doctor --category binary,hook  # Check specific categories`,
+  klaudiush doctor --category binary,hook  # Check specific categories
+  klaudiush doctor --json       # Machine-readable output for CI`,
 	RunE: runDoctor,
 }
 
@@ -80,6 +86,15 @@ func init() {
 		[]string{},
 		"Filter checks by category (binary, hook, config, tools, patterns, backup, overrides, xdg)",
 	)
+
+	doctorCmd.Flags().BoolVar(
+		&doctorJSONFlag,
+		"json",
+		false,
+		"Output check results as JSON",
+	)
+
+	doctorCmd.MarkFlagsMutuallyExclusive("json", "fix")
 }
 
 func runDoctor(cmd *cobra.Command, _ []string) error {
@@ -89,6 +104,7 @@ func runDoctor(cmd *cobra.Command, _ []string) error {
 		"verbose", verboseFlag,
 		"fix", fixFlag,
 		"categories", categoryFlag,
+		"json", doctorJSONFlag,
 	)
 
 	doctorCfg, cfgErr := loadDoctorConfig(log)
@@ -105,8 +121,8 @@ func runDoctor(cmd *cobra.Command, _ []string) error {
 	// Register fixers
 	registerFixers(registry, prompter, log, doctorCfg)
 
-	// Create reporter based on terminal capabilities
-	reporter := selectReporter()
+	// Create reporter based on output format and terminal capabilities
+	reporter := selectReporter(doctorJSONFlag)
 
 	// Create runner
 	runner := doctor.NewRunner(registry, reporter, prompter, log)
@@ -118,10 +134,11 @@ func runDoctor(cmd *cobra.Command, _ []string) error {
 	opts := doctor.RunOptions{
 		Verbose:     verboseFlag,
 		AutoFix:     fixFlag,
-		Interactive: !fixFlag && isInteractive(),
+		Interactive: !fixFlag && !doctorJSONFlag && isInteractive(),
 		Categories:  categories,
 		Global:      true,
 		Project:     true,
+		Quiet:       doctorJSONFlag,
 	}
 
 	// Run doctor
@@ -303,15 +320,21 @@ func isInteractive() bool {
 	return (fileInfo.Mode() & os.ModeCharDevice) != 0
 }
 
-// selectReporter picks the right reporter based on TTY and color settings.
+// selectReporter picks the right reporter based on output format, TTY and
+// color settings.
 //
+//	--json           -> JSONReporter (JSON array on stdout)
 //	TTY + colors     -> InteractiveReporter (spinners + colored table)
 //	TTY + no colors  -> InteractiveReporter (spinners + plain table)
 //	non-TTY + colors -> ColoredReporter (static colored table, no spinners)
 //	non-TTY + no color -> SimpleReporter (plain text, backward compat)
 //
 //nolint:ireturn // factory function selecting reporter implementation by environment
-func selectReporter() doctor.Reporter {
+func selectReporter(jsonOutput bool) doctor.Reporter {
+	if jsonOutput {
+		return reporters.NewJSONReporter(os.Stdout)
+	}
+
 	colorEnabled := internalcolor.Profile(noColorFlag)
 	tty := internalcolor.IsTerminal(os.Stdout)
 	theme := internalcolor.NewTheme(colorEnabled)
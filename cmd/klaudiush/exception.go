@@ -0,0 +1,132 @@
+// Package main provides the CLI entry point for klaudiush.
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/smykla-skalski/klaudiush/internal/exceptions"
+)
+
+// Exception command flags.
+var (
+	exceptionGrantCode   string
+	exceptionGrantReason string
+	exceptionGrantTTL    time.Duration
+)
+
+var exceptionCmd = &cobra.Command{
+	Use:   "exception",
+	Short: "Manage one-time exception grants",
+	Long: `Manage one-time exception grants.
+
+Subcommands:
+  grant  Mint a token that bypasses one blocking error code once`,
+}
+
+var exceptionGrantCmd = &cobra.Command{
+	Use:   "grant",
+	Short: "Mint a one-time exception token",
+	Long: `Mint a one-time token that bypasses one blocking error code once.
+
+Set the token in KLAUDIUSH_EXCEPTION_TOKEN for the next hook invocation. The
+first blocking error with the --code error code consumes the token, and every
+blocking error with that code in the invocation is downgraded to a warning.
+Errors with other codes still block. The token then stops working, and it
+expires after --ttl even if unused. The exception policy for the code must
+allow exceptions with the given reason. Grants count against the exception
+rate limits and every use is recorded in the audit log.
+
+Examples:
+  klaudiush exception grant --code GIT022 --reason "hotfix" --ttl 1h
+  klaudiush exception grant --code GIT019 --reason "release branch push" --ttl 15m`,
+	Args: cobra.NoArgs,
+	RunE: runExceptionGrant,
+}
+
+func init() {
+	rootCmd.AddCommand(exceptionCmd)
+	exceptionCmd.AddCommand(exceptionGrantCmd)
+
+	exceptionGrantCmd.Flags().StringVar(
+		&exceptionGrantCode,
+		"code",
+		"",
+		"Error code the grant bypasses, e.g. GIT022 (required)",
+	)
+
+	exceptionGrantCmd.Flags().StringVar(
+		&exceptionGrantReason,
+		"reason",
+		"",
+		"Justification recorded with the grant (required)",
+	)
+
+	exceptionGrantCmd.Flags().DurationVar(
+		&exceptionGrantTTL,
+		"ttl",
+		exceptions.DefaultGrantTTL,
+		"How long the token stays valid (max 24h)",
+	)
+
+	_ = exceptionGrantCmd.MarkFlagRequired("code")
+	_ = exceptionGrantCmd.MarkFlagRequired("reason")
+}
+
+func runExceptionGrant(cmd *cobra.Command, _ []string) error {
+	log := loggerFromCmd(cmd)
+	log.Info("exception grant command invoked",
+		"code", exceptionGrantCode,
+		"ttl", exceptionGrantTTL,
+	)
+
+	workDir, err := os.Getwd()
+	if err != nil {
+		return errors.Wrap(err, "failed to get working directory")
+	}
+
+	cfg, err := loadConfig(log, workDir)
+	if err != nil {
+		return errors.Wrap(err, "failed to load configuration")
+	}
+
+	exCfg := cfg.GetExceptions()
+	if !exCfg.IsEnabled() {
+		return errors.New("exceptions are disabled in the configuration")
+	}
+
+	handler := exceptions.NewHandler(exCfg,
+		exceptions.WithHandlerLogger(log),
+		exceptions.WithHandlerProjectDir(workDir),
+	)
+
+	if err := handler.LoadState(); err != nil {
+		log.Info("failed to load exception state, starting fresh", "error", err)
+	}
+
+	token, grant, err := handler.Grant(
+		exceptionGrantCode,
+		exceptionGrantReason,
+		exceptionGrantTTL,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := handler.SaveState(); err != nil {
+		log.Info("failed to save exception state", "error", err)
+	}
+
+	fmt.Printf("Exception for %s granted until %s\n",
+		grant.Code,
+		grant.ExpiresAt.Format(time.RFC3339),
+	)
+	fmt.Println("Use it once by setting it for the next hook invocation:")
+	fmt.Printf("  export %s=%s\n", exceptions.GrantTokenEnvVar, token)
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+)
+
+var explainCmd = &cobra.Command{
+	Use:   "explain CODE",
+	Short: "Explain an error reference code",
+	Long: `Print the title, description, fix hint and documentation link of an
+error reference code such as GIT019.
+
+Examples:
+  klaudiush explain GIT019
+  klaudiush explain sec001
+  klaudiush explain https://klaudiu.sh/e/FILE001`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExplain,
+}
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+}
+
+func runExplain(_ *cobra.Command, args []string) error {
+	info, ok := validator.LookupReference(args[0])
+	if !ok {
+		return errors.Newf("unknown reference code %q", args[0])
+	}
+
+	fmt.Print(explainString(info))
+
+	return nil
+}
+
+func explainString(info validator.ReferenceInfo) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s: %s\n\n", info.Code, info.Title)
+
+	if info.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", info.Description)
+	}
+
+	ref := validator.Reference(validator.ReferenceBaseURL + "/" + info.Code)
+	if hint := validator.GetSuggestion(ref); hint != "" {
+		fmt.Fprintf(&b, "Fix: %s\n\n", hint)
+	}
+
+	fmt.Fprintf(&b, "Docs: %s\n", info.URL)
+
+	return b.String()
+}
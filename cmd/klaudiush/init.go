@@ -31,6 +31,7 @@ var (
 	providersFlag      []string
 	codexHooksFlag     string
 	geminiSettingsFlag string
+	editorConfigFlag   bool
 )
 
 var initCmd = &cobra.Command{
@@ -45,7 +46,9 @@ Use --global or -g to create a global configuration file (~/.klaudiush/config.to
 Use --install-hooks to register hooks only (skip TUI).
 Use --install-hooks=false to skip hook registration.
 Use --force to overwrite an existing configuration file.
-Use --no-tui to use simple prompts instead of the interactive TUI.`,
+Use --no-tui to use simple prompts instead of the interactive TUI.
+Use --editor-config to associate the project config with its schema in
+.vscode/settings.json; with an existing config, only the settings are written.`,
 	RunE: runInit,
 }
 
@@ -102,6 +105,13 @@ func init() {
 		"",
 		"Gemini settings.json path to configure during init",
 	)
+
+	initCmd.Flags().BoolVar(
+		&editorConfigFlag,
+		"editor-config",
+		false,
+		"Associate the project config with its schema in .vscode/settings.json",
+	)
 }
 
 func runInit(cmd *cobra.Command, _ []string) error {
@@ -114,6 +124,10 @@ func runInit(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if editorConfigFlag && globalFlag {
+		return errors.New("--editor-config only applies to the project configuration")
+	}
+
 	writer := config.NewWriter()
 
 	// Check if config already exists
@@ -169,7 +183,11 @@ func handleExistingInitConfig(
 			fmt.Println("Configuration updated successfully!")
 		}
 
-		return true, nil
+		return true, maybeWriteEditorSettings(writer)
+	}
+
+	if editorConfigFlag {
+		return true, maybeWriteEditorSettings(writer)
 	}
 
 	return true, errors.Errorf(
@@ -212,6 +230,10 @@ func runFreshInit(
 	maybeAddConfigToExclude(addToExclude, showGitExclude)
 	maybeInstallInitHooks(cfg)
 
+	if err := maybeWriteEditorSettings(writer); err != nil {
+		return err
+	}
+
 	fmt.Println()
 	fmt.Println("Configuration initialized successfully!")
 
@@ -260,6 +282,29 @@ func maybeInstallInitHooks(cfg *pkgConfig.Config) {
 	}
 }
 
+// maybeWriteEditorSettings associates the project config with its schema in
+// the VS Code workspace settings when --editor-config is set.
+func maybeWriteEditorSettings(writer *config.Writer) error {
+	if !editorConfigFlag {
+		return nil
+	}
+
+	changed, err := writer.WriteEditorSettings()
+	if err != nil {
+		return errors.Wrap(err, "failed to write editor settings")
+	}
+
+	if !changed {
+		fmt.Printf("Schema already associated in %s\n", writer.EditorSettingsPath())
+
+		return nil
+	}
+
+	fmt.Printf("✅ Associated the config schema in %s\n", writer.EditorSettingsPath())
+
+	return nil
+}
+
 func resolveInitConfigPath(writer *config.Writer) (string, bool) {
 	var (
 		configPath   string
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/smykla-skalski/klaudiush/internal/backup"
+	"github.com/smykla-skalski/klaudiush/internal/doctor/settings"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+var installHookGlobal bool
+
+var installHookCmd = &cobra.Command{
+	Use:   "install-hook",
+	Short: "Register klaudiush in the Claude hook settings",
+	Long: `Register klaudiush in the Claude hook settings.
+
+Adds klaudiush hooks for PreToolUse, PostToolUse and Notification to
+.claude/settings.json in the current directory, or to ~/.claude/settings.json
+with --global. Other settings and hooks are kept, and events that already run
+klaudiush are left alone, so running it again changes nothing. The existing
+settings file is backed up first; see 'klaudiush backup list'.
+
+Examples:
+  klaudiush install-hook            # Register in the project settings
+  klaudiush install-hook --global   # Register in the user settings`,
+	Args: cobra.NoArgs,
+	RunE: runInstallHook,
+}
+
+var uninstallHookCmd = &cobra.Command{
+	Use:   "uninstall-hook",
+	Short: "Remove klaudiush from the Claude hook settings",
+	Long: `Remove klaudiush from the Claude hook settings.
+
+Removes the klaudiush hooks that install-hook registers from
+.claude/settings.json in the current directory, or from
+~/.claude/settings.json with --global. Other settings and hooks are kept. The
+existing settings file is backed up first.
+
+Examples:
+  klaudiush uninstall-hook            # Remove from the project settings
+  klaudiush uninstall-hook --global   # Remove from the user settings`,
+	Args: cobra.NoArgs,
+	RunE: runUninstallHook,
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{installHookCmd, uninstallHookCmd} {
+		cmd.Flags().BoolVar(
+			&installHookGlobal,
+			"global",
+			false,
+			"Use the user settings (~/.claude/settings.json)",
+		)
+
+		rootCmd.AddCommand(cmd)
+	}
+}
+
+func runInstallHook(cmd *cobra.Command, _ []string) error {
+	binaries := klaudiushBinaries(exec.LookPath, os.Executable)
+	if len(binaries) == 0 {
+		return errors.New("failed to locate the klaudiush binary")
+	}
+
+	binaryPath := binaries[0]
+
+	settingsPath, configType, err := hookSettingsTarget()
+	if err != nil {
+		return err
+	}
+
+	changed, err := updateHookSettings(
+		settingsPath,
+		func(raw map[string]any) bool { return settings.AddClaudeHooks(raw, binaryPath) },
+		hookSettingsBackup(loggerFromCmd(cmd), configType, "install-hook"),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		fmt.Printf("klaudiush is already registered in %s\n", settingsPath)
+
+		return nil
+	}
+
+	fmt.Printf("klaudiush registered in %s\n", settingsPath)
+
+	return nil
+}
+
+func runUninstallHook(cmd *cobra.Command, _ []string) error {
+	// Commands are matched by name too, so a binary no longer in PATH can
+	// still be removed.
+	binaries := append(klaudiushBinaries(exec.LookPath, os.Executable), "klaudiush")
+
+	settingsPath, configType, err := hookSettingsTarget()
+	if err != nil {
+		return err
+	}
+
+	changed, err := updateHookSettings(
+		settingsPath,
+		func(raw map[string]any) bool {
+			removed := false
+
+			for _, binaryPath := range binaries {
+				if settings.RemoveClaudeHooks(raw, binaryPath) {
+					removed = true
+				}
+			}
+
+			return removed
+		},
+		hookSettingsBackup(loggerFromCmd(cmd), configType, "uninstall-hook"),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		fmt.Printf("klaudiush is not registered in %s\n", settingsPath)
+
+		return nil
+	}
+
+	fmt.Printf("klaudiush removed from %s\n", settingsPath)
+
+	return nil
+}
+
+// klaudiushBinaries returns the paths the hooks can run klaudiush by, in
+// order of preference: the binary in PATH, then the running executable with
+// symlinks resolved, so the hooks can be managed from a build directory or
+// with go run.
+func klaudiushBinaries(
+	lookPath func(file string) (string, error),
+	executable func() (string, error),
+) []string {
+	var binaries []string
+
+	if path, err := lookPath("klaudiush"); err == nil {
+		binaries = append(binaries, path)
+	}
+
+	if path, err := executable(); err == nil {
+		if resolved, err := filepath.EvalSymlinks(path); err == nil {
+			path = resolved
+		}
+
+		if !slices.Contains(binaries, path) {
+			binaries = append(binaries, path)
+		}
+	}
+
+	return binaries
+}
+
+// hookSettingsTarget returns the absolute path of the Claude settings file
+// selected by --global and the backup storage it belongs to.
+func hookSettingsTarget() (string, backup.ConfigType, error) {
+	if installHookGlobal {
+		settingsPath := settings.GetUserSettingsPath()
+		if settingsPath == "" {
+			return "", "", errors.New("failed to determine home directory")
+		}
+
+		return settingsPath, backup.ConfigTypeGlobal, nil
+	}
+
+	workDir, err := os.Getwd()
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to get working directory")
+	}
+
+	return filepath.Join(workDir, settings.GetProjectSettingsPath()), backup.ConfigTypeProject, nil
+}
+
+// updateHookSettings applies update to the Claude settings at settingsPath.
+// When update reports a change, the existing file is backed up with
+// backupFn before the result is written. Returns whether the file changed.
+func updateHookSettings(
+	settingsPath string,
+	update func(raw map[string]any) bool,
+	backupFn func(path string) error,
+) (bool, error) {
+	raw, err := settings.LoadRawJSONFile(settingsPath)
+	if err != nil {
+		return false, err
+	}
+
+	if !update(raw) {
+		return false, nil
+	}
+
+	if _, err := os.Stat(settingsPath); err == nil {
+		if err := backupFn(settingsPath); err != nil {
+			return false, errors.Wrap(err, "failed to back up settings")
+		}
+	}
+
+	if err := settings.WriteRawJSONFile(settingsPath, raw); err != nil {
+		return false, errors.Wrap(err, "failed to write settings")
+	}
+
+	return true, nil
+}
+
+// hookSettingsBackup returns a backup function storing settings files with
+// the backup manager of configType. Nothing is stored when backups are
+// disabled.
+func hookSettingsBackup(
+	log logger.Logger,
+	configType backup.ConfigType,
+	command string,
+) func(path string) error {
+	return func(path string) error {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return errors.Wrap(err, "failed to get home directory")
+		}
+
+		manager, err := newTypedBackupManager(log, configType, homeDir)
+		if err != nil {
+			return err
+		}
+
+		snapshot, err := manager.CreateBackup(backup.CreateBackupOptions{
+			ConfigPath: path,
+			ConfigType: configType,
+			Trigger:    backup.TriggerAutomatic,
+			Metadata: backup.SnapshotMetadata{
+				Command: command,
+			},
+		})
+		if errors.Is(err, backup.ErrBackupDisabled) {
+			log.Info("backups disabled, not backing up settings", "path", path)
+
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		log.Info("backed up settings", "path", path, "snapshot", snapshot.ID)
+
+		return nil
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/doctor/settings"
+)
+
+var _ = Describe("updateHookSettings", func() {
+	const binaryPath = "/usr/local/bin/klaudiush"
+
+	var (
+		settingsPath string
+		backedUp     []string
+	)
+
+	install := func(raw map[string]any) bool {
+		return settings.AddClaudeHooks(raw, binaryPath)
+	}
+
+	uninstall := func(raw map[string]any) bool {
+		return settings.RemoveClaudeHooks(raw, binaryPath)
+	}
+
+	backupFn := func(path string) error {
+		backedUp = append(backedUp, path)
+
+		return nil
+	}
+
+	readSettings := func() map[string]any {
+		data, err := os.ReadFile(settingsPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		var raw map[string]any
+		Expect(json.Unmarshal(data, &raw)).To(Succeed())
+
+		return raw
+	}
+
+	BeforeEach(func() {
+		settingsPath = filepath.Join(GinkgoT().TempDir(), ".claude", "settings.json")
+		backedUp = nil
+	})
+
+	It("creates the settings on a fresh install without a backup", func() {
+		changed, err := updateHookSettings(settingsPath, install, backupFn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(backedUp).To(BeEmpty())
+
+		hooks := readSettings()["hooks"]
+		Expect(hooks).To(HaveKey("PreToolUse"))
+		Expect(hooks).To(HaveKey("PostToolUse"))
+		Expect(hooks).To(HaveKey("Notification"))
+	})
+
+	It("backs up existing settings and leaves them unchanged on re-install", func() {
+		Expect(os.MkdirAll(filepath.Dir(settingsPath), 0o750)).To(Succeed())
+		Expect(os.WriteFile(settingsPath, []byte(`{"model":"opus"}`), 0o600)).To(Succeed())
+
+		changed, err := updateHookSettings(settingsPath, install, backupFn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(backedUp).To(Equal([]string{settingsPath}))
+
+		before, err := os.ReadFile(settingsPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		changed, err = updateHookSettings(settingsPath, install, backupFn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(backedUp).To(HaveLen(1))
+
+		after, err := os.ReadFile(settingsPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(Equal(before))
+		Expect(readSettings()).To(HaveKeyWithValue("model", "opus"))
+	})
+
+	It("removes the hooks on uninstall and keeps other settings", func() {
+		Expect(os.MkdirAll(filepath.Dir(settingsPath), 0o750)).To(Succeed())
+		Expect(os.WriteFile(settingsPath, []byte(`{"model":"opus"}`), 0o600)).To(Succeed())
+
+		_, err := updateHookSettings(settingsPath, install, backupFn)
+		Expect(err).NotTo(HaveOccurred())
+
+		changed, err := updateHookSettings(settingsPath, uninstall, backupFn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(backedUp).To(HaveLen(2))
+		Expect(readSettings()).To(Equal(map[string]any{"model": "opus"}))
+
+		changed, err = updateHookSettings(settingsPath, uninstall, backupFn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(backedUp).To(HaveLen(2))
+	})
+
+	It("doesn't write the settings when the backup fails", func() {
+		Expect(os.MkdirAll(filepath.Dir(settingsPath), 0o750)).To(Succeed())
+		Expect(os.WriteFile(settingsPath, []byte(`{"model":"opus"}`), 0o600)).To(Succeed())
+
+		_, err := updateHookSettings(settingsPath, install, func(string) error {
+			return os.ErrPermission
+		})
+		Expect(err).To(MatchError(ContainSubstring("failed to back up settings")))
+		Expect(readSettings()).To(Equal(map[string]any{"model": "opus"}))
+	})
+})
+
+var _ = Describe("klaudiushBinaries", func() {
+	notFound := func(string) (string, error) { return "", exec.ErrNotFound }
+
+	It("prefers the binary in PATH", func() {
+		binaries := klaudiushBinaries(
+			func(string) (string, error) { return "/usr/local/bin/klaudiush", nil },
+			func() (string, error) { return "/nonexistent/build/klaudiush", nil },
+		)
+		Expect(binaries).To(Equal([]string{
+			"/usr/local/bin/klaudiush",
+			"/nonexistent/build/klaudiush",
+		}))
+	})
+
+	It("falls back to the running executable with symlinks resolved", func() {
+		dir, err := filepath.EvalSymlinks(GinkgoT().TempDir())
+		Expect(err).NotTo(HaveOccurred())
+
+		binary := filepath.Join(dir, "klaudiush")
+		Expect(os.WriteFile(binary, nil, 0o600)).To(Succeed())
+
+		link := filepath.Join(dir, "link")
+		Expect(os.Symlink(binary, link)).To(Succeed())
+
+		binaries := klaudiushBinaries(notFound, func() (string, error) { return link, nil })
+		Expect(binaries).To(Equal([]string{binary}))
+	})
+
+	It("lists a binary only once", func() {
+		binaries := klaudiushBinaries(
+			func(string) (string, error) { return "/nonexistent/klaudiush", nil },
+			func() (string, error) { return "/nonexistent/klaudiush", nil },
+		)
+		Expect(binaries).To(Equal([]string{"/nonexistent/klaudiush"}))
+	})
+
+	It("returns nothing when neither is available", func() {
+		binaries := klaudiushBinaries(notFound, func() (string, error) {
+			return "", os.ErrNotExist
+		})
+		Expect(binaries).To(BeEmpty())
+	})
+})
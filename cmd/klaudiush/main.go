@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -12,11 +13,11 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/smykla-skalski/klaudiush/internal/backup"
+	internalcolor "github.com/smykla-skalski/klaudiush/internal/color"
 	internalconfig "github.com/smykla-skalski/klaudiush/internal/config"
-	"github.com/smykla-skalski/klaudiush/internal/config/factory"
 	"github.com/smykla-skalski/klaudiush/internal/crashdump"
 	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
-	"github.com/smykla-skalski/klaudiush/internal/exceptions"
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
 	"github.com/smykla-skalski/klaudiush/internal/hookresponse"
 	"github.com/smykla-skalski/klaudiush/internal/hooksession"
 	"github.com/smykla-skalski/klaudiush/internal/parser"
@@ -26,6 +27,7 @@ import (
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
 	bashparser "github.com/smykla-skalski/klaudiush/pkg/parser"
+	"github.com/smykla-skalski/klaudiush/pkg/runner"
 )
 
 const (
@@ -38,6 +40,15 @@ const (
 
 	// MigrationMarkerFile is used to track if first-run migration has completed.
 	MigrationMarkerFile = ".migration_v1"
+
+	// outputFileMode is the permission mode for the --output-file report.
+	outputFileMode = 0o600
+)
+
+// Report formats of --output-file.
+const (
+	outputFormatText = "text"
+	outputFormatJSON = "json"
 )
 
 // contextKey is an unexported type for context keys to prevent collisions.
@@ -64,6 +75,18 @@ var (
 	globalConfig string
 	disableList  []string
 	noColorFlag  bool
+	profileName  string
+	verboseMode  bool
+	colorReport  bool
+	outputFile   string
+	outputFormat string
+	inputFile    string
+	hookTimeout  string
+	onlyTagged   []string
+	skipTagged   []string
+
+	// maxInputBytes limits the size of the hook input read from stdin.
+	maxInputBytes int64
 
 	// crashContext stores the current hook context for crash recovery.
 	// Set during validation dispatch and accessed by panic handler.
@@ -72,6 +95,10 @@ var (
 	// crashConfig stores the current configuration for crash recovery.
 	// Set during validation dispatch and accessed by panic handler.
 	crashConfig *config.Config
+
+	// dispatcherLog is the rotating log file opened before configuration is
+	// loaded. run applies the configured rotation settings to it.
+	dispatcherLog *logger.RotatingFile
 )
 
 func main() {
@@ -110,11 +137,17 @@ across supported hook providers.`,
 			return errors.Wrap(err, "failed to create log directory")
 		}
 
-		log, err := logger.NewFileLogger(logFile, debugMode, traceMode)
+		log, file, err := logger.NewRotatingFileLogger(
+			logFile,
+			logger.LevelFromFlags(debugMode, traceMode),
+			logRotateConfig(nil),
+		)
 		if err != nil {
 			return errors.Wrap(err, "failed to create logger")
 		}
 
+		dispatcherLog = file
+
 		ctx := context.WithValue(cmd.Context(), loggerKey, logger.Logger(log))
 		cmd.SetContext(ctx)
 
@@ -146,12 +179,43 @@ func init() {
 	)
 	rootCmd.Flags().BoolVar(&debugMode, "debug", true, "Enable debug logging")
 	rootCmd.Flags().BoolVar(&traceMode, "trace", false, "Enable trace logging")
+	rootCmd.Flags().BoolVar(
+		&verboseMode,
+		"verbose",
+		false,
+		"Include plugin-provided details and info findings in the hook response",
+	)
+	rootCmd.Flags().BoolVar(
+		&colorReport,
+		"color",
+		false,
+		"Print a colorized, grouped error report to stderr when it is a terminal",
+	)
+	rootCmd.Flags().StringVar(
+		&outputFile,
+		"output-file",
+		"",
+		"Also write the validation report to this file (overwritten on each run)",
+	)
+	rootCmd.Flags().StringVar(
+		&outputFormat,
+		"output-format",
+		outputFormatText,
+		"Format of the --output-file report (text, json)",
+	)
+	rootCmd.Flags().StringVar(
+		&inputFile,
+		"input-file",
+		"",
+		"Read the hook input JSON from this file instead of stdin",
+	)
 	rootCmd.Flags().StringVarP(
 		&configPath,
 		"config",
 		"c",
 		"",
-		"Path to project configuration file (default: .klaudiush/config.toml or klaudiush.toml)",
+		"Path to project configuration file, or a directory containing config.toml, "+
+			"klaudiush.toml or .klaudiush.toml (default: discovered from the working directory)",
 	)
 	rootCmd.Flags().StringVar(
 		&globalConfig,
@@ -163,7 +227,31 @@ func init() {
 		&disableList,
 		"disable",
 		[]string{},
-		"Comma-separated list of validators to disable (e.g., commit,markdown)",
+		"Comma-separated list of validators to disable (e.g., commit,file.markdown,'git.*')",
+	)
+	rootCmd.Flags().StringVar(
+		&hookTimeout,
+		"timeout",
+		"",
+		"Maximum total validation time, e.g. 5s (overrides global.hook_timeout)",
+	)
+	rootCmd.Flags().Int64Var(
+		&maxInputBytes,
+		"max-input-bytes",
+		parser.DefaultMaxInputBytes,
+		"Reject hook input larger than this many bytes",
+	)
+	rootCmd.Flags().StringSliceVar(
+		&onlyTagged,
+		"only-tagged",
+		[]string{},
+		"Comma-separated rule tags; load only rules with at least one of them",
+	)
+	rootCmd.Flags().StringSliceVar(
+		&skipTagged,
+		"skip-tagged",
+		[]string{},
+		"Comma-separated rule tags; skip rules with any of them",
 	)
 
 	rootCmd.PersistentFlags().BoolVar(
@@ -172,12 +260,22 @@ func init() {
 		false,
 		"Disable colored output",
 	)
+	rootCmd.PersistentFlags().StringVar(
+		&profileName,
+		"profile",
+		"",
+		"Named config profile to apply from [profiles.<name>] (env: KLAUDIUSH_PROFILE)",
+	)
 }
 
 func run(cmd *cobra.Command, _ []string) error {
 	bt := newBenchTiming()
 	log := loggerFromCmd(cmd)
 
+	if err := checkOutputFormat(); err != nil {
+		return err
+	}
+
 	// Perform first-run migration if needed
 	if migErr := performFirstRunMigration(log); migErr != nil {
 		log.Error("first-run migration failed", "error", migErr)
@@ -196,7 +294,13 @@ func run(cmd *cobra.Command, _ []string) error {
 		"trace", traceMode,
 	)
 
-	ctx, err := parseHookContext(provider, eventType, requestedEventName, log)
+	input, err := openHookInput(inputFile)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	ctx, err := parseHookContext(input, provider, eventType, requestedEventName, log)
 	if err != nil {
 		if errors.Is(err, parser.ErrEmptyInput) {
 			return nil
@@ -233,47 +337,38 @@ func run(cmd *cobra.Command, _ []string) error {
 
 	bt.mark("config")
 
+	if dispatcherLog != nil {
+		dispatcherLog.SetConfig(logRotateConfig(cfg.GetGlobal().GetLog()))
+	}
+
 	// Store context and config for crash recovery
 	crashContext = ctx
 	crashConfig = cfg
 
-	// Build validator registry from configuration
-	registryBuilder := factory.NewRegistryBuilder(log)
-
-	registry, _, err := registryBuilder.BuildWithRuleEngine(cfg)
+	// Build validators, dispatch and save exception state
+	decision, err := runner.RunValidation(context.Background(), cfg, ctx, log,
+		runner.WithWorkDir(workDir),
+		runner.WithPhaseCallback(bt.mark),
+	)
 	if err != nil {
-		return errors.Wrap(err, "failed to build validator registry")
+		return err
 	}
 
-	bt.mark("registry")
-
-	// Create and initialize exception checker if enabled
-	exceptionHandler, exceptionChecker := initExceptionChecker(cfg, workDir, log)
-
-	// Create dispatcher with exception checker and overrides
-	disp := dispatcher.NewDispatcherWithOptions(
-		registry,
+	sessionStore := hooksession.NewStore()
+	errs, sessionCleanup := applyHookSessionLifecycle(
+		sessionStore,
+		ctx,
+		validationErrors(decision.Errors),
 		log,
-		dispatcher.NewSequentialExecutor(log),
-		dispatcher.WithExceptionChecker(exceptionChecker),
-		dispatcher.WithOverrides(cfg.Overrides),
 	)
 
-	// Dispatch validation
-	errs := disp.Dispatch(context.Background(), ctx)
-	sessionStore := hooksession.NewStore()
-	errs, sessionCleanup := applyHookSessionLifecycle(sessionStore, ctx, errs, log)
-
-	bt.mark("dispatch")
-
-	// Save persistent state after dispatch
-	savePersistentState(exceptionHandler, log)
-
 	// Run failure pattern tracking
 	patternWarnings := runPatternTracking(cfg, ctx, errs, workDir, log)
 
 	// Build and write response
-	writeErr := writeResponse(ctx, errs, patternWarnings, log)
+	writeErr := writeResponse(ctx, cfg.GetGlobal(), errs, patternWarnings, log)
+
+	writeOutputFile(outputFile, errs, log)
 
 	sessionCleanup()
 
@@ -311,7 +406,31 @@ func resolveHookInvocation() (hook.Provider, hook.EventType, string, error) {
 	return provider, eventType, requestedEventName, nil
 }
 
+// openHookInput returns the file at path, or stdin when path is empty.
+func openHookInput(path string) (io.ReadCloser, error) {
+	if path == "" {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read hook input %s", path)
+	}
+
+	if info.IsDir() {
+		return nil, errors.Newf("failed to read hook input %s: is a directory", path)
+	}
+
+	f, err := os.Open(path) //nolint:gosec // G304: reading the given input file is intended
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read hook input %s", path)
+	}
+
+	return f, nil
+}
+
 func parseHookContext(
+	input io.Reader,
 	provider hook.Provider,
 	eventType hook.EventType,
 	requestedEventName string,
@@ -319,7 +438,7 @@ func parseHookContext(
 ) (*hook.Context, error) {
 	// Parse JSON input first so we can detect the effective working directory
 	// from cd commands (e.g. "cd /path/to/repo && git commit") before loading config.
-	jsonParser := parser.NewJSONParser(os.Stdin)
+	jsonParser := parser.NewJSONParser(input, parser.WithMaxInputBytes(maxInputBytes))
 
 	ctx, err := jsonParser.ParseWithOptions(parser.ParseOptions{
 		Provider:  provider,
@@ -339,26 +458,21 @@ func parseHookContext(
 	return ctx, nil
 }
 
-// savePersistentState saves exception state after dispatch.
-func savePersistentState(
-	exceptionHandler *exceptions.Handler,
-	log logger.Logger,
-) {
-	if exceptionHandler != nil {
-		if err := exceptionHandler.SaveState(); err != nil {
-			log.Info("failed to save exception state", "error", err)
-		}
-	}
-}
-
 // writeResponse builds and writes the JSON hook response to stdout.
 func writeResponse(
 	hookCtx *hook.Context,
+	global *config.GlobalConfig,
 	errs []*dispatcher.ValidationError,
 	patternWarnings []string,
 	log logger.Logger,
 ) error {
-	response := hookresponse.BuildForContext(hookCtx, errs, patternWarnings)
+	response := hookresponse.BuildForContext(
+		hookCtx,
+		errs,
+		patternWarnings,
+		hookresponse.WithVerbose(verboseMode),
+		hookresponse.WithPostToolUseSummary(global.IsPostToolUseSummaryEnabled()),
+	)
 	if response == nil {
 		log.Info("validation passed")
 
@@ -375,6 +489,8 @@ func writeResponse(
 	//nolint:errcheck // Writing marshalled JSON to stdout is best-effort for hook responses.
 	fmt.Fprintf(os.Stdout, "%s\n", data)
 
+	writeResultReport(global, errs, log)
+
 	if dispatcher.ShouldBlock(errs) {
 		log.Error("validation blocked", "errorCount", len(errs))
 	} else {
@@ -384,6 +500,110 @@ func writeResponse(
 	return nil
 }
 
+// writeResultReport writes the grouped error report to the configured result
+// sink. The stderr sink only prints when --color is set, stderr is a terminal
+// and color is not disabled (NO_COLOR, --no-color); piped stderr is left
+// untouched. Informational findings always reach the file and syslog sinks,
+// but stderr only with --verbose. Sink failures are logged, not returned.
+func writeResultReport(
+	global *config.GlobalConfig,
+	errs []*dispatcher.ValidationError,
+	log logger.Logger,
+) {
+	if len(errs) == 0 {
+		return
+	}
+
+	var sink dispatcher.ResultSink
+
+	switch global.GetResultSink() {
+	case config.ResultSinkFile:
+		path := global.ResultFile
+		if path == "" {
+			path = xdg.ResultsFile()
+		}
+
+		sink = dispatcher.NewFileSink(xdg.ExpandPathSilent(path))
+	case config.ResultSinkSyslog:
+		sink = dispatcher.NewSyslogSink("klaudiush")
+	default:
+		errs = dispatcher.Reported(errs, verboseMode)
+		if len(errs) == 0 ||
+			!colorReport ||
+			!internalcolor.IsTerminal(os.Stderr) ||
+			!internalcolor.Profile(noColorFlag) {
+			return
+		}
+
+		sink = dispatcher.NewWriterSink(os.Stderr, true)
+	}
+
+	if err := sink.Emit(errs); err != nil {
+		log.Error("failed to write validation results",
+			"sink", global.GetResultSink(),
+			"error", err,
+		)
+	}
+}
+
+// checkOutputFormat checks that --output-format names a known format.
+func checkOutputFormat() error {
+	switch outputFormat {
+	case outputFormatText, outputFormatJSON:
+		return nil
+	default:
+		return errors.Newf(
+			"unknown output format %q: must be %s or %s",
+			outputFormat, outputFormatText, outputFormatJSON,
+		)
+	}
+}
+
+// writeOutputFile writes the validation report to path in --output-format,
+// replacing any previous report, so CI can archive it when stderr is not
+// captured. A run without findings leaves an empty text report, or a JSON
+// report with no findings. Informational findings are included only with
+// --verbose. Write failures are reported on stderr and logged, but never
+// change the hook decision or exit code.
+func writeOutputFile(path string, errs []*dispatcher.ValidationError, log logger.Logger) {
+	if path == "" {
+		return
+	}
+
+	path = xdg.ExpandPathSilent(path)
+
+	report, err := formatOutputReport(dispatcher.Reported(errs, verboseMode))
+	if err == nil {
+		err = fileutil.WriteFileAtomic(path, report, outputFileMode)
+	}
+
+	if err != nil {
+		log.Error("failed to write output file", "path", path, "error", err)
+
+		//nolint:errcheck // Best-effort notice; the hook response is already written.
+		fmt.Fprintf(os.Stderr, "Warning: failed to write --output-file %s: %v\n", path, err)
+	}
+}
+
+// formatOutputReport renders errs in --output-format.
+func formatOutputReport(errs []*dispatcher.ValidationError) ([]byte, error) {
+	if outputFormat == outputFormatJSON {
+		return dispatcher.FormatErrorsJSON(errs)
+	}
+
+	return []byte(dispatcher.FormatErrorsPretty(errs, false)), nil
+}
+
+// logRotateConfig returns the dispatcher log rotation settings for cfg.
+// A nil cfg yields the defaults.
+func logRotateConfig(cfg *config.LogConfig) logger.RotateConfig {
+	return logger.RotateConfig{
+		MaxSize:    cfg.GetMaxSizeBytes(),
+		MaxBackups: cfg.GetMaxBackups(),
+		Compress:   cfg.IsCompress(),
+	}
+}
+
 // loadConfig loads configuration from all sources with precedence.
 // workDir overrides the current working directory for project config resolution.
 // Pass "" to use os.Getwd() (the default behavior).
@@ -391,6 +611,25 @@ func loadConfig(log logger.Logger, workDir string) (*config.Config, error) {
 	// Build flags map from CLI arguments
 	flags := buildFlagsMap()
 
+	loader, err := newConfigLoader(workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration
+	cfg, err := loader.Load(flags)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load config")
+	}
+
+	log.Debug("configuration loaded")
+
+	return cfg, nil
+}
+
+// newConfigLoader creates a config loader for workDir, or for the current
+// directory when workDir is empty.
+func newConfigLoader(workDir string) (*internalconfig.KoanfLoader, error) {
 	var loader *internalconfig.KoanfLoader
 
 	var err error
@@ -410,15 +649,7 @@ func loadConfig(log logger.Logger, workDir string) (*config.Config, error) {
 		return nil, errors.Wrap(err, "failed to create config loader")
 	}
 
-	// Load configuration
-	cfg, err := loader.Load(flags)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to load config")
-	}
-
-	log.Debug("configuration loaded")
-
-	return cfg, nil
+	return loader, nil
 }
 
 // extractEffectiveWorkDir returns the effective working directory for config loading.
@@ -483,48 +714,6 @@ func extractEffectiveWorkDir(ctx *hook.Context, log logger.Logger) string {
 	return cdTarget
 }
 
-// initExceptionChecker creates and initializes an exception checker if enabled in the config.
-func initExceptionChecker(
-	cfg *config.Config,
-	workDir string,
-	log logger.Logger,
-) (*exceptions.Handler, dispatcher.ExceptionChecker) {
-	exCfg := cfg.GetExceptions()
-	if !exCfg.IsEnabled() {
-		return nil, nil
-	}
-
-	// Resolve project directory for per-project state scoping
-	projectDir := workDir
-	if projectDir == "" {
-		var err error
-
-		projectDir, err = os.Getwd()
-		if err != nil {
-			log.Info("failed to get working directory for exceptions", "error", err)
-
-			return nil, nil
-		}
-	}
-
-	handler := exceptions.NewHandler(exCfg,
-		exceptions.WithHandlerLogger(log),
-		exceptions.WithHandlerProjectDir(projectDir),
-	)
-
-	if err := handler.LoadState(); err != nil {
-		log.Info("failed to load exception state, starting fresh", "error", err)
-	}
-
-	checker := dispatcher.NewExceptionChecker(handler,
-		dispatcher.WithExceptionCheckerLogger(log),
-	)
-
-	log.Debug("exception checker initialized")
-
-	return handler, checker
-}
-
 // runPatternTracking runs the failure pattern advisor and recorder.
 // Returns pattern warnings for blocking errors, or nil if disabled.
 func runPatternTracking(
@@ -611,6 +800,22 @@ func buildFlagsMap() map[string]any {
 		flags["disable"] = disableList
 	}
 
+	if profileName != "" {
+		flags["profile"] = profileName
+	}
+
+	if hookTimeout != "" {
+		flags["timeout"] = hookTimeout
+	}
+
+	if len(onlyTagged) > 0 {
+		flags["only-tagged"] = onlyTagged
+	}
+
+	if len(skipTagged) > 0 {
+		flags["skip-tagged"] = skipTagged
+	}
+
 	return flags
 }
 
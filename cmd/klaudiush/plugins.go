@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/smykla-skalski/klaudiush/internal/plugin"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+)
+
+// pluginsApproveGlobal selects the global config for plugins approve.
+var pluginsApproveGlobal bool
+
+var pluginsCmd = &cobra.Command{
+	Use:   "plugins",
+	Short: "Manage plugins",
+	Long: `Manage plugins.
+
+Subcommands:
+  approve  Record the checksum of a plugin executable as approved`,
+}
+
+var pluginsApproveCmd = &cobra.Command{
+	Use:   "approve NAME",
+	Short: "Record the checksum of a plugin executable as approved",
+	Long: `Compute the SHA-256 of a plugin executable and store it as the plugin's
+approved_checksum. With plugins.require_approval = true, plugins load only
+while their executable matches the approved checksum.
+
+Review the plugin before approving it, and approve it again after every update.
+
+Examples:
+  klaudiush plugins approve lint            # Plugin in project config
+  klaudiush plugins approve lint --global   # Plugin in global config`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPluginsApprove,
+}
+
+func init() {
+	rootCmd.AddCommand(pluginsCmd)
+	pluginsCmd.AddCommand(pluginsApproveCmd)
+
+	pluginsApproveCmd.Flags().BoolVar(
+		&pluginsApproveGlobal,
+		"global",
+		false,
+		"Approve a plugin from the global config",
+	)
+}
+
+func runPluginsApprove(_ *cobra.Command, args []string) error {
+	name := args[0]
+
+	scope := "project"
+	if pluginsApproveGlobal {
+		scope = scopeGlobal
+	}
+
+	cfg, err := loadOverrideConfig(pluginsApproveGlobal)
+	if err != nil {
+		return err
+	}
+
+	pluginCfg := findPluginConfig(cfg, name)
+	if pluginCfg == nil {
+		return errors.Errorf("plugin %q not found in %s config", name, scope)
+	}
+
+	if pluginCfg.Type != config.PluginTypeExec {
+		return errors.Errorf("plugin %q: approval is not supported for type %q", name, pluginCfg.Type)
+	}
+
+	checksum, err := plugin.Checksum(pluginCfg.Path)
+	if err != nil {
+		return errors.Wrapf(err, "plugin %q", name)
+	}
+
+	if pluginCfg.ApprovedChecksum == checksum {
+		fmt.Printf("Plugin %s is already approved (sha256 %s).\n", name, checksum)
+
+		return nil
+	}
+
+	pluginCfg.ApprovedChecksum = checksum
+
+	if err := writeOverrideConfig(cfg, pluginsApproveGlobal); err != nil {
+		return err
+	}
+
+	fmt.Printf("Approved plugin %s (sha256 %s).\n", name, checksum)
+	fmt.Printf("\nWritten to %s config.\n", scope)
+
+	return nil
+}
+
+// findPluginConfig returns the plugin instance with the given name, or nil.
+func findPluginConfig(cfg *config.Config, name string) *config.PluginInstanceConfig {
+	if cfg.Plugins == nil {
+		return nil
+	}
+
+	for _, p := range cfg.Plugins.Plugins {
+		if p != nil && p.Name == name {
+			return p
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+
+	internalconfig "github.com/smykla-skalski/klaudiush/internal/config"
+	"github.com/smykla-skalski/klaudiush/internal/config/factory"
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+	"github.com/smykla-skalski/klaudiush/internal/rules"
+)
+
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "Manage validation rules",
+	Long: `Manage validation rules.
+
+Subcommands:
+  lint    Detect unreachable, conflicting, or invalid rules
+  export  Write the effective rules as a standalone TOML file
+  import  Merge rules from a TOML file into the project config`,
+}
+
+// rulesExportOut is the file rules export writes to.
+var rulesExportOut string
+
+var rulesLintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Detect unreachable, conflicting, or invalid rules",
+	Long: `Statically analyze the loaded validation rules and report:
+
+  - rules whose patterns fail to compile
+  - rules that can never fire because a higher-priority rule matches
+    everything they match
+  - rules with identical conditions but conflicting actions
+
+Exits with code 1 when any issue is found.
+
+Examples:
+  klaudiush rules lint`,
+	RunE: runRulesLint,
+}
+
+var rulesExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Write the effective rules as a standalone TOML file",
+	Long: `Write the [rules] section of the effective configuration, including
+pattern aliases, as a standalone TOML file for sharing with
+"klaudiush rules import". Writes to stdout unless --out is set.
+
+Examples:
+  klaudiush rules export
+  klaudiush rules export --out team-rules.toml`,
+	Args: cobra.NoArgs,
+	RunE: runRulesExport,
+}
+
+var rulesImportCmd = &cobra.Command{
+	Use:   "import FILE",
+	Short: "Merge rules from a TOML file into the project config",
+	Long: `Merge the rules and pattern aliases of a TOML file, such as one written
+by "klaudiush rules export", into the project config. Rules replace
+project rules with the same name and are appended otherwise. Other
+settings in the project config are left untouched.
+
+Examples:
+  klaudiush rules import team-rules.toml`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRulesImport,
+}
+
+func init() {
+	rootCmd.AddCommand(rulesCmd)
+	rulesCmd.AddCommand(rulesLintCmd)
+	rulesCmd.AddCommand(rulesExportCmd)
+	rulesCmd.AddCommand(rulesImportCmd)
+
+	rulesExportCmd.Flags().
+		StringVarP(&rulesExportOut, "out", "o", "", "File to write the rules to (default: stdout)")
+}
+
+func runRulesLint(cmd *cobra.Command, _ []string) error {
+	cfg, err := setupDebugContext(loggerFromCmd(cmd), "rules lint", "", "")
+	if err != nil {
+		return err
+	}
+
+	rulesCfg := cfg.GetRules()
+	if rulesCfg == nil || len(rulesCfg.Rules) == 0 {
+		fmt.Println("No rules configured.")
+
+		return nil
+	}
+
+	ruleConfigs, err := factory.ResolvePatternAliases(rulesCfg.Rules, rulesCfg.Patterns)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve pattern aliases")
+	}
+
+	issues := rules.Lint(
+		factory.ConvertRules(ruleConfigs),
+		rules.WithLintAllowWins(rulesCfg.AllowWins),
+	)
+	if len(issues) == 0 {
+		fmt.Printf("No issues found in %d rules.\n", len(rulesCfg.Rules))
+
+		return nil
+	}
+
+	for _, issue := range issues {
+		fmt.Printf("warning: %s\n", issue)
+	}
+
+	// Return an error so cobra exits with code 1
+	return errors.Newf("found %d rule issue(s)", len(issues))
+}
+
+func runRulesExport(cmd *cobra.Command, _ []string) error {
+	cfg, err := setupDebugContext(loggerFromCmd(cmd), "rules export", "out", rulesExportOut)
+	if err != nil {
+		return err
+	}
+
+	if rulesExportOut == "" {
+		return internalconfig.ExportRules(os.Stdout, cfg.Rules)
+	}
+
+	var buf bytes.Buffer
+
+	if err := internalconfig.ExportRules(&buf, cfg.Rules); err != nil {
+		return err
+	}
+
+	if err := fileutil.WriteFileAtomic(rulesExportOut, buf.Bytes(), internalconfig.ConfigFileMode); err != nil {
+		return errors.Wrapf(err, "failed to write %s", rulesExportOut)
+	}
+
+	fmt.Printf("Exported %d rules to %s.\n", len(cfg.GetRules().Rules), rulesExportOut)
+
+	return nil
+}
+
+func runRulesImport(cmd *cobra.Command, args []string) error {
+	loggerFromCmd(cmd).Info("rules import command invoked", "file", args[0])
+
+	imported, err := internalconfig.LoadRulesExport(args[0])
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadOverrideConfig(false)
+	if err != nil {
+		return err
+	}
+
+	added, replaced := internalconfig.ImportRules(cfg, imported)
+
+	if err := writeOverrideConfig(cfg, false); err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"Imported %d rules from %s (%d added, %d replaced).\n",
+		added+replaced, args[0], added, replaced,
+	)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/rogpeppe/go-internal/testscript"
 
+	"github.com/smykla-skalski/klaudiush/internal/exceptions"
 	gitpkg "github.com/smykla-skalski/klaudiush/internal/git"
 )
 
@@ -25,6 +26,9 @@ func mainFunc() {
 	configPath = ""
 	globalConfig = ""
 	disableList = []string{}
+	onlyTagged = []string{}
+	skipTagged = []string{}
+	outputFile = ""
 	globalFlag = false
 	forceFlag = false
 	noTUIFlag = false
@@ -34,7 +38,21 @@ func mainFunc() {
 	verboseFlag = false
 	fixFlag = false
 	categoryFlag = []string{}
+	doctorJSONFlag = false
 	validatorFilter = ""
+	pluginsApproveGlobal = false
+	backupStdin = false
+	backupPath = ""
+	backupType = ""
+	backupInteractive = false
+	validateInput = ""
+	validateWatch = false
+	validateAllFiles = false
+	validateStagedOnly = false
+	validateFormat = validateFormatText
+	installHookGlobal = false
+	exceptionGrantReason = ""
+	exceptionGrantTTL = exceptions.DefaultGrantTTL
 
 	// Reset git repository cache so each test discovers its own repo
 	gitpkg.ResetRepositoryCache()
@@ -90,6 +108,55 @@ func TestScriptMarkdown(t *testing.T) {
 	})
 }
 
+func TestScriptRules(t *testing.T) {
+	testscript.Run(t, testscript.Params{
+		Dir:   "testdata/scripts/rules",
+		Setup: setupTestEnv,
+	})
+}
+
+func TestScriptPlugins(t *testing.T) {
+	testscript.Run(t, testscript.Params{
+		Dir:   "testdata/scripts/plugins",
+		Setup: setupTestEnv,
+	})
+}
+
+func TestScriptConfig(t *testing.T) {
+	testscript.Run(t, testscript.Params{
+		Dir:   "testdata/scripts/config",
+		Setup: setupTestEnv,
+	})
+}
+
+func TestScriptExplain(t *testing.T) {
+	testscript.Run(t, testscript.Params{
+		Dir:   "testdata/scripts/explain",
+		Setup: setupTestEnv,
+	})
+}
+
+func TestScriptValidate(t *testing.T) {
+	testscript.Run(t, testscript.Params{
+		Dir:   "testdata/scripts/validate",
+		Setup: setupTestEnv,
+	})
+}
+
+func TestScriptException(t *testing.T) {
+	testscript.Run(t, testscript.Params{
+		Dir:   "testdata/scripts/exception",
+		Setup: setupTestEnv,
+	})
+}
+
+func TestScriptBackup(t *testing.T) {
+	testscript.Run(t, testscript.Params{
+		Dir:   "testdata/scripts/backup",
+		Setup: setupTestEnv,
+	})
+}
+
 func TestScriptDebug(t *testing.T) {
 	testscript.Run(t, testscript.Params{
 		Dir:   "testdata/scripts/debug",
@@ -0,0 +1,594 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+
+	internalconfig "github.com/smykla-skalski/klaudiush/internal/config"
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+	"github.com/smykla-skalski/klaudiush/pkg/runner"
+)
+
+// configWatchDebounce is how long the watcher waits after the last config
+// change before re-running validation. Editors often write a file in several
+// steps (temp file, rename, chmod).
+const configWatchDebounce = 100 * time.Millisecond
+
+// Output formats of the validate command.
+const (
+	validateFormatText  = "text"
+	validateFormatSARIF = "sarif"
+)
+
+var (
+	validateInput  string
+	validateWatch  bool
+	validateFormat string
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate a saved hook input and print the decision",
+	Long: `Run validation against a hook input read from --input or stdin and print
+the decision and findings.
+
+Without --watch, exits with code 1 when the decision is block.
+
+With --watch, the input is read once and validation re-runs whenever the
+global or project config file, or a rules.d file, changes. The configuration
+is reloaded and the validators rebuilt on every run, so rule changes show up
+immediately. Stop with Ctrl+C.
+
+With --all-files, no hook input is read. Instead, the file validators run on
+every file of the repository as if it were written with the Write tool, and
+the command exits with code 1 when any file is blocked. Files excluded by
+.gitignore or .klaudiushignore are skipped, and so are binary files. Add
+--staged-only to validate only the files staged for commit.
+
+With --format sarif, the findings are printed as a SARIF 2.1.0 log instead,
+for code scanning tools. Each reference code becomes a rule, blocking findings
+are errors and the others warnings, located at the file and line they report
+where known. It can't be combined with --watch.
+
+Examples:
+  klaudiush validate --input fixture.json
+  klaudiush validate --input fixture.json --watch
+  klaudiush validate --event PreToolUse < fixture.json
+  klaudiush validate --all-files
+  klaudiush validate --staged-only
+  klaudiush validate --all-files --format sarif > klaudiush.sarif`,
+	Args: cobra.NoArgs,
+	RunE: runValidate,
+}
+
+func init() {
+	validateCmd.Flags().StringVarP(
+		&validateInput,
+		"input",
+		"i",
+		"",
+		"Path to the hook input JSON (default: stdin)",
+	)
+	validateCmd.Flags().BoolVarP(
+		&validateWatch,
+		"watch",
+		"w",
+		false,
+		"Re-run validation whenever a config file changes",
+	)
+	validateCmd.Flags().StringVar(
+		&providerName,
+		"provider",
+		string(hook.ProviderClaude),
+		"Hook provider (claude, codex, gemini)",
+	)
+	validateCmd.Flags().StringVar(
+		&eventName,
+		"event",
+		"",
+		"Hook event name (provider-neutral or provider-specific)",
+	)
+	validateCmd.Flags().StringVar(
+		&validateFormat,
+		"format",
+		validateFormatText,
+		"Output format (text, sarif)",
+	)
+
+	rootCmd.AddCommand(validateCmd)
+}
+
+func runValidate(cmd *cobra.Command, _ []string) error {
+	if err := checkValidateFormat(); err != nil {
+		return err
+	}
+
+	if validateAllFiles || validateStagedOnly {
+		return runValidateFiles(cmd)
+	}
+
+	log := loggerFromCmd(cmd)
+	log.Info("validate command invoked", "input", validateInput, "watch", validateWatch)
+
+	input, err := readValidateInput()
+	if err != nil {
+		return err
+	}
+
+	hookCtx, err := parseValidateInput(input, log)
+	if err != nil {
+		return err
+	}
+
+	workDir := extractEffectiveWorkDir(hookCtx, log)
+
+	if !validateWatch {
+		decision, err := validateOnce(cmd.Context(), input, workDir, log)
+		if err != nil {
+			return err
+		}
+
+		if decision.Block {
+			return errors.New("validation blocked the operation")
+		}
+
+		return nil
+	}
+
+	return watchValidate(cmd.Context(), input, workDir, log)
+}
+
+// checkValidateFormat checks that --format names a known output format that
+// can be used with the other flags.
+func checkValidateFormat() error {
+	switch validateFormat {
+	case validateFormatText:
+		return nil
+	case validateFormatSARIF:
+		if validateWatch {
+			return errors.New("--format sarif can't be combined with --watch")
+		}
+
+		return nil
+	default:
+		return errors.Newf(
+			"unknown format %q: must be %s or %s",
+			validateFormat, validateFormatText, validateFormatSARIF,
+		)
+	}
+}
+
+// readValidateInput reads the hook input from --input or stdin.
+func readValidateInput() ([]byte, error) {
+	if validateInput == "" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read hook input from stdin")
+		}
+
+		return data, nil
+	}
+
+	data, err := os.ReadFile(validateInput)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read hook input %s", validateInput)
+	}
+
+	return data, nil
+}
+
+// parseValidateInput parses a hook input snapshot using the --provider and
+// --event flags.
+func parseValidateInput(input []byte, log logger.Logger) (*hook.Context, error) {
+	provider, eventType, requestedEventName, err := resolveHookInvocation()
+	if err != nil {
+		return nil, err
+	}
+
+	hookCtx, err := parseHookContext(
+		bytes.NewReader(input),
+		provider,
+		eventType,
+		requestedEventName,
+		log,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return hookCtx, nil
+}
+
+// validateOnce reloads the configuration, runs validation against the input
+// snapshot and prints the decision, or its findings with --format sarif.
+func validateOnce(
+	ctx context.Context,
+	input []byte,
+	workDir string,
+	log logger.Logger,
+) (*runner.Decision, error) {
+	// Parse again on every run so validators always see a fresh context.
+	hookCtx, err := parseValidateInput(input, log)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := loadConfig(log, workDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load configuration")
+	}
+
+	decision, err := runner.RunValidation(ctx, cfg, hookCtx, log, runner.WithWorkDir(workDir))
+	if err != nil {
+		return nil, err
+	}
+
+	if validateFormat == validateFormatSARIF {
+		if err := printSARIF([]dispatcher.FileErrors{
+			{
+				Path:   sarifPath(workDir, hookCtx.GetFilePath()),
+				Errors: validationErrors(decision.Errors),
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		return decision, nil
+	}
+
+	fmt.Print(formatDecision(decision))
+
+	return decision, nil
+}
+
+// printSARIF prints the findings of files as a SARIF log.
+func printSARIF(files []dispatcher.FileErrors) error {
+	data, err := dispatcher.FormatErrorsSARIF(files)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(data)
+
+	return errors.Wrap(err, "failed to write SARIF log")
+}
+
+// sarifPath returns path relative to root in slash form when it's under
+// root, as SARIF consumers resolve locations against the repository root.
+// Paths outside of root are kept as they are.
+func sarifPath(root, path string) string {
+	if path == "" || root == "" {
+		return filepath.ToSlash(path)
+	}
+
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return filepath.ToSlash(path)
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// formatDecision renders a decision and its findings, one per line.
+func formatDecision(decision *runner.Decision) string {
+	var b strings.Builder
+
+	verdict := "allow"
+	if decision.Block {
+		verdict = "block"
+	}
+
+	fmt.Fprintf(&b, "Decision: %s\n", verdict)
+	b.WriteString(formatFindings(validationErrors(decision.Errors)))
+
+	return b.String()
+}
+
+// formatFindings renders findings, one per indented line.
+func formatFindings(errs []*dispatcher.ValidationError) string {
+	var b strings.Builder
+
+	for _, e := range errs {
+		level := "warn"
+		if e.ShouldBlock {
+			level = "block"
+		}
+
+		code := ""
+		if e.Reference != "" {
+			code = " [" + e.Reference.Code() + "]"
+		}
+
+		message, _, _ := strings.Cut(strings.TrimSpace(e.Message), "\n")
+
+		fmt.Fprintf(&b, "  %s %s%s: %s\n", level, e.Validator, code, message)
+	}
+
+	return b.String()
+}
+
+// validationErrors converts the findings of a runner decision back to the
+// dispatcher type the hook response and report formatters take.
+func validationErrors(errs []*runner.Error) []*dispatcher.ValidationError {
+	if errs == nil {
+		return nil
+	}
+
+	result := make([]*dispatcher.ValidationError, len(errs))
+
+	for i, e := range errs {
+		result[i] = &dispatcher.ValidationError{
+			Validator:     e.Validator,
+			Message:       e.Message,
+			Details:       e.Details,
+			ShouldBlock:   e.ShouldBlock,
+			Informational: e.Informational,
+			Reference:     validator.Reference(e.Reference),
+			FixHint:       e.FixHint,
+			PluginDetails: e.PluginDetails,
+			UpdatedInput:  e.UpdatedInput,
+			Bypassed:      e.Bypassed,
+			BypassReason:  e.BypassReason,
+		}
+	}
+
+	return result
+}
+
+// watchValidate runs validation once, then again after every config change
+// until interrupted.
+func watchValidate(ctx context.Context, input []byte, workDir string, log logger.Logger) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	loader, err := newConfigLoader(workDir)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := newConfigWatcher(configWatchFiles(loader), configWatchRulesDirs(loader))
+	if err != nil {
+		return err
+	}
+
+	rerun := func() {
+		if _, err := validateOnce(ctx, input, workDir, log); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+	}
+
+	rerun()
+
+	fmt.Println("Watching config files for changes. Press Ctrl+C to stop.")
+
+	return watcher.Run(ctx, func() {
+		fmt.Printf("\nConfig changed at %s, re-running validation\n",
+			time.Now().Format(time.TimeOnly))
+		rerun()
+	})
+}
+
+// configWatchFiles returns the config files whose changes affect Load.
+func configWatchFiles(loader *internalconfig.KoanfLoader) []string {
+	files := append([]string{loader.GlobalConfigPath()}, loader.ProjectConfigPaths()...)
+
+	if projectPath := loader.FindProjectConfigPath(); projectPath != "" {
+		files = append(files, projectPath)
+	}
+
+	return files
+}
+
+// configWatchRulesDirs returns the rules.d directories read by Load.
+func configWatchRulesDirs(loader *internalconfig.KoanfLoader) []string {
+	dirs := []string{loader.GlobalRulesDir()}
+
+	if projectDir := loader.ProjectRulesDir(); projectDir != "" {
+		dirs = append(dirs, projectDir)
+	}
+
+	return dirs
+}
+
+// configWatcher reports changes to config files and to *.toml files in
+// rules.d directories. It watches the parent directories rather than the
+// files themselves, so editors that save by writing a temp file and renaming
+// it over the original are still seen. Directories that don't exist yet are
+// covered by watching their nearest existing parent until they appear.
+type configWatcher struct {
+	watcher   *fsnotify.Watcher
+	files     map[string]bool
+	rulesDirs map[string]bool
+	dirs      map[string]bool
+	watched   map[string]bool
+	debounce  time.Duration
+}
+
+// newConfigWatcher starts watching the given config files and rules.d
+// directories.
+func newConfigWatcher(files, rulesDirs []string) (*configWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create config watcher")
+	}
+
+	w := &configWatcher{
+		watcher:   watcher,
+		files:     make(map[string]bool, len(files)),
+		rulesDirs: make(map[string]bool, len(rulesDirs)),
+		dirs:      make(map[string]bool),
+		watched:   make(map[string]bool),
+		debounce:  configWatchDebounce,
+	}
+
+	for _, file := range files {
+		file = filepath.Clean(file)
+		w.files[file] = true
+		w.dirs[filepath.Dir(file)] = true
+	}
+
+	for _, dir := range rulesDirs {
+		dir = filepath.Clean(dir)
+		w.rulesDirs[dir] = true
+		w.dirs[dir] = true
+	}
+
+	if _, err := w.watchDirs(); err != nil {
+		_ = watcher.Close()
+
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// watchDirs watches each config directory that exists and the nearest
+// existing parent of each one that doesn't, so it is picked up once created.
+// Reports whether a newly watched config directory already holds config.
+func (w *configWatcher) watchDirs() (bool, error) {
+	appeared := false
+
+	for dir := range w.dirs {
+		target := existingDir(dir)
+		if target == "" || w.watched[target] {
+			continue
+		}
+
+		if err := w.watcher.Add(target); err != nil {
+			return false, errors.Wrapf(err, "failed to watch %s", target)
+		}
+
+		w.watched[target] = true
+
+		if w.dirs[target] && w.hasConfig(target) {
+			appeared = true
+		}
+	}
+
+	return appeared, nil
+}
+
+// follow updates the watches after event creates or removes a directory.
+// Reports whether a config directory appeared holding config, which a
+// watch added after the files were written would otherwise miss.
+func (w *configWatcher) follow(event fsnotify.Event) (bool, error) {
+	name := filepath.Clean(event.Name)
+
+	switch {
+	case event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename):
+		if !w.watched[name] {
+			return false, nil
+		}
+
+		// The watch may already be gone with the directory.
+		_ = w.watcher.Remove(name)
+
+		delete(w.watched, name)
+	case !event.Has(fsnotify.Create):
+		return false, nil
+	}
+
+	return w.watchDirs()
+}
+
+// hasConfig reports whether dir holds a watched config file or a rules
+// file.
+func (w *configWatcher) hasConfig(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		event := fsnotify.Event{Name: filepath.Join(dir, entry.Name()), Op: fsnotify.Create}
+		if w.isRelevant(event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// existingDir returns dir, or its nearest parent when dir doesn't exist.
+// Returns an empty string when no parent exists.
+func existingDir(dir string) string {
+	for {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+
+		dir = parent
+	}
+}
+
+// Run calls onChange after each burst of config changes until ctx is done.
+// The watcher is closed when Run returns.
+func (w *configWatcher) Run(ctx context.Context, onChange func()) error {
+	defer w.watcher.Close()
+
+	timer := time.NewTimer(w.debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			appeared, err := w.follow(event)
+			if err != nil {
+				return err
+			}
+
+			if appeared || w.isRelevant(event) {
+				timer.Reset(w.debounce)
+			}
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			return errors.Wrap(err, "config watcher failed")
+		case <-timer.C:
+			onChange()
+		}
+	}
+}
+
+// isRelevant reports whether event changes a watched config file or a
+// *.toml file in a watched rules.d directory.
+func (w *configWatcher) isRelevant(event fsnotify.Event) bool {
+	if event.Has(fsnotify.Chmod) && !event.Has(fsnotify.Write) {
+		return false
+	}
+
+	name := filepath.Clean(event.Name)
+	if w.files[name] {
+		return true
+	}
+
+	return w.rulesDirs[filepath.Dir(name)] && filepath.Ext(name) == ".toml"
+}
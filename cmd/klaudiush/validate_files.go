@@ -0,0 +1,263 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/smykla-skalski/klaudiush/internal/config/factory"
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/exec"
+	"github.com/smykla-skalski/klaudiush/internal/sweep"
+	"github.com/smykla-skalski/klaudiush/internal/validators"
+	gitvalidators "github.com/smykla-skalski/klaudiush/internal/validators/git"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+var (
+	validateAllFiles   bool
+	validateStagedOnly bool
+)
+
+func init() {
+	validateCmd.Flags().BoolVar(
+		&validateAllFiles,
+		"all-files",
+		false,
+		"Run the file validators on every file in the repository",
+	)
+	validateCmd.Flags().BoolVar(
+		&validateStagedOnly,
+		"staged-only",
+		false,
+		"Only validate the files staged for commit (implies --all-files)",
+	)
+}
+
+// fileSweepResult is the outcome of validating the files of a repository.
+type fileSweepResult struct {
+	Files   int
+	Blocked int
+	Warned  int
+
+	// Findings are the errors of the files with any, in sweep order.
+	Findings []dispatcher.FileErrors
+}
+
+// runValidateFiles runs the file validators on the files of the repository
+// containing the working directory, or of the working directory outside of
+// a repository, as if each file were written with the Write tool.
+func runValidateFiles(cmd *cobra.Command) error {
+	log := loggerFromCmd(cmd)
+
+	if validateInput != "" || validateWatch {
+		return errors.New("--all-files can't be combined with --input or --watch")
+	}
+
+	workDir, err := os.Getwd()
+	if err != nil {
+		return errors.Wrap(err, "failed to get working directory")
+	}
+
+	root, files, err := sweepFiles(workDir)
+	if err != nil {
+		return err
+	}
+
+	log.Info("validating files", "root", root, "files", len(files), "staged", validateStagedOnly)
+
+	cfg, err := loadConfig(log, root)
+	if err != nil {
+		return errors.Wrap(err, "failed to load configuration")
+	}
+
+	read := readWorktreeFile
+	if validateStagedOnly {
+		read = readStagedFile
+	}
+
+	result, err := validateFiles(cmd.Context(), cfg, root, files, read, log)
+	if err != nil {
+		return err
+	}
+
+	if validateFormat == validateFormatSARIF {
+		if err := printSARIF(result.Findings); err != nil {
+			return err
+		}
+	} else {
+		for _, findings := range result.Findings {
+			fmt.Printf("%s\n%s", findings.Path, formatFindings(findings.Errors))
+		}
+
+		fmt.Printf("Validated %d files: %d blocked, %d with warnings\n",
+			result.Files, result.Blocked, result.Warned)
+	}
+
+	if result.Blocked > 0 {
+		return errors.Newf("validation blocked %d files", result.Blocked)
+	}
+
+	return nil
+}
+
+// sweepFiles returns the root to validate and the files under it: the
+// staged files with --staged-only, every file that isn't ignored otherwise.
+func sweepFiles(workDir string) (string, []string, error) {
+	gitRunner := gitvalidators.NewGitRunnerForPath(workDir)
+
+	inRepo := gitRunner.IsInRepo()
+
+	root := workDir
+	if inRepo {
+		repoRoot, err := gitRunner.GetRepoRoot()
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to get repository root")
+		}
+
+		root = repoRoot
+	}
+
+	if !validateStagedOnly {
+		files, err := sweep.Files(root)
+
+		return root, files, err
+	}
+
+	if !inRepo {
+		return "", nil, errors.New("--staged-only requires a git repository")
+	}
+
+	staged, err := gitRunner.GetStagedFiles()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get staged files")
+	}
+
+	return root, sweep.Filter(root, staged), nil
+}
+
+// fileReader returns the content of the file rel, relative to root.
+type fileReader func(ctx context.Context, root, rel string) ([]byte, error)
+
+// readWorktreeFile returns the content of rel in the working tree.
+func readWorktreeFile(_ context.Context, root, rel string) ([]byte, error) {
+	path := filepath.Join(root, filepath.FromSlash(rel))
+
+	return os.ReadFile(path) //nolint:gosec // G304: files of the swept repository
+}
+
+// readStagedFile returns the content of rel staged in the index, which is
+// what gets committed even when the working tree has changed since.
+func readStagedFile(ctx context.Context, root, rel string) ([]byte, error) {
+	runner := exec.NewCommandRunner(commandRunTimeout)
+
+	result := runner.Run(ctx, "git", "-C", root, "show", ":"+rel)
+	if result.Err != nil {
+		return nil, errors.Wrapf(result.Err, "git show: %s", strings.TrimSpace(result.Stderr))
+	}
+
+	return []byte(result.Stdout), nil
+}
+
+// validateFiles dispatches a synthetic Write of each file under root with
+// its content returned by read and collects the findings per file. Binary
+// files are skipped.
+func validateFiles(
+	ctx context.Context,
+	cfg *config.Config,
+	root string,
+	files []string,
+	read fileReader,
+	log logger.Logger,
+) (*fileSweepResult, error) {
+	builder := factory.NewRegistryBuilder(log)
+
+	registry, _, err := builder.BuildWithRuleEngine(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build validator registry")
+	}
+
+	defer func() {
+		if closeErr := builder.Close(); closeErr != nil {
+			log.Debug("failed to close validators", "error", closeErr)
+		}
+	}()
+
+	disp := dispatcher.NewDispatcherWithOptions(
+		registry,
+		log,
+		dispatcher.NewSequentialExecutor(log),
+		dispatcher.WithOverrides(cfg.Overrides),
+		dispatcher.WithTimeout(
+			cfg.Global.GetHookTimeout(),
+			cfg.Global.IsFailClosedOnTimeout(),
+		),
+		dispatcher.WithMaxSeverity(cfg.Global.GetMaxSeverity()),
+	)
+
+	result := &fileSweepResult{}
+
+	for _, rel := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		path := filepath.Join(root, filepath.FromSlash(rel))
+
+		data, err := read(ctx, root, rel)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", rel)
+		}
+
+		content := string(data)
+
+		if validators.IsBinaryContent(content) {
+			log.Debug("skipping binary file", "file", rel)
+
+			continue
+		}
+
+		result.Files++
+
+		errs := disp.Dispatch(ctx, fileWriteContext(root, path, content))
+		if len(errs) == 0 {
+			continue
+		}
+
+		if dispatcher.ShouldBlock(errs) {
+			result.Blocked++
+		} else {
+			result.Warned++
+		}
+
+		result.Findings = append(result.Findings, dispatcher.FileErrors{Path: rel, Errors: errs})
+	}
+
+	return result, nil
+}
+
+// fileWriteContext returns a PreToolUse context writing content to path.
+func fileWriteContext(root, path, content string) *hook.Context {
+	return &hook.Context{
+		Provider:      hook.ProviderClaude,
+		Event:         hook.CanonicalEventBeforeTool,
+		RawEventName:  hook.EventTypePreToolUse.String(),
+		EventType:     hook.EventTypePreToolUse,
+		RawToolName:   hook.ToolTypeWrite.String(),
+		ToolFamily:    hook.ToolFamilyWrite,
+		ToolName:      hook.ToolTypeWrite,
+		WorkingDir:    root,
+		AffectedPaths: []string{path},
+		ToolInput: hook.ToolInput{
+			FilePath: path,
+			Content:  content,
+		},
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("configWatcher", func() {
+	var (
+		dir      string
+		rulesDir string
+		config   string
+		changes  chan struct{}
+		cancel   context.CancelFunc
+		done     chan error
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		rulesDir = filepath.Join(dir, "rules.d")
+		config = filepath.Join(dir, "config.toml")
+
+		Expect(os.MkdirAll(rulesDir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(config, []byte("[rules]\n"), 0o600)).To(Succeed())
+
+		w, err := newConfigWatcher([]string{config}, []string{rulesDir})
+		Expect(err).NotTo(HaveOccurred())
+
+		w.debounce = 10 * time.Millisecond
+
+		var ctx context.Context
+
+		ctx, cancel = context.WithCancel(context.Background())
+		changes = make(chan struct{}, 10)
+		done = make(chan error, 1)
+
+		go func() {
+			done <- w.Run(ctx, func() { changes <- struct{}{} })
+		}()
+
+		DeferCleanup(func() {
+			cancel()
+			Eventually(done).Should(Receive(BeNil()))
+		})
+	})
+
+	It("reports in-place writes to a config file", func() {
+		Expect(os.WriteFile(config, []byte("[rules]\nenabled = true\n"), 0o600)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+	})
+
+	It("reports atomic saves that rename a temp file over the config file", func() {
+		tmp := filepath.Join(dir, ".config.toml.swp")
+		Expect(os.WriteFile(tmp, []byte("[rules]\nenabled = false\n"), 0o600)).To(Succeed())
+		Expect(os.Rename(tmp, config)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+
+		// The directory watch survives the rename, so later saves are seen too.
+		Expect(os.WriteFile(config, []byte("[rules]\n"), 0o600)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+	})
+
+	It("reports new toml files in a rules.d directory", func() {
+		rulesFile := filepath.Join(rulesDir, "10-git.toml")
+		Expect(os.WriteFile(rulesFile, []byte("[[rules.rules]]\n"), 0o600)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+	})
+
+	It("coalesces a burst of writes into one change", func() {
+		for range 5 {
+			Expect(os.WriteFile(config, []byte("[rules]\n"), 0o600)).To(Succeed())
+		}
+
+		Eventually(changes).Should(Receive())
+		Consistently(changes, 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("ignores unrelated files", func() {
+		Expect(os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(rulesDir, "README.md"), nil, 0o600)).To(Succeed())
+
+		Consistently(changes, 100*time.Millisecond).ShouldNot(Receive())
+	})
+})
+
+var _ = Describe("configWatcher with missing directories", func() {
+	var (
+		dir        string
+		projectDir string
+		rulesDir   string
+		config     string
+		changes    chan struct{}
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		projectDir = filepath.Join(dir, ".klaudiush")
+		rulesDir = filepath.Join(projectDir, "rules.d")
+		config = filepath.Join(projectDir, "config.toml")
+
+		w, err := newConfigWatcher([]string{config}, []string{rulesDir})
+		Expect(err).NotTo(HaveOccurred())
+
+		w.debounce = 10 * time.Millisecond
+
+		ctx, cancel := context.WithCancel(context.Background())
+		changes = make(chan struct{}, 10)
+		done := make(chan error, 1)
+
+		go func() {
+			done <- w.Run(ctx, func() { changes <- struct{}{} })
+		}()
+
+		DeferCleanup(func() {
+			cancel()
+			Eventually(done).Should(Receive(BeNil()))
+		})
+	})
+
+	It("picks up directories created after the watch started", func() {
+		Expect(os.MkdirAll(rulesDir, 0o755)).To(Succeed())
+
+		Consistently(changes, 100*time.Millisecond).ShouldNot(Receive())
+
+		Expect(os.WriteFile(config, []byte("[rules]\n"), 0o600)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+
+		rulesFile := filepath.Join(rulesDir, "10-git.toml")
+		Expect(os.WriteFile(rulesFile, []byte("[[rules.rules]]\n"), 0o600)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+	})
+
+	It("reports a directory that appears already holding config", func() {
+		staging := filepath.Join(dir, "staging")
+		Expect(os.MkdirAll(filepath.Join(staging, "rules.d"), 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(staging, "config.toml"), nil, 0o600)).To(Succeed())
+
+		Expect(os.Rename(staging, projectDir)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+	})
+
+	It("keeps following a directory that is removed and created again", func() {
+		Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(config, []byte("[rules]\n"), 0o600)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+
+		Expect(os.RemoveAll(projectDir)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+
+		Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(config, []byte("[rules]\n"), 0o600)).To(Succeed())
+
+		Eventually(changes).Should(Receive())
+	})
+})
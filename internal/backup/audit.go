@@ -28,6 +28,7 @@ const (
 	OperationRestore = "restore"
 	OperationDelete  = "delete"
 	OperationPrune   = "prune"
+	OperationGC      = "gc"
 	OperationList    = "list"
 	OperationGet     = "get"
 )
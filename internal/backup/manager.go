@@ -2,6 +2,7 @@ package backup
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -20,6 +21,9 @@ var (
 	ErrBackupDisabled = errors.New("backup system is disabled")
 )
 
+// StdinConfigPath labels snapshots of content that was not read from a file.
+const StdinConfigPath = "<stdin>"
+
 // Manager orchestrates backup operations.
 type Manager struct {
 	// storage provides persistence for snapshots.
@@ -75,6 +79,9 @@ type CreateBackupOptions struct {
 	// ConfigPath is the absolute path to the config file.
 	ConfigPath string
 
+	// ConfigType overrides the config type inferred from ConfigPath.
+	ConfigType ConfigType
+
 	// Trigger indicates what caused this backup.
 	Trigger Trigger
 
@@ -98,6 +105,36 @@ func (m *Manager) CreateBackup(opts CreateBackupOptions) (*Snapshot, error) {
 		return nil, errors.Wrap(err, "failed to read config file")
 	}
 
+	return m.createBackupFromData(data, opts)
+}
+
+// CreateBackupFromReader creates a backup snapshot of the content read from r,
+// with the same deduplication as CreateBackup. opts.ConfigPath is only a label
+// and defaults to StdinConfigPath.
+func (m *Manager) CreateBackupFromReader(
+	r io.Reader,
+	opts CreateBackupOptions,
+) (*Snapshot, error) {
+	if !m.config.IsEnabled() {
+		return nil, ErrBackupDisabled
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read backup content")
+	}
+
+	if opts.ConfigPath == "" {
+		opts.ConfigPath = StdinConfigPath
+	}
+
+	return m.createBackupFromData(data, opts)
+}
+
+// createBackupFromData stores data as a snapshot unless identical content
+// is already backed up. The storage is locked from loading the index until
+// the snapshot is in it, so garbage collection never sees the file unlisted.
+func (m *Manager) createBackupFromData(data []byte, opts CreateBackupOptions) (*Snapshot, error) {
 	// Initialize storage if needed
 	if !m.storage.Exists() {
 		if initErr := m.storage.Initialize(); initErr != nil {
@@ -105,6 +142,12 @@ func (m *Manager) CreateBackup(opts CreateBackupOptions) (*Snapshot, error) {
 		}
 	}
 
+	unlock, err := m.storage.Lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
 	// Load index
 	index, err := m.storage.LoadIndex()
 	if err != nil {
@@ -315,6 +358,12 @@ func (m *Manager) ApplyRetention(policy RetentionPolicy) (*RetentionResult, erro
 		return &RetentionResult{}, nil
 	}
 
+	unlock, err := m.storage.Lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
 	// Load index
 	index, err := m.storage.LoadIndex()
 	if err != nil {
@@ -408,6 +457,136 @@ func (m *Manager) ApplyRetention(policy RetentionPolicy) (*RetentionResult, erro
 	}, nil
 }
 
+// GCResult contains information about garbage collection.
+type GCResult struct {
+	// OrphanedFiles contains the storage paths of files no index entry
+	// references.
+	OrphanedFiles []string
+
+	// DanglingSnapshots contains the IDs of index entries whose storage file
+	// is missing.
+	DanglingSnapshots []string
+
+	// BytesFreed is the number of bytes freed by removing orphaned files.
+	BytesFreed int64
+}
+
+// GarbageCollect reconciles the storage with the index. It removes the
+// snapshot files no index entry references, which crashes between saving a
+// file and the index can leave behind, and drops the index entries whose file
+// is missing. The storage is locked throughout, so snapshots being created
+// concurrently are not mistaken for orphans. With dryRun, it only reports
+// what it would remove.
+func (m *Manager) GarbageCollect(dryRun bool) (*GCResult, error) {
+	if !m.config.IsEnabled() {
+		return nil, ErrBackupDisabled
+	}
+
+	result := &GCResult{}
+
+	if !m.storage.Exists() {
+		return result, nil
+	}
+
+	unlock, err := m.storage.Lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	index, err := m.storage.LoadIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load index")
+	}
+
+	paths, err := m.storage.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list snapshot files")
+	}
+
+	stored := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		stored[path] = true
+	}
+
+	referenced := make(map[string]bool)
+
+	for _, snapshot := range index.List() {
+		if stored[snapshot.StoragePath] {
+			referenced[snapshot.StoragePath] = true
+
+			continue
+		}
+
+		if !dryRun {
+			if err := index.Delete(snapshot.ID); err != nil {
+				continue
+			}
+		}
+
+		result.DanglingSnapshots = append(result.DanglingSnapshots, snapshot.ID)
+	}
+
+	for _, path := range paths {
+		if referenced[path] {
+			continue
+		}
+
+		size := m.storedSize(path)
+
+		if !dryRun {
+			// Continue with the other files even if one fails
+			if err := m.storage.Delete(path); err != nil {
+				continue
+			}
+		}
+
+		result.OrphanedFiles = append(result.OrphanedFiles, path)
+		result.BytesFreed += size
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	if len(result.DanglingSnapshots) > 0 {
+		if err := m.storage.SaveIndex(index); err != nil {
+			m.logAuditEntry(AuditEntry{
+				Timestamp: time.Now(),
+				Operation: OperationGC,
+				Success:   false,
+				Error:     err.Error(),
+			})
+
+			return nil, errors.Wrap(err, "failed to save index after garbage collection")
+		}
+	}
+
+	m.logAuditEntry(AuditEntry{
+		Timestamp: time.Now(),
+		Operation: OperationGC,
+		Success:   true,
+		Extra: map[string]any{
+			"orphaned_files":     len(result.OrphanedFiles),
+			"dangling_snapshots": len(result.DanglingSnapshots),
+			"bytes_freed":        result.BytesFreed,
+		},
+	})
+
+	return result, nil
+}
+
+// storedSize returns the size of the stored file at path, or 0 when it can't
+// be read.
+func (m *Manager) storedSize(path string) int64 {
+	data, err := m.storage.Load(path)
+	if err != nil {
+		return 0
+	}
+
+	return int64(len(data))
+}
+
 // RestoreSnapshot restores a snapshot to a target path.
 func (m *Manager) RestoreSnapshot(
 	snapshotID string,
@@ -500,7 +679,10 @@ func (m *Manager) createSnapshotRecord(
 	chainID string,
 	opts CreateBackupOptions,
 ) Snapshot {
-	configType := m.determineConfigType(configPath)
+	configType := opts.ConfigType
+	if configType == "" {
+		configType = m.determineConfigType(configPath)
+	}
 
 	return Snapshot{
 		ID:             snapshotID,
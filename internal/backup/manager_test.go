@@ -1,8 +1,11 @@
 package backup_test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -109,6 +112,19 @@ var _ = Describe("Manager", func() {
 			Expect(snapshot.Metadata.ConfigHash).NotTo(BeEmpty())
 		})
 
+		It("uses the config type from options", func() {
+			opts := backup.CreateBackupOptions{
+				ConfigPath: configPath,
+				ConfigType: backup.ConfigTypeProject,
+				Trigger:    backup.TriggerManual,
+			}
+
+			snapshot, err := manager.CreateBackup(opts)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.ConfigType).To(Equal(backup.ConfigTypeProject))
+		})
+
 		It("automatically initializes storage", func() {
 			Expect(storage.Exists()).To(BeFalse())
 
@@ -339,6 +355,70 @@ var _ = Describe("Manager", func() {
 		})
 	})
 
+	Describe("CreateBackupFromReader", func() {
+		It("creates a snapshot labeled as stdin", func() {
+			snapshot, err := manager.CreateBackupFromReader(
+				strings.NewReader("generated = true"),
+				backup.CreateBackupOptions{
+					ConfigType: backup.ConfigTypeProject,
+					Trigger:    backup.TriggerManual,
+				},
+			)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.ConfigPath).To(Equal(backup.StdinConfigPath))
+			Expect(snapshot.ConfigType).To(Equal(backup.ConfigTypeProject))
+			Expect(snapshot.Size).To(Equal(int64(len("generated = true"))))
+
+			content, err := storage.Load(snapshot.StoragePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("generated = true"))
+		})
+
+		It("returns the same snapshot for identical content", func() {
+			opts := backup.CreateBackupOptions{Trigger: backup.TriggerManual}
+
+			snapshot1, err := manager.CreateBackupFromReader(strings.NewReader("a = 1"), opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			snapshot2, err := manager.CreateBackupFromReader(strings.NewReader("a = 1"), opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(snapshot2.ID).To(Equal(snapshot1.ID))
+
+			snapshots, err := manager.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots).To(HaveLen(1))
+		})
+
+		It("deduplicates against file backups with the same content", func() {
+			fileSnapshot, err := manager.CreateBackup(backup.CreateBackupOptions{
+				ConfigPath: configPath,
+				Trigger:    backup.TriggerManual,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			stdinSnapshot, err := manager.CreateBackupFromReader(
+				strings.NewReader("test = true"),
+				backup.CreateBackupOptions{Trigger: backup.TriggerManual},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdinSnapshot.ID).To(Equal(fileSnapshot.ID))
+		})
+
+		It("returns error when backup is disabled", func() {
+			disabled := false
+			cfg.Enabled = &disabled
+
+			_, err := manager.CreateBackupFromReader(
+				strings.NewReader("a = 1"),
+				backup.CreateBackupOptions{},
+			)
+
+			Expect(err).To(MatchError(backup.ErrBackupDisabled))
+		})
+	})
+
 	Describe("List", func() {
 		BeforeEach(func() {
 			err := storage.Initialize()
@@ -768,6 +848,123 @@ var _ = Describe("Manager", func() {
 		})
 	})
 
+	Describe("GarbageCollect", func() {
+		var (
+			kept     *backup.Snapshot
+			dangling *backup.Snapshot
+			orphan   string
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			opts := backup.CreateBackupOptions{
+				ConfigPath: configPath,
+				Trigger:    backup.TriggerManual,
+			}
+
+			kept, err = manager.CreateBackup(opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.WriteFile(configPath, []byte("test = false"), 0o600)).To(Succeed())
+
+			dangling, err = manager.CreateBackup(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Remove(dangling.StoragePath)).To(Succeed())
+
+			orphan, err = storage.Save("orphan", []byte("leftover"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns error when backup is disabled", func() {
+			disabled := false
+			cfg.Enabled = &disabled
+
+			_, err := manager.GarbageCollect(false)
+			Expect(err).To(MatchError(backup.ErrBackupDisabled))
+		})
+
+		It("removes orphaned files and dangling index entries", func() {
+			result, err := manager.GarbageCollect(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.OrphanedFiles).To(ConsistOf(orphan))
+			Expect(result.DanglingSnapshots).To(ConsistOf(dangling.ID))
+			Expect(result.BytesFreed).To(Equal(int64(len("leftover"))))
+
+			Expect(orphan).NotTo(BeAnExistingFile())
+			Expect(kept.StoragePath).To(BeAnExistingFile())
+
+			snapshots, err := manager.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots).To(HaveLen(1))
+			Expect(snapshots[0].ID).To(Equal(kept.ID))
+
+			result, err = manager.GarbageCollect(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.OrphanedFiles).To(BeEmpty())
+			Expect(result.DanglingSnapshots).To(BeEmpty())
+		})
+
+		It("keeps snapshots created concurrently by other managers", func() {
+			const creators = 8
+
+			var wg sync.WaitGroup
+
+			created := make([]*backup.Snapshot, creators)
+
+			for i := range creators {
+				// Separate storages share no state, like separate processes.
+				other, err := backup.NewFilesystemStorage(tmpDir, backup.ConfigTypeGlobal, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				otherManager, err := backup.NewManager(other, cfg)
+				Expect(err).NotTo(HaveOccurred())
+
+				wg.Go(func() {
+					defer GinkgoRecover()
+
+					snapshot, err := otherManager.CreateBackupFromReader(
+						strings.NewReader(fmt.Sprintf("test = %d", i)),
+						backup.CreateBackupOptions{Trigger: backup.TriggerManual},
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					created[i] = snapshot
+				})
+
+				wg.Go(func() {
+					defer GinkgoRecover()
+
+					_, err := manager.GarbageCollect(false)
+					Expect(err).NotTo(HaveOccurred())
+				})
+			}
+
+			wg.Wait()
+
+			snapshots, err := manager.List()
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, snapshot := range created {
+				Expect(snapshot.StoragePath).To(BeAnExistingFile())
+				Expect(snapshots).To(ContainElement(HaveField("ID", snapshot.ID)))
+			}
+		})
+
+		It("only reports in dry run mode", func() {
+			result, err := manager.GarbageCollect(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.OrphanedFiles).To(ConsistOf(orphan))
+			Expect(result.DanglingSnapshots).To(ConsistOf(dangling.ID))
+
+			Expect(orphan).To(BeAnExistingFile())
+
+			snapshots, err := manager.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots).To(HaveLen(2))
+		})
+	})
+
 	Describe("NewManagerWithAudit", func() {
 		var auditLogger backup.AuditLogger
 
@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 
 	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
 )
 
 var (
@@ -93,6 +95,10 @@ func (r *Restorer) RestoreSnapshot(
 		return nil, ErrTargetPathRequired
 	}
 
+	if targetPath == StdinConfigPath {
+		return nil, errors.Wrap(ErrTargetPathRequired, "snapshot was created from stdin")
+	}
+
 	// Validate snapshot if requested
 	if opts.Validate {
 		if err := r.ValidateSnapshot(snapshot); err != nil {
@@ -150,8 +156,9 @@ func (r *Restorer) RestoreSnapshot(
 		return nil, errors.Wrap(err, "failed to create target directory")
 	}
 
-	// Write to target path
-	if err := os.WriteFile(targetPath, content, FilePerm); err != nil {
+	// Replace the target atomically so an interrupted restore never leaves a
+	// truncated config behind
+	if err := fileutil.WriteFileAtomic(targetPath, content, FilePerm); err != nil {
 		return nil, errors.Wrap(err, "failed to write restored content")
 	}
 
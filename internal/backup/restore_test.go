@@ -223,6 +223,16 @@ var _ = Describe("Restore", func() {
 			Expect(restoredContent).To(Equal(testContent))
 		})
 
+		It("should require a target path for stdin snapshots", func() {
+			stdinSnapshot := *snapshot
+			stdinSnapshot.ConfigPath = backup.StdinConfigPath
+
+			_, err := restorer.RestoreSnapshot(&stdinSnapshot, backup.RestoreOptions{})
+
+			Expect(err).To(MatchError(backup.ErrTargetPathRequired))
+			Expect(err.Error()).To(ContainSubstring("created from stdin"))
+		})
+
 		It("should create backup before restore if requested", func() {
 			// Create existing file at target
 			existingContent := []byte("existing content")
@@ -10,6 +10,8 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
 )
 
 var (
@@ -36,6 +38,10 @@ const (
 	// MetadataFile is the filename for the snapshot index.
 	MetadataFile = "metadata.json"
 
+	// LockFile is the filename of the lock held while the snapshot files
+	// and the index are changed.
+	LockFile = "metadata.lock"
+
 	// AuditFile is the filename for the audit log.
 	AuditFile = "audit.jsonl"
 
@@ -74,6 +80,10 @@ type Storage interface {
 
 	// Initialize creates the storage directory structure.
 	Initialize() error
+
+	// Lock blocks until the storage is exclusively held, across processes,
+	// and returns a function releasing it.
+	Lock() (func(), error)
 }
 
 // FilesystemStorage implements Storage using the local filesystem.
@@ -160,6 +170,21 @@ func (f *FilesystemStorage) Initialize() error {
 	return nil
 }
 
+// Lock takes the lock file in the storage root and returns a function
+// releasing it.
+func (f *FilesystemStorage) Lock() (func(), error) {
+	if !f.Exists() {
+		return nil, errors.Wrap(ErrStorageNotInitialized, "call Initialize() first")
+	}
+
+	lock, err := fileutil.LockFile(filepath.Join(f.getStorageRoot(), LockFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to lock backup storage")
+	}
+
+	return func() { _ = lock.Unlock() }, nil
+}
+
 // Save stores snapshot data and returns the storage path.
 func (f *FilesystemStorage) Save(snapshotID string, data []byte) (string, error) {
 	if !f.Exists() {
@@ -169,7 +194,7 @@ func (f *FilesystemStorage) Save(snapshotID string, data []byte) (string, error)
 	filename := snapshotID
 	storagePath := filepath.Join(f.getSnapshotsDir(), filename)
 
-	if err := os.WriteFile(storagePath, data, FilePerm); err != nil {
+	if err := fileutil.WriteFileAtomic(storagePath, data, FilePerm); err != nil {
 		return "", errors.Wrap(err, "failed to write snapshot data")
 	}
 
@@ -220,7 +245,7 @@ func (f *FilesystemStorage) List() ([]string, error) {
 	paths := make([]string, 0, len(entries))
 
 	for _, entry := range entries {
-		if !entry.IsDir() && entry.Name() != MetadataFile {
+		if !entry.IsDir() && entry.Name() != MetadataFile && !fileutil.IsTempFile(entry.Name()) {
 			paths = append(paths, filepath.Join(snapshotsDir, entry.Name()))
 		}
 	}
@@ -228,7 +253,8 @@ func (f *FilesystemStorage) List() ([]string, error) {
 	return paths, nil
 }
 
-// SaveIndex saves the snapshot index.
+// SaveIndex saves the snapshot index. The index is replaced atomically, so an
+// interrupted save leaves the previous index intact.
 func (f *FilesystemStorage) SaveIndex(index *SnapshotIndex) error {
 	metadataPath := f.getMetadataPath()
 
@@ -237,7 +263,7 @@ func (f *FilesystemStorage) SaveIndex(index *SnapshotIndex) error {
 		return errors.Wrap(err, "failed to marshal index")
 	}
 
-	if err := os.WriteFile(metadataPath, data, FilePerm); err != nil {
+	if err := fileutil.WriteFileAtomic(metadataPath, data, FilePerm); err != nil {
 		return errors.Wrap(err, "failed to write index")
 	}
 
@@ -126,6 +126,21 @@ func (mr *MockStorageMockRecorder) LoadIndex() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadIndex", reflect.TypeOf((*MockStorage)(nil).LoadIndex))
 }
 
+// Lock mocks base method.
+func (m *MockStorage) Lock() (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock")
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockStorageMockRecorder) Lock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockStorage)(nil).Lock))
+}
+
 // Save mocks base method.
 func (m *MockStorage) Save(snapshotID string, data []byte) (string, error) {
 	m.ctrl.T.Helper()
@@ -381,3 +381,33 @@ var _ = Describe("Storage", func() {
 		})
 	})
 })
+
+var _ = Describe("FilesystemStorage after an interrupted write", func() {
+	var (
+		storage *backup.FilesystemStorage
+		kept    string
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		storage, err = backup.NewFilesystemStorage(GinkgoT().TempDir(), backup.ConfigTypeGlobal, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(storage.Initialize()).To(Succeed())
+
+		kept, err = storage.Save("kept", []byte("snapshot"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("ignores leftover temp files", func() {
+		// A process killed mid-write leaves its fully written temp file
+		// behind, without renaming it into place.
+		Expect(os.WriteFile(
+			filepath.Join(filepath.Dir(kept), ".lost.1234.tmp"), []byte("snapshot"), 0o600,
+		)).To(Succeed())
+
+		paths, err := storage.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(ConsistOf(kept))
+	})
+})
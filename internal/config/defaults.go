@@ -86,6 +86,22 @@ func DefaultGlobalConfig() *config.GlobalConfig {
 	return &config.GlobalConfig{
 		UseSDKGit:      &useSDKGit,
 		DefaultTimeout: config.Duration(DefaultTimeout),
+		ResultSink:     config.ResultSinkStderr,
+		ResultFile:     xdg.ResultsFile(),
+		Log:            DefaultLogConfig(),
+	}
+}
+
+// DefaultLogConfig returns the default log file configuration.
+func DefaultLogConfig() *config.LogConfig {
+	maxSizeMB := config.DefaultLogMaxSizeMB
+	maxBackups := config.DefaultLogMaxBackups
+	compress := false
+
+	return &config.LogConfig{
+		MaxSizeMB:  &maxSizeMB,
+		MaxBackups: &maxBackups,
+		Compress:   &compress,
 	}
 }
 
@@ -115,7 +131,9 @@ func DefaultGitConfig() *config.GitConfig {
 // DefaultGitHubConfig returns the default GitHub CLI validators configuration.
 func DefaultGitHubConfig() *config.GitHubConfig {
 	return &config.GitHubConfig{
-		Issue: DefaultIssueValidatorConfig(),
+		Issue:   DefaultIssueValidatorConfig(),
+		Release: DefaultReleaseValidatorConfig(),
+		PRMerge: DefaultPRMergeValidatorConfig(),
 	}
 }
 
@@ -140,6 +158,36 @@ func DefaultIssueValidatorConfig() *config.IssueValidatorConfig {
 	}
 }
 
+// DefaultReleaseValidatorConfig returns the default release validator configuration.
+func DefaultReleaseValidatorConfig() *config.ReleaseValidatorConfig {
+	enabled := true
+	requireNotes := false
+
+	return &config.ReleaseValidatorConfig{
+		ValidatorConfig: config.ValidatorConfig{
+			Enabled:  &enabled,
+			Severity: config.SeverityError,
+		},
+		RequireNotes: &requireNotes,
+	}
+}
+
+// DefaultPRMergeValidatorConfig returns the default PR merge validator configuration.
+func DefaultPRMergeValidatorConfig() *config.PRMergeValidatorConfig {
+	enabled := true
+	requireDeleteBranch := false
+	skipAutoMerge := false
+
+	return &config.PRMergeValidatorConfig{
+		ValidatorConfig: config.ValidatorConfig{
+			Enabled:  &enabled,
+			Severity: config.SeverityError,
+		},
+		RequireDeleteBranch: &requireDeleteBranch,
+		SkipAutoMerge:       &skipAutoMerge,
+	}
+}
+
 // DefaultFileConfig returns the default file validators configuration.
 func DefaultFileConfig() *config.FileConfig {
 	return &config.FileConfig{
@@ -164,15 +212,28 @@ func DefaultNotificationConfig() *config.NotificationConfig {
 func DefaultCommitValidatorConfig() *config.CommitValidatorConfig {
 	enabled := true
 	checkStagingArea := true
+	maxDiffLines := 0
+	maxDiffBytes := 0
+	blockOnLargeDiff := false
+	blockAmendPushed := true
+	requireChangelogFragment := false
 
 	return &config.CommitValidatorConfig{
 		ValidatorConfig: config.ValidatorConfig{
 			Enabled:  &enabled,
 			Severity: config.SeverityError,
 		},
-		RequiredFlags:    []string{"-s", "-S"},
-		CheckStagingArea: &checkStagingArea,
-		Message:          DefaultCommitMessageConfig(),
+		RequiredFlags:            []string{"-s", "-S"},
+		CheckStagingArea:         &checkStagingArea,
+		MaxDiffLines:             &maxDiffLines,
+		MaxDiffBytes:             &maxDiffBytes,
+		DiffSizeExclude:          []string{},
+		BlockOnLargeDiff:         &blockOnLargeDiff,
+		BlockAmendPushed:         &blockAmendPushed,
+		RequireChangelogFragment: &requireChangelogFragment,
+		ChangelogDir:             "changelog.d",
+		ExemptTypes:              []string{"chore", "docs"},
+		Message:                  DefaultCommitMessageConfig(),
 	}
 }
 
@@ -187,6 +248,8 @@ func DefaultCommitMessageConfig() *config.CommitMessageConfig {
 	blockInfraScopeMisuse := true
 	blockPRReferences := true
 	blockAIAttribution := true
+	requireGitmoji := false
+	blockEmoji := false
 
 	return &config.CommitMessageConfig{
 		Enabled:               &enabled,
@@ -212,6 +275,8 @@ func DefaultCommitMessageConfig() *config.CommitMessageConfig {
 			"test",
 		},
 		ExpectedSignoff: "",
+		RequireGitmoji:  &requireGitmoji,
+		BlockEmoji:      &blockEmoji,
 	}
 }
 
@@ -219,14 +284,22 @@ func DefaultCommitMessageConfig() *config.CommitMessageConfig {
 func DefaultPushValidatorConfig() *config.PushValidatorConfig {
 	enabled := true
 	requireTracking := true
+	blockTagPush := false
 
 	return &config.PushValidatorConfig{
 		ValidatorConfig: config.ValidatorConfig{
 			Enabled:  &enabled,
 			Severity: config.SeverityError,
 		},
-		BlockedRemotes:  []string{},
-		RequireTracking: &requireTracking,
+		BlockedRemotes:       []string{},
+		AllowedRemotes:       []string{},
+		RequireTracking:      &requireTracking,
+		BlockedCommitMarkers: []string{"WIP", "DO NOT MERGE", "DONOTMERGE", "[ci skip]"},
+		CommitMarkerBranches: []string{"main", "master"},
+		CommitMarkerSeverity: config.SeverityError,
+		BlockTagPush:         &blockTagPush,
+		TagPushRemotes:       []string{},
+		TagPushSeverity:      config.SeverityError,
 	}
 }
 
@@ -245,13 +318,18 @@ func DefaultFetchValidatorConfig() *config.FetchValidatorConfig {
 // DefaultAddValidatorConfig returns the default add validator configuration.
 func DefaultAddValidatorConfig() *config.AddValidatorConfig {
 	enabled := true
+	maxAddFileSizeKB := 0
+	blockOnLargeFiles := false
 
 	return &config.AddValidatorConfig{
 		ValidatorConfig: config.ValidatorConfig{
 			Enabled:  &enabled,
 			Severity: config.SeverityError,
 		},
-		BlockedPatterns: []string{"tmp/*"},
+		BlockedPatterns:         []string{"tmp/*"},
+		MaxAddFileSizeKB:        &maxAddFileSizeKB,
+		BlockedBinaryExtensions: []string{},
+		BlockOnLargeFiles:       &blockOnLargeFiles,
 	}
 }
 
@@ -340,6 +418,7 @@ func DefaultMarkdownValidatorConfig() *config.MarkdownValidatorConfig {
 	codeBlockFormatting := true
 	listFormatting := true
 	useMarkdownlint := true
+	requireFrontMatter := false
 
 	return &config.MarkdownValidatorConfig{
 		ValidatorConfig: config.ValidatorConfig{
@@ -356,6 +435,8 @@ func DefaultMarkdownValidatorConfig() *config.MarkdownValidatorConfig {
 			"MD013": false, // line-length disabled by default
 			"MD034": false, // bare URLs disabled by default (common in code blocks)
 		},
+		RequireFrontMatter:      &requireFrontMatter,
+		RequiredFrontMatterKeys: []string{},
 	}
 }
 
@@ -385,6 +466,9 @@ func DefaultTerraformValidatorConfig() *config.TerraformValidatorConfig {
 	contextLines := 2
 	checkFormat := true
 	useTflint := true
+	requireVersion := false
+	requireProviders := false
+	blockOnMissing := false
 
 	return &config.TerraformValidatorConfig{
 		ValidatorConfig: config.ValidatorConfig{
@@ -399,6 +483,10 @@ func DefaultTerraformValidatorConfig() *config.TerraformValidatorConfig {
 		TerraformPath:  "",
 		TofuPath:       "",
 		TflintPath:     "",
+
+		RequireTerraformVersionConstraint: &requireVersion,
+		RequireProviderVersionConstraints: &requireProviders,
+		BlockOnMissingVersionConstraints:  &blockOnMissing,
 	}
 }
 
@@ -409,6 +497,7 @@ func DefaultWorkflowValidatorConfig() *config.WorkflowValidatorConfig {
 	requireVersionComment := true
 	checkLatestVersion := true
 	useActionlint := true
+	blockLatestRunners := false
 
 	return &config.WorkflowValidatorConfig{
 		ValidatorConfig: config.ValidatorConfig{
@@ -422,6 +511,8 @@ func DefaultWorkflowValidatorConfig() *config.WorkflowValidatorConfig {
 		CheckLatestVersion:    &checkLatestVersion,
 		UseActionlint:         &useActionlint,
 		ActionlintPath:        "",
+		AllowedRunners:        []string{},
+		BlockLatestRunners:    &blockLatestRunners,
 	}
 }
 
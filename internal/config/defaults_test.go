@@ -97,6 +97,34 @@ var _ = Describe("Defaults", func() {
 			cfg := DefaultGitHubConfig()
 			Expect(cfg).NotTo(BeNil())
 			Expect(cfg.Issue).NotTo(BeNil())
+			Expect(cfg.Release).NotTo(BeNil())
+			Expect(cfg.PRMerge).NotTo(BeNil())
+		})
+	})
+
+	Describe("DefaultPRMergeValidatorConfig", func() {
+		It("should return PR merge validator config that checks nothing by default", func() {
+			cfg := DefaultPRMergeValidatorConfig()
+			Expect(cfg).NotTo(BeNil())
+			Expect(cfg.IsEnabled()).To(BeTrue())
+			Expect(cfg.AllowedMethods).To(BeEmpty())
+			Expect(cfg.RequireDeleteBranch).NotTo(BeNil())
+			Expect(*cfg.RequireDeleteBranch).To(BeFalse())
+			Expect(cfg.SkipAutoMerge).NotTo(BeNil())
+			Expect(*cfg.SkipAutoMerge).To(BeFalse())
+		})
+	})
+
+	Describe("DefaultReleaseValidatorConfig", func() {
+		It("should return release validator config that checks nothing by default", func() {
+			cfg := DefaultReleaseValidatorConfig()
+			Expect(cfg).NotTo(BeNil())
+			Expect(cfg.IsEnabled()).To(BeTrue())
+			Expect(cfg.RequireNotes).NotTo(BeNil())
+			Expect(*cfg.RequireNotes).To(BeFalse())
+			Expect(cfg.RequiredSections).To(BeEmpty())
+			Expect(cfg.TagPattern).To(BeEmpty())
+			Expect(cfg.TitlePattern).To(BeEmpty())
 		})
 	})
 
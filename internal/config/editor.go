@@ -0,0 +1,106 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+	"github.com/smykla-skalski/klaudiush/internal/schema"
+)
+
+const (
+	// vscodeDir is the VS Code workspace settings directory.
+	vscodeDir = ".vscode"
+
+	// vscodeSettingsFile is the VS Code workspace settings file.
+	vscodeSettingsFile = "settings.json"
+
+	// tomlAssociationsKey is the Even Better TOML (Taplo) setting mapping
+	// file URI regexes to schemas.
+	tomlAssociationsKey = "evenBetterToml.schema.associations"
+
+	// projectConfigFilesRegex matches the project config file names.
+	projectConfigFilesRegex = `(^|/)(\.klaudiush/config|\.?klaudiush)\.toml$`
+
+	// editorSettingsMode is the file mode for editor settings, which are
+	// usually shared with the project.
+	editorSettingsMode = 0o644
+
+	// editorSettingsDirMode is the file mode for the editor settings directory.
+	editorSettingsDirMode = 0o755
+)
+
+// ErrInvalidEditorSettings is returned when existing editor settings can't be
+// merged, e.g. because they contain comments.
+var ErrInvalidEditorSettings = errors.New("invalid editor settings")
+
+// EditorSettingsPath returns the path of the VS Code workspace settings.
+func (w *Writer) EditorSettingsPath() string {
+	return filepath.Join(w.workDir, vscodeDir, vscodeSettingsFile)
+}
+
+// WriteEditorSettings associates the project config files with the config
+// schema in the VS Code workspace settings, for editors that don't read the
+// schema directive. Existing settings are kept. It returns false when the
+// association was already present.
+func (w *Writer) WriteEditorSettings() (bool, error) {
+	path := w.EditorSettingsPath()
+
+	settings := make(map[string]any)
+
+	data, err := os.ReadFile(path) //nolint:gosec // path is the workspace settings file
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return false, errors.Wrapf(err, "failed to read %s", path)
+	case len(bytes.TrimSpace(data)) > 0:
+		if err := json.Unmarshal(data, &settings); err != nil {
+			return false, errors.Wrapf(
+				ErrInvalidEditorSettings,
+				"%s is not plain JSON (comments are not supported): %v",
+				path,
+				err,
+			)
+		}
+	}
+
+	associations, ok := settings[tomlAssociationsKey].(map[string]any)
+	if !ok {
+		if _, exists := settings[tomlAssociationsKey]; exists {
+			return false, errors.Wrapf(
+				ErrInvalidEditorSettings,
+				"%s: %s is not an object",
+				path,
+				tomlAssociationsKey,
+			)
+		}
+
+		associations = make(map[string]any)
+	}
+
+	if associations[projectConfigFilesRegex] == schema.SchemaURL() {
+		return false, nil
+	}
+
+	associations[projectConfigFilesRegex] = schema.SchemaURL()
+	settings[tomlAssociationsKey] = associations
+
+	out, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode editor settings")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), editorSettingsDirMode); err != nil {
+		return false, errors.Wrapf(err, "failed to create directory %s", filepath.Dir(path))
+	}
+
+	if err := fileutil.WriteFileAtomic(path, append(out, '\n'), editorSettingsMode); err != nil {
+		return false, errors.Wrapf(err, "failed to write %s", path)
+	}
+
+	return true, nil
+}
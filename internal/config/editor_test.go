@@ -0,0 +1,83 @@
+package config_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/config"
+	"github.com/smykla-skalski/klaudiush/internal/schema"
+)
+
+var _ = Describe("WriteEditorSettings", func() {
+	const associationsKey = "evenBetterToml.schema.associations"
+
+	var (
+		writer       *config.Writer
+		settingsPath string
+	)
+
+	readSettings := func() map[string]any {
+		data, err := os.ReadFile(settingsPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		settings := make(map[string]any)
+		Expect(json.Unmarshal(data, &settings)).To(Succeed())
+
+		return settings
+	}
+
+	BeforeEach(func() {
+		workDir := GinkgoT().TempDir()
+		writer = config.NewWriterWithDirs(GinkgoT().TempDir(), workDir)
+		settingsPath = filepath.Join(workDir, ".vscode", "settings.json")
+	})
+
+	It("should associate the project config with the schema", func() {
+		changed, err := writer.WriteEditorSettings()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(writer.EditorSettingsPath()).To(Equal(settingsPath))
+
+		Expect(readSettings()).To(HaveKeyWithValue(associationsKey, HaveKeyWithValue(
+			`(^|/)(\.klaudiush/config|\.?klaudiush)\.toml$`, schema.SchemaURL(),
+		)))
+	})
+
+	It("should keep existing settings and report no change when already present", func() {
+		Expect(os.MkdirAll(filepath.Dir(settingsPath), 0o755)).To(Succeed())
+		Expect(os.WriteFile(settingsPath, []byte(`{
+  "editor.tabSize": 4,
+  "evenBetterToml.schema.associations": {"other\\.toml$": "https://example.com/s.json"}
+}`), 0o644)).To(Succeed())
+
+		changed, err := writer.WriteEditorSettings()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		settings := readSettings()
+		Expect(settings).To(HaveKeyWithValue("editor.tabSize", BeEquivalentTo(4)))
+		Expect(settings[associationsKey]).To(HaveLen(2))
+
+		changed, err = writer.WriteEditorSettings()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+
+	It("should refuse settings with comments", func() {
+		Expect(os.MkdirAll(filepath.Dir(settingsPath), 0o755)).To(Succeed())
+
+		content := []byte("{\n  // keep me\n  \"editor.tabSize\": 4\n}\n")
+		Expect(os.WriteFile(settingsPath, content, 0o644)).To(Succeed())
+
+		_, err := writer.WriteEditorSettings()
+		Expect(err).To(MatchError(config.ErrInvalidEditorSettings))
+
+		data, err := os.ReadFile(settingsPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal(content))
+	})
+})
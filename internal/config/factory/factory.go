@@ -12,6 +12,10 @@ import (
 type ValidatorWithPredicate struct {
 	Validator validator.Validator
 	Predicate validator.Predicate
+
+	// Namespace is the reference namespace of the validator. CreateAll sets
+	// it from the category.
+	Namespace string
 }
 
 // ValidatorFactory creates validators from configuration.
@@ -48,6 +52,10 @@ type ValidatorFactory interface {
 
 	// CreateAll creates all validators from config.
 	CreateAll(cfg *config.Config) []ValidatorWithPredicate
+
+	// Close releases the resources of the created validators, such as
+	// loaded plugins.
+	Close() error
 }
 
 // DefaultValidatorFactory is the default implementation of ValidatorFactory.
@@ -151,21 +159,60 @@ func (f *DefaultValidatorFactory) CreateLifecycleValidators(
 	return f.lifecycleFactory.CreateValidators(cfg)
 }
 
-// CreateAll creates all validators from config.
+// Close releases the loaded plugins.
+func (f *DefaultValidatorFactory) Close() error {
+	return f.pluginFactory.Close()
+}
+
+// CreateAll creates all validators from config. Categories are assembled in
+// a fixed order: git, file, shell, secrets, github, notification,
+// elicitation, lifecycle and plugins last, so validation errors are always
+// reported in the same order. Each factory keeps its own order within its
+// category.
 func (f *DefaultValidatorFactory) CreateAll(cfg *config.Config) []ValidatorWithPredicate {
+	return uniqueValidators(
+		inNamespace(validator.NamespaceGit, f.CreateGitValidators(cfg)),
+		inNamespace(validator.NamespaceFile, f.CreateFileValidators(cfg)),
+		inNamespace(validator.NamespaceShell, f.CreateShellValidators(cfg)),
+		inNamespace(validator.NamespaceSecrets, f.CreateSecretsValidators(cfg)),
+		inNamespace(validator.NamespaceGitHub, f.CreateGitHubValidators(cfg)),
+		f.CreateNotificationValidators(cfg),
+		inNamespace(validator.NamespaceMCP, f.CreateElicitationValidators(cfg)),
+		f.CreateLifecycleValidators(cfg),
+		inNamespace(validator.NamespacePlugin, f.CreatePluginValidators(cfg)),
+	)
+}
+
+// inNamespace sets the reference namespace of validators that don't have one.
+func inNamespace(namespace string, group []ValidatorWithPredicate) []ValidatorWithPredicate {
+	for i := range group {
+		if group[i].Namespace == "" {
+			group[i].Namespace = namespace
+		}
+	}
+
+	return group
+}
+
+// uniqueValidators concatenates the groups in order. A validator whose name
+// was already seen is dropped, so only its first registration is kept.
+func uniqueValidators(groups ...[]ValidatorWithPredicate) []ValidatorWithPredicate {
 	const typicalValidatorCount = 20 // Typical total across all categories
 
 	all := make([]ValidatorWithPredicate, 0, typicalValidatorCount)
-
-	all = append(all, f.CreateGitValidators(cfg)...)
-	all = append(all, f.CreateGitHubValidators(cfg)...)
-	all = append(all, f.CreateFileValidators(cfg)...)
-	all = append(all, f.CreateNotificationValidators(cfg)...)
-	all = append(all, f.CreateSecretsValidators(cfg)...)
-	all = append(all, f.CreateShellValidators(cfg)...)
-	all = append(all, f.CreatePluginValidators(cfg)...)
-	all = append(all, f.CreateElicitationValidators(cfg)...)
-	all = append(all, f.CreateLifecycleValidators(cfg)...)
+	seen := make(map[string]struct{}, typicalValidatorCount)
+
+	for _, group := range groups {
+		for _, v := range group {
+			name := v.Validator.Name()
+			if _, ok := seen[name]; ok {
+				continue
+			}
+
+			seen[name] = struct{}{}
+			all = append(all, v)
+		}
+	}
 
 	return all
 }
@@ -1,6 +1,7 @@
 package factory_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -467,6 +468,34 @@ var _ = Describe("DefaultValidatorFactory", func() {
 			Expect(len(validators)).To(BeNumerically(">=", 1))
 		})
 
+		It("should only scan git push when scan_push is enabled", func() {
+			secretsCfg := &config.SecretsValidatorConfig{
+				ValidatorConfig: config.ValidatorConfig{Enabled: new(true)},
+			}
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					Secrets: &config.SecretsConfig{Secrets: secretsCfg},
+				},
+			}
+
+			Expect(validatorFactory.CreateSecretsValidators(cfg)).To(HaveLen(1))
+
+			secretsCfg.ScanPush = new(true)
+			validators := validatorFactory.CreateSecretsValidators(cfg)
+			Expect(validators).To(HaveLen(2))
+
+			bash := func(command string) *hook.Context {
+				return &hook.Context{
+					EventType: hook.EventTypePreToolUse,
+					ToolName:  hook.ToolTypeBash,
+					ToolInput: hook.ToolInput{Command: command},
+				}
+			}
+			Expect(validators[1].Predicate(bash("git push origin main"))).To(BeTrue())
+			Expect(validators[1].Predicate(bash("git fetch origin"))).To(BeFalse())
+			Expect(validators[0].Predicate(bash("git push origin main"))).To(BeFalse())
+		})
+
 		It("should not create validators when disabled", func() {
 			cfg := &config.Config{
 				Validators: &config.ValidatorsConfig{
@@ -664,6 +693,52 @@ var _ = Describe("DefaultValidatorFactory", func() {
 				RawEventName: "SessionStart",
 			})).To(BeTrue())
 		})
+
+		It("closes the loaded plugins when plugins are disabled", func() {
+			tmpDir := GinkgoT().TempDir()
+			pluginDir := filepath.Join(tmpDir, ".klaudiush", "plugins")
+			Expect(os.MkdirAll(pluginDir, 0o755)).To(Succeed())
+
+			pluginPath, err := createExecPlugin(
+				pluginDir,
+				"blocking-plugin",
+				&pluginapi.ValidateResponse{ShouldBlock: true, Message: "blocked by plugin"},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			pluginsEnabled := true
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{},
+				Plugins: &config.PluginConfig{
+					Enabled: &pluginsEnabled,
+					Plugins: []*config.PluginInstanceConfig{
+						{
+							Name:        "blocking-plugin",
+							Type:        config.PluginTypeExec,
+							Path:        pluginPath,
+							ProjectRoot: tmpDir,
+							Timeout:     config.Duration(5 * time.Second),
+						},
+					},
+				},
+			}
+
+			hookCtx := &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+				ToolInput: hook.ToolInput{Command: "ls"},
+			}
+
+			validators := validatorFactory.CreatePluginValidators(cfg)
+			Expect(validators).To(HaveLen(1))
+			Expect(validators[0].Validator.Validate(context.Background(), hookCtx).ShouldBlock).
+				To(BeTrue())
+
+			pluginsEnabled = false
+			Expect(validatorFactory.CreatePluginValidators(cfg)).To(BeEmpty())
+			Expect(validators[0].Validator.Validate(context.Background(), hookCtx).Passed).
+				To(BeTrue())
+		})
 	})
 
 	Describe("CreateAll", func() {
@@ -702,6 +777,54 @@ var _ = Describe("DefaultValidatorFactory", func() {
 			Expect(len(validators)).To(Equal(5))
 		})
 
+		It("should order validators by category on every run", func() {
+			enabled := config.ValidatorConfig{Enabled: new(true)}
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					Git: &config.GitConfig{
+						Push: &config.PushValidatorConfig{ValidatorConfig: enabled},
+					},
+					GitHub: &config.GitHubConfig{
+						Issue: &config.IssueValidatorConfig{ValidatorConfig: enabled},
+					},
+					File: &config.FileConfig{
+						Markdown: &config.MarkdownValidatorConfig{ValidatorConfig: enabled},
+					},
+					Notification: &config.NotificationConfig{
+						Bell: &config.BellValidatorConfig{ValidatorConfig: enabled},
+					},
+					Shell: &config.ShellConfig{
+						Backtick: &config.BacktickValidatorConfig{ValidatorConfig: enabled},
+					},
+					Secrets: &config.SecretsConfig{
+						Secrets: &config.SecretsValidatorConfig{ValidatorConfig: enabled},
+					},
+				},
+			}
+
+			names := func() []string {
+				var names []string
+				for _, v := range validatorFactory.CreateAll(cfg) {
+					names = append(names, v.Validator.Name())
+				}
+
+				return names
+			}
+
+			expected := []string{
+				"validate-git-push",
+				"validate-markdown",
+				"validate-backticks",
+				"validate-secrets",
+				"validate-issue",
+				"bell",
+			}
+
+			for range 5 {
+				Expect(names()).To(Equal(expected))
+			}
+		})
+
 		It("should return empty for minimal config", func() {
 			cfg := &config.Config{
 				Validators: &config.ValidatorsConfig{
@@ -1170,6 +1293,66 @@ var _ = Describe("GitHubValidatorFactory", func() {
 			Expect(validators).To(BeEmpty())
 		})
 
+		It("should create release validator for gh release create only", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					GitHub: &config.GitHubConfig{
+						Release: &config.ReleaseValidatorConfig{
+							ValidatorConfig: config.ValidatorConfig{Enabled: new(true)},
+						},
+					},
+				},
+			}
+
+			validators := githubFactory.CreateValidators(cfg)
+			Expect(validators).To(HaveLen(1))
+			Expect(validators[0].Validator.Name()).To(Equal("validate-release"))
+
+			bash := func(command string) *hook.Context {
+				return &hook.Context{
+					EventType: hook.EventTypePreToolUse,
+					ToolName:  hook.ToolTypeBash,
+					ToolInput: hook.ToolInput{Command: command},
+				}
+			}
+			Expect(validators[0].Predicate(bash("gh release create v1.0.0"))).To(BeTrue())
+			Expect(validators[0].Predicate(bash("gh release list"))).To(BeFalse())
+			Expect(validators[0].Predicate(bash("gh release view v1.0.0"))).To(BeFalse())
+		})
+
+		It("should create PR merge validator for gh pr merge only", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					GitHub: &config.GitHubConfig{
+						PRMerge: &config.PRMergeValidatorConfig{
+							ValidatorConfig: config.ValidatorConfig{Enabled: new(true)},
+							AllowedMethods:  []string{"squash"},
+						},
+					},
+				},
+			}
+
+			validators := githubFactory.CreateValidators(cfg)
+			Expect(validators).To(HaveLen(1))
+			Expect(validators[0].Validator.Name()).To(Equal("validate-pr-merge"))
+
+			bash := func(command string) *hook.Context {
+				return &hook.Context{
+					EventType: hook.EventTypePreToolUse,
+					ToolName:  hook.ToolTypeBash,
+					ToolInput: hook.ToolInput{Command: command},
+				}
+			}
+			Expect(validators[0].Predicate(bash("gh pr merge 12 --squash"))).To(BeTrue())
+			Expect(validators[0].Predicate(bash("gh pr create --fill"))).To(BeFalse())
+
+			result := validators[0].Validator.Validate(
+				context.Background(),
+				bash("gh pr merge 12 --merge"),
+			)
+			Expect(result.ShouldBlock).To(BeTrue())
+		})
+
 		It("should create validator with rule engine integration", func() {
 			engine, _ := rules.NewRuleEngine([]*rules.Rule{
 				{
@@ -0,0 +1,41 @@
+package factory
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+func namedValidator(name string) ValidatorWithPredicate {
+	return ValidatorWithPredicate{
+		Validator: &LifecycleRuleValidator{
+			BaseValidator: validator.NewBaseValidator(name, logger.NewNoOpLogger()),
+		},
+		Predicate: validator.Always(),
+	}
+}
+
+func TestUniqueValidatorsKeepsFirstRegistration(t *testing.T) {
+	first := namedValidator("validate-markdown")
+
+	all := uniqueValidators(
+		[]ValidatorWithPredicate{namedValidator("validate-commit"), first},
+		[]ValidatorWithPredicate{namedValidator("validate-markdown"), namedValidator("bell")},
+	)
+
+	var names []string
+	for _, v := range all {
+		names = append(names, v.Validator.Name())
+	}
+
+	want := []string{"validate-commit", "validate-markdown", "bell"}
+	if !slices.Equal(names, want) {
+		t.Fatalf("names = %v, want %v", names, want)
+	}
+
+	if all[1].Validator != first.Validator {
+		t.Fatal("expected the first validate-markdown registration to be kept")
+	}
+}
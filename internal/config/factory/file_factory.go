@@ -9,6 +9,7 @@ import (
 	"github.com/smykla-skalski/klaudiush/internal/rules"
 	"github.com/smykla-skalski/klaudiush/internal/validator"
 	filevalidators "github.com/smykla-skalski/klaudiush/internal/validators/file"
+	"github.com/smykla-skalski/klaudiush/internal/xdg"
 	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
@@ -19,10 +20,32 @@ const (
 	DefaultLinterTimeout = 10 * time.Second
 )
 
+// lintRunner returns the command runner for external linters, caching their
+// results when the lint cache is enabled.
+func lintRunner(global *config.GlobalConfig, timeout time.Duration) execpkg.CommandRunner {
+	runner := execpkg.NewCommandRunner(timeout)
+	if !global.IsLintCacheEnabled() {
+		return runner
+	}
+
+	return linters.NewCachedRunner(
+		runner,
+		linters.NewFileResultCache(xdg.LintCacheDir(), global.GetLintCacheTTL()),
+	)
+}
+
 // FileValidatorFactory creates file validators from configuration.
 type FileValidatorFactory struct {
 	log        logger.Logger
 	ruleEngine *rules.RuleEngine
+
+	// gitCtxProvider supplies the repository root for repo-relative file
+	// patterns. Created lazily and shared by all file validators.
+	gitCtxProvider func() *rules.GitContext
+
+	// afterToolSummary also runs the validators on Claude PostToolUse for
+	// Write, so the response can summarize findings after a write.
+	afterToolSummary bool
 }
 
 // NewFileValidatorFactory creates a new FileValidatorFactory.
@@ -39,6 +62,8 @@ func (f *FileValidatorFactory) SetRuleEngine(engine *rules.RuleEngine) {
 func (f *FileValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorWithPredicate {
 	var validators []ValidatorWithPredicate
 
+	f.afterToolSummary = cfg.Global.IsPostToolUseSummaryEnabled()
+
 	// Determine timeout from config or use default
 	timeout := DefaultLinterTimeout
 	if cfg.Global != nil && cfg.Global.DefaultTimeout.ToDuration() > 0 {
@@ -46,15 +71,7 @@ func (f *FileValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorW
 	}
 
 	// Initialize linters
-	runner := execpkg.NewCommandRunner(timeout)
-	shellChecker := linters.NewShellChecker(runner)
-	terraformFormatter := linters.NewTerraformFormatter(runner)
-	tfLinter := linters.NewTfLinter(runner)
-	actionLinter := linters.NewActionLinter(runner)
-	gofumptChecker := linters.NewGofumptChecker(runner)
-	ruffChecker := linters.NewRuffChecker(runner)
-	oxlintChecker := linters.NewOxlintChecker(runner)
-	rustfmtChecker := linters.NewRustfmtChecker(runner)
+	runner := lintRunner(cfg.Global, timeout)
 	githubClient := githubpkg.NewClient()
 
 	if cfg.Validators.File.Markdown != nil && cfg.Validators.File.Markdown.IsEnabled() &&
@@ -70,12 +87,27 @@ func (f *FileValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorW
 
 	if cfg.Validators.File.Terraform != nil && cfg.Validators.File.Terraform.IsEnabled() &&
 		!isValidatorOverridden(cfg.Overrides, "file.terraform") {
-		validators = append(validators, f.createTerraformValidator(
-			cfg.Validators.File.Terraform, terraformFormatter, tfLinter))
+		tfCfg := cfg.Validators.File.Terraform
+		terraformFormatter := linters.NewTerraformFormatterWithPaths(
+			runner,
+			tfCfg.TerraformPath,
+			tfCfg.TofuPath,
+		)
+		tfLinter := linters.NewTfLinterWithPath(runner, tfCfg.TflintPath)
+
+		validators = append(
+			validators,
+			f.createTerraformValidator(tfCfg, terraformFormatter, tfLinter),
+		)
 	}
 
 	if cfg.Validators.File.ShellScript != nil && cfg.Validators.File.ShellScript.IsEnabled() &&
 		!isValidatorOverridden(cfg.Overrides, "file.shellscript") {
+		shellChecker := linters.NewShellCheckerWithPath(
+			runner,
+			cfg.Validators.File.ShellScript.ShellcheckPath,
+		)
+
 		validators = append(
 			validators,
 			f.createShellScriptValidator(cfg.Validators.File.ShellScript, shellChecker),
@@ -84,12 +116,22 @@ func (f *FileValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorW
 
 	if cfg.Validators.File.Workflow != nil && cfg.Validators.File.Workflow.IsEnabled() &&
 		!isValidatorOverridden(cfg.Overrides, "file.workflow") {
+		actionLinter := linters.NewActionLinterWithPath(
+			runner,
+			cfg.Validators.File.Workflow.ActionlintPath,
+		)
+
 		validators = append(validators, f.createWorkflowValidator(
 			cfg.Validators.File.Workflow, actionLinter, githubClient))
 	}
 
 	if cfg.Validators.File.Gofumpt != nil && cfg.Validators.File.Gofumpt.IsEnabled() &&
 		!isValidatorOverridden(cfg.Overrides, "file.gofumpt") {
+		gofumptChecker := linters.NewGofumptCheckerWithPath(
+			runner,
+			cfg.Validators.File.Gofumpt.GofumptPath,
+		)
+
 		validators = append(
 			validators,
 			f.createGofumptValidator(cfg.Validators.File.Gofumpt, gofumptChecker),
@@ -98,6 +140,11 @@ func (f *FileValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorW
 
 	if cfg.Validators.File.Python != nil && cfg.Validators.File.Python.IsEnabled() &&
 		!isValidatorOverridden(cfg.Overrides, "file.python") {
+		ruffChecker := linters.NewRuffCheckerWithPath(
+			runner,
+			cfg.Validators.File.Python.RuffPath,
+		)
+
 		validators = append(
 			validators,
 			f.createPythonValidator(cfg.Validators.File.Python, ruffChecker),
@@ -106,6 +153,11 @@ func (f *FileValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorW
 
 	if cfg.Validators.File.JavaScript != nil && cfg.Validators.File.JavaScript.IsEnabled() &&
 		!isValidatorOverridden(cfg.Overrides, "file.javascript") {
+		oxlintChecker := linters.NewOxlintCheckerWithPath(
+			runner,
+			cfg.Validators.File.JavaScript.OxlintPath,
+		)
+
 		validators = append(
 			validators,
 			f.createJavaScriptValidator(cfg.Validators.File.JavaScript, oxlintChecker),
@@ -114,6 +166,11 @@ func (f *FileValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorW
 
 	if cfg.Validators.File.Rust != nil && cfg.Validators.File.Rust.IsEnabled() &&
 		!isValidatorOverridden(cfg.Overrides, "file.rust") {
+		rustfmtChecker := linters.NewRustfmtCheckerWithPath(
+			runner,
+			cfg.Validators.File.Rust.RustfmtPath,
+		)
+
 		validators = append(
 			validators,
 			f.createRustValidator(cfg.Validators.File.Rust, rustfmtChecker),
@@ -131,6 +188,17 @@ func (f *FileValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorW
 	return validators
 }
 
+// toolEventPredicate returns the events file validators run on: before tool
+// use, and after tool use for Codex and Gemini. With the PostToolUse summary
+// enabled, Claude PostToolUse for Write is included too.
+func (f *FileValidatorFactory) toolEventPredicate() validator.Predicate {
+	if f.afterToolSummary {
+		return validator.Or(beforeToolOrCodexAfterToolPredicate(), claudeAfterWritePredicate())
+	}
+
+	return beforeToolOrCodexAfterToolPredicate()
+}
+
 func (f *FileValidatorFactory) createMarkdownValidator(
 	cfg *config.MarkdownValidatorConfig,
 	linter linters.MarkdownLinter,
@@ -141,6 +209,7 @@ func (f *FileValidatorFactory) createMarkdownValidator(
 			f.ruleEngine,
 			rules.ValidatorFileMarkdown,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -150,7 +219,8 @@ func (f *FileValidatorFactory) createMarkdownValidator(
 			cfg,
 		),
 		Predicate: validator.And(
-			beforeToolOrCodexAfterToolPredicate(),
+			f.toolEventPredicate(),
+			skipPathsPredicate(cfg.PathFilterConfig, f.log),
 			validator.ToolTypeIn(hook.ToolTypeWrite, hook.ToolTypeEdit, hook.ToolTypeMultiEdit),
 			validator.FileExtensionIs(".md"),
 		),
@@ -168,6 +238,7 @@ func (f *FileValidatorFactory) createTerraformValidator(
 			f.ruleEngine,
 			rules.ValidatorFileTerraform,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -177,7 +248,8 @@ func (f *FileValidatorFactory) createTerraformValidator(
 			cfg,
 		),
 		Predicate: validator.And(
-			beforeToolOrCodexAfterToolPredicate(),
+			f.toolEventPredicate(),
+			skipPathsPredicate(cfg.PathFilterConfig, f.log),
 			validator.ToolTypeIn(hook.ToolTypeWrite, hook.ToolTypeEdit, hook.ToolTypeMultiEdit),
 			validator.FileExtensionIs(".tf"),
 		),
@@ -194,6 +266,7 @@ func (f *FileValidatorFactory) createShellScriptValidator(
 			f.ruleEngine,
 			rules.ValidatorFileShell,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -203,7 +276,8 @@ func (f *FileValidatorFactory) createShellScriptValidator(
 			cfg,
 		),
 		Predicate: validator.And(
-			beforeToolOrCodexAfterToolPredicate(),
+			f.toolEventPredicate(),
+			skipPathsPredicate(cfg.PathFilterConfig, f.log),
 			validator.ToolTypeIn(hook.ToolTypeWrite, hook.ToolTypeEdit, hook.ToolTypeMultiEdit),
 			validator.Or(
 				validator.FileExtensionIs(".sh"),
@@ -224,6 +298,7 @@ func (f *FileValidatorFactory) createWorkflowValidator(
 			f.ruleEngine,
 			rules.ValidatorFileWorkflow,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -235,7 +310,8 @@ func (f *FileValidatorFactory) createWorkflowValidator(
 			cfg,
 		),
 		Predicate: validator.And(
-			beforeToolOrCodexAfterToolPredicate(),
+			f.toolEventPredicate(),
+			skipPathsPredicate(cfg.PathFilterConfig, f.log),
 			validator.ToolTypeIn(hook.ToolTypeWrite, hook.ToolTypeEdit, hook.ToolTypeMultiEdit),
 			validator.Or(
 				validator.FilePathContains(".github/workflows/"),
@@ -259,6 +335,7 @@ func (f *FileValidatorFactory) createGofumptValidator(
 			f.ruleEngine,
 			rules.ValidatorFileGofumpt,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -268,7 +345,8 @@ func (f *FileValidatorFactory) createGofumptValidator(
 			cfg,
 		),
 		Predicate: validator.And(
-			beforeToolOrCodexAfterToolPredicate(),
+			f.toolEventPredicate(),
+			skipPathsPredicate(cfg.PathFilterConfig, f.log),
 			validator.ToolTypeIn(hook.ToolTypeWrite),
 			validator.FileExtensionIs(".go"),
 		),
@@ -282,6 +360,7 @@ func (f *FileValidatorFactory) createPythonValidator(
 	return f.createSingleExtensionValidator(
 		rules.ValidatorFilePython,
 		cfg,
+		cfg.PathFilterConfig,
 		".py",
 		func(rc validator.RuleChecker) validator.Validator {
 			return filevalidators.NewPythonValidator(f.log, checker, cfg, rc)
@@ -299,6 +378,7 @@ func (f *FileValidatorFactory) createJavaScriptValidator(
 			f.ruleEngine,
 			rules.ValidatorFileJavaScript,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -308,7 +388,8 @@ func (f *FileValidatorFactory) createJavaScriptValidator(
 			cfg,
 		),
 		Predicate: validator.And(
-			beforeToolOrCodexAfterToolPredicate(),
+			f.toolEventPredicate(),
+			skipPathsPredicate(cfg.PathFilterConfig, f.log),
 			validator.ToolTypeIn(hook.ToolTypeWrite, hook.ToolTypeEdit, hook.ToolTypeMultiEdit),
 			validator.Or(
 				validator.FileExtensionIs(".js"),
@@ -327,6 +408,7 @@ func (f *FileValidatorFactory) createRustValidator(
 	return f.createSingleExtensionValidator(
 		rules.ValidatorFileRust,
 		cfg,
+		cfg.PathFilterConfig,
 		".rs",
 		func(rc validator.RuleChecker) validator.Validator {
 			return filevalidators.NewRustValidator(f.log, checker, cfg, rc)
@@ -337,6 +419,7 @@ func (f *FileValidatorFactory) createRustValidator(
 func (f *FileValidatorFactory) createSingleExtensionValidator(
 	ruleType rules.ValidatorType,
 	cfg severityConfig,
+	filter config.PathFilterConfig,
 	extension string,
 	builder func(validator.RuleChecker) validator.Validator,
 ) ValidatorWithPredicate {
@@ -346,6 +429,7 @@ func (f *FileValidatorFactory) createSingleExtensionValidator(
 			f.ruleEngine,
 			ruleType,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -355,7 +439,8 @@ func (f *FileValidatorFactory) createSingleExtensionValidator(
 			cfg,
 		),
 		Predicate: validator.And(
-			beforeToolOrCodexAfterToolPredicate(),
+			f.toolEventPredicate(),
+			skipPathsPredicate(filter, f.log),
 			validator.ToolTypeIn(hook.ToolTypeWrite, hook.ToolTypeEdit, hook.ToolTypeMultiEdit),
 			validator.FileExtensionIs(extension),
 		),
@@ -371,6 +456,7 @@ func (f *FileValidatorFactory) createLinterIgnoreValidator(
 			f.ruleEngine,
 			rules.ValidatorFileLinterIgnore,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -380,7 +466,8 @@ func (f *FileValidatorFactory) createLinterIgnoreValidator(
 			cfg,
 		),
 		Predicate: validator.And(
-			beforeToolOrCodexAfterToolPredicate(),
+			f.toolEventPredicate(),
+			skipPathsPredicate(cfg.PathFilterConfig, f.log),
 			validator.ToolTypeIn(hook.ToolTypeWrite, hook.ToolTypeEdit, hook.ToolTypeMultiEdit),
 		),
 	}
@@ -5,7 +5,9 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/smykla-skalski/klaudiush/internal/config/factory"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
 	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
 )
 
@@ -177,6 +179,52 @@ var _ = Describe("FileValidatorFactory", func() {
 			})
 		})
 
+		Context("skip_paths", func() {
+			markdownContext := func(filePath string) *hook.Context {
+				return &hook.Context{
+					Event:      hook.CanonicalEventBeforeTool,
+					ToolName:   hook.ToolTypeWrite,
+					WorkingDir: "/repo",
+					ToolInput:  hook.ToolInput{FilePath: filePath},
+				}
+			}
+
+			markdownPredicate := func(skipPaths ...string) validator.Predicate {
+				cfg.Validators.File.Markdown = &config.MarkdownValidatorConfig{
+					PathFilterConfig: config.PathFilterConfig{SkipPaths: skipPaths},
+				}
+
+				validators := fileFactory.CreateValidators(cfg)
+				Expect(validators).To(HaveLen(1))
+
+				return validators[0].Predicate
+			}
+
+			It("should skip files matching a skip_paths glob", func() {
+				predicate := markdownPredicate("CHANGELOG.md", "docs/generated/**")
+
+				Expect(predicate(markdownContext("/repo/CHANGELOG.md"))).To(BeFalse())
+				Expect(predicate(markdownContext("/repo/pkg/CHANGELOG.md"))).To(BeFalse())
+				Expect(predicate(markdownContext("/repo/docs/generated/api/index.md"))).
+					To(BeFalse())
+				Expect(predicate(markdownContext("docs/generated/cli.md"))).To(BeFalse())
+			})
+
+			It("should still validate files not matching any glob", func() {
+				predicate := markdownPredicate("CHANGELOG.md", "docs/generated/**")
+
+				Expect(predicate(markdownContext("/repo/README.md"))).To(BeTrue())
+				Expect(predicate(markdownContext("/repo/docs/guide.md"))).To(BeTrue())
+				Expect(predicate(markdownContext("/other/docs/generated/cli.md"))).To(BeTrue())
+			})
+
+			It("should validate every file without skip_paths", func() {
+				predicate := markdownPredicate()
+
+				Expect(predicate(markdownContext("/repo/CHANGELOG.md"))).To(BeTrue())
+			})
+		})
+
 		Context("when rule engine is configured", func() {
 			It("should attach rule adapter to validators", func() {
 				enabled := true
@@ -0,0 +1,178 @@
+package factory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smykla-skalski/klaudiush/internal/git"
+	"github.com/smykla-skalski/klaudiush/internal/rules"
+	gitvalidators "github.com/smykla-skalski/klaudiush/internal/validators/git"
+)
+
+// day is the length of a day for DaysSinceLastCommit.
+const day = 24 * time.Hour
+
+// gitContextProvider returns a provider that builds the rule git context
+// from the shared git runner. The context is built on first use and shared
+// by all git validators created by this factory. Only the lookups the
+// enabled rules' conditions use are run.
+func (f *GitValidatorFactory) gitContextProvider() func() *rules.GitContext {
+	if f.gitCtxProvider == nil {
+		f.gitCtxProvider = sync.OnceValue(func() *rules.GitContext {
+			var needs gitContextNeeds
+			if f.ruleEngine != nil {
+				needs = gitNeedsOf(f.ruleEngine.GetEnabledRules())
+			}
+
+			return buildGitContext(f.getGitRunner(), f.now(), needs)
+		})
+	}
+
+	return f.gitCtxProvider
+}
+
+// gitContextNeeds records which git context fields rule conditions use,
+// beyond the repository root, which is always looked up.
+type gitContextNeeds struct {
+	branch     bool
+	upstream   bool
+	lastCommit bool
+	dirty      bool
+	staged     bool
+}
+
+// gitNeedsOf returns the git context fields the conditions of ruleList use.
+func gitNeedsOf(ruleList []*rules.Rule) gitContextNeeds {
+	var needs gitContextNeeds
+
+	for _, rule := range ruleList {
+		match := rule.Match
+		if match == nil {
+			continue
+		}
+
+		if match.RequireUpstream || match.MinAhead > 0 || match.MinBehind > 0 {
+			needs.upstream = true
+		}
+
+		if needs.upstream || match.BranchPattern != "" || len(match.BranchPatterns) > 0 {
+			needs.branch = true
+		}
+
+		if match.MinDaysSinceCommit > 0 {
+			needs.lastCommit = true
+		}
+
+		if match.RequireDirty || match.RequireClean {
+			needs.dirty = true
+		}
+
+		if match.StagedPathPattern != "" || match.RequireStagedPath != "" {
+			needs.staged = true
+		}
+	}
+
+	return needs
+}
+
+// gitContextProvider returns a provider of the repository root for file
+// validator rules, so file patterns can match repo-relative paths. The
+// context is built on first use and shared by all file validators created by
+// this factory.
+func (f *FileValidatorFactory) gitContextProvider() func() *rules.GitContext {
+	if f.gitCtxProvider == nil {
+		f.gitCtxProvider = sync.OnceValue(func() *rules.GitContext {
+			return buildRepoRootContext(gitvalidators.NewGitRunner())
+		})
+	}
+
+	return f.gitCtxProvider
+}
+
+// buildRepoRootContext returns a git context with only the repository root
+// and worktree flag set. Outside a repository it is empty.
+func buildRepoRootContext(runner git.Runner) *rules.GitContext {
+	gitCtx := &rules.GitContext{}
+
+	if !runner.IsInRepo() {
+		return gitCtx
+	}
+
+	gitCtx.IsInRepo = true
+
+	if root, err := runner.GetRepoRoot(); err == nil {
+		gitCtx.RepoRoot = root
+	}
+
+	if isWorktree, err := runner.IsWorktree(); err == nil {
+		gitCtx.IsWorktree = isWorktree
+	}
+
+	return gitCtx
+}
+
+// buildGitContext collects the repository state needs asks for. Lookup
+// failures leave the corresponding fields empty instead of failing, so a
+// detached HEAD or a branch without upstream simply does not match
+// branch or tracking conditions, and a failed status lookup counts as a
+// clean working tree with nothing staged. now is the reference time for
+// DaysSinceLastCommit.
+func buildGitContext(runner git.Runner, now time.Time, needs gitContextNeeds) *rules.GitContext {
+	gitCtx := buildRepoRootContext(runner)
+	if !gitCtx.IsInRepo {
+		return gitCtx
+	}
+
+	if needs.lastCommit {
+		gitCtx.DaysSinceLastCommit = daysSinceLastCommit(runner, now)
+	}
+
+	if needs.dirty {
+		if dirty, err := runner.IsDirty(); err == nil {
+			gitCtx.IsDirty = dirty
+		}
+	}
+
+	if needs.staged {
+		if staged, err := runner.GetStagedFiles(); err == nil {
+			gitCtx.StagedFiles = staged
+		}
+	}
+
+	if !needs.branch {
+		return gitCtx
+	}
+
+	branch, err := runner.GetCurrentBranch()
+	if err != nil || branch == "" {
+		return gitCtx
+	}
+
+	gitCtx.Branch = branch
+
+	if !needs.upstream {
+		return gitCtx
+	}
+
+	if status, err := runner.GetUpstreamStatus(branch); err == nil {
+		gitCtx.HasUpstream = status.HasUpstream
+		gitCtx.AheadBehind = rules.AheadBehind{
+			Ahead:  status.Ahead,
+			Behind: status.Behind,
+		}
+	}
+
+	return gitCtx
+}
+
+// daysSinceLastCommit returns the whole days between the last commit on HEAD
+// and now. A repository without commits, a lookup failure or a commit dated
+// in the future yields 0.
+func daysSinceLastCommit(runner git.Runner, now time.Time) int {
+	lastCommit, err := runner.GetLastCommitTime()
+	if err != nil || lastCommit.IsZero() || !now.After(lastCommit) {
+		return 0
+	}
+
+	return int(now.Sub(lastCommit) / day)
+}
@@ -0,0 +1,219 @@
+package factory
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/smykla-skalski/klaudiush/internal/git"
+	"github.com/smykla-skalski/klaudiush/internal/rules"
+)
+
+// allGitNeeds asks buildGitContext for every field.
+var allGitNeeds = gitContextNeeds{
+	branch:     true,
+	upstream:   true,
+	lastCommit: true,
+	dirty:      true,
+	staged:     true,
+}
+
+func TestBuildGitContextPopulatesUpstreamStatus(t *testing.T) {
+	runner := git.NewFakeRunner()
+	runner.CurrentBranch = "feat/x"
+	runner.Upstreams = map[string]git.UpstreamStatus{
+		"feat/x": {HasUpstream: true, Ahead: 1, Behind: 3},
+	}
+
+	gitCtx := buildGitContext(runner, time.Now(), allGitNeeds)
+
+	if !gitCtx.IsInRepo || gitCtx.RepoRoot != "/mock/repo" || gitCtx.Branch != "feat/x" {
+		t.Fatalf("unexpected repository fields: %+v", gitCtx)
+	}
+
+	if !gitCtx.HasUpstream {
+		t.Fatal("expected HasUpstream to be true")
+	}
+
+	if gitCtx.AheadBehind != (rules.AheadBehind{Ahead: 1, Behind: 3}) {
+		t.Fatalf("unexpected ahead/behind: %+v", gitCtx.AheadBehind)
+	}
+}
+
+func TestBuildGitContextWithoutUpstream(t *testing.T) {
+	runner := git.NewFakeRunner()
+
+	gitCtx := buildGitContext(runner, time.Now(), allGitNeeds)
+
+	if gitCtx.Branch != "main" || gitCtx.HasUpstream {
+		t.Fatalf("expected branch without upstream, got %+v", gitCtx)
+	}
+}
+
+func TestBuildGitContextDetachedHead(t *testing.T) {
+	runner := git.NewFakeRunner()
+	runner.CurrentBranch = ""
+	runner.Upstreams = map[string]git.UpstreamStatus{
+		"": {HasUpstream: true, Ahead: 1},
+	}
+
+	gitCtx := buildGitContext(runner, time.Now(), allGitNeeds)
+
+	if !gitCtx.IsInRepo || gitCtx.Branch != "" || gitCtx.HasUpstream {
+		t.Fatalf("expected detached HEAD without upstream, got %+v", gitCtx)
+	}
+}
+
+func TestBuildGitContextOutsideRepository(t *testing.T) {
+	runner := git.NewFakeRunner()
+	runner.InRepo = false
+
+	gitCtx := buildGitContext(runner, time.Now(), allGitNeeds)
+	if !reflect.DeepEqual(*gitCtx, rules.GitContext{}) {
+		t.Fatalf("expected empty git context, got %+v", gitCtx)
+	}
+}
+
+func TestBuildGitContextIgnoresLookupErrors(t *testing.T) {
+	runner := git.NewFakeRunner()
+	runner.Err = &git.FakeRunnerError{Msg: "git failed"}
+
+	gitCtx := buildGitContext(runner, time.Now(), allGitNeeds)
+
+	if !gitCtx.IsInRepo || gitCtx.RepoRoot != "" || gitCtx.Branch != "" {
+		t.Fatalf("expected only IsInRepo to be set, got %+v", gitCtx)
+	}
+}
+
+func TestBuildGitContextDaysSinceLastCommit(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		lastCommit time.Time
+		want       int
+	}{
+		"no commits":       {time.Time{}, 0},
+		"committed today":  {now.Add(-3 * time.Hour), 0},
+		"idle 31 days":     {now.Add(-31*24*time.Hour - time.Minute), 31},
+		"future committer": {now.Add(48 * time.Hour), 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			runner := git.NewFakeRunner()
+			runner.LastCommit = tc.lastCommit
+
+			if got := buildGitContext(runner, now, allGitNeeds).DaysSinceLastCommit; got != tc.want {
+				t.Fatalf("DaysSinceLastCommit = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildGitContextIsDirty(t *testing.T) {
+	for name, tc := range map[string]struct {
+		setup func(*git.FakeRunner)
+		want  bool
+	}{
+		"clean":          {func(*git.FakeRunner) {}, false},
+		"staged file":    {func(r *git.FakeRunner) { r.StagedFiles = []string{"a.go"} }, true},
+		"modified file":  {func(r *git.FakeRunner) { r.ModifiedFiles = []string{"a.go"} }, true},
+		"untracked file": {func(r *git.FakeRunner) { r.UntrackedFiles = []string{"new.go"} }, true},
+		"lookup failures": {
+			func(r *git.FakeRunner) { r.Err = &git.FakeRunnerError{Msg: "status failed"} },
+			false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			runner := git.NewFakeRunner()
+			tc.setup(runner)
+
+			if got := buildGitContext(runner, time.Now(), allGitNeeds).IsDirty; got != tc.want {
+				t.Fatalf("IsDirty = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildRepoRootContextMarksWorktree(t *testing.T) {
+	runner := git.NewFakeRunner()
+	runner.RepoRoot = "/work/repo-feature"
+	runner.Worktree = true
+
+	gitCtx := buildRepoRootContext(runner)
+
+	if !gitCtx.IsWorktree || gitCtx.RepoRoot != "/work/repo-feature" {
+		t.Fatalf("expected worktree root context, got %+v", gitCtx)
+	}
+}
+
+func TestBuildGitContextStagedFiles(t *testing.T) {
+	for name, tc := range map[string]struct {
+		setup func(*git.FakeRunner)
+		want  []string
+	}{
+		"nothing staged": {func(*git.FakeRunner) {}, []string{}},
+		"staged files": {
+			func(r *git.FakeRunner) { r.StagedFiles = []string{"go.mod", "cmd/.env"} },
+			[]string{"go.mod", "cmd/.env"},
+		},
+		"lookup failure": {
+			func(r *git.FakeRunner) {
+				r.StagedFiles = []string{"go.mod"}
+				r.Err = &git.FakeRunnerError{Msg: "diff failed"}
+			},
+			nil,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			runner := git.NewFakeRunner()
+			tc.setup(runner)
+
+			got := buildGitContext(runner, time.Now(), allGitNeeds).StagedFiles
+			if !slices.Equal(got, tc.want) || (got == nil) != (tc.want == nil) {
+				t.Fatalf("StagedFiles = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildGitContextSkipsUnusedLookups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	runner := git.NewMockRunner(ctrl)
+
+	runner.EXPECT().IsInRepo().Return(true)
+	runner.EXPECT().GetRepoRoot().Return("/work/repo", nil)
+	runner.EXPECT().IsWorktree().Return(false, nil)
+	runner.EXPECT().IsDirty().Return(true, nil)
+
+	gitCtx := buildGitContext(runner, time.Now(), gitContextNeeds{dirty: true})
+
+	if !gitCtx.IsDirty || gitCtx.RepoRoot != "/work/repo" || gitCtx.Branch != "" {
+		t.Fatalf("expected only the repository root and dirty flag, got %+v", gitCtx)
+	}
+}
+
+func TestGitNeedsOf(t *testing.T) {
+	for name, tc := range map[string]struct {
+		match *rules.RuleMatch
+		want  gitContextNeeds
+	}{
+		"no conditions":  {&rules.RuleMatch{CommandPattern: "git push*"}, gitContextNeeds{}},
+		"branch pattern": {&rules.RuleMatch{BranchPattern: "main"}, gitContextNeeds{branch: true}},
+		"ahead count": {
+			&rules.RuleMatch{MinAhead: 1},
+			gitContextNeeds{branch: true, upstream: true},
+		},
+		"stale branch": {&rules.RuleMatch{MinDaysSinceCommit: 30}, gitContextNeeds{lastCommit: true}},
+		"clean tree":   {&rules.RuleMatch{RequireClean: true}, gitContextNeeds{dirty: true}},
+		"staged path":  {&rules.RuleMatch{RequireStagedPath: "go.sum"}, gitContextNeeds{staged: true}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := gitNeedsOf([]*rules.Rule{{Name: name, Match: tc.match}, {Name: "no match"}})
+			if got != tc.want {
+				t.Fatalf("gitNeedsOf = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
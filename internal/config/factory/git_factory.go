@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"time"
+
 	"github.com/smykla-skalski/klaudiush/internal/git"
 	"github.com/smykla-skalski/klaudiush/internal/rules"
 	"github.com/smykla-skalski/klaudiush/internal/validator"
@@ -16,11 +18,14 @@ type GitValidatorFactory struct {
 	log        logger.Logger
 	gitRunner  git.Runner
 	ruleEngine *rules.RuleEngine
+	now        func() time.Time
+
+	gitCtxProvider func() *rules.GitContext
 }
 
 // NewGitValidatorFactory creates a new GitValidatorFactory.
 func NewGitValidatorFactory(log logger.Logger) *GitValidatorFactory {
-	return &GitValidatorFactory{log: log}
+	return &GitValidatorFactory{log: log, now: time.Now}
 }
 
 // getGitRunner returns the shared cached git runner, creating it lazily.
@@ -98,6 +103,7 @@ func (f *GitValidatorFactory) createAddValidator(
 			f.ruleEngine,
 			rules.ValidatorGitAdd,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -122,6 +128,7 @@ func (f *GitValidatorFactory) createNoVerifyValidator(
 			f.ruleEngine,
 			rules.ValidatorGitNoVerify,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -146,6 +153,7 @@ func (f *GitValidatorFactory) createCommitValidator(
 			f.ruleEngine,
 			rules.ValidatorGitCommit,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -170,6 +178,7 @@ func (f *GitValidatorFactory) createPushValidator(
 			f.ruleEngine,
 			rules.ValidatorGitPush,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -194,6 +203,7 @@ func (f *GitValidatorFactory) createFetchValidator(
 			f.ruleEngine,
 			rules.ValidatorGitFetch,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -218,6 +228,7 @@ func (f *GitValidatorFactory) createPRValidator(
 			f.ruleEngine,
 			rules.ValidatorGitPR,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -243,6 +254,7 @@ func (f *GitValidatorFactory) createBranchValidator(
 			f.ruleEngine,
 			rules.ValidatorGitBranch,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
@@ -280,6 +292,7 @@ func (f *GitValidatorFactory) createMergeValidator(
 			f.ruleEngine,
 			rules.ValidatorGitMerge,
 			rules.WithAdapterLogger(f.log),
+			rules.WithGitContextProvider(f.gitContextProvider()),
 		)
 	}
 
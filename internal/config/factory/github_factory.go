@@ -51,6 +51,18 @@ func (f *GitHubValidatorFactory) CreateValidators(cfg *config.Config) []Validato
 		validators = append(validators, f.createIssueValidator(ghCfg.Issue))
 	}
 
+	// Release validator - create only if explicitly configured and enabled.
+	if ghCfg.Release != nil && ghCfg.Release.IsEnabled() &&
+		!isValidatorOverridden(cfg.Overrides, "github.release") {
+		validators = append(validators, f.createReleaseValidator(ghCfg.Release))
+	}
+
+	// PR merge validator - create only if explicitly configured and enabled.
+	if ghCfg.PRMerge != nil && ghCfg.PRMerge.IsEnabled() &&
+		!isValidatorOverridden(cfg.Overrides, "github.pr_merge") {
+		validators = append(validators, f.createPRMergeValidator(ghCfg.PRMerge))
+	}
+
 	return validators
 }
 
@@ -83,3 +95,55 @@ func (f *GitHubValidatorFactory) createIssueValidator(
 		),
 	}
 }
+
+func (f *GitHubValidatorFactory) createReleaseValidator(
+	cfg *config.ReleaseValidatorConfig,
+) ValidatorWithPredicate {
+	var rc validator.RuleChecker
+
+	if f.ruleEngine != nil {
+		rc = rules.NewRuleValidatorAdapter(
+			f.ruleEngine,
+			rules.ValidatorGitHubRelease,
+			rules.WithAdapterLogger(f.log),
+		)
+	}
+
+	return ValidatorWithPredicate{
+		Validator: wrapValidatorWithSeverity(
+			githubvalidators.NewReleaseValidator(cfg, f.log, rc),
+			cfg,
+		),
+		Predicate: validator.And(
+			beforeToolOrCodexAfterToolPredicate(),
+			validator.ToolTypeIs(hook.ToolTypeBash),
+			validator.CommandContains("gh release create"),
+		),
+	}
+}
+
+func (f *GitHubValidatorFactory) createPRMergeValidator(
+	cfg *config.PRMergeValidatorConfig,
+) ValidatorWithPredicate {
+	var rc validator.RuleChecker
+
+	if f.ruleEngine != nil {
+		rc = rules.NewRuleValidatorAdapter(
+			f.ruleEngine,
+			rules.ValidatorGitHubPRMerge,
+			rules.WithAdapterLogger(f.log),
+		)
+	}
+
+	return ValidatorWithPredicate{
+		Validator: wrapValidatorWithSeverity(
+			githubvalidators.NewPRMergeValidator(cfg, f.log, rc),
+			cfg,
+		),
+		Predicate: validator.And(
+			beforeToolOrCodexAfterToolPredicate(),
+			validator.ToolTypeIs(hook.ToolTypeBash),
+			validator.CommandContains("gh pr merge"),
+		),
+	}
+}
@@ -0,0 +1,176 @@
+package factory
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+)
+
+// patternAliasPrefix marks a rule pattern that references a named alias.
+const patternAliasPrefix = "@"
+
+var (
+	// ErrUnknownPatternAlias is returned when a rule references an undefined alias.
+	ErrUnknownPatternAlias = errors.New("unknown pattern alias")
+
+	// ErrPatternAliasCycle is returned when aliases reference each other in a loop.
+	ErrPatternAliasCycle = errors.New("pattern alias cycle")
+)
+
+// ResolvePatternAliases returns copies of cfgs with every "@name" pattern
+// replaced by its alias definition. A leading "!" negation is preserved.
+// The input rules are not modified.
+func ResolvePatternAliases(
+	cfgs []config.RuleConfig,
+	aliases map[string]string,
+) ([]config.RuleConfig, error) {
+	result := make([]config.RuleConfig, 0, len(cfgs))
+
+	for _, cfg := range cfgs {
+		if cfg.Match != nil {
+			match, err := resolveMatchAliases(*cfg.Match, aliases)
+			if err != nil {
+				return nil, errors.Wrapf(err, "rule %q", cfg.Name)
+			}
+
+			cfg.Match = &match
+		}
+
+		result = append(result, cfg)
+	}
+
+	return result, nil
+}
+
+// resolveMatchAliases resolves aliases in every pattern field of match.
+func resolveMatchAliases(
+	match config.RuleMatchConfig,
+	aliases map[string]string,
+) (config.RuleMatchConfig, error) {
+	var err error
+
+	single := []*string{
+		&match.RepoPattern,
+		&match.BranchPattern,
+		&match.FilePattern,
+		&match.ContentPattern,
+		&match.CommandPattern,
+		&match.PromptPattern,
+		&match.CommitMessagePattern,
+	}
+
+	for _, field := range single {
+		if *field, err = resolvePatternAlias(*field, aliases); err != nil {
+			return match, err
+		}
+	}
+
+	lists := []*[]string{
+		&match.RepoPatterns,
+		&match.BranchPatterns,
+		&match.FilePatterns,
+		&match.ContentPatterns,
+		&match.CommandPatterns,
+	}
+
+	for _, field := range lists {
+		if *field, err = resolvePatternAliasList(*field, aliases); err != nil {
+			return match, err
+		}
+	}
+
+	if match.ContentInFiles, err = resolveContentInFilesAliases(
+		match.ContentInFiles,
+		aliases,
+	); err != nil {
+		return match, err
+	}
+
+	return match, nil
+}
+
+// resolveContentInFilesAliases resolves aliases in a copy of pairs.
+func resolveContentInFilesAliases(
+	pairs []config.ContentInFileConfig,
+	aliases map[string]string,
+) ([]config.ContentInFileConfig, error) {
+	if len(pairs) == 0 {
+		return pairs, nil
+	}
+
+	resolved := make([]config.ContentInFileConfig, len(pairs))
+
+	for i, pair := range pairs {
+		var err error
+
+		resolved[i].FilePattern, err = resolvePatternAlias(pair.FilePattern, aliases)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved[i].ContentPattern, err = resolvePatternAlias(pair.ContentPattern, aliases)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resolved, nil
+}
+
+// resolvePatternAliasList resolves aliases in a copy of patterns.
+func resolvePatternAliasList(patterns []string, aliases map[string]string) ([]string, error) {
+	if len(patterns) == 0 {
+		return patterns, nil
+	}
+
+	resolved := make([]string, len(patterns))
+
+	for i, pattern := range patterns {
+		var err error
+
+		if resolved[i], err = resolvePatternAlias(pattern, aliases); err != nil {
+			return nil, err
+		}
+	}
+
+	return resolved, nil
+}
+
+// resolvePatternAlias returns pattern with an alias reference replaced by
+// its definition. Patterns that are not references are returned unchanged.
+func resolvePatternAlias(pattern string, aliases map[string]string) (string, error) {
+	negation := ""
+	if rest, ok := strings.CutPrefix(pattern, "!"); ok {
+		negation = "!"
+		pattern = rest
+	}
+
+	var chain []string
+
+	for {
+		name, ok := strings.CutPrefix(pattern, patternAliasPrefix)
+		if !ok {
+			return negation + pattern, nil
+		}
+
+		for _, seen := range chain {
+			if seen == name {
+				return "", errors.Wrapf(
+					ErrPatternAliasCycle,
+					"%s",
+					strings.Join(append(chain, name), " -> "),
+				)
+			}
+		}
+
+		definition, defined := aliases[name]
+		if !defined {
+			return "", errors.Wrapf(ErrUnknownPatternAlias, "%q", name)
+		}
+
+		chain = append(chain, name)
+		pattern = definition
+	}
+}
@@ -25,18 +25,18 @@ func NewPluginValidatorFactory(log logger.Logger) *PluginValidatorFactory {
 	}
 }
 
-// CreateValidators creates validators from plugin configuration.
+// CreateValidators creates validators from plugin configuration. Plugins
+// loaded by a previous call are closed when plugins are now disabled.
 func (f *PluginValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorWithPredicate {
-	if cfg == nil || cfg.Plugins == nil || !cfg.Plugins.IsEnabled() {
-		return nil
-	}
+	if cfg == nil || cfg.Plugins == nil || !cfg.Plugins.IsEnabled() ||
+		isValidatorOverridden(cfg.Overrides, "plugins") {
+		f.unloadPlugins()
 
-	if isValidatorOverridden(cfg.Overrides, "plugins") {
 		return nil
 	}
 
-	// Load all plugins
-	if err := f.registry.LoadPlugins(cfg.Plugins); err != nil {
+	// Load all plugins, replacing any loaded by a previous build
+	if err := f.registry.Reload(cfg.Plugins); err != nil {
 		f.logger.Error("failed to load plugins", "error", err)
 
 		return nil
@@ -59,6 +59,14 @@ func (f *PluginValidatorFactory) CreateValidators(cfg *config.Config) []Validato
 	}
 }
 
+// unloadPlugins closes the plugins loaded by a previous build, which
+// validators built earlier stop running.
+func (f *PluginValidatorFactory) unloadPlugins() {
+	if err := f.registry.Reload(nil); err != nil {
+		f.logger.Error("failed to unload plugins", "error", err)
+	}
+}
+
 // Close releases plugin resources.
 func (f *PluginValidatorFactory) Close() error {
 	return f.registry.Close()
@@ -75,22 +83,19 @@ func (v *PluginRegistryValidator) Validate(
 	ctx context.Context,
 	hookCtx *hook.Context,
 ) *validator.Result {
-	// Get validators for plugins that match this context
-	plugins := v.registry.GetValidators(hookCtx)
-	if len(plugins) == 0 {
+	// Run the plugins that match this context and aggregate their results
+	results := v.registry.Validate(ctx, hookCtx)
+	if len(results) == 0 {
 		return validator.Pass()
 	}
 
-	// Run all matching plugins and aggregate results
 	var warnings []string
 
 	var blockingResult validator.Result
 
 	var hasBlockingResult bool
 
-	for _, p := range plugins {
-		result := p.Validate(ctx, hookCtx)
-
+	for _, result := range results {
 		// Collect warnings
 		if !result.Passed && !result.ShouldBlock {
 			warnings = append(warnings, result.Message)
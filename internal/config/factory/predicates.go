@@ -22,6 +22,14 @@ func beforeToolOrCodexAfterToolPredicate() validator.Predicate {
 	)
 }
 
+func claudeAfterWritePredicate() validator.Predicate {
+	return validator.And(
+		validator.ProviderIs(hook.ProviderClaude),
+		validator.EventIs(hook.CanonicalEventAfterTool),
+		validator.ToolTypeIs(hook.ToolTypeWrite),
+	)
+}
+
 func elicitationEventPredicate() validator.Predicate {
 	return validator.Or(
 		validator.EventIs(hook.CanonicalEventElicitation),
@@ -28,6 +28,34 @@ func TestBeforeToolOrProviderAfterToolPredicateDoesNotMatchClaudePostTool(t *tes
 	}
 }
 
+func TestClaudeAfterWritePredicateMatchesOnlyClaudePostToolWrite(t *testing.T) {
+	predicate := claudeAfterWritePredicate()
+
+	if !predicate(&hook.Context{
+		Provider: hook.ProviderClaude,
+		Event:    hook.CanonicalEventAfterTool,
+		ToolName: hook.ToolTypeWrite,
+	}) {
+		t.Fatal("expected Claude post-tool Write to match")
+	}
+
+	if predicate(&hook.Context{
+		Provider: hook.ProviderClaude,
+		Event:    hook.CanonicalEventAfterTool,
+		ToolName: hook.ToolTypeBash,
+	}) {
+		t.Fatal("did not expect Claude post-tool Bash to match")
+	}
+
+	if predicate(&hook.Context{
+		Provider: hook.ProviderClaude,
+		Event:    hook.CanonicalEventBeforeTool,
+		ToolName: hook.ToolTypeWrite,
+	}) {
+		t.Fatal("did not expect Claude pre-tool Write to match")
+	}
+}
+
 func TestLifecycleEventPredicateMatchesPreCompress(t *testing.T) {
 	predicate := lifecycleEventPredicate()
 
@@ -27,17 +27,10 @@ func NewRegistryBuilder(log logger.Logger) *RegistryBuilder {
 // It creates all enabled validators and registers them with their predicates.
 func (b *RegistryBuilder) Build(cfg *config.Config) *validator.Registry {
 	registry := validator.NewRegistry()
-
-	// Get all validators with predicates from factory
-	validatorsWithPredicates := b.factory.CreateAll(cfg)
-
-	// Register each validator with its predicate
-	for _, vp := range validatorsWithPredicates {
-		registry.Register(vp.Validator, vp.Predicate)
-	}
+	registry.Replace(b.registrations(cfg))
 
 	b.log.Debug("registry built",
-		"validator_count", len(validatorsWithPredicates),
+		"validator_count", registry.Count(),
 	)
 
 	return registry
@@ -73,3 +66,60 @@ func (b *RegistryBuilder) BuildWithRuleEngine(
 func (b *RegistryBuilder) CreateRuleEngine(cfg *config.Config) (*rules.RuleEngine, error) {
 	return b.rulesFactory.CreateRuleEngine(cfg)
 }
+
+// Reload rebuilds the rule engine and validators from cfg and atomically swaps
+// them into registry, for embedders that keep a registry across config
+// changes. Dispatches already running keep the validators they found; later
+// dispatches use the new set. If the rule engine cannot be created, registry
+// is left unchanged. Reload must not run concurrently with other builder
+// methods.
+func (b *RegistryBuilder) Reload(
+	registry *validator.Registry,
+	cfg *config.Config,
+) (*rules.RuleEngine, error) {
+	ruleEngine, err := b.rulesFactory.CreateRuleEngine(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reset the engine even when rules are now disabled, so the new
+	// validators don't keep evaluating the previous rules
+	b.factory.SetRuleEngine(ruleEngine)
+
+	registrations := b.registrations(cfg)
+	registry.Replace(registrations)
+
+	ruleCount := 0
+	if ruleEngine != nil {
+		ruleCount = ruleEngine.Size()
+	}
+
+	b.log.Debug("registry reloaded",
+		"validator_count", len(registrations),
+		"rule_count", ruleCount,
+	)
+
+	return ruleEngine, nil
+}
+
+// Close releases the resources of the validators built by b, such as loaded
+// plugins. Registries built by b must not be used after Close.
+func (b *RegistryBuilder) Close() error {
+	return b.factory.Close()
+}
+
+// registrations creates all enabled validators with their predicates.
+func (b *RegistryBuilder) registrations(cfg *config.Config) []validator.Registration {
+	validatorsWithPredicates := b.factory.CreateAll(cfg)
+	registrations := make([]validator.Registration, 0, len(validatorsWithPredicates))
+
+	for _, vp := range validatorsWithPredicates {
+		registrations = append(registrations, validator.Registration{
+			Validator: vp.Validator,
+			Predicate: vp.Predicate,
+			Namespace: vp.Namespace,
+		})
+	}
+
+	return registrations
+}
@@ -0,0 +1,145 @@
+package factory_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/config/factory"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+var _ = Describe("RegistryBuilder", func() {
+	var builder *factory.RegistryBuilder
+
+	BeforeEach(func() {
+		builder = factory.NewRegistryBuilder(logger.NewNoOpLogger())
+	})
+
+	Describe("Reload", func() {
+		gitConfig := func(addEnabled bool) *config.Config {
+			return &config.Config{
+				Validators: &config.ValidatorsConfig{
+					Git: &config.GitConfig{
+						Add: &config.AddValidatorConfig{
+							ValidatorConfig: config.ValidatorConfig{Enabled: new(addEnabled)},
+						},
+						Commit: &config.CommitValidatorConfig{
+							ValidatorConfig: config.ValidatorConfig{Enabled: new(true)},
+						},
+					},
+					File:         &config.FileConfig{},
+					Notification: &config.NotificationConfig{},
+					Shell:        &config.ShellConfig{},
+				},
+			}
+		}
+
+		withRules := func(ruleCfg config.RuleConfig) *config.Config {
+			cfg := gitConfig(true)
+			cfg.Rules = &config.RulesConfig{
+				Enabled: new(true),
+				Rules:   []config.RuleConfig{ruleCfg},
+			}
+
+			return cfg
+		}
+
+		It("should swap in the validators built from the new config", func() {
+			registry := builder.Build(gitConfig(true))
+			Expect(registry.Count()).To(Equal(2))
+
+			_, err := builder.Reload(registry, gitConfig(false))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registry.Count()).To(Equal(1))
+
+			_, err = builder.Reload(registry, gitConfig(true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registry.Count()).To(Equal(2))
+		})
+
+		It("should return the rebuilt rule engine", func() {
+			registry := builder.Build(gitConfig(true))
+
+			engine, err := builder.Reload(registry, withRules(config.RuleConfig{
+				Name:   "block-push",
+				Match:  &config.RuleMatchConfig{ValidatorType: "git.push"},
+				Action: &config.RuleActionConfig{Type: "block"},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(engine).NotTo(BeNil())
+			Expect(engine.Size()).To(Equal(1))
+
+			engine, err = builder.Reload(registry, gitConfig(true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(engine).To(BeNil())
+		})
+
+		It("should leave the registry unchanged when rules fail to build", func() {
+			registry := builder.Build(gitConfig(false))
+			initial := registry.Count()
+
+			_, err := builder.Reload(registry, withRules(config.RuleConfig{
+				Name:  "no-aws-keys",
+				Match: &config.RuleMatchConfig{ContentPattern: "@aws_key"},
+			}))
+			Expect(err).To(MatchError(factory.ErrUnknownPatternAlias))
+			Expect(registry.Count()).To(Equal(initial))
+		})
+	})
+
+	Describe("Validators", func() {
+		It("should describe the validators built from the config", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					Git: &config.GitConfig{
+						Commit: &config.CommitValidatorConfig{
+							ValidatorConfig: config.ValidatorConfig{
+								Enabled:  new(true),
+								Severity: config.SeverityWarning,
+							},
+						},
+						Push: &config.PushValidatorConfig{
+							ValidatorConfig: config.ValidatorConfig{Enabled: new(true)},
+						},
+					},
+					Secrets: &config.SecretsConfig{
+						Secrets: &config.SecretsValidatorConfig{
+							ValidatorConfig: config.ValidatorConfig{Enabled: new(true)},
+						},
+					},
+					File:         &config.FileConfig{},
+					Notification: &config.NotificationConfig{},
+					Shell:        &config.ShellConfig{},
+				},
+			}
+
+			built := factory.NewValidatorFactory(logger.NewNoOpLogger()).CreateAll(cfg)
+			infos := builder.Build(cfg).Validators()
+			Expect(infos).To(HaveLen(len(built)))
+
+			byName := make(map[string]validator.ValidatorInfo, len(infos))
+			for i, info := range infos {
+				Expect(info.Name).To(Equal(built[i].Validator.Name()))
+				byName[info.Name] = info
+			}
+
+			Expect(byName).To(HaveKeyWithValue("validate-commit", validator.ValidatorInfo{
+				Name:               "validate-commit",
+				Category:           validator.CategoryGit,
+				Severity:           config.SeverityWarning,
+				ReferenceNamespace: validator.NamespaceGit,
+			}))
+			Expect(byName).To(HaveKeyWithValue("validate-git-push", validator.ValidatorInfo{
+				Name:               "validate-git-push",
+				Category:           validator.CategoryGit,
+				Severity:           config.SeverityError,
+				ReferenceNamespace: validator.NamespaceGit,
+			}))
+			Expect(byName).To(HaveKeyWithValue("validate-secrets", HaveField(
+				"ReferenceNamespace", validator.NamespaceSecrets,
+			)))
+		})
+	})
+})
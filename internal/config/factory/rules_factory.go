@@ -41,14 +41,25 @@ func (f *RulesFactory) CreateRuleEngine(cfg *config.Config) (*rules.RuleEngine,
 		return nil, nil
 	}
 
+	ruleConfigs, err := ResolvePatternAliases(rulesConfig.Rules, rulesConfig.Patterns)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert config rules to internal rules
-	internalRules := make([]*rules.Rule, 0, len(rulesConfig.Rules))
+	internalRules := make([]*rules.Rule, 0, len(ruleConfigs))
 
-	for _, ruleConfig := range rulesConfig.Rules {
+	for _, ruleConfig := range ruleConfigs {
 		if !ruleConfig.IsRuleEnabled() {
 			continue
 		}
 
+		if !rulesConfig.IsRuleSelected(&ruleConfig) {
+			f.log.Debug("rule skipped by tag filter", "rule", ruleConfig.Name)
+
+			continue
+		}
+
 		internalRule := convertRuleConfig(ruleConfig)
 		internalRules = append(internalRules, internalRule)
 	}
@@ -63,6 +74,13 @@ func (f *RulesFactory) CreateRuleEngine(cfg *config.Config) (*rules.RuleEngine,
 	opts := []rules.EngineOption{
 		rules.WithLogger(f.log),
 		rules.WithEngineStopOnFirstMatch(rulesConfig.ShouldStopOnFirstMatch()),
+		rules.WithEngineAllowWins(rulesConfig.AllowWins),
+		rules.WithEngineIndex(rulesConfig.IndexByValidator),
+		rules.WithEngineFileContent(
+			rulesConfig.LoadFileContent,
+			rulesConfig.GetMaxFileContentSize(),
+		),
+		rules.WithEngineRateLimiter(rules.NewRateLimitStore()),
 	}
 
 	engine, err := rules.NewRuleEngine(internalRules, opts...)
@@ -77,6 +95,18 @@ func (f *RulesFactory) CreateRuleEngine(cfg *config.Config) (*rules.RuleEngine,
 	return engine, nil
 }
 
+// ConvertRules converts rule configurations to rules without compiling
+// them. Disabled rules are included with Enabled set to false.
+func ConvertRules(cfgs []config.RuleConfig) []*rules.Rule {
+	result := make([]*rules.Rule, 0, len(cfgs))
+
+	for _, cfg := range cfgs {
+		result = append(result, convertRuleConfig(cfg))
+	}
+
+	return result
+}
+
 // convertRuleConfig converts a config.RuleConfig to a rules.Rule.
 func convertRuleConfig(cfg config.RuleConfig) *rules.Rule {
 	rule := &rules.Rule{
@@ -89,38 +119,92 @@ func convertRuleConfig(cfg config.RuleConfig) *rules.Rule {
 	// Convert match conditions
 	if cfg.Match != nil {
 		rule.Match = &rules.RuleMatch{
-			ValidatorType:   rules.ValidatorType(cfg.Match.ValidatorType),
-			Provider:        cfg.Match.Provider,
-			RepoPattern:     cfg.Match.RepoPattern,
-			RepoPatterns:    cfg.Match.RepoPatterns,
-			Remote:          cfg.Match.Remote,
-			BranchPattern:   cfg.Match.BranchPattern,
-			BranchPatterns:  cfg.Match.BranchPatterns,
-			FilePattern:     cfg.Match.FilePattern,
-			FilePatterns:    cfg.Match.FilePatterns,
-			ContentPattern:  cfg.Match.ContentPattern,
-			ContentPatterns: cfg.Match.ContentPatterns,
-			CommandPattern:  cfg.Match.CommandPattern,
-			CommandPatterns: cfg.Match.CommandPatterns,
-			ToolType:        cfg.Match.ToolType,
-			EventType:       cfg.Match.EventType,
-			CaseInsensitive: cfg.Match.IsCaseInsensitive(),
-			PatternMode:     cfg.Match.GetPatternMode(),
+			ValidatorType:        rules.ValidatorType(cfg.Match.ValidatorType),
+			Provider:             cfg.Match.Provider,
+			RepoPattern:          cfg.Match.RepoPattern,
+			RepoPatterns:         cfg.Match.RepoPatterns,
+			Remote:               cfg.Match.Remote,
+			BranchPattern:        cfg.Match.BranchPattern,
+			BranchPatterns:       cfg.Match.BranchPatterns,
+			FilePattern:          cfg.Match.FilePattern,
+			FilePatterns:         cfg.Match.FilePatterns,
+			FileExtensions:       cfg.Match.FileExtensions,
+			ContentPattern:       cfg.Match.ContentPattern,
+			ContentPatterns:      cfg.Match.ContentPatterns,
+			ContentInFiles:       convertContentInFiles(cfg.Match.ContentInFiles),
+			CommandPattern:       cfg.Match.CommandPattern,
+			CommandPatterns:      cfg.Match.CommandPatterns,
+			CommandContains:      cfg.Match.CommandContains,
+			PromptPattern:        cfg.Match.PromptPattern,
+			CommitMessagePattern: cfg.Match.CommitMessagePattern,
+			MinSubjectLength:     cfg.Match.MinSubjectLength,
+			MaxSubjectLength:     cfg.Match.MaxSubjectLength,
+			ToolType:             cfg.Match.ToolType,
+			EventType:            cfg.Match.EventType,
+			Scope:                rules.Scope(cfg.Match.Scope),
+			OS:                   cfg.Match.OS,
+			RequireUpstream:      cfg.Match.RequireUpstream,
+			MinAhead:             cfg.Match.MinAhead,
+			MinBehind:            cfg.Match.MinBehind,
+			MinDaysSinceCommit:   cfg.Match.MinDaysSinceCommit,
+			RequireDirty:         cfg.Match.RequireDirty,
+			RequireClean:         cfg.Match.RequireClean,
+			StagedPathPattern:    cfg.Match.StagedPathPattern,
+			RequireStagedPath:    cfg.Match.RequireStagedPath,
+			IsBinary:             cfg.Match.IsBinary,
+			UsesSudo:             cfg.Match.UsesSudo,
+			MinCommandPaths:      cfg.Match.MinCommandPaths,
+			MinConsecutiveBlocks: cfg.Match.MinConsecutiveBlocks,
+			LoadFileContent:      cfg.Match.LoadFileContent,
+			CaseInsensitive:      cfg.Match.IsCaseInsensitive(),
+			PatternMode:          cfg.Match.GetPatternMode(),
+			PathMode:             cfg.Match.GetPathMode(),
 		}
 	}
 
+	if window := cfg.RateLimit.GetWindow(); window > 0 {
+		rule.RateLimit = &rules.RateLimit{Window: window}
+	}
+
 	// Convert action
 	if cfg.Action != nil {
 		rule.Action = &rules.RuleAction{
 			Type:      convertActionType(cfg.Action.GetActionType()),
 			Message:   cfg.Action.Message,
 			Reference: cfg.Action.Reference,
+			Severity:  cfg.Action.Severity,
+		}
+
+		if rule.Action.Type == rules.ActionTransform {
+			rule.Action.Transform = &rules.Transform{
+				Field:   rules.TransformField(cfg.Action.Field),
+				Find:    cfg.Action.Find,
+				Replace: cfg.Action.Replace,
+			}
 		}
 	}
 
 	return rule
 }
 
+// convertContentInFiles converts content-in-files config entries to rule pairs.
+func convertContentInFiles(cfgs []config.ContentInFileConfig) []rules.ContentInFile {
+	if len(cfgs) == 0 {
+		return nil
+	}
+
+	pairs := make([]rules.ContentInFile, 0, len(cfgs))
+
+	for _, cfg := range cfgs {
+		pairs = append(pairs, rules.ContentInFile{
+			FilePattern:    cfg.FilePattern,
+			ContentPattern: cfg.ContentPattern,
+		})
+	}
+
+	return pairs
+}
+
 // convertActionType converts a string action type to rules.ActionType.
 func convertActionType(actionType string) rules.ActionType {
 	switch actionType {
@@ -130,6 +214,10 @@ func convertActionType(actionType string) rules.ActionType {
 		return rules.ActionWarn
 	case "allow":
 		return rules.ActionAllow
+	case "log":
+		return rules.ActionLog
+	case "transform":
+		return rules.ActionTransform
 	default:
 		return rules.ActionBlock
 	}
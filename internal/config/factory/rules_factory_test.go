@@ -1,7 +1,9 @@
 package factory_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -163,6 +165,39 @@ var _ = Describe("RulesFactory", func() {
 			Expect(engine).NotTo(BeNil())
 		})
 
+		It("should let allow rules win with allow_wins", func() {
+			enabled := true
+			cfg := &config.Config{
+				Rules: &config.RulesConfig{
+					Enabled:   &enabled,
+					AllowWins: true,
+					Rules: []config.RuleConfig{
+						{
+							Name:     "block-push",
+							Priority: 100,
+							Match:    &config.RuleMatchConfig{ValidatorType: "git.push"},
+							Action:   &config.RuleActionConfig{Type: "block"},
+						},
+						{
+							Name:     "allow-push",
+							Priority: 1,
+							Match:    &config.RuleMatchConfig{ValidatorType: "git.push"},
+							Action:   &config.RuleActionConfig{Type: "allow"},
+						},
+					},
+				},
+			}
+
+			engine, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			result := engine.Evaluate(context.Background(), &rules.MatchContext{
+				ValidatorType: rules.ValidatorGitPush,
+			})
+			Expect(result.Rule.Name).To(Equal("allow-push"))
+			Expect(result.Action).To(Equal(rules.ActionAllow))
+		})
+
 		It("should convert action types correctly", func() {
 			enabled := true
 			cfg := &config.Config{
@@ -218,5 +253,255 @@ var _ = Describe("RulesFactory", func() {
 			rule := engine.GetRule("unknown-action-rule")
 			Expect(rule.Action.Type).To(Equal(rules.ActionBlock))
 		})
+
+		It("should convert the rate limit", func() {
+			converted := factory.ConvertRules([]config.RuleConfig{
+				{
+					Name: "limited",
+					RateLimit: &config.RuleRateLimitConfig{
+						Window: config.Duration(10 * time.Minute),
+					},
+				},
+				{Name: "unlimited"},
+			})
+
+			Expect(converted[0].RateLimit).To(Equal(&rules.RateLimit{Window: 10 * time.Minute}))
+			Expect(converted[1].RateLimit).To(BeNil())
+		})
+
+		It("should resolve pattern aliases", func() {
+			enabled := true
+			cfg := &config.Config{
+				Rules: &config.RulesConfig{
+					Enabled:  &enabled,
+					Patterns: map[string]string{"aws_key": "AKIA[0-9A-Z]{16}"},
+					Rules: []config.RuleConfig{
+						{
+							Name:   "no-aws-keys",
+							Match:  &config.RuleMatchConfig{ContentPattern: "@aws_key"},
+							Action: &config.RuleActionConfig{Type: "block"},
+						},
+					},
+				},
+			}
+
+			engine, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(engine.GetRule("no-aws-keys").Match.ContentPattern).
+				To(Equal("AKIA[0-9A-Z]{16}"))
+		})
+
+		It("should fail on unknown pattern aliases", func() {
+			enabled := true
+			cfg := &config.Config{
+				Rules: &config.RulesConfig{
+					Enabled: &enabled,
+					Rules: []config.RuleConfig{
+						{
+							Name:  "no-aws-keys",
+							Match: &config.RuleMatchConfig{ContentPattern: "@aws_key"},
+						},
+					},
+				},
+			}
+
+			_, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).To(MatchError(factory.ErrUnknownPatternAlias))
+			Expect(err.Error()).To(ContainSubstring(`rule "no-aws-keys"`))
+		})
+	})
+
+	Describe("tag filtering", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			ruleDisabled := false
+			cfg = &config.Config{
+				Rules: &config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name:   "secrets",
+							Tags:   []string{"security"},
+							Action: &config.RuleActionConfig{Type: "block"},
+						},
+						{
+							Name:   "new-secrets",
+							Tags:   []string{"security", "experimental"},
+							Action: &config.RuleActionConfig{Type: "block"},
+						},
+						{
+							Name:   "licenses",
+							Tags:   []string{"compliance"},
+							Action: &config.RuleActionConfig{Type: "warn"},
+						},
+						{
+							Name:   "untagged",
+							Action: &config.RuleActionConfig{Type: "warn"},
+						},
+						{
+							Name:    "disabled-security",
+							Tags:    []string{"security"},
+							Enabled: &ruleDisabled,
+							Action:  &config.RuleActionConfig{Type: "block"},
+						},
+					},
+				},
+			}
+		})
+
+		ruleNames := func(engine *rules.RuleEngine) []string {
+			names := make([]string, 0, engine.Size())
+			for _, rule := range engine.GetAllRules() {
+				names = append(names, rule.Name)
+			}
+
+			return names
+		}
+
+		It("should load all enabled rules without a filter", func() {
+			engine, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleNames(engine)).To(ConsistOf(
+				"secrets", "new-secrets", "licenses", "untagged",
+			))
+		})
+
+		It("should load only rules with one of the only_tagged tags", func() {
+			cfg.Rules.OnlyTagged = []string{"security"}
+
+			engine, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleNames(engine)).To(ConsistOf("secrets", "new-secrets"))
+		})
+
+		It("should match any of several only_tagged tags", func() {
+			cfg.Rules.OnlyTagged = []string{"experimental", "compliance"}
+
+			engine, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleNames(engine)).To(ConsistOf("new-secrets", "licenses"))
+		})
+
+		It("should skip rules with any skip_tagged tag", func() {
+			cfg.Rules.SkipTagged = []string{"experimental"}
+
+			engine, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleNames(engine)).To(ConsistOf("secrets", "licenses", "untagged"))
+		})
+
+		It("should let skip_tagged win over only_tagged", func() {
+			cfg.Rules.OnlyTagged = []string{"security"}
+			cfg.Rules.SkipTagged = []string{"experimental"}
+
+			engine, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleNames(engine)).To(ConsistOf("secrets"))
+		})
+
+		It("should keep disabled rules out when their tag is selected", func() {
+			cfg.Rules.OnlyTagged = []string{"security"}
+
+			engine, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(engine.GetRule("disabled-security")).To(BeNil())
+		})
+
+		It("should return nil when no rule is selected", func() {
+			cfg.Rules.OnlyTagged = []string{"performance"}
+
+			engine, err := rulesFactory.CreateRuleEngine(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(engine).To(BeNil())
+		})
+	})
+
+	Describe("ResolvePatternAliases", func() {
+		aliases := map[string]string{
+			"aws_key":  "AKIA[0-9A-Z]{16}",
+			"secret":   "@aws_key",
+			"main":     "main",
+			"cycle_a":  "@cycle_b",
+			"cycle_b":  "@cycle_a",
+			"self_ref": "@self_ref",
+		}
+
+		resolve := func(match *config.RuleMatchConfig) (*config.RuleMatchConfig, error) {
+			resolved, err := factory.ResolvePatternAliases(
+				[]config.RuleConfig{{Name: "rule", Match: match}},
+				aliases,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return resolved[0].Match, nil
+		}
+
+		It("should resolve single and list patterns", func() {
+			match, err := resolve(&config.RuleMatchConfig{
+				BranchPattern:   "@main",
+				ContentPatterns: []string{"@aws_key", "password="},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match.BranchPattern).To(Equal("main"))
+			Expect(match.ContentPatterns).To(Equal([]string{"AKIA[0-9A-Z]{16}", "password="}))
+		})
+
+		It("should resolve content_in_files patterns", func() {
+			match, err := resolve(&config.RuleMatchConfig{
+				ContentInFiles: []config.ContentInFileConfig{
+					{FilePattern: "**/*.env", ContentPattern: "@secret"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match.ContentInFiles).To(Equal([]config.ContentInFileConfig{
+				{FilePattern: "**/*.env", ContentPattern: "AKIA[0-9A-Z]{16}"},
+			}))
+		})
+
+		It("should resolve aliases that reference other aliases", func() {
+			match, err := resolve(&config.RuleMatchConfig{ContentPattern: "@secret"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match.ContentPattern).To(Equal("AKIA[0-9A-Z]{16}"))
+		})
+
+		It("should keep negation", func() {
+			match, err := resolve(&config.RuleMatchConfig{BranchPattern: "!@main"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match.BranchPattern).To(Equal("!main"))
+		})
+
+		It("should leave patterns without references unchanged", func() {
+			match, err := resolve(&config.RuleMatchConfig{CommandPattern: "git push*"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match.CommandPattern).To(Equal("git push*"))
+		})
+
+		It("should not modify the input rules", func() {
+			input := []config.RuleConfig{
+				{Match: &config.RuleMatchConfig{FilePatterns: []string{"@main"}}},
+			}
+
+			_, err := factory.ResolvePatternAliases(input, aliases)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input[0].Match.FilePatterns).To(Equal([]string{"@main"}))
+		})
+
+		It("should fail on unknown aliases", func() {
+			_, err := resolve(&config.RuleMatchConfig{RepoPatterns: []string{"@missing"}})
+			Expect(err).To(MatchError(factory.ErrUnknownPatternAlias))
+			Expect(err.Error()).To(ContainSubstring(`"missing"`))
+		})
+
+		DescribeTable("should fail on cycles",
+			func(pattern, chain string) {
+				_, err := resolve(&config.RuleMatchConfig{ContentPattern: pattern})
+				Expect(err).To(MatchError(factory.ErrPatternAliasCycle))
+				Expect(err.Error()).To(ContainSubstring(chain))
+			},
+			Entry("two aliases", "@cycle_a", "cycle_a -> cycle_b -> cycle_a"),
+			Entry("self reference", "@self_ref", "self_ref -> self_ref"),
+		)
 	})
 })
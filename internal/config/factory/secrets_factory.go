@@ -4,10 +4,11 @@ import (
 	"regexp"
 	"time"
 
-	execpkg "github.com/smykla-skalski/klaudiush/internal/exec"
+	"github.com/smykla-skalski/klaudiush/internal/git"
 	"github.com/smykla-skalski/klaudiush/internal/linters"
 	"github.com/smykla-skalski/klaudiush/internal/rules"
 	"github.com/smykla-skalski/klaudiush/internal/validator"
+	gitvalidators "github.com/smykla-skalski/klaudiush/internal/validators/git"
 	"github.com/smykla-skalski/klaudiush/internal/validators/secrets"
 	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
@@ -32,7 +33,7 @@ func (f *SecretsValidatorFactory) SetRuleEngine(engine *rules.RuleEngine) {
 
 // CreateValidators creates all secrets validators based on configuration.
 func (f *SecretsValidatorFactory) CreateValidators(cfg *config.Config) []ValidatorWithPredicate {
-	validators := make([]ValidatorWithPredicate, 0, 1)
+	validators := make([]ValidatorWithPredicate, 0, 2)
 
 	// Check override first
 	if isValidatorOverridden(cfg.Overrides, "secrets") {
@@ -55,7 +56,7 @@ func (f *SecretsValidatorFactory) CreateValidators(cfg *config.Config) []Validat
 	detector := f.createDetector(secretsCfg)
 
 	// Create gitleaks checker
-	gitleaks := f.createGitleaksChecker(timeout)
+	gitleaks := f.createGitleaksChecker(cfg.Global, timeout)
 
 	// Create rule checker if rule engine is configured
 	var rc validator.RuleChecker
@@ -78,6 +79,24 @@ func (f *SecretsValidatorFactory) CreateValidators(cfg *config.Config) []Validat
 		),
 	})
 
+	if secretsCfg.IsScanPushEnabled() {
+		pushValidator := secrets.NewSecretsValidator(
+			f.log, detector, gitleaks, secretsCfg, rc,
+			secrets.WithGitRunner(git.NewCachedRunner(gitvalidators.NewGitRunner())),
+			secrets.WithGitRunnerForPath(func(path string) git.Runner {
+				return git.NewCachedRunner(gitvalidators.NewGitRunnerForPath(path))
+			}),
+		)
+
+		validators = append(validators, ValidatorWithPredicate{
+			Validator: wrapValidatorWithSeverity(pushValidator, secretsCfg),
+			Predicate: validator.And(
+				beforeToolOrCodexAfterToolPredicate(),
+				validator.GitSubcommandIs("push"),
+			),
+		})
+	}
+
 	return validators
 }
 
@@ -137,9 +156,8 @@ func (f *SecretsValidatorFactory) buildCustomPatterns(
 
 // createGitleaksChecker creates a gitleaks checker.
 func (*SecretsValidatorFactory) createGitleaksChecker(
+	global *config.GlobalConfig,
 	timeout time.Duration,
 ) linters.GitleaksChecker {
-	runner := execpkg.NewCommandRunner(timeout)
-
-	return linters.NewGitleaksChecker(runner)
+	return linters.NewGitleaksChecker(lintRunner(global, timeout))
 }
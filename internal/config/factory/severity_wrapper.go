@@ -36,12 +36,17 @@ type severityWrappedValidator struct {
 	severity config.Severity
 }
 
+// Severity returns the configured severity.
+func (v *severityWrappedValidator) Severity() config.Severity {
+	return v.severity
+}
+
 func (v *severityWrappedValidator) Validate(
 	ctx context.Context,
 	hookCtx *hook.Context,
 ) *validator.Result {
 	result := v.Validator.Validate(ctx, hookCtx)
-	if result == nil || result.Passed || !result.ShouldBlock {
+	if result == nil || result.Passed {
 		return result
 	}
 
@@ -49,8 +54,13 @@ func (v *severityWrappedValidator) Validate(
 		return result
 	}
 
+	if !result.ShouldBlock && v.severity != config.SeverityInfo {
+		return result
+	}
+
 	cloned := *result
 	cloned.ShouldBlock = false
+	cloned.Informational = v.severity == config.SeverityInfo
 
 	return &cloned
 }
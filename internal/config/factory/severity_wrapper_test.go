@@ -95,3 +95,39 @@ func TestWrapValidatorWithSeveritySkipsDefaultErrorSeverity(t *testing.T) {
 		t.Fatal("expected error severity to preserve blocking result")
 	}
 }
+
+func TestWrapValidatorWithSeverityMarksInfoResults(t *testing.T) {
+	for _, base := range []*validator.Result{
+		validator.FailWithRef(validator.RefGitMissingFlags, "missing flags"),
+		validator.WarnWithRef(validator.RefGitMissingFlags, "missing flags"),
+	} {
+		wrapped := wrapValidatorWithSeverity(
+			fakeValidator{name: "fake", category: validator.CategoryCPU, result: base},
+			fakeSeverityConfig{severity: config.SeverityInfo},
+		)
+		result := wrapped.Validate(context.Background(), &hook.Context{})
+
+		if result == nil {
+			t.Fatal("expected result")
+		}
+
+		if result.ShouldBlock || !result.Informational {
+			t.Fatalf("expected info severity to make %s result informational", base)
+		}
+
+		if base.Informational {
+			t.Fatal("expected the validator's own result to be left unchanged")
+		}
+	}
+}
+
+func TestWrapValidatorWithSeverityPassesInfoPassingResults(t *testing.T) {
+	wrapped := wrapValidatorWithSeverity(
+		fakeValidator{name: "fake", category: validator.CategoryCPU, result: validator.Pass()},
+		fakeSeverityConfig{severity: config.SeverityInfo},
+	)
+
+	if result := wrapped.Validate(context.Background(), &hook.Context{}); result.Informational {
+		t.Fatal("expected passing result to stay a pass")
+	}
+}
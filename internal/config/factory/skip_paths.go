@@ -0,0 +1,70 @@
+package factory
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+// skipPathsPredicate returns a predicate that rejects files matching any of
+// the filter's skip_paths globs. Invalid globs are logged and ignored.
+func skipPathsPredicate(filter config.PathFilterConfig, log logger.Logger) validator.Predicate {
+	patterns := make([]string, 0, len(filter.SkipPaths))
+
+	for _, pattern := range filter.SkipPaths {
+		pattern = filepath.ToSlash(pattern)
+		if !doublestar.ValidatePattern(pattern) {
+			log.Error("ignoring invalid skip_paths glob", "pattern", pattern)
+
+			continue
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	if len(patterns) == 0 {
+		return validator.Always()
+	}
+
+	return func(ctx *hook.Context) bool {
+		forms := skipPathForms(ctx)
+
+		for _, pattern := range patterns {
+			for _, form := range forms {
+				if doublestar.MatchUnvalidated(pattern, form) {
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+}
+
+// skipPathForms returns the forms of the hook's file path that skip_paths
+// globs match against: the path as given, the path relative to the working
+// directory when it is inside it, and the file name.
+func skipPathForms(ctx *hook.Context) []string {
+	filePath := ctx.GetFilePath()
+	if filePath == "" {
+		return nil
+	}
+
+	forms := []string{filepath.ToSlash(filePath)}
+
+	if filepath.IsAbs(filePath) && ctx.WorkingDir != "" {
+		rel, err := filepath.Rel(ctx.WorkingDir, filePath)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			forms = append(forms, filepath.ToSlash(rel))
+		}
+	}
+
+	return append(forms, path.Base(forms[0]))
+}
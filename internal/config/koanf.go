@@ -14,6 +14,7 @@ import (
 	"github.com/knadh/koanf/providers/file"
 	"github.com/knadh/koanf/v2"
 
+	"github.com/smykla-skalski/klaudiush/internal/rules"
 	"github.com/smykla-skalski/klaudiush/internal/xdg"
 	"github.com/smykla-skalski/klaudiush/pkg/config"
 )
@@ -35,6 +36,9 @@ var (
 
 	// ErrInvalidPermissions is returned when config file has insecure permissions.
 	ErrInvalidPermissions = errors.New("config file has insecure permissions")
+
+	// ErrUnknownProfile is returned when the selected config profile is not defined.
+	ErrUnknownProfile = errors.New("unknown config profile")
 )
 
 const (
@@ -52,6 +56,16 @@ const (
 
 	// ProjectConfigFileAlt is the alternative project configuration file name.
 	ProjectConfigFileAlt = "klaudiush.toml"
+
+	// ProjectConfigFileHidden is the hidden project configuration file name.
+	ProjectConfigFileHidden = ".klaudiush.toml"
+
+	// RulesDirName is the directory next to a config file whose *.toml files
+	// contribute additional [[rules.rules]] entries.
+	RulesDirName = "rules.d"
+
+	// ProfileEnvVar is the environment variable that selects a config profile.
+	ProfileEnvVar = "KLAUDIUSH_PROFILE"
 )
 
 // Default configuration constants for koanf map defaults.
@@ -70,6 +84,10 @@ const (
 	defaultExceptionMinReasonLength = 10
 )
 
+// ConfigFileNames are the file names searched, in order, when --config points
+// to a directory.
+var ConfigFileNames = []string{ProjectConfigFile, ProjectConfigFileAlt, ProjectConfigFileHidden}
+
 // defaultValidTypes is the list of valid commit types.
 var defaultValidTypes = []string{
 	"build", "chore", "ci", "docs", "feat",
@@ -86,11 +104,13 @@ var defaultBranchValidTypes = []string{
 // Precedence order (highest to lowest):
 // 1. CLI Flags
 // 2. Environment Variables (KLAUDIUSH_*)
-// 3. Project Config (.klaudiush/config.toml or klaudiush.toml)
-// 4. Global Config (~/.klaudiush/config.toml)
-// 5. Defaults
+// 3. Selected Profile ([profiles.<name>] via --profile or KLAUDIUSH_PROFILE)
+// 4. Project Config (--config or discovered config file, then .klaudiush/rules.d/*.toml)
+// 5. Global Config (~/.klaudiush/config.toml, then ~/.klaudiush/rules.d/*.toml)
+// 6. Defaults
 type KoanfLoader struct {
 	k        *koanf.Koanf
+	explicit *koanf.Koanf
 	homeDir  string
 	workDir  string
 	paths    xdg.PathResolver
@@ -133,11 +153,12 @@ func makeKoanfLoader(homeDir, workDir string, paths xdg.PathResolver) *KoanfLoad
 }
 
 // Load loads configuration from all sources with precedence.
-// Defaults → Global TOML → Project TOML → Env Vars → CLI Flags
+// Defaults → Global TOML → Project TOML → Profile → Env Vars → CLI Flags
 //
 // Rules have special merge semantics:
 // - Rules with the same name: project overrides global
 // - Rules with different names: combined (both included)
+// - rules.d files: merged in filename order after their config file, by name
 func (l *KoanfLoader) Load(flags map[string]any) (*config.Config, error) {
 	cfg, err := l.LoadWithoutValidation(flags)
 	if err != nil {
@@ -156,14 +177,20 @@ func (l *KoanfLoader) Load(flags map[string]any) (*config.Config, error) {
 // LoadWithoutValidation loads configuration without running validation.
 // This is useful for tools that need to fix invalid configurations.
 func (l *KoanfLoader) LoadWithoutValidation(flags map[string]any) (*config.Config, error) {
-	// Reset koanf instance for fresh load
+	// Reset koanf instances for fresh load
 	l.k = koanf.New(".")
+	l.explicit = koanf.New(".")
 
 	// Track rules from each source for proper merging
 	var globalRules []config.RuleConfig
 
 	var projectRules []config.RuleConfig
 
+	sources, err := l.Sources(flags)
+	if err != nil {
+		return nil, err
+	}
+
 	// 1. Load defaults first (lowest priority)
 	defaults := defaultsToMap()
 	if err := l.k.Load(confmap.Provider(defaults, "."), nil); err != nil {
@@ -171,41 +198,66 @@ func (l *KoanfLoader) LoadWithoutValidation(flags map[string]any) (*config.Confi
 	}
 
 	// 2. Global config: ~/.klaudiush/config.toml
-	globalPath := l.GlobalConfigPath()
-	if err := l.loadTOMLFile(globalPath); err != nil && !os.IsNotExist(err) {
+	if err := l.loadTOMLFile(sources.GlobalConfig); err != nil && !os.IsNotExist(err) {
 		return nil, errors.Wrap(err, "failed to load global config")
 	} else if err == nil {
-		globalRules = l.extractRules()
+		globalRules, err = extractRules(l.k)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load global config")
+		}
 	}
 
-	// 3. Project config: .klaudiush/config.toml or klaudiush.toml
-	projectPath := l.findProjectConfig()
-	if projectPath != "" {
-		if err := l.loadTOMLFile(projectPath); err != nil {
+	globalRules, err = mergeRulesDir(globalRules, sources.GlobalRulesDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load global rules directory")
+	}
+
+	// 3. Project config: --config, or .klaudiush/config.toml, klaudiush.toml
+	// or .klaudiush.toml
+	if sources.ProjectConfig != "" {
+		if err := l.loadTOMLFile(sources.ProjectConfig); err != nil {
+			return nil, errors.Wrap(err, "failed to load project config")
+		}
+
+		projectRules, err = extractRules(l.k)
+		if err != nil {
 			return nil, errors.Wrap(err, "failed to load project config")
 		}
+	}
+
+	projectRules, err = mergeRulesDir(projectRules, sources.ProjectRulesDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load project rules directory")
+	}
 
-		projectRules = l.extractRules()
+	// 4. Profile overrides: [profiles.<name>] from global and project config
+	if err := l.applyProfile(flags); err != nil {
+		return nil, err
 	}
 
-	// 4. Environment variables: KLAUDIUSH_*
+	// 5. Environment variables: KLAUDIUSH_*
 	envOpt := env.Opt{
 		Prefix:        "KLAUDIUSH_",
 		TransformFunc: l.envTransform,
 	}
 
-	if err := l.k.Load(env.Provider(".", envOpt), nil, deepMergeOpt); err != nil {
+	if err := l.load(env.Provider(".", envOpt), nil); err != nil {
 		return nil, errors.Wrap(err, "failed to load env vars")
 	}
 
-	// 5. CLI flags (highest priority)
+	// 6. CLI flags (highest priority)
 	if len(flags) > 0 {
 		flagConfig := l.flagsToConfig(flags)
-		if err := l.k.Load(confmap.Provider(flagConfig, "."), nil, deepMergeOpt); err != nil {
+		if err := l.load(confmap.Provider(flagConfig, "."), nil); err != nil {
 			return nil, errors.Wrap(err, "failed to load flags")
 		}
 	}
 
+	// 7. Bulk [validators] enabled/disabled selectors, below explicit settings
+	if err := l.applyValidatorSelectors(); err != nil {
+		return nil, err
+	}
+
 	// Unmarshal into config struct
 	var cfg config.Config
 	if err := l.k.UnmarshalWithConf("", &cfg, l.tomlOpts); err != nil {
@@ -224,9 +276,51 @@ func (l *KoanfLoader) LoadWithoutValidation(flags map[string]any) (*config.Confi
 	return &cfg, nil
 }
 
-// extractRules extracts rules from the current koanf state.
-func (l *KoanfLoader) extractRules() []config.RuleConfig {
-	rulesSlice := l.k.Slices("rules.rules")
+// applyProfile deep-merges the selected profile's overrides on top of the
+// file-based config. The profile comes from the "profile" flag, falling back
+// to the KLAUDIUSH_PROFILE env var. Returns ErrUnknownProfile if it isn't defined.
+func (l *KoanfLoader) applyProfile(flags map[string]any) error {
+	name := selectedProfile(flags)
+	if name == "" {
+		return nil
+	}
+
+	path := "profiles." + name
+	if strings.Contains(name, ".") || !l.k.Exists(path) {
+		available := l.k.MapKeys("profiles")
+		if len(available) == 0 {
+			return errors.Wrapf(ErrUnknownProfile, "%q (no profiles defined)", name)
+		}
+
+		return errors.Wrapf(
+			ErrUnknownProfile,
+			"%q (available: %s)",
+			name,
+			strings.Join(available, ", "),
+		)
+	}
+
+	overrides := l.k.Cut(path).Raw()
+	if err := l.load(confmap.Provider(overrides, "."), nil); err != nil {
+		return errors.Wrapf(err, "failed to load profile %q", name)
+	}
+
+	return nil
+}
+
+// selectedProfile returns the profile name from flags or the environment.
+func selectedProfile(flags map[string]any) string {
+	if name, ok := flags["profile"].(string); ok && name != "" {
+		return strings.TrimSpace(name)
+	}
+
+	return strings.TrimSpace(os.Getenv(ProfileEnvVar))
+}
+
+// extractRules extracts rules from the given koanf state. It fails on an
+// invalid action severity.
+func extractRules(k *koanf.Koanf) ([]config.RuleConfig, error) {
+	rulesSlice := k.Slices("rules.rules")
 	rules := make([]config.RuleConfig, 0, len(rulesSlice))
 
 	for _, ruleK := range rulesSlice {
@@ -236,6 +330,7 @@ func (l *KoanfLoader) extractRules() []config.RuleConfig {
 		rule.Name = ruleK.String("name")
 		rule.Description = ruleK.String("description")
 		rule.Priority = ruleK.Int("priority")
+		rule.Tags = ruleK.Strings("tags")
 
 		if ruleK.Exists("enabled") {
 			enabled := ruleK.Bool("enabled")
@@ -245,15 +340,43 @@ func (l *KoanfLoader) extractRules() []config.RuleConfig {
 		// Extract match conditions
 		if ruleK.Exists("match") {
 			rule.Match = &config.RuleMatchConfig{
-				ValidatorType:  ruleK.String("match.validator_type"),
-				RepoPattern:    ruleK.String("match.repo_pattern"),
-				Remote:         ruleK.String("match.remote"),
-				BranchPattern:  ruleK.String("match.branch_pattern"),
-				FilePattern:    ruleK.String("match.file_pattern"),
-				ContentPattern: ruleK.String("match.content_pattern"),
-				CommandPattern: ruleK.String("match.command_pattern"),
-				ToolType:       ruleK.String("match.tool_type"),
-				EventType:      ruleK.String("match.event_type"),
+				ValidatorType:        ruleK.String("match.validator_type"),
+				RepoPattern:          ruleK.String("match.repo_pattern"),
+				Remote:               ruleK.String("match.remote"),
+				BranchPattern:        ruleK.String("match.branch_pattern"),
+				FilePattern:          ruleK.String("match.file_pattern"),
+				FileExtensions:       ruleK.Strings("match.file_extensions"),
+				ContentPattern:       ruleK.String("match.content_pattern"),
+				CommandPattern:       ruleK.String("match.command_pattern"),
+				CommandContains:      ruleK.Strings("match.command_contains"),
+				PromptPattern:        ruleK.String("match.prompt_pattern"),
+				CommitMessagePattern: ruleK.String("match.commit_message_pattern"),
+				MinSubjectLength:     ruleK.Int("match.min_subject_length"),
+				MaxSubjectLength:     ruleK.Int("match.max_subject_length"),
+				ToolType:             ruleK.String("match.tool_type"),
+				EventType:            ruleK.String("match.event_type"),
+				Scope:                ruleK.String("match.scope"),
+				OS:                   ruleK.Strings("match.os"),
+				RequireUpstream:      ruleK.Bool("match.require_upstream"),
+				MinAhead:             ruleK.Int("match.min_ahead"),
+				MinBehind:            ruleK.Int("match.min_behind"),
+				MinDaysSinceCommit:   ruleK.Int("match.min_days_since_commit"),
+				RequireDirty:         ruleK.Bool("match.require_dirty"),
+				RequireClean:         ruleK.Bool("match.require_clean"),
+				StagedPathPattern:    ruleK.String("match.staged_path_pattern"),
+				RequireStagedPath:    ruleK.String("match.require_staged_path"),
+				IsBinary:             ruleK.Bool("match.is_binary"),
+				UsesSudo:             ruleK.Bool("match.uses_sudo"),
+				MinCommandPaths:      ruleK.Int("match.min_command_paths"),
+				MinConsecutiveBlocks: ruleK.Int("match.min_consecutive_blocks"),
+				LoadFileContent:      ruleK.Bool("match.load_file_content"),
+				PatternMode:          ruleK.String("match.pattern_mode"),
+				PathMode:             ruleK.String("match.path_mode"),
+				ContentInFiles:       extractContentInFiles(ruleK),
+			}
+
+			if ruleK.Exists("match.case_insensitive") {
+				rule.Match.CaseInsensitive = new(ruleK.Bool("match.case_insensitive"))
 			}
 		}
 
@@ -263,13 +386,55 @@ func (l *KoanfLoader) extractRules() []config.RuleConfig {
 				Type:      ruleK.String("action.type"),
 				Message:   ruleK.String("action.message"),
 				Reference: ruleK.String("action.reference"),
+				Field:     ruleK.String("action.field"),
+				Find:      ruleK.String("action.find"),
+				Replace:   ruleK.String("action.replace"),
+			}
+
+			if severity := ruleK.String("action.severity"); severity != "" {
+				parsed, err := config.ParseSeverity(severity)
+				if err != nil {
+					return nil, errors.Wrapf(err, "rule %q action severity", rule.Name)
+				}
+
+				rule.Action.Severity = parsed
+			}
+		}
+
+		if ruleK.Exists("rate_limit") {
+			rule.RateLimit = &config.RuleRateLimitConfig{}
+
+			if window := ruleK.String("rate_limit.window"); window != "" {
+				if err := rule.RateLimit.Window.UnmarshalText([]byte(window)); err != nil {
+					return nil, errors.Wrapf(err, "rule %q rate limit window", rule.Name)
+				}
 			}
 		}
 
 		rules = append(rules, rule)
 	}
 
-	return rules
+	return rules, nil
+}
+
+// extractContentInFiles extracts the [[rules.rules.match.content_in_files]]
+// entries of a rule.
+func extractContentInFiles(ruleK *koanf.Koanf) []config.ContentInFileConfig {
+	pairsSlice := ruleK.Slices("match.content_in_files")
+	if len(pairsSlice) == 0 {
+		return nil
+	}
+
+	pairs := make([]config.ContentInFileConfig, 0, len(pairsSlice))
+
+	for _, pairK := range pairsSlice {
+		pairs = append(pairs, config.ContentInFileConfig{
+			FilePattern:    pairK.String("file_pattern"),
+			ContentPattern: pairK.String("content_pattern"),
+		})
+	}
+
+	return pairs
 }
 
 // mergeRules merges global and project rules.
@@ -327,9 +492,111 @@ func mergeRules(globalRules, projectRules []config.RuleConfig) []config.RuleConf
 	return merged
 }
 
+// mergeRulesDir merges the rules from every *.toml file in dir into rules,
+// in filename order. A rule in a later file overrides an earlier rule with
+// the same name. An empty or missing directory contributes no rules.
+func mergeRulesDir(rules []config.RuleConfig, dir string) ([]config.RuleConfig, error) {
+	if dir == "" {
+		return rules, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return rules, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to read %s", dir)
+	}
+
+	// os.ReadDir returns entries sorted by filename
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".toml" {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+
+		fileRules, err := loadRulesFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load %s", path)
+		}
+
+		rules = mergeRules(rules, fileRules)
+	}
+
+	return rules, nil
+}
+
+// loadRulesFile loads the [[rules.rules]] entries of a rules.d file.
+// Other sections in the file are ignored.
+func loadRulesFile(path string) ([]config.RuleConfig, error) {
+	if err := checkConfigPermissions(path); err != nil {
+		return nil, err
+	}
+
+	k := koanf.New(".")
+	if err := k.Load(file.Provider(path), tomlparser.Parser()); err != nil {
+		return nil, err
+	}
+
+	return extractRules(k)
+}
+
 // loadTOMLFile loads a TOML configuration file with security checks.
 func (l *KoanfLoader) loadTOMLFile(path string) error {
-	// Check if file exists
+	if err := checkConfigPermissions(path); err != nil {
+		return err
+	}
+
+	return l.load(file.Provider(path), tomlparser.Parser())
+}
+
+// load merges a user-provided source into the config and records its keys
+// as explicitly set, unlike the built-in defaults.
+func (l *KoanfLoader) load(p koanf.Provider, pa koanf.Parser) error {
+	if err := l.k.Load(p, pa, deepMergeOpt); err != nil {
+		return err
+	}
+
+	return l.explicit.Load(p, pa, deepMergeOpt)
+}
+
+// applyValidatorSelectors applies the [validators] enabled and disabled
+// selectors to every validator whose "enabled" key was not set explicitly by
+// a config file, profile, env var or flag. Enabled wins over disabled.
+func (l *KoanfLoader) applyValidatorSelectors() error {
+	states := make(map[string]bool)
+
+	for _, selector := range []struct {
+		key     string
+		enabled bool
+	}{
+		{"validators.disabled", false},
+		{"validators.enabled", true},
+	} {
+		for _, name := range l.k.Strings(selector.key) {
+			for _, target := range resolveDisableName(strings.TrimSpace(name)) {
+				states["validators."+strings.Join(target.path, ".")+".enabled"] = selector.enabled
+			}
+		}
+	}
+
+	for key, enabled := range states {
+		if l.explicit.Exists(key) {
+			continue
+		}
+
+		if err := l.k.Set(key, enabled); err != nil {
+			return errors.Wrapf(err, "failed to apply validator selector to %s", key)
+		}
+	}
+
+	return nil
+}
+
+// checkConfigPermissions checks that path exists and is not world-writable.
+func checkConfigPermissions(path string) error {
 	info, err := os.Stat(path)
 	if err != nil {
 		return err
@@ -345,7 +612,7 @@ func (l *KoanfLoader) loadTOMLFile(path string) error {
 		)
 	}
 
-	return l.k.Load(file.Provider(path), tomlparser.Parser(), deepMergeOpt)
+	return nil
 }
 
 // envHierarchy maps each valid parent path to its known child segment names.
@@ -375,6 +642,7 @@ var envHierarchy = map[string][]string{
 		"no_verify",
 		"merge",
 	},
+	"global":                {"log"},
 	"validators.git.commit": {"message"},
 	"validators.git.merge":  {"message"},
 	"validators.file": {
@@ -480,14 +748,143 @@ func (l *KoanfLoader) GlobalConfigPath() string {
 	return xdg.ResolveFile(xdgPath, legacyPath)
 }
 
+// GlobalRulesDir returns the rules.d directory next to the global config file.
+func (l *KoanfLoader) GlobalRulesDir() string {
+	return filepath.Join(filepath.Dir(l.GlobalConfigPath()), RulesDirName)
+}
+
+// projectRulesDir returns the project rules.d directory. It lives in the
+// .klaudiush directory of the project whose config was found, or of the
+// working directory when there is no project config. Returns empty string
+// when it is the global rules directory, so those rules aren't loaded twice.
+func (l *KoanfLoader) projectRulesDir(projectPath string) string {
+	dir := filepath.Join(l.workDir, ProjectConfigDir)
+
+	if projectPath != "" {
+		dir = filepath.Dir(projectPath)
+		if filepath.Base(dir) != ProjectConfigDir {
+			dir = filepath.Join(dir, ProjectConfigDir)
+		}
+	}
+
+	rulesDir := filepath.Join(dir, RulesDirName)
+	if rulesDir == l.GlobalRulesDir() {
+		return ""
+	}
+
+	return rulesDir
+}
+
+// ProjectRulesDir returns the project rules.d directory that Load reads, or
+// empty string when it is the global rules directory.
+func (l *KoanfLoader) ProjectRulesDir() string {
+	return l.projectRulesDir(l.findProjectConfig())
+}
+
+// ConfigSources are the config files and rules directories Load reads for a
+// set of flags. Paths may not exist.
+type ConfigSources struct {
+	// GlobalConfig is the global config file.
+	GlobalConfig string
+
+	// GlobalRulesDir is the rules.d directory next to the global config.
+	GlobalRulesDir string
+
+	// ProjectConfig is the project config file, or empty string when none
+	// was found.
+	ProjectConfig string
+
+	// ProjectRulesDir is the project rules.d directory, or empty string when
+	// it is the global rules directory.
+	ProjectRulesDir string
+}
+
+// Sources returns the config sources Load reads for flags, using the same
+// discovery as Load. It fails like Load when --config points to a missing
+// path or a directory without a config file.
+func (l *KoanfLoader) Sources(flags map[string]any) (*ConfigSources, error) {
+	projectPath, err := l.resolveProjectConfig(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConfigSources{
+		GlobalConfig:    l.GlobalConfigPath(),
+		GlobalRulesDir:  l.GlobalRulesDir(),
+		ProjectConfig:   projectPath,
+		ProjectRulesDir: l.projectRulesDir(projectPath),
+	}, nil
+}
+
 // ProjectConfigPaths returns the paths to check for project configuration.
 func (l *KoanfLoader) ProjectConfigPaths() []string {
+	return projectConfigCandidates(l.workDir)
+}
+
+// projectConfigCandidates returns the project config paths in dir, in
+// search order.
+func projectConfigCandidates(dir string) []string {
 	return []string{
-		filepath.Join(l.workDir, ProjectConfigDir, ProjectConfigFile),
-		filepath.Join(l.workDir, ProjectConfigFileAlt),
+		filepath.Join(dir, ProjectConfigDir, ProjectConfigFile),
+		filepath.Join(dir, ProjectConfigFileAlt),
+		filepath.Join(dir, ProjectConfigFileHidden),
 	}
 }
 
+// findConfigInDir returns the first of ConfigFileNames in dir, falling back
+// to the project config paths so a project root works too. Returns empty
+// string if dir has no config file.
+func findConfigInDir(dir string) string {
+	candidates := make([]string, 0, len(ConfigFileNames))
+
+	for _, name := range ConfigFileNames {
+		candidates = append(candidates, filepath.Join(dir, name))
+	}
+
+	for _, path := range append(candidates, projectConfigCandidates(dir)...) {
+		if fileExists(path) {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// resolveProjectConfig returns the project config file to load. The
+// config_path flag wins over discovery: a file is used as is, and a directory
+// is searched with findConfigInDir. Relative paths are resolved against the
+// working directory.
+func (l *KoanfLoader) resolveProjectConfig(flags map[string]any) (string, error) {
+	path, _ := flags["config_path"].(string)
+	if path == "" {
+		return l.findProjectConfig(), nil
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(l.workDir, path)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", errors.Wrapf(ErrConfigNotFound, "%s", path)
+	}
+
+	if !info.IsDir() {
+		return path, nil
+	}
+
+	if found := findConfigInDir(path); found != "" {
+		return found, nil
+	}
+
+	return "", errors.Wrapf(
+		ErrConfigNotFound,
+		"no %s in %s",
+		strings.Join(ConfigFileNames, ", "),
+		path,
+	)
+}
+
 // findProjectConfig checks for project config files and returns the first found.
 // Falls back to walking up parent directories if nothing is found in the cwd.
 func (l *KoanfLoader) findProjectConfig() string {
@@ -507,10 +904,7 @@ func (l *KoanfLoader) walkUpForConfig() string {
 	dir := filepath.Dir(l.workDir)
 
 	for {
-		for _, candidate := range []string{
-			filepath.Join(dir, ProjectConfigDir, ProjectConfigFile),
-			filepath.Join(dir, ProjectConfigFileAlt),
-		} {
+		for _, candidate := range projectConfigCandidates(dir) {
 			if candidate != globalPath && fileExists(candidate) {
 				return candidate
 			}
@@ -602,7 +996,21 @@ func (*KoanfLoader) flagsToConfig(flags map[string]any) map[string]any {
 		case "timeout":
 			if strVal, ok := value.(string); ok {
 				globalMap := ensureMapKey(result, "global")
-				globalMap["default_timeout"] = strVal
+				globalMap["hook_timeout"] = strVal
+			}
+
+		case "only-tagged":
+			// Handle --only-tagged=security,compliance
+			if tags, ok := value.([]string); ok {
+				rulesMap := ensureMapKey(result, "rules")
+				rulesMap["only_tagged"] = tags
+			}
+
+		case "skip-tagged":
+			// Handle --skip-tagged=experimental
+			if tags, ok := value.([]string); ok {
+				rulesMap := ensureMapKey(result, "rules")
+				rulesMap["skip_tagged"] = tags
 			}
 		}
 	}
@@ -621,52 +1029,88 @@ func ensureMapKey(cfg map[string]any, key string) map[string]any {
 	return result
 }
 
+// disableTarget is a validator that can be disabled with --disable.
+type disableTarget struct {
+	// path is the config path below "validators".
+	path []string
+
+	// validatorType is the rule taxonomy type of the validator.
+	validatorType rules.ValidatorType
+}
+
+// disableTargets maps short validator names accepted by --disable to their
+// config path and rule validator type.
+var disableTargets = map[string]disableTarget{
+	"commit":        {[]string{"git", "commit"}, rules.ValidatorGitCommit},
+	"push":          {[]string{"git", "push"}, rules.ValidatorGitPush},
+	"add":           {[]string{"git", "add"}, rules.ValidatorGitAdd},
+	"pr":            {[]string{"git", "pr"}, rules.ValidatorGitPR},
+	"branch":        {[]string{"git", "branch"}, rules.ValidatorGitBranch},
+	"no_verify":     {[]string{"git", "no_verify"}, rules.ValidatorGitNoVerify},
+	"merge":         {[]string{"git", "merge"}, rules.ValidatorGitMerge},
+	"fetch":         {[]string{"git", "fetch"}, rules.ValidatorGitFetch},
+	"markdown":      {[]string{"file", "markdown"}, rules.ValidatorFileMarkdown},
+	"shellscript":   {[]string{"file", "shellscript"}, rules.ValidatorFileShell},
+	"terraform":     {[]string{"file", "terraform"}, rules.ValidatorFileTerraform},
+	"workflow":      {[]string{"file", "workflow"}, rules.ValidatorFileWorkflow},
+	"gofumpt":       {[]string{"file", "gofumpt"}, rules.ValidatorFileGofumpt},
+	"python":        {[]string{"file", "python"}, rules.ValidatorFilePython},
+	"javascript":    {[]string{"file", "javascript"}, rules.ValidatorFileJavaScript},
+	"rust":          {[]string{"file", "rust"}, rules.ValidatorFileRust},
+	"linter_ignore": {[]string{"file", "linter_ignore"}, rules.ValidatorFileLinterIgnore},
+	"secrets":       {[]string{"secrets", "secrets"}, rules.ValidatorSecrets},
+	"backtick":      {[]string{"shell", "backtick"}, rules.ValidatorShellBacktick},
+	"issue":         {[]string{"github", "issue"}, rules.ValidatorGitHubIssue},
+	"release":       {[]string{"github", "release"}, rules.ValidatorGitHubRelease},
+	"pr_merge":      {[]string{"github", "pr_merge"}, rules.ValidatorGitHubPRMerge},
+	"bell":          {[]string{"notification", "bell"}, rules.ValidatorNotification},
+}
+
 // applyDisableFlags applies --disable flags to the config map.
+// Each name is a short validator name ("markdown"), a dotted name
+// ("file.markdown", "file.shellscript" or its rule type "file.shell"), or a
+// rule validator type wildcard ("git.*", "*"). Unknown names are ignored.
 func applyDisableFlags(cfg map[string]any, validatorNames []string) {
-	validatorPaths := map[string][]string{
-		"commit":        {"git", "commit"},
-		"push":          {"git", "push"},
-		"add":           {"git", "add"},
-		"pr":            {"git", "pr"},
-		"branch":        {"git", "branch"},
-		"no_verify":     {"git", "no_verify"},
-		"merge":         {"git", "merge"},
-		"fetch":         {"git", "fetch"},
-		"markdown":      {"file", "markdown"},
-		"shellscript":   {"file", "shellscript"},
-		"terraform":     {"file", "terraform"},
-		"workflow":      {"file", "workflow"},
-		"gofumpt":       {"file", "gofumpt"},
-		"python":        {"file", "python"},
-		"javascript":    {"file", "javascript"},
-		"rust":          {"file", "rust"},
-		"linter_ignore": {"file", "linter_ignore"},
-		"secrets":       {"secrets", "secrets"},
-		"backtick":      {"shell", "backtick"},
-		"issue":         {"github", "issue"},
-		"bell":          {"notification", "bell"},
-	}
-
 	for _, name := range validatorNames {
-		name = strings.TrimSpace(name)
+		for _, target := range resolveDisableName(strings.TrimSpace(name)) {
+			validators := ensureMapKey(cfg, "validators")
+			current := validators
 
-		path, ok := validatorPaths[name]
-		if !ok {
-			continue
+			// Navigate/create path
+			for i := range len(target.path) - 1 {
+				current = ensureMapKey(current, target.path[i])
+			}
+
+			// Set enabled = false on the final level
+			finalMap := ensureMapKey(current, target.path[len(target.path)-1])
+			finalMap["enabled"] = false
 		}
+	}
+}
 
-		validators := ensureMapKey(cfg, "validators")
-		current := validators
+// resolveDisableName returns the validators selected by a --disable name or a
+// [validators] enabled/disabled selector.
+func resolveDisableName(name string) []disableTarget {
+	if target, ok := disableTargets[name]; ok {
+		return []disableTarget{target}
+	}
 
-		// Navigate/create path
-		for i := range len(path) - 1 {
-			current = ensureMapKey(current, path[i])
-		}
+	if name == "" {
+		return nil
+	}
 
-		// Set enabled = false on the final level
-		finalMap := ensureMapKey(current, path[len(path)-1])
-		finalMap["enabled"] = false
+	matcher := rules.NewValidatorTypeMatcher(rules.ValidatorType(name))
+
+	var targets []disableTarget
+
+	for _, target := range disableTargets {
+		if name == strings.Join(target.path, ".") ||
+			matcher.Match(&rules.MatchContext{ValidatorType: target.validatorType}) {
+			targets = append(targets, target)
+		}
 	}
+
+	return targets
 }
 
 // defaultsToMap converts DefaultConfig to a map for koanf loading.
@@ -703,6 +1147,9 @@ func defaultRulesMap() map[string]any {
 	return map[string]any{
 		"enabled":             true,
 		"stop_on_first_match": true,
+		"allow_wins":          false,
+		"index_by_validator":  false,
+		"load_file_content":   false,
 		"rules":               []any{},
 	}
 }
@@ -732,6 +1179,13 @@ func defaultGlobalMap() map[string]any {
 	return map[string]any{
 		"use_sdk_git":     true,
 		"default_timeout": defaultTimeoutStr,
+		"result_sink":     config.ResultSinkStderr,
+		"result_file":     xdg.ResultsFile(),
+		"log": map[string]any{
+			"max_size_mb": config.DefaultLogMaxSizeMB,
+			"max_backups": config.DefaultLogMaxBackups,
+			"compress":    false,
+		},
 	}
 }
 
@@ -773,10 +1227,18 @@ func defaultGitValidatorsMap() map[string]any {
 
 func defaultCommitMap() map[string]any {
 	return map[string]any{
-		"enabled":            true,
-		"severity":           "error",
-		"required_flags":     []string{"-s", "-S"},
-		"check_staging_area": true,
+		"enabled":                    true,
+		"severity":                   "error",
+		"required_flags":             []string{"-s", "-S"},
+		"check_staging_area":         true,
+		"max_diff_lines":             0,
+		"max_diff_bytes":             0,
+		"diff_size_exclude":          []string{},
+		"block_on_large_diff":        false,
+		"block_amend_pushed":         true,
+		"require_changelog_fragment": false,
+		"changelog_dir":              "changelog.d",
+		"exempt_types":               []string{"chore", "docs"},
 		"message": map[string]any{
 			"enabled":                  true,
 			"title_max_length":         config.DefaultTitleMaxLength,
@@ -789,16 +1251,22 @@ func defaultCommitMap() map[string]any {
 			"block_ai_attribution":     true,
 			"valid_types":              defaultValidTypes,
 			"expected_signoff":         "",
+			"require_gitmoji":          false,
+			"block_emoji":              false,
 		},
 	}
 }
 
 func defaultPushMap() map[string]any {
 	return map[string]any{
-		"enabled":          true,
-		"severity":         "error",
-		"blocked_remotes":  []string{},
-		"require_tracking": true,
+		"enabled":                true,
+		"severity":               "error",
+		"blocked_remotes":        []string{},
+		"allowed_remotes":        []string{},
+		"require_tracking":       true,
+		"blocked_commit_markers": config.DefaultBlockedCommitMarkers,
+		"commit_marker_branches": config.DefaultCommitMarkerBranches,
+		"commit_marker_severity": "error",
 	}
 }
 
@@ -811,9 +1279,12 @@ func defaultFetchMap() map[string]any {
 
 func defaultAddMap() map[string]any {
 	return map[string]any{
-		"enabled":          true,
-		"severity":         "error",
-		"blocked_patterns": []string{"tmp/*"},
+		"enabled":                   true,
+		"severity":                  "error",
+		"blocked_patterns":          []string{"tmp/*"},
+		"max_add_file_size_kb":      0,
+		"blocked_binary_extensions": []string{},
+		"block_on_large_files":      false,
 	}
 }
 
@@ -868,6 +1339,9 @@ func defaultMarkdownMap() map[string]any {
 		"code_block_formatting": true,
 		"list_formatting":       true,
 		"use_markdownlint":      true,
+
+		"require_front_matter":       false,
+		"required_front_matter_keys": []string{},
 	}
 }
 
@@ -892,6 +1366,10 @@ func defaultTerraformMap() map[string]any {
 		"tool_preference": "auto",
 		"check_format":    true,
 		"use_tflint":      true,
+
+		"require_terraform_version_constraint": false,
+		"require_provider_version_constraints": false,
+		"block_on_missing_version_constraints": false,
 	}
 }
 
@@ -905,6 +1383,8 @@ func defaultWorkflowMap() map[string]any {
 		"require_version_comment": true,
 		"check_latest_version":    true,
 		"use_actionlint":          true,
+		"allowed_runners":         []string{},
+		"block_latest_runners":    false,
 	}
 }
 
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const commitDisabledConfig = `
+version = 1
+[validators.git.commit]
+enabled = false
+`
+
+var _ = Describe("Explicit config path", func() {
+	var (
+		loader  *KoanfLoader
+		workDir string
+	)
+
+	BeforeEach(func() {
+		var homeDir string
+
+		loader, homeDir, _, workDir = newWalkupLoader(0)
+
+		DeferCleanup(func() { os.RemoveAll(homeDir) })
+	})
+
+	writeFile := func(path, content string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+	}
+
+	resolve := func(configPath string) (string, error) {
+		return loader.resolveProjectConfig(map[string]any{"config_path": configPath})
+	}
+
+	It("loads the config contained in a directory", func() {
+		writeFile(filepath.Join(workDir, "somedir", ProjectConfigFile), commitDisabledConfig)
+
+		cfg, err := loader.Load(map[string]any{"config_path": "./somedir"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*cfg.Validators.Git.Commit.Enabled).To(BeFalse())
+	})
+
+	It("uses a file path as is", func() {
+		path := filepath.Join(workDir, "custom", "policy.toml")
+		writeFile(path, "version = 1\n")
+
+		Expect(resolve(path)).To(Equal(path))
+	})
+
+	It("takes precedence over the discovered project config", func() {
+		writeConfigAt(workDir, "version = 1\n")
+		writeFile(filepath.Join(workDir, "other", ProjectConfigFileHidden), "version = 1\n")
+
+		Expect(resolve("other")).
+			To(Equal(filepath.Join(workDir, "other", ProjectConfigFileHidden)))
+	})
+
+	DescribeTable("should search directory file names in order",
+		func(present []string, expected string) {
+			dir := filepath.Join(workDir, "cfg")
+			for _, name := range present {
+				writeFile(filepath.Join(dir, name), "version = 1\n")
+			}
+
+			Expect(resolve(dir)).To(Equal(filepath.Join(dir, expected)))
+		},
+		Entry("config.toml first",
+			[]string{ProjectConfigFileHidden, ProjectConfigFileAlt, ProjectConfigFile},
+			ProjectConfigFile),
+		Entry("klaudiush.toml before .klaudiush.toml",
+			[]string{ProjectConfigFileHidden, ProjectConfigFileAlt},
+			ProjectConfigFileAlt),
+		Entry(".klaudiush.toml alone",
+			[]string{ProjectConfigFileHidden},
+			ProjectConfigFileHidden),
+		Entry("project root with .klaudiush/config.toml",
+			[]string{filepath.Join(ProjectConfigDir, ProjectConfigFile)},
+			filepath.Join(ProjectConfigDir, ProjectConfigFile)),
+	)
+
+	It("fails when the directory has no config file", func() {
+		Expect(os.MkdirAll(filepath.Join(workDir, "empty"), 0o755)).To(Succeed())
+
+		_, err := resolve("empty")
+		Expect(err).To(MatchError(ErrConfigNotFound))
+	})
+
+	It("fails when the path does not exist", func() {
+		_, err := loader.Load(map[string]any{"config_path": "missing.toml"})
+		Expect(err).To(MatchError(ErrConfigNotFound))
+	})
+
+	It("finds .klaudiush.toml during discovery", func() {
+		writeFile(filepath.Join(workDir, ProjectConfigFileHidden), commitDisabledConfig)
+
+		Expect(loader.findProjectConfig()).
+			To(Equal(filepath.Join(workDir, ProjectConfigFileHidden)))
+	})
+})
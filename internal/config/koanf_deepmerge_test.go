@@ -3,9 +3,12 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 )
 
 // helper to create a loader with separate home and work dirs.
@@ -51,9 +54,9 @@ var _ = Describe("Deep merge config integration", func() {
 		// --- Markdown ---
 		Context("markdown: only enabled=true", func() {
 			It("preserves all markdown defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.file.markdown]
 enabled = true
 `)
@@ -74,14 +77,40 @@ enabled = true
 				Expect(*md.ListFormatting).To(BeTrue(), "list_formatting")
 				Expect(md.ContextLines).NotTo(BeNil(), "context_lines nil")
 				Expect(*md.ContextLines).To(Equal(2), "context_lines")
+				Expect(md.RequireFrontMatter).NotTo(BeNil(), "require_front_matter nil")
+				Expect(*md.RequireFrontMatter).To(BeFalse(), "require_front_matter")
+				Expect(md.RequiredFrontMatterKeys).To(BeEmpty(), "required_front_matter_keys")
+			})
+		})
+
+		Context("markdown: only required_front_matter_keys", func() {
+			It("preserves all markdown defaults", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, `[validators.file.markdown]
+required_front_matter_keys = ["title", "description"]
+`)
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				md := cfg.Validators.File.Markdown
+				Expect(md.IsEnabled()).To(BeTrue(), "enabled preserved")
+				Expect(md.RequiredFrontMatterKeys).To(Equal([]string{"title", "description"}))
+				Expect(md.RequireFrontMatter).NotTo(BeNil(), "require_front_matter nil")
+				Expect(*md.RequireFrontMatter).To(BeFalse(), "require_front_matter preserved")
+				Expect(*md.UseMarkdownlint).To(BeTrue(), "use_markdownlint preserved")
+				Expect(*md.HeadingSpacing).To(BeTrue(), "heading_spacing preserved")
+				Expect(*md.ContextLines).To(Equal(2), "context_lines preserved")
 			})
 		})
 
 		Context("markdown: only use_markdownlint=false", func() {
 			It("preserves enabled and other booleans", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.file.markdown]
 use_markdownlint = false
 `)
@@ -101,9 +130,9 @@ use_markdownlint = false
 		// --- Shellscript ---
 		Context("shellscript: only severity=warning", func() {
 			It("preserves all shellscript defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.file.shellscript]
 severity = "warning"
 `)
@@ -125,9 +154,9 @@ severity = "warning"
 		// --- Terraform ---
 		Context("terraform: only check_format=false", func() {
 			It("preserves all terraform defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.file.terraform]
 check_format = false
 `)
@@ -142,15 +171,41 @@ check_format = false
 				Expect(tf.UseTflint).NotTo(BeNil(), "use_tflint nil")
 				Expect(*tf.UseTflint).To(BeTrue(), "use_tflint preserved")
 				Expect(tf.ToolPreference).To(Equal("auto"), "tool_preference preserved")
+				Expect(tf.RequireTerraformVersionConstraint).NotTo(BeNil(), "require_terraform_version_constraint nil")
+				Expect(*tf.RequireTerraformVersionConstraint).To(BeFalse(), "require_terraform_version_constraint preserved")
+			})
+		})
+
+		Context("terraform: only require_provider_version_constraints=true", func() {
+			It("preserves all terraform defaults", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, `[validators.file.terraform]
+require_provider_version_constraints = true
+`)
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				tf := cfg.Validators.File.Terraform
+				Expect(tf.IsEnabled()).To(BeTrue(), "enabled preserved")
+				Expect(*tf.RequireProviderVersionConstraints).To(BeTrue(), "require_provider_version_constraints set")
+				Expect(tf.RequireTerraformVersionConstraint).NotTo(BeNil(), "require_terraform_version_constraint nil")
+				Expect(*tf.RequireTerraformVersionConstraint).To(BeFalse(), "require_terraform_version_constraint preserved")
+				Expect(tf.BlockOnMissingVersionConstraints).NotTo(BeNil(), "block_on_missing_version_constraints nil")
+				Expect(*tf.BlockOnMissingVersionConstraints).To(BeFalse(), "block_on_missing_version_constraints preserved")
+				Expect(*tf.CheckFormat).To(BeTrue(), "check_format preserved")
+				Expect(*tf.UseTflint).To(BeTrue(), "use_tflint preserved")
 			})
 		})
 
 		// --- Workflow ---
 		Context("workflow: only enforce_digest_pinning=false", func() {
 			It("preserves all workflow defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.file.workflow]
 enforce_digest_pinning = false
 `)
@@ -164,15 +219,41 @@ enforce_digest_pinning = false
 				Expect(*wf.RequireVersionComment).To(BeTrue(), "require_version_comment preserved")
 				Expect(*wf.CheckLatestVersion).To(BeTrue(), "check_latest_version preserved")
 				Expect(*wf.UseActionlint).To(BeTrue(), "use_actionlint preserved")
+				Expect(wf.AllowedRunners).To(BeEmpty(), "allowed_runners preserved")
+				Expect(*wf.BlockLatestRunners).To(BeFalse(), "block_latest_runners preserved")
+			})
+		})
+
+		Context("workflow: only runner policy", func() {
+			It("preserves digest pinning and actionlint defaults", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, `[validators.file.workflow]
+allowed_runners = ["self-hosted", "linux"]
+block_latest_runners = true
+`)
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				wf := cfg.Validators.File.Workflow
+				Expect(wf.AllowedRunners).To(Equal([]string{"self-hosted", "linux"}), "allowed_runners set")
+				Expect(*wf.BlockLatestRunners).To(BeTrue(), "block_latest_runners set")
+				Expect(wf.IsEnabled()).To(BeTrue(), "enabled preserved")
+				Expect(*wf.EnforceDigestPinning).To(BeTrue(), "enforce_digest_pinning preserved")
+				Expect(*wf.RequireVersionComment).To(BeTrue(), "require_version_comment preserved")
+				Expect(*wf.CheckLatestVersion).To(BeTrue(), "check_latest_version preserved")
+				Expect(*wf.UseActionlint).To(BeTrue(), "use_actionlint preserved")
 			})
 		})
 
 		// --- Commit ---
 		Context("commit: only severity=warning", func() {
 			It("preserves all commit defaults including nested message config", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.git.commit]
 severity = "warning"
 `)
@@ -217,9 +298,9 @@ severity = "warning"
 		// --- Push ---
 		Context("push: only require_tracking=false", func() {
 			It("preserves all push defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.git.push]
 require_tracking = false
 `)
@@ -237,9 +318,9 @@ require_tracking = false
 		// --- Branch ---
 		Context("branch: only allow_uppercase=true", func() {
 			It("preserves all branch defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.git.branch]
 allow_uppercase = true
 `)
@@ -260,12 +341,40 @@ allow_uppercase = true
 			})
 		})
 
+		Context("branch: only require_ticket_pattern set", func() {
+			It("preserves all branch defaults", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, `[validators.git.branch]
+require_ticket_pattern = "[A-Z]+-\\d+"
+`)
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				branch := cfg.Validators.Git.Branch
+				Expect(branch.IsEnabled()).To(BeTrue(), "enabled preserved")
+				Expect(
+					branch.RequireTicketPattern,
+				).To(Equal(`[A-Z]+-\d+`), "require_ticket_pattern set")
+				Expect(*branch.RequireType).To(BeTrue(), "require_type preserved")
+				Expect(*branch.AllowUppercase).To(BeFalse(), "allow_uppercase preserved")
+				Expect(
+					branch.ProtectedBranches,
+				).To(ContainElements("main", "master"), "protected_branches preserved")
+				Expect(
+					branch.ValidTypes,
+				).To(ContainElements("feat", "fix", "docs"), "valid_types preserved")
+			})
+		})
+
 		// --- PR ---
 		Context("pr: only require_body=false", func() {
 			It("preserves all PR defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.git.pr]
 require_body = false
 `)
@@ -292,9 +401,9 @@ require_body = false
 		// --- Exceptions rate_limit sub-map ---
 		Context("exceptions: only token_prefix changed", func() {
 			It("preserves rate_limit and audit sub-maps", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[exceptions]
 token_prefix = "MYEXC"
 `)
@@ -330,9 +439,9 @@ token_prefix = "MYEXC"
 		// --- Global ---
 		Context("global: only use_sdk_git=false", func() {
 			It("preserves default_timeout", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[global]
 use_sdk_git = false
 `)
@@ -460,9 +569,9 @@ enabled = false
 
 		Context("env var overrides specific field", func() {
 			It("preserves defaults for unset fields", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 
 				// No TOML configs - just env var
 				os.Setenv("KLAUDIUSH_VALIDATORS_FILE_MARKDOWN_ENABLED", "false")
@@ -480,9 +589,9 @@ enabled = false
 
 		Context("--disable flag for one validator", func() {
 			It("preserves other validators and defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 
 				flags := map[string]any{
 					"disable": []string{"markdown"},
@@ -506,6 +615,195 @@ enabled = false
 			})
 		})
 
+		Context("--disable flag with a category wildcard", func() {
+			It("disables every validator in the category", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+
+				flags := map[string]any{
+					"disable": []string{"git.*"},
+				}
+				cfg, err := loader.Load(flags)
+				Expect(err).NotTo(HaveOccurred())
+
+				git := cfg.Validators.Git
+				Expect(git.Commit.IsEnabled()).To(BeFalse(), "commit disabled by wildcard")
+				Expect(git.Push.IsEnabled()).To(BeFalse(), "push disabled by wildcard")
+				Expect(git.NoVerify.IsEnabled()).To(BeFalse(), "no_verify disabled by wildcard")
+				// other categories untouched
+				Expect(
+					cfg.Validators.File.Markdown.IsEnabled(),
+				).To(BeTrue(), "markdown unaffected")
+				Expect(
+					cfg.Validators.Notification.Bell.IsEnabled(),
+				).To(BeTrue(), "bell unaffected")
+			})
+		})
+
+		Context("--disable flag with dotted names", func() {
+			It("accepts config paths and rule validator types", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+
+				flags := map[string]any{
+					"disable": []string{"file.markdown", "file.shell", " git.push "},
+				}
+				cfg, err := loader.Load(flags)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(
+					cfg.Validators.File.Markdown.IsEnabled(),
+				).To(BeFalse(), "markdown disabled by dotted name")
+				Expect(
+					cfg.Validators.File.ShellScript.IsEnabled(),
+				).To(BeFalse(), "shellscript disabled by rule type")
+				Expect(cfg.Validators.Git.Push.IsEnabled()).To(BeFalse(), "push disabled")
+				// other validators untouched
+				Expect(cfg.Validators.Git.Commit.IsEnabled()).To(BeTrue(), "commit unaffected")
+				Expect(
+					cfg.Validators.File.Terraform.IsEnabled(),
+				).To(BeTrue(), "terraform unaffected")
+			})
+		})
+
+		Context("[validators] disabled selector with a glob", func() {
+			It("disables the group and lets enabled re-enable one validator", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, `[validators]
+disabled = ["file.*", "git.pr"]
+enabled = ["file.markdown"]
+`)
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				file := cfg.Validators.File
+				Expect(file.ShellScript.IsEnabled()).To(BeFalse(), "shellscript disabled by glob")
+				Expect(file.Terraform.IsEnabled()).To(BeFalse(), "terraform disabled by glob")
+				Expect(file.Markdown.IsEnabled()).To(BeTrue(), "markdown re-enabled")
+				Expect(cfg.Validators.Git.PR.IsEnabled()).To(BeFalse(), "pr disabled")
+				// other validators untouched
+				Expect(cfg.Validators.Git.Commit.IsEnabled()).To(BeTrue(), "commit unaffected")
+				Expect(
+					*file.Markdown.UseMarkdownlint,
+				).To(BeTrue(), "use_markdownlint preserved")
+			})
+
+			It("lets an explicit per-validator enabled win", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeGlobalConfig(homeDir, `[validators.file.terraform]
+enabled = true
+`)
+				writeProjectConfig(workDir, `[validators]
+disabled = ["file.*"]
+enabled = ["git.*"]
+
+[validators.file.shellscript]
+enabled = true
+
+[validators.git.push]
+enabled = false
+`)
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				file := cfg.Validators.File
+				Expect(file.ShellScript.IsEnabled()).To(BeTrue(), "explicit enabled wins")
+				Expect(file.Terraform.IsEnabled()).To(BeTrue(), "global explicit enabled wins")
+				Expect(file.Markdown.IsEnabled()).To(BeFalse(), "markdown disabled by glob")
+				Expect(cfg.Validators.Git.Push.IsEnabled()).To(BeFalse(), "explicit disabled wins")
+			})
+
+			It("does not re-enable a validator disabled with --disable", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, `[validators]
+enabled = ["file.*"]
+`)
+
+				cfg, err := loader.Load(map[string]any{"disable": []string{"markdown"}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(
+					cfg.Validators.File.Markdown.IsEnabled(),
+				).To(BeFalse(), "markdown disabled by flag")
+			})
+		})
+
+		Context("--timeout flag overrides hook_timeout", func() {
+			It("sets the hook timeout and keeps fail_closed_on_timeout", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, `[global]
+hook_timeout = "30s"
+fail_closed_on_timeout = true
+`)
+
+				cfg, err := loader.Load(map[string]any{"timeout": "5s"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cfg.Global.GetHookTimeout()).To(Equal(5*time.Second), "flag wins")
+				Expect(cfg.Global.IsFailClosedOnTimeout()).To(BeTrue(), "fail closed kept")
+				Expect(cfg.Global.DefaultTimeout).NotTo(BeZero(), "default_timeout preserved")
+			})
+		})
+
+		Context("--only-tagged and --skip-tagged flags", func() {
+			It("sets the rule tag filters and keeps rule tags", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, `[[rules.rules]]
+name = "tagged"
+tags = ["security", "experimental"]
+
+[rules.rules.match]
+validator_type = "git.push"
+`)
+
+				cfg, err := loader.Load(map[string]any{
+					"only-tagged": []string{"security"},
+					"skip-tagged": []string{"experimental"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cfg.Rules.OnlyTagged).To(Equal([]string{"security"}))
+				Expect(cfg.Rules.SkipTagged).To(Equal([]string{"experimental"}))
+				Expect(cfg.Rules.Rules).To(HaveLen(1))
+				Expect(cfg.Rules.Rules[0].Tags).To(Equal([]string{"security", "experimental"}))
+				Expect(cfg.Rules.IsRuleSelected(&cfg.Rules.Rules[0])).To(BeFalse(), "skip wins")
+			})
+		})
+
+		Context("global max_severity", func() {
+			It("defaults to error and loads warning from project config", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.Global.GetMaxSeverity()).To(Equal(config.SeverityError), "no cap by default")
+
+				writeProjectConfig(workDir, `[global]
+max_severity = "warning"
+`)
+
+				cfg, err = loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.Global.GetMaxSeverity()).To(Equal(config.SeverityWarning))
+			})
+		})
+
 		Context("four sources: defaults + global + project + flags", func() {
 			It("all layers merge correctly", func() {
 				loader, homeDir, workDir := newSeparatedLoader()
@@ -544,9 +842,9 @@ heading_spacing = false
 	Describe("nested sub-maps and arrays", func() {
 		Context("commit.message: setting one nested field", func() {
 			It("preserves all other message defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.git.commit.message]
 title_max_length = 72
 `)
@@ -584,11 +882,41 @@ title_max_length = 72
 			})
 		})
 
+		Context("commit.message: enabling require_gitmoji", func() {
+			It("preserves conventional commit and other message defaults", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, `[validators.git.commit.message]
+require_gitmoji = true
+`)
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				msg := cfg.Validators.Git.Commit.Message
+				Expect(msg).NotTo(BeNil(), "message not nil")
+				Expect(*msg.RequireGitmoji).To(BeTrue(), "require_gitmoji set")
+				Expect(*msg.BlockEmoji).To(BeFalse(), "block_emoji default preserved")
+				Expect(*msg.TitleMaxLength).To(Equal(50), "title_max_length default preserved")
+				Expect(
+					*msg.ConventionalCommits,
+				).To(BeTrue(), "conventional_commits default preserved")
+				Expect(*msg.RequireScope).To(BeTrue(), "require_scope default preserved")
+				Expect(
+					*msg.BlockAIAttribution,
+				).To(BeTrue(), "block_ai_attribution default preserved")
+				Expect(
+					msg.ValidTypes,
+				).To(ContainElements("feat", "fix", "chore"), "valid_types default preserved")
+			})
+		})
+
 		Context("exceptions.rate_limit: setting one nested field", func() {
 			It("preserves other rate_limit fields and parent fields", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[exceptions.rate_limit]
 max_per_hour = 5
 `)
@@ -620,9 +948,9 @@ max_per_hour = 5
 
 		Context("array fields: project replaces array (not append)", func() {
 			It("replaces required_flags completely", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.git.commit]
 required_flags = ["-s"]
 `)
@@ -639,9 +967,9 @@ required_flags = ["-s"]
 			})
 
 			It("replaces valid_types completely", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.git.commit.message]
 valid_types = ["feat", "fix"]
 `)
@@ -655,9 +983,9 @@ valid_types = ["feat", "fix"]
 			})
 
 			It("replaces protected_branches completely", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.git.branch]
 protected_branches = ["main", "develop", "release"]
 `)
@@ -680,9 +1008,9 @@ protected_branches = ["main", "develop", "release"]
 	Describe("edge cases", func() {
 		Context("empty project config file", func() {
 			It("all defaults are intact", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, "")
 
 				cfg, err := loader.Load(nil)
@@ -732,10 +1060,10 @@ protected_branches = ["main", "develop", "release"]
 			"original bug report scenario: markdown enabled=true wiping use_markdownlint",
 			func() {
 				It("enabled=true does not wipe use_markdownlint or table_formatting", func() {
-					loader, _, workDir := newSeparatedLoader()
+					loader, homeDir, workDir := newSeparatedLoader()
 
 					DeferCleanup(
-						func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) },
+						func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) },
 					)
 					// Exact config from the bug report
 					writeProjectConfig(workDir, `[validators.file.markdown]
@@ -785,9 +1113,9 @@ use_markdownlint = true
 
 		Context("multiple validators in one project config", func() {
 			It("each validator preserves its own defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.file.markdown]
 enabled = true
 
@@ -845,9 +1173,9 @@ require_tracking = false
 
 		Context("deeply nested: commit message field + parent field", func() {
 			It("both levels merge without interference", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[validators.git.commit]
 check_staging_area = false
 
@@ -877,9 +1205,9 @@ conventional_commits = false
 
 		Context("exceptions.audit: setting one field in deeply nested sub-map", func() {
 			It("preserves sibling audit fields and parent exception fields", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 				writeProjectConfig(workDir, `[exceptions.audit]
 max_size_mb = 50
 `)
@@ -908,4 +1236,156 @@ max_size_mb = 50
 			})
 		})
 	})
+
+	// ===================================================================
+	// Named profiles: [profiles.<name>] merged over file config
+	// ===================================================================
+	Describe("config profiles", func() {
+		const profileConfig = `[validators.file.markdown]
+severity = "warning"
+
+[profiles.strict.validators.file.markdown]
+severity = "error"
+heading_spacing = false
+
+[profiles.relaxed.validators.file.markdown]
+enabled = false
+`
+
+		Context("profile selected via flag", func() {
+			It("overrides base fields and preserves siblings", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, profileConfig)
+
+				cfg, err := loader.Load(map[string]any{"profile": "strict"})
+				Expect(err).NotTo(HaveOccurred())
+
+				md := cfg.Validators.File.Markdown
+				Expect(md.GetSeverity().String()).To(Equal("error"), "severity from profile")
+				Expect(*md.HeadingSpacing).To(BeFalse(), "heading_spacing from profile")
+				Expect(md.IsEnabled()).To(BeTrue(), "enabled from defaults")
+				Expect(*md.UseMarkdownlint).To(BeTrue(), "use_markdownlint from defaults")
+			})
+		})
+
+		Context("no profile selected", func() {
+			It("ignores profile sections", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, profileConfig)
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				md := cfg.Validators.File.Markdown
+				Expect(md.GetSeverity().String()).To(Equal("warning"), "severity from base")
+				Expect(*md.HeadingSpacing).To(BeTrue(), "heading_spacing from defaults")
+			})
+		})
+
+		Context("profile selected via KLAUDIUSH_PROFILE", func() {
+			It("applies the profile", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, profileConfig)
+
+				os.Setenv(ProfileEnvVar, "relaxed")
+				DeferCleanup(func() { os.Unsetenv(ProfileEnvVar) })
+
+				cfg, err := loader.Load(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(
+					cfg.Validators.File.Markdown.IsEnabled(),
+				).To(BeFalse(), "enabled=false from profile")
+			})
+
+			It("is overridden by the flag", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, profileConfig)
+
+				os.Setenv(ProfileEnvVar, "relaxed")
+				DeferCleanup(func() { os.Unsetenv(ProfileEnvVar) })
+
+				cfg, err := loader.Load(map[string]any{"profile": "strict"})
+				Expect(err).NotTo(HaveOccurred())
+
+				md := cfg.Validators.File.Markdown
+				Expect(md.IsEnabled()).To(BeTrue(), "relaxed profile not applied")
+				Expect(md.GetSeverity().String()).To(Equal("error"), "strict profile applied")
+			})
+		})
+
+		Context("env var and profile set the same field", func() {
+			It("env var wins over the profile", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, profileConfig)
+
+				os.Setenv("KLAUDIUSH_VALIDATORS_FILE_MARKDOWN_HEADING_SPACING", "true")
+				DeferCleanup(func() {
+					os.Unsetenv("KLAUDIUSH_VALIDATORS_FILE_MARKDOWN_HEADING_SPACING")
+				})
+
+				cfg, err := loader.Load(map[string]any{"profile": "strict"})
+				Expect(err).NotTo(HaveOccurred())
+
+				md := cfg.Validators.File.Markdown
+				Expect(*md.HeadingSpacing).To(BeTrue(), "heading_spacing from env")
+				Expect(md.GetSeverity().String()).To(Equal("error"), "severity from profile")
+			})
+		})
+
+		Context("profile defined in global config", func() {
+			It("applies over project config", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeGlobalConfig(homeDir, `[profiles.ci.validators.git.commit]
+check_staging_area = false
+`)
+				writeProjectConfig(workDir, `[validators.git.commit]
+check_staging_area = true
+severity = "warning"
+`)
+
+				cfg, err := loader.Load(map[string]any{"profile": "ci"})
+				Expect(err).NotTo(HaveOccurred())
+
+				commit := cfg.Validators.Git.Commit
+				Expect(*commit.CheckStagingArea).To(BeFalse(), "check_staging_area from profile")
+				Expect(commit.GetSeverity().String()).To(Equal("warning"), "severity from project")
+			})
+		})
+
+		Context("unknown profile", func() {
+			It("returns ErrUnknownProfile listing available profiles", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+				writeProjectConfig(workDir, profileConfig)
+
+				_, err := loader.Load(map[string]any{"profile": "missing"})
+				Expect(err).To(MatchError(ErrUnknownProfile))
+				Expect(err.Error()).To(ContainSubstring("relaxed, strict"))
+			})
+
+			It("returns ErrUnknownProfile when no profiles are defined", func() {
+				loader, homeDir, workDir := newSeparatedLoader()
+
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
+
+				_, err := loader.Load(map[string]any{"profile": "strict"})
+				Expect(err).To(MatchError(ErrUnknownProfile))
+				Expect(err.Error()).To(ContainSubstring("no profiles defined"))
+			})
+		})
+	})
 })
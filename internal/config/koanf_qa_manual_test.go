@@ -2,7 +2,6 @@ package config
 
 import (
 	"os"
-	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -77,9 +76,9 @@ require_scope = false
 
 		Context("env var overrides one field while TOML sets another", func() {
 			It("both sources merge correctly with defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 
 				writeProjectConfig(workDir, `[validators.file.markdown]
 heading_spacing = false
@@ -148,10 +147,10 @@ heading_spacing = false
 			"project overrides env var (env has lower priority than... wait, env is higher)",
 			func() {
 				It("env var wins over project TOML for the same field", func() {
-					loader, _, workDir := newSeparatedLoader()
+					loader, homeDir, workDir := newSeparatedLoader()
 
 					DeferCleanup(
-						func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) },
+						func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) },
 					)
 
 					writeProjectConfig(workDir, `[validators.file.markdown]
@@ -175,9 +174,9 @@ severity = "warning"
 
 		Context("notification bell: only severity set", func() {
 			It("preserves bell defaults", func() {
-				loader, _, workDir := newSeparatedLoader()
+				loader, homeDir, workDir := newSeparatedLoader()
 
-				DeferCleanup(func() { os.RemoveAll(filepath.Dir(workDir)); os.RemoveAll(workDir) })
+				DeferCleanup(func() { os.RemoveAll(homeDir); os.RemoveAll(workDir) })
 
 				writeProjectConfig(workDir, `[validators.notification.bell]
 severity = "warning"
@@ -4,7 +4,9 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -242,6 +244,267 @@ message = "Project warning"
 			Expect(cfg.Rules.Rules[0].Name).To(Equal("project-rule"))
 		})
 
+		It("should load file extensions from project config", func() {
+			projectDir := filepath.Join(workDir, ProjectConfigDir)
+			Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+
+			projectConfig := `
+[[rules.rules]]
+name = "go-and-ts"
+[rules.rules.match]
+file_extensions = ["go", ".ts"]
+[rules.rules.action]
+type = "warn"
+message = "Source file"
+`
+			err := os.WriteFile(
+				filepath.Join(projectDir, ProjectConfigFile),
+				[]byte(projectConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Rules.Rules).To(HaveLen(1))
+			Expect(cfg.Rules.Rules[0].Match.FileExtensions).To(Equal([]string{"go", ".ts"}))
+		})
+
+		It("should load content_in_files pairs from project config", func() {
+			projectDir := filepath.Join(workDir, ProjectConfigDir)
+			Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+
+			projectConfig := `
+[[rules.rules]]
+name = "todo-needs-ticket"
+[rules.rules.match]
+[[rules.rules.match.content_in_files]]
+file_pattern = "**/*.go"
+content_pattern = "TODO[^(]"
+[[rules.rules.match.content_in_files]]
+file_pattern = "**/*.py"
+content_pattern = "# TODO[^(]"
+[rules.rules.action]
+type = "block"
+`
+			err := os.WriteFile(
+				filepath.Join(projectDir, ProjectConfigFile),
+				[]byte(projectConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Rules.Rules).To(HaveLen(1))
+			Expect(cfg.Rules.Rules[0].Match.ContentInFiles).To(Equal([]config.ContentInFileConfig{
+				{FilePattern: "**/*.go", ContentPattern: "TODO[^(]"},
+				{FilePattern: "**/*.py", ContentPattern: "# TODO[^(]"},
+			}))
+		})
+
+		It("should load command_contains with its pattern options", func() {
+			projectDir := filepath.Join(workDir, ProjectConfigDir)
+			Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+
+			projectConfig := `
+[[rules.rules]]
+name = "no-recursive-force-rm"
+[rules.rules.match]
+command_contains = ["rm ", "-rf"]
+pattern_mode = "all"
+case_insensitive = true
+[rules.rules.action]
+type = "block"
+`
+			err := os.WriteFile(
+				filepath.Join(projectDir, ProjectConfigFile),
+				[]byte(projectConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Rules.Rules).To(HaveLen(1))
+
+			match := cfg.Rules.Rules[0].Match
+			Expect(match.CommandContains).To(Equal([]string{"rm ", "-rf"}))
+			Expect(match.GetPatternMode()).To(Equal("all"))
+			Expect(match.IsCaseInsensitive()).To(BeTrue())
+		})
+
+		It("should load file content options", func() {
+			projectDir := filepath.Join(workDir, ProjectConfigDir)
+			Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+
+			projectConfig := `
+[rules]
+max_file_content_size = 4096
+
+[[rules.rules]]
+name = "no-aws-keys-in-edits"
+[rules.rules.match]
+content_pattern = "AKIA[0-9A-Z]{16}"
+load_file_content = true
+[rules.rules.action]
+type = "block"
+`
+			err := os.WriteFile(
+				filepath.Join(projectDir, ProjectConfigFile),
+				[]byte(projectConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Rules.LoadFileContent).To(BeFalse())
+			Expect(cfg.Rules.GetMaxFileContentSize()).To(Equal(int64(4096)))
+			Expect(cfg.Rules.Rules).To(HaveLen(1))
+			Expect(cfg.Rules.Rules[0].Match.LoadFileContent).To(BeTrue())
+		})
+
+		It("should load the action severity override", func() {
+			projectDir := filepath.Join(workDir, ProjectConfigDir)
+			Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+
+			projectConfig := `
+[[rules.rules]]
+name = "escalate-force-push"
+[rules.rules.match]
+validator_type = "git.push"
+[rules.rules.action]
+type = "warn"
+severity = "error"
+`
+			err := os.WriteFile(
+				filepath.Join(projectDir, ProjectConfigFile),
+				[]byte(projectConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Rules.Rules).To(HaveLen(1))
+			Expect(cfg.Rules.Rules[0].Action.Severity).To(Equal(config.SeverityError))
+		})
+
+		It("should reject an unknown action severity", func() {
+			projectDir := filepath.Join(workDir, ProjectConfigDir)
+			Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+
+			projectConfig := `
+[[rules.rules]]
+name = "bad-severity"
+[rules.rules.match]
+validator_type = "git.push"
+[rules.rules.action]
+type = "warn"
+severity = "fatal"
+`
+			err := os.WriteFile(
+				filepath.Join(projectDir, ProjectConfigFile),
+				[]byte(projectConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = loader.Load(nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`rule "bad-severity" action severity`))
+		})
+
+		It("should load the rule rate limit", func() {
+			projectDir := filepath.Join(workDir, ProjectConfigDir)
+			Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+
+			projectConfig := `
+[[rules.rules]]
+name = "noisy-push"
+[rules.rules.match]
+validator_type = "git.push"
+[rules.rules.action]
+type = "warn"
+[rules.rules.rate_limit]
+window = "10m"
+`
+			err := os.WriteFile(
+				filepath.Join(projectDir, ProjectConfigFile),
+				[]byte(projectConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Rules.Rules).To(HaveLen(1))
+			Expect(cfg.Rules.Rules[0].RateLimit.GetWindow()).To(Equal(10 * time.Minute))
+		})
+
+		It("should reject an invalid rate limit window", func() {
+			projectDir := filepath.Join(workDir, ProjectConfigDir)
+			Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+
+			projectConfig := `
+[[rules.rules]]
+name = "bad-window"
+[rules.rules.match]
+validator_type = "git.push"
+[rules.rules.rate_limit]
+window = "often"
+`
+			err := os.WriteFile(
+				filepath.Join(projectDir, ProjectConfigFile),
+				[]byte(projectConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = loader.Load(nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`rule "bad-window" rate limit window`))
+		})
+
+		It("should merge pattern aliases from global and project config", func() {
+			globalDir := filepath.Join(homeDir, GlobalConfigDir)
+			Expect(os.MkdirAll(globalDir, 0o755)).To(Succeed())
+
+			globalConfig := `
+[rules.patterns]
+aws_key = "AKIA[0-9A-Z]{16}"
+release = "release/*"
+`
+			err := os.WriteFile(
+				filepath.Join(globalDir, GlobalConfigFile),
+				[]byte(globalConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			projectDir := filepath.Join(workDir, ProjectConfigDir)
+			Expect(os.MkdirAll(projectDir, 0o755)).To(Succeed())
+
+			projectConfig := `
+[rules.patterns]
+release = "^release/v[0-9]+$"
+`
+			err = os.WriteFile(
+				filepath.Join(projectDir, ProjectConfigFile),
+				[]byte(projectConfig),
+				0o600,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Rules.Patterns).To(Equal(map[string]string{
+				"aws_key": "AKIA[0-9A-Z]{16}",
+				"release": "^release/v[0-9]+$",
+			}))
+		})
+
 		It("should merge global and project rules", func() {
 			// Create global config in homeDir
 			globalDir := filepath.Join(homeDir, GlobalConfigDir)
@@ -355,4 +618,137 @@ type = "block"
 			Expect(cfg.Rules.IsEnabled()).To(BeFalse())
 		})
 	})
+
+	Describe("loading rules from rules.d", func() {
+		writeRulesFile := func(dir, name, content string) {
+			Expect(os.MkdirAll(dir, 0o755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)).To(Succeed())
+		}
+
+		ruleNames := func(rules []config.RuleConfig) []string {
+			names := make([]string, 0, len(rules))
+			for _, rule := range rules {
+				names = append(names, rule.Name)
+			}
+
+			return names
+		}
+
+		It("should combine rules from multiple files in filename order", func() {
+			rulesDir := filepath.Join(workDir, ProjectConfigDir, RulesDirName)
+
+			writeRulesFile(rulesDir, "20-push.toml", `
+[[rules.rules]]
+name = "block-push"
+[rules.rules.match]
+validator_type = "git.push"
+[rules.rules.action]
+type = "block"
+`)
+			writeRulesFile(rulesDir, "10-commit.toml", `
+[[rules.rules]]
+name = "warn-commit"
+[rules.rules.match]
+validator_type = "git.commit"
+[rules.rules.action]
+type = "warn"
+
+[[rules.rules]]
+name = "warn-markdown"
+[rules.rules.match]
+validator_type = "file.markdown"
+[rules.rules.action]
+type = "warn"
+`)
+			writeRulesFile(rulesDir, "README.md", "not a rules file")
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleNames(cfg.Rules.Rules)).To(Equal([]string{
+				"warn-commit",
+				"warn-markdown",
+				"block-push",
+			}))
+		})
+
+		It("should let a later file override a rule by name", func() {
+			rulesDir := filepath.Join(workDir, ProjectConfigDir, RulesDirName)
+
+			writeRulesFile(rulesDir, "10-base.toml", `
+[[rules.rules]]
+name = "shared-rule"
+priority = 50
+[rules.rules.match]
+validator_type = "git.push"
+[rules.rules.action]
+type = "block"
+`)
+			writeRulesFile(rulesDir, "20-team.toml", `
+[[rules.rules]]
+name = "shared-rule"
+priority = 150
+[rules.rules.match]
+validator_type = "git.push"
+[rules.rules.action]
+type = "warn"
+`)
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Rules.Rules).To(HaveLen(1))
+			Expect(cfg.Rules.Rules[0].Priority).To(Equal(150))
+			Expect(cfg.Rules.Rules[0].Action.Type).To(Equal("warn"))
+		})
+
+		It("should let project rules.d override global rules by name", func() {
+			globalDir := filepath.Join(homeDir, GlobalConfigDir)
+
+			writeRulesFile(globalDir, GlobalConfigFile, `
+[[rules.rules]]
+name = "global-rule"
+[rules.rules.match]
+validator_type = "git.commit"
+[rules.rules.action]
+type = "warn"
+`)
+			writeRulesFile(filepath.Join(globalDir, RulesDirName), "shared.toml", `
+[[rules.rules]]
+name = "shared-rule"
+description = "global version"
+[rules.rules.match]
+validator_type = "git.push"
+[rules.rules.action]
+type = "block"
+`)
+			writeRulesFile(filepath.Join(workDir, ProjectConfigDir, RulesDirName), "shared.toml", `
+[[rules.rules]]
+name = "shared-rule"
+description = "project version"
+[rules.rules.match]
+validator_type = "git.push"
+[rules.rules.action]
+type = "allow"
+`)
+
+			cfg, err := loader.Load(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleNames(cfg.Rules.Rules)).To(Equal([]string{"global-rule", "shared-rule"}))
+			Expect(cfg.Rules.Rules[1].Description).To(Equal("project version"))
+			Expect(cfg.Rules.Rules[1].Action.Type).To(Equal("allow"))
+		})
+
+		It("should reject world-writable rules files", func() {
+			rulesDir := filepath.Join(workDir, ProjectConfigDir, RulesDirName)
+
+			writeRulesFile(rulesDir, "open.toml", `
+[[rules.rules]]
+name = "open-rule"
+`)
+			Expect(os.Chmod(filepath.Join(rulesDir, "open.toml"), 0o666)).To(Succeed())
+
+			_, err := loader.Load(nil)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, ErrInvalidPermissions)).To(BeTrue())
+		})
+	})
 })
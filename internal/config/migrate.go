@@ -0,0 +1,341 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+	"github.com/smykla-skalski/klaudiush/internal/schema"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+)
+
+// ErrConfigVersionTooNew is returned when migrating a config written for a
+// newer schema version than this binary supports.
+var ErrConfigVersionTooNew = errors.New("config version is newer than supported")
+
+// hoursPerDay converts day counts to durations.
+const hoursPerDay = 24
+
+// migrations is the migration registry, oldest first. Adding a migration with
+// a version above config.CurrentConfigVersion requires bumping it.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "crash_dump.max_age_days is replaced by the max_age duration",
+		Renames: []KeyRename{{
+			From:    "crash_dump.max_age_days",
+			To:      "crash_dump.max_age",
+			Convert: daysToDuration,
+		}},
+	},
+}
+
+// Migration rewrites keys that are deprecated in a config schema version to
+// their replacement.
+type Migration struct {
+	// Version is the config version whose layout the migration produces.
+	// Configs at this version may still contain the deprecated keys, so the
+	// migration also applies to them.
+	Version int
+
+	// Description explains the migration.
+	Description string
+
+	// Renames lists the keys the migration moves.
+	Renames []KeyRename
+}
+
+// KeyRename moves the value of a deprecated key to its replacement.
+type KeyRename struct {
+	// From is the dotted path of the deprecated key, e.g. "crash_dump.max_age_days".
+	From string
+
+	// To is the dotted path of the replacement key.
+	To string
+
+	// Convert converts the value for the new key. ok is false when the value
+	// can't be expressed with the new key, which leaves the old key in place.
+	// Nil keeps the value as is.
+	Convert func(value any) (converted any, ok bool)
+}
+
+// MigrationChange describes one key a migration changed or left for manual
+// review.
+type MigrationChange struct {
+	// From is the deprecated key.
+	From string
+
+	// To is the replacement key.
+	To string
+
+	// Note explains a change that is not a plain move.
+	Note string
+
+	// Manual is true when the deprecated key was kept because its value has
+	// no equivalent, so it needs manual review.
+	Manual bool
+}
+
+// String returns the change as "from -> to", followed by the note if any.
+func (c MigrationChange) String() string {
+	s := c.From + " -> " + c.To
+	if c.Note != "" {
+		s += " (" + c.Note + ")"
+	}
+
+	return s
+}
+
+// MigrationResult is the outcome of migrating a config file.
+type MigrationResult struct {
+	// Path is the migrated config file.
+	Path string
+
+	// FromVersion is the config version of the file before migrating.
+	FromVersion int
+
+	// Changes lists the keys that were migrated or need manual review.
+	Changes []MigrationChange
+
+	// Original is the file content before migrating.
+	Original []byte
+
+	// Migrated is the file content after migrating. It equals Original when
+	// nothing changed.
+	Migrated []byte
+}
+
+// Changed reports whether migrating changes the file.
+func (r *MigrationResult) Changed() bool {
+	return !bytes.Equal(r.Original, r.Migrated)
+}
+
+// MigrateFile migrates the config file at path without writing it. The
+// migrated content is re-encoded, so comments and formatting of the original
+// are not kept.
+func MigrateFile(path string) (*MigrationResult, error) {
+	original, err := os.ReadFile(path) //nolint:gosec // path is a resolved config file
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read config file %s", path)
+	}
+
+	data := make(map[string]any)
+	if err := toml.Unmarshal(original, &data); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config file %s", path)
+	}
+
+	fromVersion, changes, err := migrate(data, migrations)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to migrate config file %s", path)
+	}
+
+	result := &MigrationResult{
+		Path:        path,
+		FromVersion: fromVersion,
+		Changes:     changes,
+		Original:    original,
+		Migrated:    original,
+	}
+
+	if !hasMoves(changes) {
+		return result, nil
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString(schema.SchemaDirective())
+	buf.WriteByte('\n')
+
+	encoder := toml.NewEncoder(&buf)
+	encoder.SetIndentTables(true)
+
+	if err := encoder.Encode(data); err != nil {
+		return nil, errors.Wrap(err, "failed to encode migrated config")
+	}
+
+	result.Migrated = buf.Bytes()
+
+	return result, nil
+}
+
+// WriteMigration writes the migrated content of result to its file.
+func WriteMigration(result *MigrationResult) error {
+	if err := fileutil.WriteFileAtomic(result.Path, result.Migrated, ConfigFileMode); err != nil {
+		return errors.Wrapf(err, "failed to write config file %s", result.Path)
+	}
+
+	return nil
+}
+
+// migrate applies the migrations of registry that target the version of data
+// or a later one, and sets data's version to the latest version when it was
+// older. It returns the original version and the changes made.
+func migrate(data map[string]any, registry []Migration) (int, []MigrationChange, error) {
+	version, err := configVersion(data)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if version > config.CurrentConfigVersion {
+		return 0, nil, errors.Wrapf(
+			ErrConfigVersionTooNew,
+			"version %d, latest supported is %d",
+			version,
+			config.CurrentConfigVersion,
+		)
+	}
+
+	var changes []MigrationChange
+
+	for _, m := range registry {
+		if m.Version < version {
+			continue
+		}
+
+		for _, rename := range m.Renames {
+			if change, ok := applyRename(data, rename); ok {
+				changes = append(changes, change)
+			}
+		}
+	}
+
+	if version < config.CurrentConfigVersion {
+		data["version"] = int64(config.CurrentConfigVersion)
+		changes = append(changes, MigrationChange{
+			From: fmt.Sprintf("version %d", version),
+			To:   fmt.Sprintf("version %d", config.CurrentConfigVersion),
+		})
+	}
+
+	return version, changes, nil
+}
+
+// configVersion returns the version of data, or 1 when it has none.
+func configVersion(data map[string]any) (int, error) {
+	raw, ok := data["version"]
+	if !ok {
+		return 1, nil
+	}
+
+	version, ok := raw.(int64)
+	if !ok {
+		return 0, errors.Wrapf(ErrInvalidConfig, "version must be an integer, got %v", raw)
+	}
+
+	return int(version), nil
+}
+
+// applyRename moves the value of rename.From to rename.To. It reports false
+// when data doesn't contain rename.From. When rename.To is already set it
+// wins, as it does when loading, and the old key is dropped.
+func applyRename(data map[string]any, rename KeyRename) (MigrationChange, bool) {
+	value, ok := lookupKey(data, rename.From)
+	if !ok {
+		return MigrationChange{}, false
+	}
+
+	change := MigrationChange{From: rename.From, To: rename.To}
+
+	if _, exists := lookupKey(data, rename.To); exists {
+		deleteKey(data, rename.From)
+		change.Note = "dropped, " + rename.To + " is already set"
+
+		return change, true
+	}
+
+	if rename.Convert != nil {
+		converted, ok := rename.Convert(value)
+		if !ok {
+			change.Note = fmt.Sprintf("kept, %v has no equivalent", value)
+			change.Manual = true
+
+			return change, true
+		}
+
+		value = converted
+	}
+
+	deleteKey(data, rename.From)
+	setKey(data, rename.To, value)
+
+	return change, true
+}
+
+// hasMoves reports whether any change rewrote the config, as opposed to only
+// flagging keys for manual review.
+func hasMoves(changes []MigrationChange) bool {
+	for _, c := range changes {
+		if !c.Manual {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookupKey returns the value at a dotted path of nested tables.
+func lookupKey(data map[string]any, path string) (any, bool) {
+	parts := strings.Split(path, ".")
+	table := data
+
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := table[part].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		table = next
+	}
+
+	value, ok := table[parts[len(parts)-1]]
+
+	return value, ok
+}
+
+// setKey sets the value at a dotted path, creating missing tables.
+func setKey(data map[string]any, path string, value any) {
+	parts := strings.Split(path, ".")
+	table := data
+
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := table[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			table[part] = next
+		}
+
+		table = next
+	}
+
+	table[parts[len(parts)-1]] = value
+}
+
+// deleteKey removes the value at a dotted path.
+func deleteKey(data map[string]any, path string) {
+	parent, key, found := strings.Cut(path, ".")
+	if !found {
+		delete(data, path)
+
+		return
+	}
+
+	if table, ok := data[parent].(map[string]any); ok {
+		deleteKey(table, key)
+	}
+}
+
+// daysToDuration converts a day count to a duration string. Zero days
+// disables pruning by age, which a duration can't express.
+func daysToDuration(value any) (any, bool) {
+	days, ok := value.(int64)
+	if !ok || days <= 0 {
+		return nil, false
+	}
+
+	return fmt.Sprintf("%dh", days*hoursPerDay), true
+}
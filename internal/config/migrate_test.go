@@ -0,0 +1,166 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+)
+
+var _ = Describe("Migrate", func() {
+	renameRegistry := []Migration{{
+		Version:     1,
+		Description: "global.sdk_git is renamed to use_sdk_git",
+		Renames: []KeyRename{{
+			From: "global.sdk_git",
+			To:   "global.use_sdk_git",
+		}},
+	}}
+
+	parse := func(content string) map[string]any {
+		data := make(map[string]any)
+		Expect(toml.Unmarshal([]byte(content), &data)).To(Succeed())
+
+		return data
+	}
+
+	Describe("migrate", func() {
+		It("should rename a deprecated key", func() {
+			data := parse("[global]\nsdk_git = false\ndefault_timeout = '5s'\n")
+
+			version, changes, err := migrate(data, renameRegistry)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal(1))
+			Expect(changes).To(Equal([]MigrationChange{
+				{From: "global.sdk_git", To: "global.use_sdk_git"},
+			}))
+			Expect(data).To(Equal(map[string]any{
+				"global": map[string]any{
+					"use_sdk_git":     false,
+					"default_timeout": "5s",
+				},
+			}))
+		})
+
+		It("should drop the deprecated key when the new key is set", func() {
+			data := parse("[global]\nsdk_git = false\nuse_sdk_git = true\n")
+
+			_, changes, err := migrate(data, renameRegistry)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].Note).To(Equal("dropped, global.use_sdk_git is already set"))
+			Expect(data).To(Equal(map[string]any{
+				"global": map[string]any{"use_sdk_git": true},
+			}))
+		})
+
+		It("should report nothing for an up to date config", func() {
+			data := parse("version = 1\n[global]\nuse_sdk_git = true\n")
+
+			_, changes, err := migrate(data, renameRegistry)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
+
+		It("should skip migrations for versions older than the config", func() {
+			data := parse("[global]\nsdk_git = false\n")
+
+			_, changes, err := migrate(data, []Migration{{
+				Version: 0,
+				Renames: renameRegistry[0].Renames,
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
+
+		It("should upgrade an older version", func() {
+			data := parse("version = 0\n[global]\nsdk_git = false\n")
+
+			version, changes, err := migrate(data, renameRegistry)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal(0))
+			Expect(changes).To(HaveLen(2))
+			Expect(changes[1].String()).To(Equal("version 0 -> version 1"))
+			Expect(data["version"]).To(Equal(int64(config.CurrentConfigVersion)))
+		})
+
+		It("should reject a newer version", func() {
+			data := parse("version = 99\n")
+
+			_, _, err := migrate(data, renameRegistry)
+			Expect(err).To(MatchError(ErrConfigVersionTooNew))
+		})
+
+		It("should reject a non-integer version", func() {
+			data := parse("version = 'one'\n")
+
+			_, _, err := migrate(data, renameRegistry)
+			Expect(err).To(MatchError(ErrInvalidConfig))
+		})
+	})
+
+	Describe("crash_dump.max_age_days", func() {
+		It("should convert days to a max_age duration", func() {
+			data := parse("[crash_dump]\nmax_age_days = 7\n")
+
+			_, changes, err := migrate(data, migrations)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(HaveLen(1))
+			Expect(data).To(Equal(map[string]any{
+				"crash_dump": map[string]any{"max_age": "168h"},
+			}))
+		})
+
+		It("should keep zero days for manual review", func() {
+			data := parse("[crash_dump]\nmax_age_days = 0\n")
+
+			_, changes, err := migrate(data, migrations)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].Manual).To(BeTrue())
+			Expect(data).To(HaveKeyWithValue("crash_dump", HaveKey("max_age_days")))
+		})
+	})
+
+	Describe("MigrateFile", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "config.toml")
+		})
+
+		It("should re-encode a migrated config loadable as the new layout", func() {
+			Expect(os.WriteFile(path, []byte("[crash_dump]\nmax_age_days = 2\n"), 0o600)).
+				To(Succeed())
+
+			result, err := MigrateFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Changed()).To(BeTrue())
+			Expect(string(result.Migrated)).NotTo(ContainSubstring("max_age_days"))
+
+			var cfg config.Config
+			Expect(toml.Unmarshal(result.Migrated, &cfg)).To(Succeed())
+			Expect(cfg.CrashDump.GetMaxAge().String()).To(Equal("48h0m0s"))
+
+			Expect(WriteMigration(result)).To(Succeed())
+
+			written, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(written).To(Equal(result.Migrated))
+		})
+
+		It("should keep the content of an up to date config", func() {
+			content := []byte("# keep me\n[global]\nuse_sdk_git = true\n")
+			Expect(os.WriteFile(path, content, 0o600)).To(Succeed())
+
+			result, err := MigrateFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Changed()).To(BeFalse())
+			Expect(result.Migrated).To(Equal(content))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"bytes"
+	"io"
+	"maps"
+
+	"github.com/cockroachdb/errors"
+	tomlparser "github.com/knadh/koanf/parsers/toml/v2"
+	"github.com/knadh/koanf/providers/file"
+	"github.com/knadh/koanf/v2"
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+)
+
+// rulesDocument is a standalone TOML document holding only a [rules] section.
+type rulesDocument struct {
+	Rules *config.RulesConfig `toml:"rules"`
+}
+
+// ExportRules writes rules as a standalone TOML document containing only the
+// [rules] section, in the form LoadRulesExport reads back.
+func ExportRules(w io.Writer, rules *config.RulesConfig) error {
+	if rules == nil {
+		rules = &config.RulesConfig{}
+	}
+
+	var buf bytes.Buffer
+
+	encoder := toml.NewEncoder(&buf)
+	encoder.SetIndentTables(true)
+
+	if err := encoder.Encode(rulesDocument{Rules: rules}); err != nil {
+		return errors.Wrap(err, "failed to encode rules to TOML")
+	}
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return errors.Wrap(err, "failed to write rules")
+	}
+
+	return nil
+}
+
+// LoadRulesExport reads the [rules] section of a TOML file such as one
+// written by ExportRules. Other sections in the file are ignored.
+func LoadRulesExport(path string) (*config.RulesConfig, error) {
+	if err := checkConfigPermissions(path); err != nil {
+		return nil, err
+	}
+
+	k := koanf.New(".")
+	if err := k.Load(file.Provider(path), tomlparser.Parser()); err != nil {
+		return nil, errors.Wrapf(err, "failed to load %s", path)
+	}
+
+	var rules config.RulesConfig
+
+	if err := k.UnmarshalWithConf("rules", &rules, koanf.UnmarshalConf{Tag: "koanf"}); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal rules from %s", path)
+	}
+
+	return &rules, nil
+}
+
+// ImportRules merges the rules and pattern aliases of imported into cfg.
+// Imported rules replace existing rules with the same name and are appended
+// otherwise. The other [rules] settings and the rest of cfg are untouched.
+// Returns the number of rules added and replaced.
+func ImportRules(cfg *config.Config, imported *config.RulesConfig) (added, replaced int) {
+	if imported == nil {
+		return 0, 0
+	}
+
+	rules := cfg.GetRules()
+
+	existing := make(map[string]bool, len(rules.Rules))
+	for _, rule := range rules.Rules {
+		if rule.Name != "" {
+			existing[rule.Name] = true
+		}
+	}
+
+	for _, rule := range imported.Rules {
+		if rule.Name != "" && existing[rule.Name] {
+			replaced++
+		} else {
+			added++
+		}
+	}
+
+	rules.Rules = mergeRules(rules.Rules, imported.Rules)
+
+	if len(imported.Patterns) > 0 && rules.Patterns == nil {
+		rules.Patterns = make(map[string]string, len(imported.Patterns))
+	}
+
+	maps.Copy(rules.Patterns, imported.Patterns)
+
+	return added, replaced
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+)
+
+var _ = Describe("Rules export and import", func() {
+	teamRules := func() *config.RulesConfig {
+		return &config.RulesConfig{
+			Enabled:  new(true),
+			Patterns: map[string]string{"aws_key": "AKIA[0-9A-Z]{16}"},
+			Rules: []config.RuleConfig{
+				{
+					Name:     "block-main-push",
+					Priority: 100,
+					Tags:     []string{"git"},
+					Match: &config.RuleMatchConfig{
+						ValidatorType:   "git.push",
+						BranchPatterns:  []string{"main", "master"},
+						CaseInsensitive: new(true),
+					},
+					Action: &config.RuleActionConfig{Type: "block", Message: "use a branch"},
+				},
+				{
+					Name: "no-aws-keys",
+					Match: &config.RuleMatchConfig{
+						ContentInFiles: []config.ContentInFileConfig{
+							{FilePattern: "**/*.env", ContentPattern: "@aws_key"},
+						},
+					},
+					Action: &config.RuleActionConfig{Type: "block"},
+				},
+			},
+		}
+	}
+
+	exportTo := func(rules *config.RulesConfig) string {
+		var buf bytes.Buffer
+		Expect(ExportRules(&buf, rules)).To(Succeed())
+
+		path := filepath.Join(GinkgoT().TempDir(), "team-rules.toml")
+		Expect(os.WriteFile(path, buf.Bytes(), ConfigFileMode)).To(Succeed())
+
+		return path
+	}
+
+	It("should read back the exported rules unchanged", func() {
+		imported, err := LoadRulesExport(exportTo(teamRules()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(imported).To(Equal(teamRules()))
+	})
+
+	It("should merge imported rules by name and keep other config", func() {
+		cfg := &config.Config{
+			Validators: &config.ValidatorsConfig{
+				Git: &config.GitConfig{
+					Commit: &config.CommitValidatorConfig{
+						ValidatorConfig: config.ValidatorConfig{Enabled: new(false)},
+					},
+				},
+			},
+			Rules: &config.RulesConfig{
+				AllowWins: true,
+				Patterns:  map[string]string{"ticket": "[A-Z]+-[0-9]+"},
+				Rules: []config.RuleConfig{
+					{Name: "block-main-push", Action: &config.RuleActionConfig{Type: "warn"}},
+					{Name: "local-only", Action: &config.RuleActionConfig{Type: "log"}},
+				},
+			},
+		}
+
+		imported, err := LoadRulesExport(exportTo(teamRules()))
+		Expect(err).NotTo(HaveOccurred())
+
+		added, replaced := ImportRules(cfg, imported)
+		Expect(added).To(Equal(1))
+		Expect(replaced).To(Equal(1))
+
+		Expect(cfg.Rules.Rules).To(Equal([]config.RuleConfig{
+			teamRules().Rules[0],
+			{Name: "local-only", Action: &config.RuleActionConfig{Type: "log"}},
+			teamRules().Rules[1],
+		}))
+		Expect(cfg.Rules.Patterns).To(Equal(map[string]string{
+			"ticket":  "[A-Z]+-[0-9]+",
+			"aws_key": "AKIA[0-9A-Z]{16}",
+		}))
+		Expect(cfg.Rules.AllowWins).To(BeTrue())
+		Expect(cfg.Rules.Enabled).To(BeNil())
+		Expect(cfg.Validators.Git.Commit.IsEnabled()).To(BeFalse())
+	})
+})
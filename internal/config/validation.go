@@ -3,10 +3,13 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strings"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"github.com/cockroachdb/errors"
 
 	"github.com/smykla-skalski/klaudiush/pkg/config"
@@ -50,6 +53,29 @@ func (v *Validator) Validate(cfg *config.Config) error {
 		return errors.WithMessage(ErrInvalidConfig, "config is nil")
 	}
 
+	validationErrors := v.Errors(cfg)
+
+	if len(validationErrors) > 0 {
+		return errors.WithSecondaryError(
+			errors.Wrapf(
+				ErrInvalidConfig,
+				"validation failed with %d error(s)",
+				len(validationErrors),
+			),
+			combineErrors(validationErrors),
+		)
+	}
+
+	return nil
+}
+
+// Errors returns each validation failure of the configuration, one per
+// section, or nil when the configuration is valid.
+func (v *Validator) Errors(cfg *config.Config) []error {
+	if cfg == nil {
+		return []error{errors.WithMessage(ErrInvalidConfig, "config is nil")}
+	}
+
 	var validationErrors []error
 
 	// Validate global config
@@ -87,23 +113,36 @@ func (v *Validator) Validate(cfg *config.Config) error {
 		}
 	}
 
-	if len(validationErrors) > 0 {
-		return errors.WithSecondaryError(
-			errors.Wrapf(
-				ErrInvalidConfig,
-				"validation failed with %d error(s)",
-				len(validationErrors),
-			),
-			combineErrors(validationErrors),
+	return validationErrors
+}
+
+// validateGlobalConfig validates global configuration.
+func (*Validator) validateGlobalConfig(cfg *config.GlobalConfig) error {
+	if cfg.ResultSink != "" && !slices.Contains(config.ValidResultSinks, cfg.ResultSink) {
+		return errors.Wrapf(
+			ErrInvalidOption,
+			"result_sink must be one of %v, got %q",
+			config.ValidResultSinks,
+			cfg.ResultSink,
 		)
 	}
 
-	return nil
-}
+	if cfg.Log != nil && cfg.Log.MaxSizeMB != nil && *cfg.Log.MaxSizeMB < 0 {
+		return errors.Wrapf(
+			ErrInvalidLength,
+			"log.max_size_mb must be non-negative, got %d",
+			*cfg.Log.MaxSizeMB,
+		)
+	}
+
+	if cfg.Log != nil && cfg.Log.MaxBackups != nil && *cfg.Log.MaxBackups < 0 {
+		return errors.Wrapf(
+			ErrInvalidLength,
+			"log.max_backups must be non-negative, got %d",
+			*cfg.Log.MaxBackups,
+		)
+	}
 
-// validateGlobalConfig validates global configuration.
-func (*Validator) validateGlobalConfig(*config.GlobalConfig) error {
-	// No specific validation needed for global config currently
 	return nil
 }
 
@@ -142,6 +181,12 @@ func (v *Validator) validateValidatorsConfig(cfg *config.ValidatorsConfig) error
 		}
 	}
 
+	if cfg.GitHub != nil {
+		if err := v.validateGitHubConfig(cfg.GitHub); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
 	if len(validationErrors) > 0 {
 		return combineErrors(validationErrors)
 	}
@@ -245,6 +290,8 @@ func (v *Validator) validateFileConfig(cfg *config.FileConfig) error {
 		}
 	}
 
+	validationErrors = append(validationErrors, validateSkipPaths(cfg)...)
+
 	if len(validationErrors) > 0 {
 		return combineErrors(validationErrors)
 	}
@@ -252,6 +299,65 @@ func (v *Validator) validateFileConfig(cfg *config.FileConfig) error {
 	return nil
 }
 
+// validateSkipPaths checks that the skip_paths of every file validator are
+// valid globs.
+func validateSkipPaths(cfg *config.FileConfig) []error {
+	filters := make(map[string]config.PathFilterConfig)
+
+	if cfg.Markdown != nil {
+		filters["markdown"] = cfg.Markdown.PathFilterConfig
+	}
+
+	if cfg.ShellScript != nil {
+		filters["shellscript"] = cfg.ShellScript.PathFilterConfig
+	}
+
+	if cfg.Terraform != nil {
+		filters["terraform"] = cfg.Terraform.PathFilterConfig
+	}
+
+	if cfg.Workflow != nil {
+		filters["workflow"] = cfg.Workflow.PathFilterConfig
+	}
+
+	if cfg.Gofumpt != nil {
+		filters["gofumpt"] = cfg.Gofumpt.PathFilterConfig
+	}
+
+	if cfg.Python != nil {
+		filters["python"] = cfg.Python.PathFilterConfig
+	}
+
+	if cfg.JavaScript != nil {
+		filters["javascript"] = cfg.JavaScript.PathFilterConfig
+	}
+
+	if cfg.Rust != nil {
+		filters["rust"] = cfg.Rust.PathFilterConfig
+	}
+
+	if cfg.LinterIgnore != nil {
+		filters["linter_ignore"] = cfg.LinterIgnore.PathFilterConfig
+	}
+
+	var errs []error
+
+	for _, name := range slices.Sorted(maps.Keys(filters)) {
+		for _, pattern := range filters[name].SkipPaths {
+			if !doublestar.ValidatePattern(filepath.ToSlash(pattern)) {
+				errs = append(errs, errors.Wrapf(
+					ErrInvalidOption,
+					"validators.file.%s: skip_paths has invalid glob %q",
+					name,
+					pattern,
+				))
+			}
+		}
+	}
+
+	return errs
+}
+
 // validateNotificationConfig validates notification validators configuration.
 func (v *Validator) validateNotificationConfig(cfg *config.NotificationConfig) error {
 	if cfg.Bell != nil {
@@ -263,6 +369,76 @@ func (v *Validator) validateNotificationConfig(cfg *config.NotificationConfig) e
 	return nil
 }
 
+// validateGitHubConfig validates GitHub CLI validators configuration.
+func (v *Validator) validateGitHubConfig(cfg *config.GitHubConfig) error {
+	if cfg.Release != nil {
+		if err := v.validateReleaseConfig(cfg.Release); err != nil {
+			return errors.Wrap(err, "validators.github.release")
+		}
+	}
+
+	if cfg.PRMerge != nil {
+		if err := v.validatePRMergeConfig(cfg.PRMerge); err != nil {
+			return errors.Wrap(err, "validators.github.pr_merge")
+		}
+	}
+
+	return nil
+}
+
+// validatePRMergeConfig validates PR merge validator configuration.
+func (v *Validator) validatePRMergeConfig(cfg *config.PRMergeValidatorConfig) error {
+	if err := v.validateBaseConfig(&cfg.ValidatorConfig); err != nil {
+		return err
+	}
+
+	for _, method := range cfg.AllowedMethods {
+		if !slices.Contains(config.ValidMergeMethods, method) {
+			return errors.Wrapf(
+				ErrInvalidOption,
+				"allowed_methods must contain only %v, got %q",
+				config.ValidMergeMethods,
+				method,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateReleaseConfig validates release validator configuration.
+func (v *Validator) validateReleaseConfig(cfg *config.ReleaseValidatorConfig) error {
+	if err := v.validateBaseConfig(&cfg.ValidatorConfig); err != nil {
+		return err
+	}
+
+	var validationErrors []error
+
+	if cfg.TagPattern != "" {
+		if _, err := regexp.Compile(cfg.TagPattern); err != nil {
+			validationErrors = append(
+				validationErrors,
+				errors.Wrapf(err, "tag_pattern is not a valid regex"),
+			)
+		}
+	}
+
+	if cfg.TitlePattern != "" {
+		if _, err := regexp.Compile(cfg.TitlePattern); err != nil {
+			validationErrors = append(
+				validationErrors,
+				errors.Wrapf(err, "title_pattern is not a valid regex"),
+			)
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return combineErrors(validationErrors)
+	}
+
+	return nil
+}
+
 // validateCommitConfig validates commit validator configuration.
 func (v *Validator) validateCommitConfig(cfg *config.CommitValidatorConfig) error {
 	if err := v.validateBaseConfig(&cfg.ValidatorConfig); err != nil {
@@ -324,6 +500,13 @@ func (*Validator) validateCommitMessageConfig(cfg *config.CommitMessageConfig) e
 		}
 	}
 
+	if slices.Contains(cfg.RequiredTrailers, "") {
+		validationErrors = append(
+			validationErrors,
+			errors.WithMessage(ErrEmptyValue, "required_trailers"),
+		)
+	}
+
 	validCommitStyles := []string{"", "conventional", "scope-only", "none", "custom", "auto"}
 	if !slices.Contains(validCommitStyles, cfg.CommitStyle) {
 		validationErrors = append(
@@ -343,6 +526,14 @@ func (*Validator) validateCommitMessageConfig(cfg *config.CommitMessageConfig) e
 		)
 	}
 
+	if cfg.RequireGitmoji != nil && *cfg.RequireGitmoji &&
+		cfg.BlockEmoji != nil && *cfg.BlockEmoji {
+		validationErrors = append(
+			validationErrors,
+			errors.New("require_gitmoji and block_emoji are mutually exclusive"),
+		)
+	}
+
 	if cfg.TitlePattern != "" {
 		if _, err := regexp.Compile(cfg.TitlePattern); err != nil {
 			validationErrors = append(
@@ -366,7 +557,19 @@ func (v *Validator) validatePushConfig(cfg *config.PushValidatorConfig) error {
 
 // validateAddConfig validates add validator configuration.
 func (v *Validator) validateAddConfig(cfg *config.AddValidatorConfig) error {
-	return v.validateBaseConfig(&cfg.ValidatorConfig)
+	if err := v.validateBaseConfig(&cfg.ValidatorConfig); err != nil {
+		return err
+	}
+
+	if cfg.MaxAddFileSizeKB != nil && *cfg.MaxAddFileSizeKB < 0 {
+		return errors.Wrapf(
+			ErrInvalidLength,
+			"max_add_file_size_kb must be non-negative, got %d",
+			*cfg.MaxAddFileSizeKB,
+		)
+	}
+
+	return nil
 }
 
 // validatePRConfig validates PR validator configuration.
@@ -444,6 +647,12 @@ func (v *Validator) validateBranchConfig(cfg *config.BranchValidatorConfig) erro
 		}
 	}
 
+	if cfg.RequireTicketPattern != "" {
+		if _, err := regexp.Compile(cfg.RequireTicketPattern); err != nil {
+			return errors.Wrapf(err, "require_ticket_pattern is not a valid regex")
+		}
+	}
+
 	return nil
 }
 
@@ -540,9 +749,10 @@ func (*Validator) validateBaseConfig(cfg *config.ValidatorConfig) error {
 	if cfg.Severity != config.SeverityUnknown && !cfg.Severity.IsASeverity() {
 		return errors.Wrapf(
 			ErrInvalidSeverity,
-			"must be %q or %q, got %q",
+			"must be %q, %q or %q, got %q",
 			config.SeverityError.String(),
 			config.SeverityWarning.String(),
+			config.SeverityInfo.String(),
 			cfg.Severity.String(),
 		)
 	}
@@ -618,12 +828,20 @@ func (*Validator) validatePatternsConfig(cfg *config.PatternsConfig) error {
 
 // validateRulesConfig validates the rules configuration.
 func (v *Validator) validateRulesConfig(cfg *config.RulesConfig) error {
-	if cfg == nil || len(cfg.Rules) == 0 {
+	if cfg == nil {
 		return nil
 	}
 
 	var validationErrors []error
 
+	if cfg.MaxFileContentSize != nil && *cfg.MaxFileContentSize <= 0 {
+		validationErrors = append(validationErrors, errors.Wrapf(
+			ErrInvalidOption,
+			"max_file_content_size must be positive, got %d",
+			*cfg.MaxFileContentSize,
+		))
+	}
+
 	for i := range cfg.Rules {
 		// Skip validation for disabled rules
 		if !cfg.Rules[i].IsRuleEnabled() {
@@ -674,6 +892,12 @@ func (v *Validator) validateRule(rule *config.RuleConfig, ruleID string) error {
 		validationErrors = append(validationErrors, err)
 	}
 
+	if rule.RateLimit != nil {
+		if err := validateRuleRateLimit(rule, ruleID); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
 	if len(validationErrors) > 0 {
 		return combineErrors(validationErrors)
 	}
@@ -757,6 +981,56 @@ func (*Validator) validateRuleMatchFields(match *config.RuleMatchConfig, ruleID
 		}
 	}
 
+	// Validate path_mode if specified
+	if match.PathMode != "" && !slices.Contains(config.ValidPathModes, match.PathMode) {
+		validationErrors = append(
+			validationErrors,
+			errors.Wrapf(
+				ErrInvalidRule,
+				"%s has invalid path_mode %q (valid: %v)",
+				ruleID,
+				match.PathMode,
+				config.ValidPathModes,
+			),
+		)
+	}
+
+	if err := validateScope(match, ruleID); err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	if err := validateCommandContains(match, ruleID); err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	if match.MinCommandPaths < 0 {
+		validationErrors = append(
+			validationErrors,
+			errors.Wrapf(
+				ErrInvalidRule,
+				"%s has negative min_command_paths (%d)",
+				ruleID,
+				match.MinCommandPaths,
+			),
+		)
+	}
+
+	if match.MinConsecutiveBlocks < 0 {
+		validationErrors = append(
+			validationErrors,
+			errors.Wrapf(
+				ErrInvalidRule,
+				"%s has negative min_consecutive_blocks (%d)",
+				ruleID,
+				match.MinConsecutiveBlocks,
+			),
+		)
+	}
+
+	validationErrors = append(validationErrors, validateContentInFiles(match, ruleID)...)
+	validationErrors = append(validationErrors, validateSubjectLength(match, ruleID)...)
+	validationErrors = append(validationErrors, validateGitConditions(match, ruleID)...)
+
 	if len(validationErrors) > 0 {
 		return combineErrors(validationErrors)
 	}
@@ -764,6 +1038,128 @@ func (*Validator) validateRuleMatchFields(match *config.RuleMatchConfig, ruleID
 	return nil
 }
 
+// validateGitConditions checks that the upstream tracking counts and the
+// stale-branch day count are not negative, and that require_dirty and
+// require_clean are not both set.
+func validateGitConditions(match *config.RuleMatchConfig, ruleID string) []error {
+	var validationErrors []error
+
+	if match.MinAhead < 0 || match.MinBehind < 0 {
+		validationErrors = append(
+			validationErrors,
+			errors.Wrapf(
+				ErrInvalidRule,
+				"%s has negative min_ahead/min_behind (%d/%d)",
+				ruleID,
+				match.MinAhead,
+				match.MinBehind,
+			),
+		)
+	}
+
+	if match.MinDaysSinceCommit < 0 {
+		validationErrors = append(
+			validationErrors,
+			errors.Wrapf(
+				ErrInvalidRule,
+				"%s has negative min_days_since_commit (%d)",
+				ruleID,
+				match.MinDaysSinceCommit,
+			),
+		)
+	}
+
+	if match.RequireDirty && match.RequireClean {
+		validationErrors = append(
+			validationErrors,
+			errors.Wrapf(
+				ErrInvalidRule,
+				"%s sets both require_dirty and require_clean",
+				ruleID,
+			),
+		)
+	}
+
+	return validationErrors
+}
+
+// validateScope checks that scope, when set, is "local" or "remote".
+func validateScope(match *config.RuleMatchConfig, ruleID string) error {
+	if match.Scope == "" || slices.Contains(config.ValidScopes, match.Scope) {
+		return nil
+	}
+
+	return errors.Wrapf(
+		ErrInvalidRule,
+		"%s has invalid scope %q (valid: %v)",
+		ruleID,
+		match.Scope,
+		config.ValidScopes,
+	)
+}
+
+// validateSubjectLength checks that the commit subject length bounds are not
+// negative and leave a range that can match.
+func validateSubjectLength(match *config.RuleMatchConfig, ruleID string) []error {
+	if match.MinSubjectLength < 0 || match.MaxSubjectLength < 0 {
+		return []error{errors.Wrapf(
+			ErrInvalidRule,
+			"%s has negative min_subject_length/max_subject_length (%d/%d)",
+			ruleID,
+			match.MinSubjectLength,
+			match.MaxSubjectLength,
+		)}
+	}
+
+	if match.MaxSubjectLength > 0 && match.MinSubjectLength > match.MaxSubjectLength {
+		return []error{errors.Wrapf(
+			ErrInvalidRule,
+			"%s has min_subject_length above max_subject_length (%d > %d)",
+			ruleID,
+			match.MinSubjectLength,
+			match.MaxSubjectLength,
+		)}
+	}
+
+	return nil
+}
+
+// validateCommandContains checks that command_contains has no empty entry,
+// which would match every command.
+func validateCommandContains(match *config.RuleMatchConfig, ruleID string) error {
+	if !slices.Contains(match.CommandContains, "") {
+		return nil
+	}
+
+	return errors.Wrapf(
+		ErrInvalidRule,
+		"%s has an empty command_contains entry (it would match every command)",
+		ruleID,
+	)
+}
+
+// validateContentInFiles checks that every content_in_files entry has both
+// a file pattern and a content pattern.
+func validateContentInFiles(match *config.RuleMatchConfig, ruleID string) []error {
+	var validationErrors []error
+
+	for i, pair := range match.ContentInFiles {
+		if pair.FilePattern == "" || pair.ContentPattern == "" {
+			validationErrors = append(
+				validationErrors,
+				errors.Wrapf(
+					ErrInvalidRule,
+					"%s content_in_files[%d] needs both file_pattern and content_pattern",
+					ruleID,
+					i,
+				),
+			)
+		}
+	}
+
+	return validationErrors
+}
+
 // validateRuleAction validates a rule's action configuration.
 func (*Validator) validateRuleAction(action *config.RuleActionConfig, ruleID string) error {
 	if action == nil {
@@ -782,6 +1178,74 @@ func (*Validator) validateRuleAction(action *config.RuleActionConfig, ruleID str
 		)
 	}
 
+	if action.Severity != config.SeverityUnknown &&
+		!slices.Contains([]string{"block", "warn"}, action.GetActionType()) {
+		return errors.Wrapf(
+			ErrInvalidRule,
+			"%s has a severity, which only applies to block and warn actions, not %q",
+			ruleID,
+			action.GetActionType(),
+		)
+	}
+
+	if action.Type == "transform" {
+		return validateTransform(action, ruleID)
+	}
+
+	return nil
+}
+
+// validateRuleRateLimit checks that a rate-limited rule has a name to key
+// its state by, a positive window and a block or warn action.
+func validateRuleRateLimit(rule *config.RuleConfig, ruleID string) error {
+	if rule.Name == "" {
+		return errors.Wrapf(ErrInvalidRule, "%s has a rate limit but no name", ruleID)
+	}
+
+	if rule.RateLimit.GetWindow() <= 0 {
+		return errors.Wrapf(ErrInvalidRule, "%s rate limit requires a positive window", ruleID)
+	}
+
+	actionType := rule.Action.GetActionType()
+	if !slices.Contains([]string{"block", "warn"}, actionType) {
+		return errors.Wrapf(
+			ErrInvalidRule,
+			"%s has a rate limit, which only applies to block and warn actions, not %q",
+			ruleID,
+			actionType,
+		)
+	}
+
+	return nil
+}
+
+// validateTransform checks that a transform action has a compilable find
+// pattern and a known field.
+func validateTransform(action *config.RuleActionConfig, ruleID string) error {
+	if action.Field != "" && !slices.Contains(config.ValidTransformFields, action.Field) {
+		return errors.Wrapf(
+			ErrInvalidRule,
+			"%s has invalid transform field %q (valid: %v)",
+			ruleID,
+			action.Field,
+			config.ValidTransformFields,
+		)
+	}
+
+	if action.Find == "" {
+		return errors.Wrapf(ErrInvalidRule, "%s transform action requires find", ruleID)
+	}
+
+	if _, err := regexp.Compile(action.Find); err != nil {
+		return errors.Wrapf(
+			ErrInvalidRule,
+			"%s has invalid transform find pattern %q: %v",
+			ruleID,
+			action.Find,
+			err,
+		)
+	}
+
 	return nil
 }
 
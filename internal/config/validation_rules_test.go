@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -102,6 +104,20 @@ var _ = Describe("Validator", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It("should pass for rule with only upstream tracking conditions", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "behind-upstream-rule",
+							Match: &config.RuleMatchConfig{
+								MinBehind: 1,
+							},
+						},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("should pass for rule with valid action type", func() {
 				err := validator.validateRulesConfig(&config.RulesConfig{
 					Rules: []config.RuleConfig{
@@ -239,6 +255,236 @@ var _ = Describe("Validator", func() {
 				Expect(err.Error()).To(ContainSubstring("empty match section"))
 			})
 
+			It("should fail when min_behind is negative", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "negative-behind-rule",
+							Match: &config.RuleMatchConfig{
+								ValidatorType: "git.push",
+								MinBehind:     -1,
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("negative min_ahead/min_behind"))
+			})
+
+			It("should fail when a transform action has no find pattern", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "transform-without-find",
+							Match: &config.RuleMatchConfig{
+								ValidatorType: "git.push",
+							},
+							Action: &config.RuleActionConfig{
+								Type:    "transform",
+								Replace: "--force-with-lease",
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("transform action requires find"))
+			})
+
+			It("should fail when a transform action targets an unsupported field", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "transform-file-path",
+							Match: &config.RuleMatchConfig{
+								ValidatorType: "file.*",
+							},
+							Action: &config.RuleActionConfig{
+								Type:  "transform",
+								Field: "file_path",
+								Find:  "tmp",
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid transform field"))
+			})
+
+			It("should fail when a severity is set on an allow action", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "allow-with-severity",
+							Match: &config.RuleMatchConfig{
+								ValidatorType: "git.push",
+							},
+							Action: &config.RuleActionConfig{
+								Type:     "allow",
+								Severity: config.SeverityError,
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(
+					"severity, which only applies to block and warn actions",
+				))
+			})
+
+			It("should fail when a rate limit is set on an allow action", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "allow-with-rate-limit",
+							Match: &config.RuleMatchConfig{
+								ValidatorType: "git.push",
+							},
+							Action: &config.RuleActionConfig{Type: "allow"},
+							RateLimit: &config.RuleRateLimitConfig{
+								Window: config.Duration(10 * time.Minute),
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(
+					"rate limit, which only applies to block and warn actions",
+				))
+			})
+
+			It("should fail when a rate limit has no window", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "rate-limit-without-window",
+							Match: &config.RuleMatchConfig{
+								ValidatorType: "git.push",
+							},
+							RateLimit: &config.RuleRateLimitConfig{},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("requires a positive window"))
+			})
+
+			It("should fail when a rate-limited rule has no name", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Match: &config.RuleMatchConfig{
+								ValidatorType: "git.push",
+							},
+							RateLimit: &config.RuleRateLimitConfig{
+								Window: config.Duration(time.Hour),
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("has a rate limit but no name"))
+			})
+
+			It("should fail when min_days_since_commit is negative", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "negative-days-rule",
+							Match: &config.RuleMatchConfig{
+								ValidatorType:      "git.push",
+								MinDaysSinceCommit: -1,
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("negative min_days_since_commit"))
+			})
+
+			It("should fail when both require_dirty and require_clean are set", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "dirty-and-clean-rule",
+							Match: &config.RuleMatchConfig{
+								ValidatorType: "git.branch",
+								RequireDirty:  true,
+								RequireClean:  true,
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("both require_dirty and require_clean"))
+			})
+
+			It("should fail when command_contains has an empty entry", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "empty-substring-rule",
+							Match: &config.RuleMatchConfig{
+								CommandContains: []string{"rm -rf", ""},
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("empty command_contains entry"))
+			})
+
+			It("should fail when max_file_content_size is not positive", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					MaxFileContentSize: new(int64(0)),
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("max_file_content_size must be positive"))
+			})
+
+			It("should fail when min_command_paths is negative", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name:  "negative-paths-rule",
+							Match: &config.RuleMatchConfig{MinCommandPaths: -1},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("negative min_command_paths (-1)"))
+			})
+
+			It("should fail when min_consecutive_blocks is negative", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name:  "negative-blocks-rule",
+							Match: &config.RuleMatchConfig{MinConsecutiveBlocks: -1},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("negative min_consecutive_blocks (-1)"))
+			})
+
+			It("should fail when min_subject_length is above max_subject_length", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "subject-length-rule",
+							Match: &config.RuleMatchConfig{
+								MinSubjectLength: 72,
+								MaxSubjectLength: 50,
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(
+					"min_subject_length above max_subject_length (72 > 50)",
+				))
+			})
+
 			It("should fail when tool_type is invalid", func() {
 				err := validator.validateRulesConfig(&config.RulesConfig{
 					Rules: []config.RuleConfig{
@@ -271,6 +517,39 @@ var _ = Describe("Validator", func() {
 				Expect(err.Error()).To(ContainSubstring("InvalidEvent"))
 			})
 
+			It("should fail when path_mode is invalid", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "invalid-path-mode-rule",
+							Match: &config.RuleMatchConfig{
+								FilePattern: "src/**",
+								PathMode:    "repo",
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid path_mode"))
+				Expect(err.Error()).To(ContainSubstring("repo"))
+			})
+
+			It("should fail when scope is invalid", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "invalid-scope-rule",
+							Match: &config.RuleMatchConfig{
+								Scope: "upstream",
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid scope"))
+				Expect(err.Error()).To(ContainSubstring("upstream"))
+			})
+
 			It("should fail when action type is invalid", func() {
 				err := validator.validateRulesConfig(&config.RulesConfig{
 					Rules: []config.RuleConfig{
@@ -305,6 +584,24 @@ var _ = Describe("Validator", func() {
 				Expect(err.Error()).To(ContainSubstring("invalid provider"))
 			})
 
+			It("should fail when a content_in_files entry lacks a pattern", func() {
+				err := validator.validateRulesConfig(&config.RulesConfig{
+					Rules: []config.RuleConfig{
+						{
+							Name: "half-pair-rule",
+							Match: &config.RuleMatchConfig{
+								ContentInFiles: []config.ContentInFileConfig{
+									{FilePattern: "*.go", ContentPattern: "TODO"},
+									{FilePattern: "*.py"},
+								},
+							},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("content_in_files[1]"))
+			})
+
 			It("should report multiple errors", func() {
 				err := validator.validateRulesConfig(&config.RulesConfig{
 					Rules: []config.RuleConfig{
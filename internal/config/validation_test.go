@@ -65,6 +65,54 @@ var _ = Describe("Validator", func() {
 		})
 	})
 
+	Describe("validateGlobalConfig", func() {
+		It("should reject an invalid result_sink", func() {
+			cfg := &config.Config{
+				Global: &config.GlobalConfig{ResultSink: "kafka"},
+			}
+
+			err := validator.Validate(cfg)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, ErrInvalidConfig)).To(BeTrue())
+		})
+
+		It("should list each invalid section in Errors", func() {
+			cfg := &config.Config{
+				Global:   &config.GlobalConfig{ResultSink: "kafka"},
+				Patterns: &config.PatternsConfig{MinCount: -1},
+			}
+
+			errs := validator.Errors(cfg)
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0]).To(MatchError(ContainSubstring("result_sink")))
+			Expect(errs[1]).To(MatchError(ContainSubstring("patterns")))
+			Expect(validator.Errors(&config.Config{})).To(BeEmpty())
+		})
+
+		It("should accept valid result_sink values", func() {
+			for _, sink := range config.ValidResultSinks {
+				cfg := &config.Config{
+					Global: &config.GlobalConfig{ResultSink: sink},
+				}
+
+				err := validator.Validate(cfg)
+				Expect(err).NotTo(HaveOccurred(), "result_sink %q should be valid", sink)
+			}
+		})
+		It("should reject negative log rotation settings", func() {
+			negative := -1
+
+			for _, log := range []*config.LogConfig{
+				{MaxSizeMB: &negative},
+				{MaxBackups: &negative},
+			} {
+				errs := validator.Errors(&config.Config{Global: &config.GlobalConfig{Log: log}})
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0]).To(MatchError(ContainSubstring("log.max_")))
+			}
+		})
+	})
+
 	Describe("validateGitConfig", func() {
 		It("should pass with nil config", func() {
 			cfg := &config.Config{
@@ -110,6 +158,19 @@ var _ = Describe("Validator", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should reject negative max_add_file_size_kb", func() {
+			negative := -1
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					Git: &config.GitConfig{
+						Add: &config.AddValidatorConfig{MaxAddFileSizeKB: &negative},
+					},
+				},
+			}
+			err := validator.Validate(cfg)
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should validate branch config", func() {
 			cfg := &config.Config{
 				Validators: &config.ValidatorsConfig{
@@ -262,6 +323,25 @@ var _ = Describe("Validator", func() {
 			err := validator.Validate(cfg)
 			Expect(err).NotTo(HaveOccurred())
 		})
+		It("should reject require_gitmoji together with block_emoji", func() {
+			enabled := true
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					Git: &config.GitConfig{
+						Commit: &config.CommitValidatorConfig{
+							Message: &config.CommitMessageConfig{
+								RequireGitmoji: &enabled,
+								BlockEmoji:     &enabled,
+							},
+						},
+					},
+				},
+			}
+
+			err := validator.Validate(cfg)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, ErrInvalidConfig)).To(BeTrue())
+		})
 	})
 
 	Describe("validatePRConfig", func() {
@@ -328,6 +408,22 @@ var _ = Describe("Validator", func() {
 			err := validator.Validate(cfg)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should reject invalid require_ticket_pattern regex", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					Git: &config.GitConfig{
+						Branch: &config.BranchValidatorConfig{
+							RequireTicketPattern: "[A-Z+-\\d+",
+						},
+					},
+				},
+			}
+
+			err := validator.Validate(cfg)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, ErrInvalidConfig)).To(BeTrue())
+		})
 	})
 
 	Describe("validateFileConfig", func() {
@@ -350,6 +446,45 @@ var _ = Describe("Validator", func() {
 			err := validator.Validate(cfg)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should reject an invalid skip_paths glob", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					File: &config.FileConfig{
+						Python: &config.PythonValidatorConfig{
+							PathFilterConfig: config.PathFilterConfig{
+								SkipPaths: []string{"vendor/**", "gen/[a-"},
+							},
+						},
+					},
+				},
+			}
+
+			err := validator.Validate(cfg)
+			Expect(errors.Is(err, ErrInvalidConfig)).To(BeTrue())
+
+			errs := validator.Errors(cfg)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(MatchError(ContainSubstring(
+				`validators.file.python: skip_paths has invalid glob "gen/[a-"`,
+			)))
+		})
+
+		It("should accept valid skip_paths globs", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					File: &config.FileConfig{
+						Markdown: &config.MarkdownValidatorConfig{
+							PathFilterConfig: config.PathFilterConfig{
+								SkipPaths: []string{"CHANGELOG.md", "docs/**/*.md"},
+							},
+						},
+					},
+				},
+			}
+
+			Expect(validator.Validate(cfg)).To(Succeed())
+		})
 	})
 
 	Describe("validateMarkdownConfig", func() {
@@ -537,6 +672,70 @@ var _ = Describe("Validator", func() {
 		})
 	})
 
+	Describe("validateGitHubConfig", func() {
+		It("should pass with valid release patterns", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					GitHub: &config.GitHubConfig{
+						Release: &config.ReleaseValidatorConfig{
+							TagPattern:   `^v\d+\.\d+\.\d+$`,
+							TitlePattern: `^Release `,
+						},
+					},
+				},
+			}
+			err := validator.Validate(cfg)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject invalid release patterns", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					GitHub: &config.GitHubConfig{
+						Release: &config.ReleaseValidatorConfig{
+							TagPattern:   "v(",
+							TitlePattern: "[",
+						},
+					},
+				},
+			}
+			errs := validator.Errors(cfg)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Error()).To(ContainSubstring("validators.github.release"))
+			Expect(errs[0].Error()).To(ContainSubstring("tag_pattern is not a valid regex"))
+			Expect(errs[0].Error()).To(ContainSubstring("title_pattern is not a valid regex"))
+		})
+
+		It("should accept valid PR merge methods", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					GitHub: &config.GitHubConfig{
+						PRMerge: &config.PRMergeValidatorConfig{
+							AllowedMethods: []string{"squash", "rebase"},
+						},
+					},
+				},
+			}
+			Expect(validator.Validate(cfg)).To(Succeed())
+		})
+
+		It("should reject unknown PR merge methods", func() {
+			cfg := &config.Config{
+				Validators: &config.ValidatorsConfig{
+					GitHub: &config.GitHubConfig{
+						PRMerge: &config.PRMergeValidatorConfig{
+							AllowedMethods: []string{"squash", "fast-forward"},
+						},
+					},
+				},
+			}
+			errs := validator.Errors(cfg)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Error()).To(ContainSubstring("validators.github.pr_merge"))
+			Expect(errs[0].Error()).To(ContainSubstring(`got "fast-forward"`))
+		})
+	})
+
 	Describe("validateBaseConfig", func() {
 		It("should reject invalid severity", func() {
 			cfg := &config.Config{
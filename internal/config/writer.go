@@ -10,6 +10,7 @@ import (
 	"github.com/pelletier/go-toml/v2"
 
 	"github.com/smykla-skalski/klaudiush/internal/backup"
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
 	"github.com/smykla-skalski/klaudiush/internal/schema"
 	"github.com/smykla-skalski/klaudiush/internal/xdg"
 	"github.com/smykla-skalski/klaudiush/pkg/config"
@@ -112,8 +113,8 @@ func (w *Writer) WriteFile(path string, cfg *config.Config) error {
 		return errors.Wrap(err, "failed to encode config to TOML")
 	}
 
-	// Write to file with secure permissions
-	if err := os.WriteFile(path, buf.Bytes(), ConfigFileMode); err != nil {
+	// Replace the file atomically with secure permissions
+	if err := fileutil.WriteFileAtomic(path, buf.Bytes(), ConfigFileMode); err != nil {
 		return errors.Wrapf(err, "failed to write config file %s", path)
 	}
 
@@ -0,0 +1,80 @@
+package dispatcher
+
+import (
+	"context"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+// OnStartFunc is called when a dispatch starts, before any validator runs.
+type OnStartFunc func(ctx context.Context, hookCtx *hook.Context)
+
+// OnValidatorResultFunc is called after each validator returns, including
+// validators run on synthetic Write contexts for Bash file writes. With the
+// parallel executor it may be called concurrently.
+type OnValidatorResultFunc func(ctx context.Context, name string, result *validator.Result)
+
+// OnCompleteFunc is called when a dispatch finishes with the final errors
+// (after overrides, exceptions and the maximum severity) and whether they
+// block the operation.
+type OnCompleteFunc func(
+	ctx context.Context,
+	hookCtx *hook.Context,
+	errs []*ValidationError,
+	blocked bool,
+)
+
+// WithOnStart sets the callback called when a dispatch starts.
+func WithOnStart(fn OnStartFunc) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.onStart = fn
+	}
+}
+
+// WithOnValidatorResult sets the callback called with each validator result.
+func WithOnValidatorResult(fn OnValidatorResultFunc) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.onValidatorResult = fn
+	}
+}
+
+// WithOnComplete sets the callback called when a dispatch finishes.
+func WithOnComplete(fn OnCompleteFunc) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.onComplete = fn
+	}
+}
+
+// observedValidator reports the result of a validator to a callback.
+type observedValidator struct {
+	validator.Validator
+
+	onResult OnValidatorResultFunc
+}
+
+// Validate runs the wrapped validator and reports its result. Results of
+// validators abandoned by a timed-out dispatch are not reported.
+func (v *observedValidator) Validate(ctx context.Context, hookCtx *hook.Context) *validator.Result {
+	result := v.Validator.Validate(ctx, hookCtx)
+
+	if !isAbandoned(ctx) {
+		v.onResult(ctx, v.Name(), result)
+	}
+
+	return result
+}
+
+// observeValidators wraps validators for the result callback, if any.
+func (d *Dispatcher) observeValidators(validators []validator.Validator) []validator.Validator {
+	if d.onValidatorResult == nil {
+		return validators
+	}
+
+	observed := make([]validator.Validator, 0, len(validators))
+	for _, v := range validators {
+		observed = append(observed, &observedValidator{Validator: v, onResult: d.onValidatorResult})
+	}
+
+	return observed
+}
@@ -0,0 +1,132 @@
+package dispatcher_test
+
+import (
+	"context"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+var _ = Describe("Dispatcher callbacks", func() {
+	var (
+		log     logger.Logger
+		reg     *validator.Registry
+		hookCtx *hook.Context
+		events  []string
+	)
+
+	BeforeEach(func() {
+		log = logger.NewNoOpLogger()
+		reg = validator.NewRegistry()
+		events = nil
+		hookCtx = &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{Command: "ls"},
+		}
+
+		reg.Register(
+			newTestValidator("validate-pass", validator.CategoryCPU, validator.Pass()),
+			validator.ToolTypeIs(hook.ToolTypeBash),
+		)
+		reg.Register(
+			newTestValidator("validate-block", validator.CategoryCPU, validator.Fail("blocked")),
+			validator.ToolTypeIs(hook.ToolTypeBash),
+		)
+	})
+
+	recordingOptions := func() []dispatcher.DispatcherOption {
+		return []dispatcher.DispatcherOption{
+			dispatcher.WithOnStart(func(_ context.Context, got *hook.Context) {
+				Expect(got).To(BeIdenticalTo(hookCtx))
+
+				events = append(events, "start")
+			}),
+			dispatcher.WithOnValidatorResult(
+				func(_ context.Context, name string, result *validator.Result) {
+					Expect(result).NotTo(BeNil())
+
+					if result.Passed {
+						events = append(events, "result:"+name+":pass")
+					} else {
+						events = append(events, "result:"+name+":fail")
+					}
+				},
+			),
+			dispatcher.WithOnComplete(func(
+				_ context.Context,
+				got *hook.Context,
+				errs []*dispatcher.ValidationError,
+				blocked bool,
+			) {
+				Expect(got).To(BeIdenticalTo(hookCtx))
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0].Validator).To(Equal("validate-block"))
+
+				if blocked {
+					events = append(events, "complete:blocked")
+				} else {
+					events = append(events, "complete:allowed")
+				}
+			}),
+		}
+	}
+
+	It("should fire callbacks in order with the validator results", func() {
+		disp := dispatcher.NewDispatcher(reg, log, recordingOptions()...)
+
+		errs := disp.Dispatch(context.Background(), hookCtx)
+
+		Expect(errs).To(HaveLen(1))
+		Expect(events).To(Equal([]string{
+			"start",
+			"result:validate-pass:pass",
+			"result:validate-block:fail",
+			"complete:blocked",
+		}))
+	})
+
+	It("should report the final decision after the maximum severity", func() {
+		opts := append(recordingOptions(), dispatcher.WithMaxSeverity(config.SeverityWarning))
+		disp := dispatcher.NewDispatcher(reg, log, opts...)
+
+		disp.Dispatch(context.Background(), hookCtx)
+
+		Expect(events).To(HaveLen(4))
+		Expect(events[3]).To(Equal("complete:allowed"))
+	})
+
+	It("should report every result with the parallel executor", func() {
+		var reported atomic.Int32
+
+		disp := dispatcher.NewDispatcherWithOptions(
+			reg,
+			log,
+			dispatcher.NewParallelExecutor(log, nil),
+			dispatcher.WithOnValidatorResult(
+				func(context.Context, string, *validator.Result) {
+					reported.Add(1)
+				},
+			),
+		)
+
+		Expect(disp.Dispatch(context.Background(), hookCtx)).To(HaveLen(1))
+		Expect(reported.Load()).To(Equal(int32(2)))
+	})
+
+	It("should run unchanged without callbacks", func() {
+		disp := dispatcher.NewDispatcher(reg, log)
+
+		errs := disp.Dispatch(context.Background(), hookCtx)
+
+		Expect(errs).To(HaveLen(1))
+		Expect(dispatcher.ShouldBlock(errs)).To(BeTrue())
+	})
+})
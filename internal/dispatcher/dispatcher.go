@@ -4,7 +4,9 @@ package dispatcher
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
@@ -37,6 +39,10 @@ type ValidationError struct {
 	// ShouldBlock indicates whether this error should block the operation.
 	ShouldBlock bool
 
+	// Informational marks a non-blocking finding that is only recorded.
+	// It is left out of hook responses and reports unless verbose.
+	Informational bool
+
 	// Reference is the URL that uniquely identifies this error type.
 	// Format: https://klaudiu.sh/e/{CODE} (e.g., https://klaudiu.sh/e/GIT001).
 	Reference validator.Reference
@@ -44,6 +50,14 @@ type ValidationError struct {
 	// FixHint provides a short suggestion for fixing the issue.
 	FixHint string
 
+	// PluginDetails contains structured key/value context reported by an
+	// external plugin. Only rendered in verbose output.
+	PluginDetails map[string]string
+
+	// UpdatedInput holds tool input fields (e.g. "command") to replace
+	// before the tool runs. Set by rule transform actions.
+	UpdatedInput map[string]string
+
 	// Bypassed indicates this error was bypassed via an exception token.
 	// When true, ShouldBlock is false (converted to warning).
 	Bypassed bool
@@ -73,15 +87,22 @@ type Dispatcher struct {
 	executor         Executor
 	exceptionChecker ExceptionChecker
 	overrides        *config.OverridesConfig
+	timeout          time.Duration
+	failClosed       bool
+	maxSeverity      config.Severity
+
+	onStart           OnStartFunc
+	onValidatorResult OnValidatorResultFunc
+	onComplete        OnCompleteFunc
 }
 
 // NewDispatcher creates a new Dispatcher with sequential execution.
-func NewDispatcher(registry *validator.Registry, logger logger.Logger) *Dispatcher {
-	return &Dispatcher{
-		registry: registry,
-		logger:   logger,
-		executor: NewSequentialExecutor(logger),
-	}
+func NewDispatcher(
+	registry *validator.Registry,
+	logger logger.Logger,
+	opts ...DispatcherOption,
+) *Dispatcher {
+	return NewDispatcherWithOptions(registry, logger, NewSequentialExecutor(logger), opts...)
 }
 
 // NewDispatcherWithExecutor creates a new Dispatcher with a custom executor.
@@ -137,15 +158,41 @@ func NewDispatcherWithOptions(
 }
 
 // Dispatch validates the context using all matching validators.
-// Returns a slice of validation errors (empty if all pass).
+// Returns a slice of validation errors (empty if all pass). Blocking errors
+// are downgraded to warnings when the maximum severity is warning. The
+// OnStart and OnComplete callbacks, if set, run before and after validation.
 func (d *Dispatcher) Dispatch(ctx context.Context, hookCtx *hook.Context) []*ValidationError {
+	if d.onStart != nil {
+		d.onStart(ctx, hookCtx)
+	}
+
+	var validationErrors []*ValidationError
+
+	if d.timeout > 0 {
+		validationErrors = d.dispatchWithTimeout(ctx, hookCtx)
+	} else {
+		validationErrors = d.dispatch(ctx, hookCtx)
+	}
+
+	validationErrors = d.applyMaxSeverity(validationErrors)
+
+	if d.onComplete != nil {
+		d.onComplete(ctx, hookCtx, validationErrors, ShouldBlock(validationErrors))
+	}
+
+	return validationErrors
+}
+
+// dispatch runs validators on the context, on its rewritten tool input and
+// on synthetic Write contexts for Bash file writes.
+func (d *Dispatcher) dispatch(ctx context.Context, hookCtx *hook.Context) []*ValidationError {
 	d.logger.Info("dispatching",
 		"event", hookCtx.EventType,
 		"tool", hookCtx.ToolName,
 	)
 
-	// Run validators on the main context
-	validationErrors := d.runValidators(ctx, hookCtx)
+	// Run validators on the main context, then on any rewritten input
+	validationErrors := d.recheckRewrites(ctx, hookCtx, d.runValidators(ctx, hookCtx))
 
 	// Validate synthetic Write contexts for Bash file writes on pre-tool and Codex post-tool flows.
 	if hookCtx.ToolName == hook.ToolTypeBash && (hookCtx.Event == hook.CanonicalEventBeforeTool ||
@@ -177,7 +224,17 @@ func (d *Dispatcher) runValidators(ctx context.Context, hookCtx *hook.Context) [
 	)
 
 	// Use executor to run validators (sequential or parallel)
-	validationErrors := d.executor.Execute(ctx, hookCtx, validators)
+	validationErrors := d.executor.Execute(
+		ctx,
+		hookCtx,
+		d.observeValidators(trackValidators(ctx, validators)),
+	)
+
+	// A timed-out dispatch has already returned; skip the exception checks
+	// so abandoned results do not consume exception state.
+	if isAbandoned(ctx) {
+		return nil
+	}
 
 	// Apply overrides to suppress disabled error codes
 	validationErrors = d.applyOverrides(validationErrors)
@@ -189,12 +246,18 @@ func (d *Dispatcher) runValidators(ctx context.Context, hookCtx *hook.Context) [
 	for _, verr := range validationErrors {
 		name := shortName(verr.Validator)
 
-		if verr.ShouldBlock {
+		switch {
+		case verr.ShouldBlock:
 			d.logger.Error("validator failed",
 				"validator", name,
 				"message", verr.Message,
 			)
-		} else {
+		case verr.Informational:
+			d.logger.Info("validator reported info",
+				"validator", name,
+				"message", verr.Message,
+			)
+		default:
 			d.logger.Info("validator warned",
 				"validator", name,
 				"message", verr.Message,
@@ -339,3 +402,15 @@ func ShouldBlock(errors []*ValidationError) bool {
 
 	return false
 }
+
+// Reported returns the errors to show the user: informational errors are
+// left out unless verbose is set.
+func Reported(errors []*ValidationError, verbose bool) []*ValidationError {
+	if verbose {
+		return errors
+	}
+
+	return slices.DeleteFunc(slices.Clone(errors), func(err *ValidationError) bool {
+		return err.Informational
+	})
+}
@@ -108,14 +108,15 @@ func (c *DefaultExceptionChecker) CheckException(
 
 	// Convert to a non-blocking warning with bypass info
 	bypassedErr := &ValidationError{
-		Validator:    verr.Validator,
-		Message:      formatBypassedMessage(verr.Message, resp),
-		Details:      verr.Details,
-		ShouldBlock:  false, // No longer blocks
-		Reference:    verr.Reference,
-		FixHint:      verr.FixHint,
-		Bypassed:     true,
-		BypassReason: resp.TokenReason,
+		Validator:     verr.Validator,
+		Message:       formatBypassedMessage(verr.Message, resp),
+		Details:       verr.Details,
+		ShouldBlock:   false, // No longer blocks
+		Reference:     verr.Reference,
+		FixHint:       verr.FixHint,
+		PluginDetails: verr.PluginDetails,
+		Bypassed:      true,
+		BypassReason:  resp.TokenReason,
 	}
 
 	return bypassedErr, true
@@ -222,11 +222,14 @@ func (e *ParallelExecutor) poolFor(category validator.ValidatorCategory) *semaph
 // toValidationError converts a validator and result to a ValidationError.
 func toValidationError(v validator.Validator, result *validator.Result) *ValidationError {
 	return &ValidationError{
-		Validator:   v.Name(),
-		Message:     result.Message,
-		Details:     result.Details,
-		ShouldBlock: result.ShouldBlock,
-		Reference:   result.Reference,
-		FixHint:     result.FixHint,
+		Validator:     v.Name(),
+		Message:       result.Message,
+		Details:       result.Details,
+		ShouldBlock:   result.ShouldBlock,
+		Informational: result.Informational,
+		Reference:     result.Reference,
+		FixHint:       result.FixHint,
+		PluginDetails: result.PluginDetails,
+		UpdatedInput:  result.UpdatedInput,
 	}
 }
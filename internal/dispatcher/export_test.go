@@ -0,0 +1,11 @@
+package dispatcher
+
+import "time"
+
+// Export unexported functions for external tests.
+var FormatSyslogLine = formatSyslogLine
+
+// SetNow overrides the clock used for report timestamps.
+func (s *FileSink) SetNow(now func() time.Time) {
+	s.now = now
+}
@@ -0,0 +1,130 @@
+package dispatcher
+
+import (
+	"strconv"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	internalcolor "github.com/smykla-skalski/klaudiush/internal/color"
+)
+
+// FormatErrorsPretty renders errors as a report for humans, grouped by
+// severity: blocking errors first, then warnings, then informational
+// findings. Fix hints are indented under their error. When color is true the
+// group headers are colorized (blocks red, warnings yellow, info blue);
+// otherwise no ANSI codes are emitted. Use Reported to leave out
+// informational findings.
+func FormatErrorsPretty(errs []*ValidationError, color bool) string {
+	if len(errs) == 0 {
+		return ""
+	}
+
+	var blocking, warnings, info []*ValidationError
+
+	for _, e := range errs {
+		switch {
+		case e.ShouldBlock:
+			blocking = append(blocking, e)
+		case e.Informational:
+			info = append(info, e)
+		default:
+			warnings = append(warnings, e)
+		}
+	}
+
+	theme := internalcolor.NewTheme(color)
+
+	var b strings.Builder
+
+	writePrettyGroup(&b, "Blocked", blocking, theme.Fail)
+	writePrettyGroup(&b, "Warnings", warnings, theme.Warning)
+	writePrettyGroup(&b, "Info", info, theme.Info)
+
+	return b.String()
+}
+
+// writePrettyGroup writes a group header followed by its errors.
+func writePrettyGroup(
+	b *strings.Builder,
+	title string,
+	errs []*ValidationError,
+	style lipgloss.Style,
+) {
+	if len(errs) == 0 {
+		return
+	}
+
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+
+	b.WriteString(style.Render(title + " (" + strconv.Itoa(len(errs)) + ")"))
+	b.WriteString("\n")
+
+	for _, e := range errs {
+		writePrettyError(b, e)
+	}
+}
+
+// writePrettyError writes one error with its message, fix hint and reference.
+func writePrettyError(b *strings.Builder, e *ValidationError) {
+	b.WriteString("  ")
+	b.WriteString(shortName(e.Validator))
+	b.WriteString(":")
+
+	if code := e.Reference.Code(); code != "" {
+		b.WriteString(" [")
+		b.WriteString(code)
+		b.WriteString("]")
+	}
+
+	lines := strings.Split(strings.TrimSpace(e.Message), "\n")
+
+	b.WriteString(" ")
+	b.WriteString(lines[0])
+
+	if e.Bypassed {
+		reason := e.BypassReason
+		if reason == "" {
+			reason = "no reason provided"
+		}
+
+		b.WriteString(" (bypassed: ")
+		b.WriteString(reason)
+		b.WriteString(")")
+	}
+
+	b.WriteString("\n")
+
+	for _, line := range lines[1:] {
+		if strings.TrimSpace(line) == "" {
+			b.WriteString("\n")
+
+			continue
+		}
+
+		b.WriteString("    ")
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	if e.FixHint != "" {
+		b.WriteString("    Fix: ")
+		b.WriteString(e.FixHint)
+		b.WriteString("\n")
+	}
+
+	if e.Reference != "" {
+		b.WriteString("    Ref: ")
+		b.WriteString(string(e.Reference))
+
+		if info, ok := e.Reference.Info(); ok && info.Title != "" {
+			b.WriteString(" (")
+			b.WriteString(info.Title)
+			b.WriteString(")")
+		}
+
+		b.WriteString("\n")
+	}
+}
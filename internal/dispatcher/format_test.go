@@ -0,0 +1,117 @@
+package dispatcher_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+)
+
+var _ = Describe("FormatErrorsPretty", func() {
+	var errs []*dispatcher.ValidationError
+
+	BeforeEach(func() {
+		errs = []*dispatcher.ValidationError{
+			{
+				Validator: "validate-markdown",
+				Message:   "Trailing whitespace",
+				FixHint:   "Remove trailing spaces",
+			},
+			{
+				Validator:   "validate-commit",
+				Message:     "Title too long\nTitle: feat: a very long title",
+				ShouldBlock: true,
+				Reference:   validator.RefGitBadTitle,
+				FixHint:     "Shorten the title",
+			},
+		}
+	})
+
+	It("should return empty string for no errors", func() {
+		Expect(dispatcher.FormatErrorsPretty(nil, true)).To(BeEmpty())
+	})
+
+	It("should list blocking errors before warnings", func() {
+		out := dispatcher.FormatErrorsPretty(errs, false)
+
+		blocked := strings.Index(out, "Blocked (1)")
+		warnings := strings.Index(out, "Warnings (1)")
+
+		Expect(blocked).To(BeNumerically(">=", 0))
+		Expect(warnings).To(BeNumerically(">", blocked))
+		Expect(strings.Index(out, "commit:")).To(BeNumerically("<", warnings))
+		Expect(strings.Index(out, "markdown:")).To(BeNumerically(">", warnings))
+	})
+
+	It("should list info findings last", func() {
+		errs = append(errs, &dispatcher.ValidationError{
+			Validator:     "validate-push",
+			Message:       "Pushing tags",
+			Informational: true,
+		})
+
+		out := dispatcher.FormatErrorsPretty(errs, false)
+
+		Expect(out).To(ContainSubstring("Warnings (1)"))
+		Expect(strings.Index(out, "Info (1)")).
+			To(BeNumerically(">", strings.Index(out, "markdown:")))
+		Expect(out).To(HaveSuffix("Info (1)\n  push: Pushing tags\n"))
+
+		Expect(dispatcher.FormatErrorsPretty(dispatcher.Reported(errs, false), false)).
+			NotTo(ContainSubstring("Info"))
+		Expect(dispatcher.Reported(errs, true)).To(HaveLen(3))
+	})
+
+	It("should indent message continuation lines and fix hints", func() {
+		out := dispatcher.FormatErrorsPretty(errs, false)
+
+		Expect(out).To(ContainSubstring(
+			"  commit: [" + validator.RefGitBadTitle.Code() + "] Title too long\n",
+		))
+		Expect(out).To(ContainSubstring("    Title: feat: a very long title\n"))
+		Expect(out).To(ContainSubstring("    Fix: Shorten the title\n"))
+		Expect(out).To(ContainSubstring("    Fix: Remove trailing spaces\n"))
+	})
+
+	It("should add the registered title to the reference line", func() {
+		ref := validator.Reference(validator.ReferenceBaseURL + "/TEST901")
+		validator.RegisterReferences(validator.ReferenceInfo{
+			Code:  ref.Code(),
+			Title: "Test reference",
+		})
+
+		errs[1].Reference = ref
+		out := dispatcher.FormatErrorsPretty(errs, false)
+
+		Expect(out).To(ContainSubstring("    Ref: " + string(ref) + " (Test reference)\n"))
+	})
+
+	It("should mark bypassed errors", func() {
+		out := dispatcher.FormatErrorsPretty([]*dispatcher.ValidationError{
+			{
+				Validator:    "validate-push",
+				Message:      "Push to main",
+				Bypassed:     true,
+				BypassReason: "hotfix",
+			},
+		}, false)
+
+		Expect(out).To(ContainSubstring("Push to main (bypassed: hotfix)"))
+		Expect(out).NotTo(ContainSubstring("Blocked"))
+	})
+
+	It("should not emit ANSI codes without color", func() {
+		Expect(dispatcher.FormatErrorsPretty(errs, false)).NotTo(ContainSubstring("\x1b["))
+	})
+
+	It("should colorize group headers with color", func() {
+		out := dispatcher.FormatErrorsPretty(errs, true)
+
+		Expect(out).To(ContainSubstring("\x1b["))
+		Expect(out).To(ContainSubstring("Blocked (1)"))
+		Expect(out).To(ContainSubstring("Warnings (1)"))
+	})
+})
@@ -0,0 +1,76 @@
+package dispatcher
+
+import (
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	jsonSeverityError   = "error"
+	jsonSeverityWarning = "warning"
+	jsonSeverityInfo    = "info"
+)
+
+// jsonReport is the machine-readable report of one run.
+type jsonReport struct {
+	Blocked  bool          `json:"blocked"`
+	Findings []jsonFinding `json:"findings"`
+}
+
+// jsonFinding is one validation error in a jsonReport.
+type jsonFinding struct {
+	Validator    string            `json:"validator"`
+	Code         string            `json:"code,omitempty"`
+	Severity     string            `json:"severity"`
+	Message      string            `json:"message"`
+	FixHint      string            `json:"fix_hint,omitempty"`
+	Reference    string            `json:"reference,omitempty"`
+	Details      map[string]string `json:"details,omitempty"`
+	Bypassed     bool              `json:"bypassed,omitempty"`
+	BypassReason string            `json:"bypass_reason,omitempty"`
+}
+
+// FormatErrorsJSON renders errors as a JSON report: whether any error blocks,
+// and each error with its validator, code, severity (error, warning or
+// info), message, fix hint and reference. A run without errors has an empty
+// findings list. Use Reported to leave out informational findings.
+func FormatErrorsJSON(errs []*ValidationError) ([]byte, error) {
+	report := jsonReport{Findings: make([]jsonFinding, 0, len(errs))}
+
+	for _, e := range errs {
+		if e.ShouldBlock {
+			report.Blocked = true
+		}
+
+		report.Findings = append(report.Findings, jsonFinding{
+			Validator:    shortName(e.Validator),
+			Code:         e.Reference.Code(),
+			Severity:     jsonSeverity(e),
+			Message:      e.Message,
+			FixHint:      e.FixHint,
+			Reference:    string(e.Reference),
+			Details:      e.Details,
+			Bypassed:     e.Bypassed,
+			BypassReason: e.BypassReason,
+		})
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode JSON report")
+	}
+
+	return append(data, '\n'), nil
+}
+
+func jsonSeverity(e *ValidationError) string {
+	switch {
+	case e.ShouldBlock:
+		return jsonSeverityError
+	case e.Informational:
+		return jsonSeverityInfo
+	default:
+		return jsonSeverityWarning
+	}
+}
@@ -0,0 +1,75 @@
+package dispatcher_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+)
+
+var _ = Describe("FormatErrorsJSON", func() {
+	type report struct {
+		Blocked  bool `json:"blocked"`
+		Findings []struct {
+			Validator string `json:"validator"`
+			Code      string `json:"code"`
+			Severity  string `json:"severity"`
+			Message   string `json:"message"`
+			FixHint   string `json:"fix_hint"`
+			Reference string `json:"reference"`
+			Bypassed  bool   `json:"bypassed"`
+		} `json:"findings"`
+	}
+
+	decode := func(errs []*dispatcher.ValidationError) report {
+		data, err := dispatcher.FormatErrorsJSON(errs)
+		Expect(err).NotTo(HaveOccurred())
+
+		var r report
+		Expect(json.Unmarshal(data, &r)).To(Succeed())
+
+		return r
+	}
+
+	It("should report an empty findings list for a clean run", func() {
+		data, err := dispatcher.FormatErrorsJSON(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(MatchJSON(`{"blocked": false, "findings": []}`))
+	})
+
+	It("should report each finding with its severity and code", func() {
+		r := decode([]*dispatcher.ValidationError{
+			{
+				Validator:   "validate-commit",
+				Message:     "invalid commit type",
+				ShouldBlock: true,
+				Reference:   validator.ReferenceBaseURL + "/GIT013",
+				FixHint:     "use a conventional commit type",
+			},
+			{Validator: "file.markdown", Message: "trailing spaces"},
+			{Validator: "rules", Message: "observed", Informational: true},
+		})
+
+		Expect(r.Blocked).To(BeTrue())
+		Expect(r.Findings).To(HaveLen(3))
+		Expect(r.Findings[0].Validator).To(Equal("commit"))
+		Expect(r.Findings[0].Code).To(Equal("GIT013"))
+		Expect(r.Findings[0].Severity).To(Equal("error"))
+		Expect(r.Findings[0].FixHint).To(Equal("use a conventional commit type"))
+		Expect(r.Findings[0].Reference).To(Equal("https://klaudiu.sh/e/GIT013"))
+		Expect(r.Findings[1].Severity).To(Equal("warning"))
+		Expect(r.Findings[2].Severity).To(Equal("info"))
+	})
+
+	It("should not report a run with only bypassed findings as blocked", func() {
+		r := decode([]*dispatcher.ValidationError{
+			{Validator: "validate-commit", Message: "invalid", Bypassed: true},
+		})
+
+		Expect(r.Blocked).To(BeFalse())
+		Expect(r.Findings[0].Bypassed).To(BeTrue())
+	})
+})
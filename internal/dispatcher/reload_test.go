@@ -0,0 +1,117 @@
+package dispatcher_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+// blockingRegistrations returns count always-blocking registrations named
+// "<prefix>.<n>".
+func blockingRegistrations(prefix string, count int) []validator.Registration {
+	registrations := make([]validator.Registration, 0, count)
+
+	for i := range count {
+		registrations = append(registrations, validator.Registration{
+			Validator: &mockBlockingValidator{
+				name:      fmt.Sprintf("%s.%d", prefix, i),
+				reference: "https://klaudiu.sh/e/GIT022",
+			},
+			Predicate: validator.Always(),
+		})
+	}
+
+	return registrations
+}
+
+var _ = Describe("Dispatcher during registry reload", func() {
+	It("should only see complete validator sets", func() {
+		oldSet := blockingRegistrations("old", 3)
+		newSet := blockingRegistrations("new", 5)
+
+		reg := validator.NewRegistry()
+		reg.Replace(oldSet)
+
+		disp := dispatcher.NewDispatcher(reg, logger.NewNoOpLogger())
+		hookCtx := &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeRead,
+			ToolInput: hook.ToolInput{FilePath: "README.md"},
+		}
+
+		stop := make(chan struct{})
+
+		var reloads sync.WaitGroup
+
+		reloads.Go(func() {
+			for i := 0; ; i++ {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				if i%2 == 0 {
+					reg.Replace(newSet)
+				} else {
+					reg.Replace(oldSet)
+				}
+			}
+		})
+
+		var (
+			dispatches sync.WaitGroup
+			mu         sync.Mutex
+			torn       []string
+		)
+
+		for range 4 {
+			dispatches.Go(func() {
+				for range 200 {
+					errs := disp.Dispatch(context.Background(), hookCtx)
+
+					names := make([]string, 0, len(errs))
+					for _, e := range errs {
+						names = append(names, e.Validator)
+					}
+
+					if !isCompleteSet(names, "old.", 3) && !isCompleteSet(names, "new.", 5) {
+						mu.Lock()
+						torn = append(torn, strings.Join(names, ","))
+						mu.Unlock()
+					}
+				}
+			})
+		}
+
+		dispatches.Wait()
+		close(stop)
+		reloads.Wait()
+
+		Expect(torn).To(BeEmpty())
+	})
+})
+
+// isCompleteSet reports whether names holds exactly count names with prefix.
+func isCompleteSet(names []string, prefix string, count int) bool {
+	if len(names) != count {
+		return false
+	}
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
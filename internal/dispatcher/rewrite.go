@@ -0,0 +1,166 @@
+package dispatcher
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+// maxRewritePasses bounds how many times rewritten tool input is validated
+// again, so transforms that keep rewriting each other's output terminate.
+const maxRewritePasses = 3
+
+// CollectUpdates merges the rewritten fields of non-blocking findings.
+// Fields rewritten to different values are returned, sorted, as conflicts
+// and left out of updates.
+func CollectUpdates(errs []*ValidationError) (map[string]string, []string) {
+	updates := make(map[string]string)
+
+	var conflicts []string
+
+	for _, e := range errs {
+		if e.ShouldBlock {
+			continue
+		}
+
+		for field, value := range e.UpdatedInput {
+			if slices.Contains(conflicts, field) {
+				continue
+			}
+
+			if prev, ok := updates[field]; ok && prev != value {
+				conflicts = append(conflicts, field)
+				delete(updates, field)
+
+				continue
+			}
+
+			updates[field] = value
+		}
+	}
+
+	slices.Sort(conflicts)
+
+	return updates, conflicts
+}
+
+// recheckRewrites validates the rewritten tool input of the allowing
+// findings errs with every matching validator, since each of them only
+// approved the original input. Rewrites of the rewritten input are applied
+// in turn, up to maxRewritePasses. Once no transform changes the input, the
+// validators run once more with transforms skipped, so a matching transform
+// rule can't hide the built-in checks. A block on the rewritten input is
+// added to errs; otherwise the findings carry the final rewrite.
+func (d *Dispatcher) recheckRewrites(
+	ctx context.Context,
+	hookCtx *hook.Context,
+	errs []*ValidationError,
+) []*ValidationError {
+	if ShouldBlock(errs) {
+		return errs
+	}
+
+	current, _ := CollectUpdates(errs)
+	if len(current) == 0 {
+		return errs
+	}
+
+	var rewrites []*ValidationError
+
+	for range maxRewritePasses {
+		if ctx.Err() != nil {
+			return errs
+		}
+
+		rewritten := rewrittenContext(hookCtx, current)
+
+		recheck := d.runValidators(ctx, rewritten)
+		if ShouldBlock(recheck) {
+			return append(errs, blocking(recheck)...)
+		}
+
+		next, _ := CollectUpdates(recheck)
+
+		merged := maps.Clone(current)
+		maps.Copy(merged, next)
+
+		if maps.Equal(merged, current) {
+			final := d.runValidators(validator.WithoutTransforms(ctx), rewritten)
+			if ShouldBlock(final) {
+				return append(errs, blocking(final)...)
+			}
+
+			errs = append(errs, rewrites...)
+			applyFinalUpdates(errs, current)
+
+			return errs
+		}
+
+		for _, e := range recheck {
+			if len(e.UpdatedInput) > 0 {
+				rewrites = append(rewrites, e)
+			}
+		}
+
+		current = merged
+	}
+
+	d.logger.Error("rewritten input did not settle", "passes", maxRewritePasses)
+
+	return append(errs, &ValidationError{
+		Validator: "rewrite",
+		Message: "Rewritten tool input kept changing after " +
+			strconv.Itoa(maxRewritePasses) + " passes",
+		ShouldBlock: true,
+		FixHint:     "Check transform rules that rewrite each other's output",
+	})
+}
+
+// applyFinalUpdates sets every rewritten field of errs that is part of the
+// final rewrite to its final value, so that chained rewrites of a field
+// agree in the hook response.
+func applyFinalUpdates(errs []*ValidationError, final map[string]string) {
+	for _, e := range errs {
+		if len(e.UpdatedInput) == 0 {
+			continue
+		}
+
+		updated := maps.Clone(e.UpdatedInput)
+
+		for field := range updated {
+			if value, ok := final[field]; ok {
+				updated[field] = value
+			}
+		}
+
+		e.UpdatedInput = updated
+	}
+}
+
+// blocking returns the blocking findings of errs.
+func blocking(errs []*ValidationError) []*ValidationError {
+	return slices.DeleteFunc(slices.Clone(errs), func(e *ValidationError) bool {
+		return !e.ShouldBlock
+	})
+}
+
+// rewrittenContext returns a copy of hookCtx with the rewritten tool input
+// fields replaced.
+func rewrittenContext(hookCtx *hook.Context, updates map[string]string) *hook.Context {
+	rewritten := *hookCtx
+
+	for field, value := range updates {
+		switch field {
+		case "command":
+			rewritten.ToolInput.Command = value
+		case "content":
+			rewritten.ToolInput.Content = value
+		}
+	}
+
+	return &rewritten
+}
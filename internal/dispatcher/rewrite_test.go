@@ -0,0 +1,119 @@
+package dispatcher_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+// rewritingValidator rewrites commands containing find to rewrite unless
+// transforms are skipped, and blocks commands containing blocked.
+type rewritingValidator struct {
+	name    string
+	find    string
+	rewrite string
+	blocked string
+}
+
+func (v *rewritingValidator) Name() string {
+	return v.name
+}
+
+func (*rewritingValidator) Category() validator.ValidatorCategory {
+	return validator.CategoryCPU
+}
+
+func (v *rewritingValidator) Validate(ctx context.Context, hookCtx *hook.Context) *validator.Result {
+	command := hookCtx.GetCommand()
+
+	if !validator.TransformsSkipped(ctx) && strings.Contains(command, v.find) {
+		rewritten := strings.ReplaceAll(command, v.find, v.rewrite)
+		if rewritten != command {
+			result := validator.Warn("rewrote command")
+			result.UpdatedInput = map[string]string{"command": rewritten}
+
+			return result
+		}
+	}
+
+	if v.blocked != "" && strings.Contains(command, v.blocked) {
+		return validator.Fail(v.name + " blocked " + command)
+	}
+
+	return validator.Pass()
+}
+
+var _ = Describe("Dispatcher rewrites", func() {
+	var reg *validator.Registry
+
+	dispatch := func(command string) []*dispatcher.ValidationError {
+		disp := dispatcher.NewDispatcher(reg, logger.NewNoOpLogger())
+
+		return disp.Dispatch(context.Background(), &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{Command: command},
+		})
+	}
+
+	register := func(v validator.Validator) {
+		reg.Register(v, validator.ToolTypeIs(hook.ToolTypeBash))
+	}
+
+	BeforeEach(func() {
+		reg = validator.NewRegistry()
+	})
+
+	It("should keep the rewrite when the rewritten input passes", func() {
+		register(&rewritingValidator{name: "a", find: "unsafe", rewrite: "safe", blocked: "danger"})
+
+		errs := dispatch("unsafe command")
+		Expect(dispatcher.ShouldBlock(errs)).To(BeFalse())
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].UpdatedInput).To(HaveKeyWithValue("command", "safe command"))
+	})
+
+	It("should block when the rewriting validator blocks the rewritten input", func() {
+		register(&rewritingValidator{name: "a", find: "test", rewrite: "danger", blocked: "danger"})
+
+		errs := dispatch("test command")
+		Expect(dispatcher.ShouldBlock(errs)).To(BeTrue())
+		Expect(errs).To(ContainElement(HaveField("Message", "a blocked danger command")))
+	})
+
+	It("should block when another validator blocks the rewritten input", func() {
+		register(&rewritingValidator{name: "a", find: "test", rewrite: "danger"})
+		register(&rewritingValidator{name: "b", blocked: "danger"})
+
+		errs := dispatch("test command")
+		Expect(dispatcher.ShouldBlock(errs)).To(BeTrue())
+		Expect(errs).To(ContainElement(HaveField("Message", "b blocked danger command")))
+	})
+
+	It("should apply a rewrite of the rewritten input", func() {
+		register(&rewritingValidator{name: "a", find: "one", rewrite: "two"})
+		register(&rewritingValidator{name: "b", find: "two", rewrite: "three"})
+
+		errs := dispatch("echo one")
+		Expect(dispatcher.ShouldBlock(errs)).To(BeFalse())
+
+		updates, conflicts := dispatcher.CollectUpdates(errs)
+		Expect(conflicts).To(BeEmpty())
+		Expect(updates).To(Equal(map[string]string{"command": "echo three"}))
+	})
+
+	It("should block rewrites that never settle", func() {
+		register(&rewritingValidator{name: "a", find: "x", rewrite: "xx"})
+
+		errs := dispatch("echo x")
+		Expect(dispatcher.ShouldBlock(errs)).To(BeTrue())
+		Expect(errs).To(ContainElement(HaveField("Validator", "rewrite")))
+	})
+})
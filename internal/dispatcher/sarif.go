@@ -0,0 +1,239 @@
+package dispatcher
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	sarifToolName = "klaudiush"
+	sarifToolURI  = "https://klaudiu.sh"
+
+	sarifLevelError   = "error"
+	sarifLevelWarning = "warning"
+	sarifLevelNote    = "note"
+)
+
+// findingLinePattern finds the line, and the column when known, a finding
+// message points at: "path:12:5: ..." from linters, or "Line 12: ..." from
+// the markdown checks.
+var findingLinePattern = regexp.MustCompile(`(?m)^(?:\S*?:(\d+)(?::(\d+))?: |Line (\d+):)`)
+
+// FileErrors are the validation errors reported for one file. Path is the
+// file's slash-separated path, relative to the root of the scan; it is empty
+// for findings not tied to a file.
+type FileErrors struct {
+	Path   string
+	Errors []*ValidationError
+}
+
+// FormatErrorsSARIF renders errors as a SARIF 2.1.0 log with a single run.
+// Each reference code becomes a rule, and errors without a reference use
+// their validator name as the rule ID. Blocking errors are reported at the
+// error level, informational findings as notes and other errors as
+// warnings. Results of a file are located at it, on the first line the
+// message points at, if any.
+func FormatErrorsSARIF(files []FileErrors) ([]byte, error) {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           sarifToolName,
+				InformationURI: sarifToolURI,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	ruleIndex := make(map[string]int)
+
+	for _, file := range files {
+		for _, e := range file.Errors {
+			id := sarifRuleID(e)
+
+			index, ok := ruleIndex[id]
+			if !ok {
+				index = len(run.Tool.Driver.Rules)
+				ruleIndex[id] = index
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, newSARIFRule(id, e))
+			}
+
+			run.Results = append(run.Results, newSARIFResult(id, index, file.Path, e))
+		}
+	}
+
+	data, err := json.MarshalIndent(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal SARIF log")
+	}
+
+	return append(data, '\n'), nil
+}
+
+// sarifRuleID returns the reference code of e, or its validator name when
+// it has no reference.
+func sarifRuleID(e *ValidationError) string {
+	if code := e.Reference.Code(); code != "" {
+		return code
+	}
+
+	return shortName(e.Validator)
+}
+
+func newSARIFRule(id string, e *ValidationError) sarifRule {
+	rule := sarifRule{ID: id}
+
+	if e.Reference == "" {
+		return rule
+	}
+
+	rule.HelpURI = string(e.Reference)
+
+	if info, ok := e.Reference.Info(); ok {
+		if info.Title != "" {
+			rule.ShortDescription = &sarifMessage{Text: info.Title}
+		}
+
+		if info.Description != "" {
+			rule.FullDescription = &sarifMessage{Text: info.Description}
+		}
+	}
+
+	return rule
+}
+
+func newSARIFResult(id string, index int, path string, e *ValidationError) sarifResult {
+	text := strings.TrimSpace(e.Message)
+	if e.FixHint != "" {
+		text += "\n\nFix: " + e.FixHint
+	}
+
+	result := sarifResult{
+		RuleID:    id,
+		RuleIndex: index,
+		Level:     sarifLevel(e),
+		Message:   sarifMessage{Text: text},
+	}
+
+	if path == "" {
+		return result
+	}
+
+	location := sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: path},
+			Region:           findingRegion(e.Message),
+		},
+	}
+
+	result.Locations = []sarifLocation{location}
+
+	return result
+}
+
+func sarifLevel(e *ValidationError) string {
+	switch {
+	case e.ShouldBlock:
+		return sarifLevelError
+	case e.Informational:
+		return sarifLevelNote
+	default:
+		return sarifLevelWarning
+	}
+}
+
+// findingRegion returns the region of the first line message points at, or
+// nil when it points at none.
+func findingRegion(message string) *sarifRegion {
+	match := findingLinePattern.FindStringSubmatch(message)
+	if match == nil {
+		return nil
+	}
+
+	lineText := match[1]
+	if lineText == "" {
+		lineText = match[3]
+	}
+
+	line, err := strconv.Atoi(lineText)
+	if err != nil || line < 1 {
+		return nil
+	}
+
+	region := &sarifRegion{StartLine: line}
+
+	if column, err := strconv.Atoi(match[2]); err == nil && column > 0 {
+		region.StartColumn = column
+	}
+
+	return region
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	FullDescription  *sarifMessage `json:"fullDescription,omitempty"`
+	HelpURI          string        `json:"helpUri,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
@@ -0,0 +1,179 @@
+package dispatcher_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+)
+
+var _ = Describe("FormatErrorsSARIF", func() {
+	// Validator packages register their references, none of which are
+	// imported here.
+	const refSARIFTest validator.Reference = validator.ReferenceBaseURL + "/SARIF901"
+
+	BeforeEach(func() {
+		validator.RegisterReferences(validator.ReferenceInfo{
+			Code:        "SARIF901",
+			Title:       "Shell script issues",
+			Description: "Shellcheck reported issues in a shell script",
+		})
+	})
+
+	type sarifLog struct {
+		Schema  string `json:"$schema"`
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID               string `json:"id"`
+						HelpURI          string `json:"helpUri"`
+						ShortDescription struct {
+							Text string `json:"text"`
+						} `json:"shortDescription"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				RuleIndex int    `json:"ruleIndex"`
+				Level     string `json:"level"`
+				Message   struct {
+					Text string `json:"text"`
+				} `json:"message"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region *struct {
+							StartLine   int `json:"startLine"`
+							StartColumn int `json:"startColumn"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+
+	parse := func(files []dispatcher.FileErrors) sarifLog {
+		data, err := dispatcher.FormatErrorsSARIF(files)
+		Expect(err).NotTo(HaveOccurred())
+
+		var log sarifLog
+		Expect(json.Unmarshal(data, &log)).To(Succeed())
+		Expect(log.Version).To(Equal("2.1.0"))
+		Expect(log.Schema).To(ContainSubstring("sarif-2.1.0"))
+		Expect(log.Runs).To(HaveLen(1))
+		Expect(log.Runs[0].Tool.Driver.Name).To(Equal("klaudiush"))
+
+		return log
+	}
+
+	It("should emit an empty run without errors", func() {
+		data, err := dispatcher.FormatErrorsSARIF(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"results": []`))
+		Expect(string(data)).To(ContainSubstring(`"rules": []`))
+
+		Expect(parse(nil).Runs[0].Results).To(BeEmpty())
+	})
+
+	It("should map references to rules and findings to results", func() {
+		log := parse([]dispatcher.FileErrors{
+			{
+				Path: "scripts/deploy.sh",
+				Errors: []*dispatcher.ValidationError{
+					{
+						Validator:   "validate-shellscript",
+						Message:     "Shellcheck found issues\n/tmp/x.sh:12:5: Quote this (SC2086)",
+						ShouldBlock: true,
+						Reference:   refSARIFTest,
+						FixHint:     "Fix the reported issues",
+					},
+				},
+			},
+			{
+				Path: "docs/README.md",
+				Errors: []*dispatcher.ValidationError{
+					{
+						Validator: "validate-markdown",
+						Message:   "Markdown formatting issues\nLine 3: Missing empty line",
+					},
+					{
+						Validator:     "validate-markdown",
+						Message:       "Table could be aligned",
+						Informational: true,
+					},
+				},
+			},
+			{
+				Path: "scripts/build.sh",
+				Errors: []*dispatcher.ValidationError{
+					{
+						Validator:   "validate-shellscript",
+						Message:     "Shellcheck found issues",
+						ShouldBlock: true,
+						Reference:   refSARIFTest,
+					},
+				},
+			},
+		})
+
+		run := log.Runs[0]
+
+		Expect(run.Tool.Driver.Rules).To(HaveLen(2))
+		Expect(run.Tool.Driver.Rules[0].ID).To(Equal("SARIF901"))
+		Expect(run.Tool.Driver.Rules[0].HelpURI).To(Equal(string(refSARIFTest)))
+		Expect(run.Tool.Driver.Rules[0].ShortDescription.Text).To(Equal("Shell script issues"))
+		Expect(run.Tool.Driver.Rules[1].ID).To(Equal("markdown"))
+
+		Expect(run.Results).To(HaveLen(4))
+
+		first := run.Results[0]
+		Expect(first.RuleID).To(Equal("SARIF901"))
+		Expect(first.RuleIndex).To(Equal(0))
+		Expect(first.Level).To(Equal("error"))
+		Expect(first.Message.Text).To(HaveSuffix("Fix: Fix the reported issues"))
+		Expect(first.Locations).To(HaveLen(1))
+		Expect(first.Locations[0].PhysicalLocation.ArtifactLocation.URI).
+			To(Equal("scripts/deploy.sh"))
+		Expect(first.Locations[0].PhysicalLocation.Region.StartLine).To(Equal(12))
+		Expect(first.Locations[0].PhysicalLocation.Region.StartColumn).To(Equal(5))
+
+		Expect(run.Results[1].RuleIndex).To(Equal(1))
+		Expect(run.Results[1].Level).To(Equal("warning"))
+		Expect(run.Results[1].Locations[0].PhysicalLocation.Region.StartLine).To(Equal(3))
+
+		Expect(run.Results[2].Level).To(Equal("note"))
+		Expect(run.Results[2].Locations[0].PhysicalLocation.Region).To(BeNil())
+
+		Expect(run.Results[3].RuleIndex).To(Equal(0))
+		Expect(run.Results[3].Locations[0].PhysicalLocation.ArtifactLocation.URI).
+			To(Equal("scripts/build.sh"))
+	})
+
+	It("should leave out locations for findings not tied to a file", func() {
+		log := parse([]dispatcher.FileErrors{
+			{
+				Errors: []*dispatcher.ValidationError{
+					{
+						Validator:   "validate-commit",
+						Message:     "Title too long",
+						ShouldBlock: true,
+						Reference:   validator.RefGitBadTitle,
+					},
+				},
+			},
+		})
+
+		Expect(log.Runs[0].Results).To(HaveLen(1))
+		Expect(log.Runs[0].Results[0].RuleID).To(Equal(validator.RefGitBadTitle.Code()))
+		Expect(log.Runs[0].Results[0].Locations).To(BeEmpty())
+	})
+})
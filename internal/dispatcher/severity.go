@@ -0,0 +1,59 @@
+package dispatcher
+
+import (
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+)
+
+// WithMaxSeverity caps the severity of all validation errors. With
+// config.SeverityWarning, blocking errors are downgraded to warnings. With
+// config.SeverityInfo, blocking errors and warnings are downgraded to
+// informational findings, which are only recorded. Other values leave errors
+// unchanged.
+func WithMaxSeverity(severity config.Severity) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.maxSeverity = severity
+	}
+}
+
+// applyMaxSeverity downgrades the errors above the maximum severity.
+func (d *Dispatcher) applyMaxSeverity(errors []*ValidationError) []*ValidationError {
+	switch d.maxSeverity {
+	case config.SeverityWarning:
+		return d.downgrade(errors, false, func(verr *ValidationError) bool {
+			return verr.ShouldBlock
+		})
+	case config.SeverityInfo:
+		return d.downgrade(errors, true, func(verr *ValidationError) bool {
+			return !verr.Informational
+		})
+	default:
+		return errors
+	}
+}
+
+// downgrade replaces the errors matched by above with non-blocking copies,
+// marked informational when informational is set.
+func (d *Dispatcher) downgrade(
+	errors []*ValidationError,
+	informational bool,
+	above func(*ValidationError) bool,
+) []*ValidationError {
+	for i, verr := range errors {
+		if !above(verr) {
+			continue
+		}
+
+		d.logger.Info("validation error downgraded by max_severity",
+			"validator", verr.Validator,
+			"reference", verr.Reference,
+			"max_severity", d.maxSeverity.String(),
+		)
+
+		downgraded := *verr
+		downgraded.ShouldBlock = false
+		downgraded.Informational = informational
+		errors[i] = &downgraded
+	}
+
+	return errors
+}
@@ -0,0 +1,102 @@
+package dispatcher_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+var _ = Describe("Dispatcher max severity", func() {
+	var (
+		log     logger.Logger
+		reg     *validator.Registry
+		hookCtx *hook.Context
+	)
+
+	BeforeEach(func() {
+		log = logger.NewNoOpLogger()
+		reg = validator.NewRegistry()
+		hookCtx = &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{Command: "ls"},
+		}
+
+		reg.Register(
+			newTestValidator("validate-block", validator.CategoryCPU, validator.Fail("blocked")),
+			validator.ToolTypeIs(hook.ToolTypeBash),
+		)
+		reg.Register(
+			newTestValidator("validate-warn", validator.CategoryCPU, validator.Warn("warned")),
+			validator.ToolTypeIs(hook.ToolTypeBash),
+		)
+	})
+
+	dispatch := func(opts ...dispatcher.DispatcherOption) []*dispatcher.ValidationError {
+		disp := dispatcher.NewDispatcherWithOptions(
+			reg,
+			log,
+			dispatcher.NewSequentialExecutor(log),
+			opts...,
+		)
+
+		return disp.Dispatch(context.Background(), hookCtx)
+	}
+
+	It("should keep blocking errors without a cap", func() {
+		errs := dispatch()
+
+		Expect(errs).To(HaveLen(2))
+		Expect(dispatcher.ShouldBlock(errs)).To(BeTrue())
+	})
+
+	It("should keep blocking errors with max severity error", func() {
+		errs := dispatch(dispatcher.WithMaxSeverity(config.SeverityError))
+
+		Expect(dispatcher.ShouldBlock(errs)).To(BeTrue())
+	})
+
+	It("should downgrade blocking errors with max severity warning", func() {
+		errs := dispatch(dispatcher.WithMaxSeverity(config.SeverityWarning))
+
+		Expect(errs).To(HaveLen(2))
+		Expect(errs).To(HaveEach(HaveField("ShouldBlock", BeFalse())))
+		Expect(errs).To(ContainElement(HaveField("Message", "blocked")))
+		Expect(dispatcher.ShouldBlock(errs)).To(BeFalse())
+	})
+
+	It("should downgrade blocking errors and warnings with max severity info", func() {
+		errs := dispatch(dispatcher.WithMaxSeverity(config.SeverityInfo))
+
+		Expect(errs).To(HaveLen(2))
+		Expect(errs).To(HaveEach(HaveField("ShouldBlock", BeFalse())))
+		Expect(errs).To(HaveEach(HaveField("Informational", BeTrue())))
+		Expect(dispatcher.ShouldBlock(errs)).To(BeFalse())
+		Expect(dispatcher.Reported(errs, false)).To(BeEmpty())
+	})
+
+	It("should downgrade the fail-closed timeout error", func() {
+		slow := newTestValidator("validate-slow", validator.CategoryCPU, validator.Fail("late"))
+		slow.delay = time.Second
+
+		reg = validator.NewRegistry()
+		reg.Register(slow, validator.ToolTypeIs(hook.ToolTypeBash))
+
+		errs := dispatch(
+			dispatcher.WithTimeout(20*time.Millisecond, true),
+			dispatcher.WithMaxSeverity(config.SeverityWarning),
+		)
+
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Validator).To(Equal(dispatcher.TimeoutValidatorName))
+		Expect(errs[0].ShouldBlock).To(BeFalse())
+	})
+})
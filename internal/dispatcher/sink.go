@@ -0,0 +1,126 @@
+package dispatcher
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// resultFileDirMode is the permission mode for the results file directory.
+	resultFileDirMode = 0o700
+
+	// resultFileMode is the permission mode for the results file.
+	resultFileMode = 0o600
+)
+
+// ErrSyslogUnsupported is returned by SyslogSink on platforms without syslog.
+var ErrSyslogUnsupported = errors.New("syslog is not supported on this platform")
+
+// ResultSink receives the human-readable report of a dispatch.
+type ResultSink interface {
+	// Emit writes the report for errs. Callers skip it when errs is empty.
+	Emit(errs []*ValidationError) error
+}
+
+// WriterSink writes the report to an io.Writer, such as stderr.
+type WriterSink struct {
+	w     io.Writer
+	color bool
+}
+
+// NewWriterSink creates a WriterSink. When color is true the report group
+// headers are colorized.
+func NewWriterSink(w io.Writer, color bool) *WriterSink {
+	return &WriterSink{w: w, color: color}
+}
+
+// Emit writes the report to the writer.
+func (s *WriterSink) Emit(errs []*ValidationError) error {
+	if _, err := io.WriteString(s.w, FormatErrorsPretty(errs, s.color)); err != nil {
+		return errors.Wrap(err, "failed to write results")
+	}
+
+	return nil
+}
+
+// FileSink appends reports to a file, each preceded by a timestamp line.
+type FileSink struct {
+	path string
+	now  func() time.Time
+}
+
+// NewFileSink creates a FileSink appending to path. The file and its
+// directory are created on first use.
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path, now: time.Now}
+}
+
+// Emit appends the report to the file.
+func (s *FileSink) Emit(errs []*ValidationError) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), resultFileDirMode); err != nil {
+		return errors.Wrap(err, "failed to create results directory")
+	}
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, resultFileMode)
+	if err != nil {
+		return errors.Wrap(err, "failed to open results file")
+	}
+
+	var b strings.Builder
+
+	b.WriteString("=== ")
+	b.WriteString(s.now().UTC().Format(time.RFC3339))
+	b.WriteString(" ===\n")
+	b.WriteString(FormatErrorsPretty(errs, false))
+
+	if _, err := f.WriteString(b.String()); err != nil {
+		_ = f.Close()
+
+		return errors.Wrap(err, "failed to write results file")
+	}
+
+	return errors.Wrap(f.Close(), "failed to close results file")
+}
+
+// SyslogSink sends each finding to the local syslog daemon as a separate
+// message: blocking errors at error priority, warnings at warning priority.
+type SyslogSink struct {
+	tag string
+}
+
+// NewSyslogSink creates a SyslogSink logging with the given tag.
+func NewSyslogSink(tag string) *SyslogSink {
+	return &SyslogSink{tag: tag}
+}
+
+// formatSyslogLine renders one finding as a single line.
+func formatSyslogLine(e *ValidationError) string {
+	var b strings.Builder
+
+	b.WriteString(shortName(e.Validator))
+	b.WriteString(":")
+
+	if code := e.Reference.Code(); code != "" {
+		b.WriteString(" [")
+		b.WriteString(code)
+		b.WriteString("]")
+	}
+
+	message, _, _ := strings.Cut(strings.TrimSpace(e.Message), "\n")
+
+	b.WriteString(" ")
+	b.WriteString(message)
+
+	if e.FixHint != "" {
+		b.WriteString(" (fix: ")
+		b.WriteString(e.FixHint)
+		b.WriteString(")")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,37 @@
+//go:build !windows && !plan9
+
+package dispatcher
+
+import (
+	"log/syslog"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Emit sends the findings to syslog.
+func (s *SyslogSink) Emit(errs []*ValidationError) error {
+	w, err := syslog.New(syslog.LOG_USER|syslog.LOG_INFO, s.tag)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to syslog")
+	}
+	defer w.Close()
+
+	for _, e := range errs {
+		line := formatSyslogLine(e)
+
+		switch {
+		case e.ShouldBlock:
+			err = w.Err(line)
+		case e.Informational:
+			err = w.Info(line)
+		default:
+			err = w.Warning(line)
+		}
+
+		if err != nil {
+			return errors.Wrap(err, "failed to write to syslog")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+//go:build windows || plan9
+
+package dispatcher
+
+// Emit returns ErrSyslogUnsupported.
+func (*SyslogSink) Emit([]*ValidationError) error {
+	return ErrSyslogUnsupported
+}
@@ -0,0 +1,93 @@
+package dispatcher_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+)
+
+var _ = Describe("ResultSink", func() {
+	var errs []*dispatcher.ValidationError
+
+	BeforeEach(func() {
+		errs = []*dispatcher.ValidationError{
+			{
+				Validator:   "validate-commit",
+				Message:     "Title too long\nTitle: feat: a very long title",
+				ShouldBlock: true,
+				Reference:   validator.RefGitBadTitle,
+				FixHint:     "Shorten the title",
+			},
+			{
+				Validator: "validate-markdown",
+				Message:   "Trailing whitespace",
+			},
+		}
+	})
+
+	Describe("WriterSink", func() {
+		It("should write the report to the writer", func() {
+			var buf bytes.Buffer
+
+			Expect(dispatcher.NewWriterSink(&buf, false).Emit(errs)).To(Succeed())
+			Expect(buf.String()).To(Equal(dispatcher.FormatErrorsPretty(errs, false)))
+			Expect(buf.String()).To(ContainSubstring("Blocked (1)"))
+			Expect(buf.String()).To(ContainSubstring("    Fix: Shorten the title\n"))
+			Expect(buf.String()).NotTo(ContainSubstring("\x1b["))
+		})
+	})
+
+	Describe("FileSink", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "nested", "results.log")
+		})
+
+		It("should create the file and append timestamped reports", func() {
+			sink := dispatcher.NewFileSink(path)
+			sink.SetNow(func() time.Time {
+				return time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+			})
+
+			Expect(sink.Emit(errs)).To(Succeed())
+			Expect(sink.Emit(errs[1:])).To(Succeed())
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			header := "=== 2026-10-16T12:00:00Z ===\n"
+			Expect(string(data)).To(Equal(
+				header + dispatcher.FormatErrorsPretty(errs, false) +
+					header + dispatcher.FormatErrorsPretty(errs[1:], false),
+			))
+
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+		})
+
+		It("should fail when the path is a directory", func() {
+			Expect(os.MkdirAll(path, 0o700)).To(Succeed())
+
+			Expect(dispatcher.NewFileSink(path).Emit(errs)).NotTo(Succeed())
+		})
+	})
+
+	Describe("syslog formatting", func() {
+		It("should render a finding as one line", func() {
+			Expect(dispatcher.FormatSyslogLine(errs[0])).To(Equal(
+				"commit: [" + validator.RefGitBadTitle.Code() +
+					"] Title too long (fix: Shorten the title)",
+			))
+			Expect(dispatcher.FormatSyslogLine(errs[1])).To(Equal("markdown: Trailing whitespace"))
+		})
+	})
+})
@@ -0,0 +1,246 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+// TimeoutValidatorName is the validator name of the finding reported when
+// dispatch times out: a block with fail-closed behavior, an informational
+// finding otherwise.
+const TimeoutValidatorName = "timeout"
+
+// WithTimeout bounds the total time Dispatch may take. When the timeout
+// expires, in-flight validators are cancelled and Dispatch returns the
+// findings of the validators that finished. The unfinished ones allow the
+// operation, or block it when failClosed is set. A zero timeout disables
+// the limit.
+func WithTimeout(timeout time.Duration, failClosed bool) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.timeout = timeout
+		d.failClosed = failClosed
+	}
+}
+
+// dispatchWithTimeout runs dispatch under d.timeout. Validators that do not
+// honor cancellation keep running in the background; their results are
+// discarded.
+func (d *Dispatcher) dispatchWithTimeout(
+	ctx context.Context,
+	hookCtx *hook.Context,
+) []*ValidationError {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	tracker := &validatorTracker{running: make(map[string]int), contexts: make(map[*hook.Context]int)}
+	ctx = context.WithValue(ctx, trackerKey{}, tracker)
+
+	done := make(chan []*ValidationError, 1)
+
+	go func() {
+		done <- d.dispatch(ctx, hookCtx)
+	}()
+
+	select {
+	case errs := <-done:
+		return errs
+	case <-ctx.Done():
+	}
+
+	// Dispatch may have finished at the same moment the deadline passed.
+	select {
+	case errs := <-done:
+		return errs
+	default:
+	}
+
+	unfinished := tracker.unfinished()
+	finished := d.finishedErrors(tracker)
+
+	d.logger.Error("dispatch timed out",
+		"timeout", d.timeout.String(),
+		"unfinished", strings.Join(unfinished, ","),
+		"finished_findings", len(finished),
+		"fail_closed", d.failClosed,
+	)
+
+	message := fmt.Sprintf("Validation did not finish within %s", d.timeout)
+	if len(unfinished) > 0 {
+		message += " (unfinished: " + strings.Join(unfinished, ", ") + ")"
+	}
+
+	return append(finished, &ValidationError{
+		Validator:     TimeoutValidatorName,
+		Message:       message,
+		ShouldBlock:   d.failClosed,
+		Informational: !d.failClosed,
+		FixHint:       "Raise global.hook_timeout or disable slow validators",
+	})
+}
+
+// TimedOut reports whether errs come from a dispatch that timed out, so
+// some validators did not finish.
+func TimedOut(errs []*ValidationError) bool {
+	return slices.ContainsFunc(errs, func(err *ValidationError) bool {
+		return err.Validator == TimeoutValidatorName
+	})
+}
+
+// finishedErrors returns the findings of the validators that finished before
+// a dispatch timed out, with overrides and exceptions applied as for a
+// complete run. A finding repeated for a rewritten context is kept once.
+// Rewrites are dropped, as the rewritten input was not validated again.
+func (d *Dispatcher) finishedErrors(tracker *validatorTracker) []*ValidationError {
+	var result []*ValidationError
+
+	seen := make(map[[2]string]bool)
+
+	for _, group := range tracker.finishedGroups() {
+		errs := d.applyOverrides(group.errs)
+		errs = d.applyExceptionChecking(group.hookCtx, errs)
+
+		for _, verr := range errs {
+			key := [2]string{verr.Validator, verr.Message}
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+			verr.UpdatedInput = nil
+			result = append(result, verr)
+		}
+	}
+
+	return result
+}
+
+// isAbandoned reports whether ctx belongs to a dispatch that timed out.
+func isAbandoned(ctx context.Context) bool {
+	_, tracked := ctx.Value(trackerKey{}).(*validatorTracker)
+
+	return tracked && ctx.Err() != nil
+}
+
+// trackerKey is the context key of the validatorTracker for a dispatch.
+type trackerKey struct{}
+
+// validatorTracker records which validators are running and the findings
+// of those that finished in time.
+type validatorTracker struct {
+	mu      sync.Mutex
+	running map[string]int
+
+	// finished holds the findings per validated context, in the order the
+	// contexts were first seen; contexts maps a context to its index.
+	finished []finishedGroup
+	contexts map[*hook.Context]int
+}
+
+// finishedGroup holds the findings of finished validators for one context.
+type finishedGroup struct {
+	hookCtx *hook.Context
+	errs    []*ValidationError
+}
+
+func (t *validatorTracker) start(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.running[name]++
+}
+
+func (t *validatorTracker) finish(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.running[name]--
+	if t.running[name] <= 0 {
+		delete(t.running, name)
+	}
+}
+
+// record adds the finding of a validator that finished.
+func (t *validatorTracker) record(hookCtx *hook.Context, verr *ValidationError) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	idx, ok := t.contexts[hookCtx]
+	if !ok {
+		idx = len(t.finished)
+		t.contexts[hookCtx] = idx
+		t.finished = append(t.finished, finishedGroup{hookCtx: hookCtx})
+	}
+
+	t.finished[idx].errs = append(t.finished[idx].errs, verr)
+}
+
+// finishedGroups returns a copy of the recorded findings.
+func (t *validatorTracker) finishedGroups() []finishedGroup {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	groups := make([]finishedGroup, 0, len(t.finished))
+	for _, group := range t.finished {
+		groups = append(groups, finishedGroup{hookCtx: group.hookCtx, errs: slices.Clone(group.errs)})
+	}
+
+	return groups
+}
+
+// unfinished returns the sorted names of validators still running.
+func (t *validatorTracker) unfinished() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	names := make([]string, 0, len(t.running))
+	for name := range t.running {
+		names = append(names, shortName(name))
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// trackedValidator reports start and finish of a validator to a tracker.
+type trackedValidator struct {
+	validator.Validator
+
+	tracker *validatorTracker
+}
+
+// Validate runs the wrapped validator, recording it as running meanwhile.
+// A finding reported before the dispatch timed out is recorded too.
+func (v *trackedValidator) Validate(ctx context.Context, hookCtx *hook.Context) *validator.Result {
+	v.tracker.start(v.Name())
+	defer v.tracker.finish(v.Name())
+
+	result := v.Validator.Validate(ctx, hookCtx)
+	if result != nil && !result.Passed && ctx.Err() == nil {
+		v.tracker.record(hookCtx, toValidationError(v.Validator, result))
+	}
+
+	return result
+}
+
+// trackValidators wraps validators for the tracker in ctx, if any.
+func trackValidators(ctx context.Context, validators []validator.Validator) []validator.Validator {
+	tracker, ok := ctx.Value(trackerKey{}).(*validatorTracker)
+	if !ok {
+		return validators
+	}
+
+	tracked := make([]validator.Validator, 0, len(validators))
+	for _, v := range validators {
+		tracked = append(tracked, &trackedValidator{Validator: v, tracker: tracker})
+	}
+
+	return tracked
+}
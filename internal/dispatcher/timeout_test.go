@@ -0,0 +1,181 @@
+package dispatcher_test
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+// cancellableValidator blocks until its context is cancelled.
+type cancellableValidator struct {
+	cancelled atomic.Bool
+}
+
+func (*cancellableValidator) Name() string {
+	return "validate-cancellable"
+}
+
+func (*cancellableValidator) Category() validator.ValidatorCategory {
+	return validator.CategoryIO
+}
+
+func (v *cancellableValidator) Validate(ctx context.Context, _ *hook.Context) *validator.Result {
+	<-ctx.Done()
+	v.cancelled.Store(true)
+
+	return validator.Fail("cancelled")
+}
+
+var _ = Describe("Dispatcher timeout", func() {
+	var (
+		log     logger.Logger
+		reg     *validator.Registry
+		hookCtx *hook.Context
+	)
+
+	BeforeEach(func() {
+		log = logger.NewNoOpLogger()
+		reg = validator.NewRegistry()
+		hookCtx = &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{Command: "ls"},
+		}
+	})
+
+	newDispatcher := func(
+		executor dispatcher.Executor,
+		opts ...dispatcher.DispatcherOption,
+	) *dispatcher.Dispatcher {
+		return dispatcher.NewDispatcherWithOptions(reg, log, executor, opts...)
+	}
+
+	slowValidator := func() *testValidator {
+		v := newTestValidator("validate-slow", validator.CategoryCPU, validator.Fail("too late"))
+		v.delay = time.Second
+
+		return v
+	}
+
+	It("should return results when validators finish in time", func() {
+		reg.Register(
+			newTestValidator("validate-fast", validator.CategoryCPU, validator.Fail("fast")),
+			validator.ToolTypeIs(hook.ToolTypeBash),
+		)
+
+		disp := newDispatcher(
+			dispatcher.NewSequentialExecutor(log),
+			dispatcher.WithTimeout(time.Second, true),
+		)
+
+		errs := disp.Dispatch(context.Background(), hookCtx)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Message).To(Equal("fast"))
+		Expect(dispatcher.TimedOut(errs)).To(BeFalse())
+	})
+
+	It("should allow the operation when a slow validator times out", func() {
+		reg.Register(slowValidator(), validator.ToolTypeIs(hook.ToolTypeBash))
+
+		disp := newDispatcher(
+			dispatcher.NewSequentialExecutor(log),
+			dispatcher.WithTimeout(50*time.Millisecond, false),
+		)
+
+		start := time.Now()
+		errs := disp.Dispatch(context.Background(), hookCtx)
+
+		Expect(time.Since(start)).To(BeNumerically("<", 500*time.Millisecond))
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Validator).To(Equal(dispatcher.TimeoutValidatorName))
+		Expect(errs[0].Informational).To(BeTrue())
+		Expect(dispatcher.ShouldBlock(errs)).To(BeFalse())
+		Expect(dispatcher.TimedOut(errs)).To(BeTrue())
+		Expect(dispatcher.Reported(errs, false)).To(BeEmpty())
+	})
+
+	It("should block and name unfinished validators when failing closed", func() {
+		reg.Register(
+			newTestValidator("validate-fast", validator.CategoryCPU, validator.Pass()),
+			validator.ToolTypeIs(hook.ToolTypeBash),
+		)
+		reg.Register(slowValidator(), validator.ToolTypeIs(hook.ToolTypeBash))
+
+		disp := newDispatcher(
+			dispatcher.NewParallelExecutor(log, nil),
+			dispatcher.WithTimeout(50*time.Millisecond, true),
+		)
+
+		errs := disp.Dispatch(context.Background(), hookCtx)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Validator).To(Equal(dispatcher.TimeoutValidatorName))
+		Expect(errs[0].ShouldBlock).To(BeTrue())
+		Expect(errs[0].Message).To(ContainSubstring("did not finish within 50ms"))
+		Expect(errs[0].Message).To(ContainSubstring("(unfinished: slow)"))
+	})
+
+	It("should keep the findings of validators that finished in time", func() {
+		reg.Register(
+			newTestValidator("validate-secrets", validator.CategoryCPU, validator.Fail("secret found")),
+			validator.ToolTypeIs(hook.ToolTypeBash),
+		)
+		reg.Register(&cancellableValidator{}, validator.ToolTypeIs(hook.ToolTypeBash))
+
+		for _, failClosed := range []bool{false, true} {
+			disp := newDispatcher(
+				dispatcher.NewParallelExecutor(log, nil),
+				dispatcher.WithTimeout(50*time.Millisecond, failClosed),
+			)
+
+			errs := disp.Dispatch(context.Background(), hookCtx)
+			Expect(errs).NotTo(BeEmpty())
+			Expect(errs[0].Validator).To(Equal("validate-secrets"))
+			Expect(errs[0].Message).To(Equal("secret found"))
+			Expect(errs[0].ShouldBlock).To(BeTrue())
+			Expect(dispatcher.ShouldBlock(errs)).To(BeTrue())
+
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[1].Validator).To(Equal(dispatcher.TimeoutValidatorName))
+			Expect(errs[1].Message).To(ContainSubstring("(unfinished: cancellable)"))
+			Expect(errs[1].ShouldBlock).To(Equal(failClosed))
+		}
+	})
+
+	It("should cancel in-flight validators", func() {
+		cancellable := &cancellableValidator{}
+		reg.Register(cancellable, validator.ToolTypeIs(hook.ToolTypeBash))
+
+		disp := newDispatcher(
+			dispatcher.NewSequentialExecutor(log),
+			dispatcher.WithTimeout(20*time.Millisecond, false),
+		)
+
+		errs := disp.Dispatch(context.Background(), hookCtx)
+		Expect(dispatcher.ShouldBlock(errs)).To(BeFalse())
+		Expect(dispatcher.TimedOut(errs)).To(BeTrue())
+		Eventually(cancellable.cancelled.Load).Should(BeTrue())
+	})
+
+	It("should not limit dispatch without a timeout", func() {
+		v := newTestValidator("validate-slow", validator.CategoryCPU, validator.Fail("slow"))
+		v.delay = 30 * time.Millisecond
+		reg.Register(v, validator.ToolTypeIs(hook.ToolTypeBash))
+
+		disp := newDispatcher(
+			dispatcher.NewSequentialExecutor(log),
+			dispatcher.WithTimeout(0, true),
+		)
+
+		errs := disp.Dispatch(context.Background(), hookCtx)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Message).To(Equal("slow"))
+	})
+})
@@ -89,6 +89,11 @@ func (*ToolChecker) Category() doctor.Category {
 	return doctor.CategoryTools
 }
 
+// Optional returns true; validators fall back when a tool is missing
+func (*ToolChecker) Optional() bool {
+	return true
+}
+
 // Check performs the tool availability check
 func (c *ToolChecker) Check(_ context.Context) doctor.CheckResult {
 	// Try to find any of the alternative tools
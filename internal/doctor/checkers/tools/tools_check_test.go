@@ -38,6 +38,10 @@ var _ = Describe("ToolChecker", func() {
 			Expect(checker.Category()).To(Equal(doctor.CategoryTools))
 		})
 
+		It("should be optional", func() {
+			Expect(doctor.RunCheck(ctx, checker).IsRequired()).To(BeFalse())
+		})
+
 		It("should perform check", func() {
 			result := checker.Check(ctx)
 			Expect(result.Name).To(Equal("shellcheck available"))
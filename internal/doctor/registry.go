@@ -81,9 +81,7 @@ func (*Registry) runCheckers(ctx context.Context, checkers []HealthChecker) []Ch
 		checker := checkers[i]
 
 		g.Go(func() error {
-			result := checker.Check(gctx)
-			result.Category = checker.Category()
-			results[i] = result
+			results[i] = RunCheck(gctx, checker)
 
 			return nil
 		})
@@ -95,6 +93,19 @@ func (*Registry) runCheckers(ctx context.Context, checkers []HealthChecker) []Ch
 	return results
 }
 
+// RunCheck runs a single health checker and fills in the result's Category
+// and Optional fields from the checker
+func RunCheck(ctx context.Context, checker HealthChecker) CheckResult {
+	result := checker.Check(ctx)
+	result.Category = checker.Category()
+
+	if optional, ok := checker.(OptionalChecker); ok {
+		result.Optional = optional.Optional()
+	}
+
+	return result
+}
+
 // GetFixer retrieves a fixer by ID.
 //
 
@@ -28,6 +28,18 @@ func (s *stubChecker) Check(_ context.Context) doctor.CheckResult {
 	return doctor.Pass(s.name, "ok")
 }
 
+// failingChecker is a HealthChecker that fails with error severity.
+type failingChecker struct {
+	stubChecker
+	optional bool
+}
+
+func (f *failingChecker) Check(_ context.Context) doctor.CheckResult {
+	return doctor.FailError(f.name, "missing")
+}
+
+func (f *failingChecker) Optional() bool { return f.optional }
+
 var _ = Describe("Registry", func() {
 	var registry *doctor.Registry
 
@@ -74,4 +86,24 @@ var _ = Describe("Registry", func() {
 			Expect(checkers).To(BeEmpty())
 		})
 	})
+
+	Describe("RunCheck", func() {
+		It("sets the category from the checker", func() {
+			result := doctor.RunCheck(context.Background(),
+				&stubChecker{name: "bin", category: doctor.CategoryBinary})
+
+			Expect(result.Category).To(Equal(doctor.CategoryBinary))
+			Expect(result.IsRequired()).To(BeTrue())
+		})
+
+		It("marks results of optional checkers", func() {
+			result := doctor.RunCheck(context.Background(), &failingChecker{
+				stubChecker: stubChecker{name: "tool", category: doctor.CategoryTools},
+				optional:    true,
+			})
+
+			Expect(result.Optional).To(BeTrue())
+			Expect(result.IsRequired()).To(BeFalse())
+		})
+	})
 })
@@ -67,10 +67,7 @@ func newDoctorModel(checkers []doctor.HealthChecker, verbose bool, theme color.T
 // runCheck returns a tea.Cmd that executes a single health check.
 func runCheck(ctx context.Context, index int, checker doctor.HealthChecker) tea.Cmd {
 	return func() tea.Msg {
-		result := checker.Check(ctx)
-		result.Category = checker.Category()
-
-		return checkDoneMsg{index: index, result: result}
+		return checkDoneMsg{index: index, result: doctor.RunCheck(ctx, checker)}
 	}
 }
 
@@ -92,9 +92,7 @@ func runCheckersBatch(ctx context.Context, checkers []doctor.HealthChecker) []do
 	results := make([]doctor.CheckResult, len(checkers))
 
 	for i, c := range checkers {
-		result := c.Check(ctx)
-		result.Category = c.Category()
-		results[i] = result
+		results[i] = doctor.RunCheck(ctx, c)
 	}
 
 	return results
@@ -0,0 +1,102 @@
+package reporters
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"slices"
+
+	"github.com/smykla-skalski/klaudiush/internal/doctor"
+)
+
+// JSON check statuses.
+const (
+	// JSONStatusOK marks a passed check.
+	JSONStatusOK = "ok"
+	// JSONStatusWarn marks a failed check with warning or info severity.
+	JSONStatusWarn = "warn"
+	// JSONStatusFail marks a failed check with error severity.
+	JSONStatusFail = "fail"
+	// JSONStatusSkipped marks a skipped check.
+	JSONStatusSkipped = "skipped"
+)
+
+// JSONCheck is the machine-readable form of a check result.
+type JSONCheck struct {
+	Name     string          `json:"name"`
+	Category doctor.Category `json:"category"`
+	Status   string          `json:"status"`
+	Detail   string          `json:"detail"`
+	Details  []string        `json:"details,omitempty"`
+	Required bool            `json:"required"`
+}
+
+// JSONReporter writes check results as a JSON array, for automation.
+type JSONReporter struct {
+	w io.Writer
+}
+
+// NewJSONReporter creates a new JSONReporter writing to w
+func NewJSONReporter(w io.Writer) *JSONReporter {
+	return &JSONReporter{w: w}
+}
+
+// Report writes the results ordered by category. Details are always
+// included, so verbose is ignored.
+func (r *JSONReporter) Report(results []doctor.CheckResult, _ bool) {
+	encoder := json.NewEncoder(r.w)
+	encoder.SetIndent("", "  ")
+
+	_ = encoder.Encode(ToJSONChecks(results))
+}
+
+// ToJSONChecks converts results to their JSON form, ordered by category.
+// Checks within a category keep their order.
+func ToJSONChecks(results []doctor.CheckResult) []JSONCheck {
+	sorted := slices.Clone(results)
+	slices.SortStableFunc(sorted, func(a, b doctor.CheckResult) int {
+		return cmp.Or(
+			cmp.Compare(categoryRank(a.Category), categoryRank(b.Category)),
+			cmp.Compare(a.Category, b.Category),
+		)
+	})
+
+	checks := make([]JSONCheck, 0, len(sorted))
+
+	for _, result := range sorted {
+		checks = append(checks, JSONCheck{
+			Name:     result.Name,
+			Category: result.Category,
+			Status:   jsonStatus(result),
+			Detail:   result.Message,
+			Details:  result.Details,
+			Required: result.IsRequired(),
+		})
+	}
+
+	return checks
+}
+
+// jsonStatus maps a result to its JSON status.
+func jsonStatus(result doctor.CheckResult) string {
+	switch {
+	case result.IsPassed():
+		return JSONStatusOK
+	case result.IsSkipped():
+		return JSONStatusSkipped
+	case result.IsError():
+		return JSONStatusFail
+	default:
+		return JSONStatusWarn
+	}
+}
+
+// categoryRank returns the display position of a category. Categories
+// without a fixed position sort last.
+func categoryRank(category doctor.Category) int {
+	if i := slices.Index(categoryOrder, category); i >= 0 {
+		return i
+	}
+
+	return len(categoryOrder)
+}
@@ -1,6 +1,8 @@
 package reporters_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"os"
 	"strings"
 	"testing"
@@ -420,3 +422,63 @@ var _ = Describe("RenderTable with verbose", func() {
 		Expect(output).To(ContainSubstring("detail one; detail two"))
 	})
 })
+
+var _ = Describe("JSONReporter", func() {
+	It("writes results as a JSON array ordered by category", func() {
+		var buf bytes.Buffer
+
+		reporters.NewJSONReporter(&buf).Report([]doctor.CheckResult{
+			{
+				Name:     "shellcheck available",
+				Category: doctor.CategoryTools,
+				Status:   doctor.StatusFail,
+				Severity: doctor.SeverityWarning,
+				Message:  "shellcheck not found",
+				Details:  []string{"Install with: brew install shellcheck"},
+				Optional: true,
+			},
+			{
+				Name:     "Binary available",
+				Category: doctor.CategoryBinary,
+				Status:   doctor.StatusPass,
+				Severity: doctor.SeverityInfo,
+				Message:  "Found klaudiush",
+			},
+		}, false)
+
+		var checks []map[string]any
+		Expect(json.Unmarshal(buf.Bytes(), &checks)).To(Succeed())
+		Expect(checks).To(HaveLen(2))
+		Expect(checks[0]).To(Equal(map[string]any{
+			"name":     "Binary available",
+			"category": "binary",
+			"status":   "ok",
+			"detail":   "Found klaudiush",
+			"required": true,
+		}))
+		Expect(checks[1]).To(HaveKeyWithValue("status", "warn"))
+		Expect(checks[1]).To(HaveKeyWithValue("required", false))
+		Expect(checks[1]).To(HaveKeyWithValue("details",
+			[]any{"Install with: brew install shellcheck"}))
+	})
+
+	DescribeTable("maps result status",
+		func(result doctor.CheckResult, expected string) {
+			Expect(reporters.ToJSONChecks([]doctor.CheckResult{result})[0].Status).
+				To(Equal(expected))
+		},
+		Entry("pass", doctor.Pass("c", "ok"), reporters.JSONStatusOK),
+		Entry("warning", doctor.FailWarning("c", "meh"), reporters.JSONStatusWarn),
+		Entry("info failure", doctor.NewCheckResult(
+			"c", doctor.SeverityInfo, doctor.StatusFail, "meh"), reporters.JSONStatusWarn),
+		Entry("error", doctor.FailError("c", "bad"), reporters.JSONStatusFail),
+		Entry("skipped", doctor.Skip("c", "n/a"), reporters.JSONStatusSkipped),
+	)
+
+	It("writes an empty array without results", func() {
+		var buf bytes.Buffer
+
+		reporters.NewJSONReporter(&buf).Report(nil, false)
+		Expect(strings.TrimSpace(buf.String())).To(Equal("[]"))
+	})
+})
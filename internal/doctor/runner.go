@@ -37,6 +37,10 @@ type RunOptions struct {
 
 	// Project checks project context
 	Project bool
+
+	// Quiet skips the fix suggestions printed after the report, so stdout
+	// holds only the reporter output
+	Quiet bool
 }
 
 // NewRunner creates a new Runner
@@ -102,6 +106,8 @@ func (r *Runner) Run(ctx context.Context, opts RunOptions) error {
 		if err := r.promptAndApplyFixes(ctx, fixableErrors); err != nil {
 			return errors.Wrap(err, "failed to apply fixes")
 		}
+	case opts.Quiet:
+		r.logger.Info("fixes available", "count", len(fixableErrors))
 	default:
 		// Just suggest fixes
 		r.logger.Info("suggesting fixes", "count", len(fixableErrors))
@@ -262,7 +268,7 @@ func (r *Runner) determineExitError(results []CheckResult) error {
 	warningCount := 0
 
 	for _, result := range results {
-		if result.IsError() {
+		if result.IsError() && result.IsRequired() {
 			hasErrors = true
 			errorCount++
 		} else if result.IsWarning() {
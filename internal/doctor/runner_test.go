@@ -83,4 +83,30 @@ var _ = Describe("Runner", func() {
 			Expect(br.reportCalled).To(BeTrue())
 		})
 	})
+
+	Describe("exit status", func() {
+		run := func(checker doctor.HealthChecker) error {
+			registry := doctor.NewRegistry()
+			registry.RegisterChecker(checker)
+
+			runner := doctor.NewRunner(registry, &mockBatchReporter{}, nil, noopLogger{})
+
+			return runner.Run(context.Background(), doctor.RunOptions{Quiet: true})
+		}
+
+		It("fails when a required check fails", func() {
+			err := run(&failingChecker{
+				stubChecker: stubChecker{name: "config", category: doctor.CategoryConfig},
+			})
+			Expect(err).To(MatchError("health checks failed"))
+		})
+
+		It("ignores failures of optional checks", func() {
+			err := run(&failingChecker{
+				stubChecker: stubChecker{name: "tool", category: doctor.CategoryTools},
+				optional:    true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
 )
 
 const (
@@ -254,6 +257,171 @@ func AddGeminiDispatcherHooks(raw map[string]any, binaryPath string, missing map
 	}
 }
 
+// claudeHookEvents are the Claude events AddClaudeHooks registers klaudiush
+// for.
+var claudeHookEvents = []string{"PreToolUse", "PostToolUse", "Notification"}
+
+// AddClaudeHooks registers klaudiush for each Claude hook event that doesn't
+// run binaryPath yet, keeping the other hooks in raw. Returns false when
+// nothing had to be added.
+func AddClaudeHooks(raw map[string]any, binaryPath string) bool {
+	hooks := ensureHooksMap(raw)
+	added := false
+
+	for _, eventName := range claudeHookEvents {
+		if eventRunsDispatcher(hooks[eventName], binaryPath, eventName) {
+			continue
+		}
+
+		// Notification events have no tool to match.
+		matcher := ""
+		if eventName != "Notification" {
+			matcher = claudeDispatcherMatcher()
+		}
+
+		hooks[eventName] = appendEventHookWithMatcher(
+			hooks[eventName],
+			ClaudeDispatcherCommand(binaryPath, eventName),
+			matcher,
+			DefaultCommandHookTimeout,
+		)
+		added = true
+	}
+
+	return added
+}
+
+// RemoveClaudeHooks removes the commands running binaryPath from the Claude
+// hook events AddClaudeHooks registers, dropping the entries and events left
+// empty. Other hooks are kept. Returns false when there was nothing to
+// remove.
+func RemoveClaudeHooks(raw map[string]any, binaryPath string) bool {
+	hooks, ok := raw["hooks"].(map[string]any)
+	if !ok {
+		return false
+	}
+
+	removed := false
+
+	for _, eventName := range claudeHookEvents {
+		entries, ok := hooks[eventName].([]any)
+		if !ok {
+			continue
+		}
+
+		kept, changed := removeDispatcherEntries(entries, binaryPath, eventName)
+		if !changed {
+			continue
+		}
+
+		removed = true
+
+		if len(kept) == 0 {
+			delete(hooks, eventName)
+		} else {
+			hooks[eventName] = kept
+		}
+	}
+
+	if removed && len(hooks) == 0 {
+		delete(raw, "hooks")
+	}
+
+	return removed
+}
+
+// removeDispatcherEntries removes the commands running binaryPath from the
+// hook entries of eventName and the entries left without commands.
+func removeDispatcherEntries(entries []any, binaryPath, eventName string) ([]any, bool) {
+	kept := make([]any, 0, len(entries))
+	changed := false
+
+	for _, entryValue := range entries {
+		entry, ok := entryValue.(map[string]any)
+		if !ok {
+			kept = append(kept, entryValue)
+
+			continue
+		}
+
+		commands, ok := entry["hooks"].([]any)
+		if !ok {
+			kept = append(kept, entryValue)
+
+			continue
+		}
+
+		keptCommands := make([]any, 0, len(commands))
+
+		for _, commandValue := range commands {
+			if isDispatcherHook(commandValue, binaryPath, eventName) {
+				changed = true
+
+				continue
+			}
+
+			keptCommands = append(keptCommands, commandValue)
+		}
+
+		if len(keptCommands) == 0 {
+			continue
+		}
+
+		entry["hooks"] = keptCommands
+		kept = append(kept, entry)
+	}
+
+	return kept, changed
+}
+
+// eventRunsDispatcher reports whether the hook entries of eventName run
+// binaryPath.
+func eventRunsDispatcher(entriesValue any, binaryPath, eventName string) bool {
+	entries, _ := entriesValue.([]any)
+
+	for _, entryValue := range entries {
+		entry, _ := entryValue.(map[string]any)
+		commands, _ := entry["hooks"].([]any)
+
+		for _, commandValue := range commands {
+			if isDispatcherHook(commandValue, binaryPath, eventName) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isDispatcherHook reports whether a raw hook command is the one
+// ClaudeDispatcherCommand builds for eventName: binaryPath, or a binary with
+// the same name, run with --hook-type eventName. Other commands that merely
+// mention klaudiush are not ours.
+func isDispatcherHook(commandValue any, binaryPath, eventName string) bool {
+	hookCmd, ok := commandValue.(map[string]any)
+	if !ok || hookCmd["type"] != commandHookType {
+		return false
+	}
+
+	command, _ := hookCmd["command"].(string)
+	if command == ClaudeDispatcherCommand(binaryPath, eventName) {
+		return true
+	}
+
+	fields := strings.Fields(command)
+	if len(fields) != dispatcherCommandFields {
+		return false
+	}
+
+	return (fields[0] == binaryPath || filepath.Base(fields[0]) == filepath.Base(binaryPath)) &&
+		fields[1] == "--hook-type" &&
+		fields[2] == eventName
+}
+
+// dispatcherCommandFields is the number of fields in a
+// ClaudeDispatcherCommand: the binary, --hook-type and the event.
+const dispatcherCommandFields = 3
+
 // ClaudeDispatcherCommand returns the Claude hook command string.
 func ClaudeDispatcherCommand(binaryPath, eventName string) string {
 	return binaryPath + " --hook-type " + eventName
@@ -352,18 +520,38 @@ func appendEventHookWithMatcher(
 }
 
 func writeRawJSONFile(path string, raw map[string]any) error {
-	data, err := json.MarshalIndent(raw, "", "  ")
+	data, err := marshalRawJSON(raw)
 	if err != nil {
-		return errors.Wrap(err, "failed to marshal settings")
+		return err
 	}
 
-	data = append(data, '\n')
-
 	return AtomicWriteFile(path, data, true)
 }
 
-// AtomicWriteFile writes data to a file atomically using a temp file and rename.
-// It creates a backup of the original file if it exists.
+// WriteRawJSONFile writes raw to path as indented JSON, atomically and
+// without a sibling backup file, for callers that back the file up
+// themselves.
+func WriteRawJSONFile(path string, raw map[string]any) error {
+	data, err := marshalRawJSON(raw)
+	if err != nil {
+		return err
+	}
+
+	return AtomicWriteFile(path, data, false)
+}
+
+func marshalRawJSON(raw map[string]any) ([]byte, error) {
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal settings")
+	}
+
+	return append(data, '\n'), nil
+}
+
+// AtomicWriteFile writes data to a file atomically with
+// fileutil.WriteFileAtomic, keeping the permissions of an existing file (0600
+// for new files). It creates a backup of the original file if it exists.
 func AtomicWriteFile(path string, data []byte, createBackup bool) error {
 	resolvedPath, err := resolveSettingsPath(path)
 	if err != nil {
@@ -389,17 +577,7 @@ func AtomicWriteFile(path string, data []byte, createBackup bool) error {
 		}
 	}
 
-	tmpFile := resolvedPath + ".tmp"
-	if err := os.WriteFile(tmpFile, data, perm); err != nil {
-		return errors.Wrap(err, "failed to write temp file")
-	}
-
-	if err := os.Rename(tmpFile, resolvedPath); err != nil {
-		_ = os.Remove(tmpFile)
-		return errors.Wrap(err, "failed to rename temp file")
-	}
-
-	return nil
+	return fileutil.WriteFileAtomic(resolvedPath, data, perm)
 }
 
 func copyFile(src, dst string) error {
@@ -57,3 +57,173 @@ var _ = Describe("copyFile", func() {
 		Expect(err.Error()).To(ContainSubstring("failed to write destination file"))
 	})
 })
+
+var _ = Describe("Claude hooks", func() {
+	const binaryPath = "/usr/local/bin/klaudiush"
+
+	eventCommands := func(raw map[string]any, eventName string) []string {
+		hooks, _ := raw["hooks"].(map[string]any)
+		entries, _ := hooks[eventName].([]any)
+
+		var commands []string
+
+		for _, entryValue := range entries {
+			entry, _ := entryValue.(map[string]any)
+			hookCmds, _ := entry["hooks"].([]any)
+
+			for _, hookValue := range hookCmds {
+				hookCmd, _ := hookValue.(map[string]any)
+				command, _ := hookCmd["command"].(string)
+				commands = append(commands, command)
+			}
+		}
+
+		return commands
+	}
+
+	Describe("AddClaudeHooks", func() {
+		It("registers PreToolUse, PostToolUse and Notification in empty settings", func() {
+			raw := map[string]any{}
+
+			Expect(settings.AddClaudeHooks(raw, binaryPath)).To(BeTrue())
+
+			for _, eventName := range []string{"PreToolUse", "PostToolUse", "Notification"} {
+				Expect(eventCommands(raw, eventName)).To(Equal([]string{
+					settings.ClaudeDispatcherCommand(binaryPath, eventName),
+				}))
+			}
+
+			hooks := raw["hooks"].(map[string]any)
+			notification := hooks["Notification"].([]any)[0].(map[string]any)
+			Expect(notification).NotTo(HaveKey("matcher"))
+		})
+
+		It("is idempotent", func() {
+			raw := map[string]any{}
+
+			Expect(settings.AddClaudeHooks(raw, binaryPath)).To(BeTrue())
+			Expect(settings.AddClaudeHooks(raw, binaryPath)).To(BeFalse())
+			Expect(eventCommands(raw, "PreToolUse")).To(HaveLen(1))
+		})
+
+		It("keeps unrelated hooks and only adds missing events", func() {
+			raw := map[string]any{
+				"model": "opus",
+				"hooks": map[string]any{
+					"PreToolUse": []any{
+						map[string]any{
+							"matcher": "Bash",
+							"hooks": []any{
+								map[string]any{"type": "command", "command": "other-hook"},
+							},
+						},
+					},
+					"PostToolUse": []any{
+						map[string]any{
+							"hooks": []any{
+								map[string]any{
+									"type":    "command",
+									"command": binaryPath + " --hook-type PostToolUse",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			Expect(settings.AddClaudeHooks(raw, binaryPath)).To(BeTrue())
+			Expect(raw).To(HaveKeyWithValue("model", "opus"))
+			Expect(eventCommands(raw, "PreToolUse")).To(Equal([]string{
+				"other-hook",
+				binaryPath + " --hook-type PreToolUse",
+			}))
+			Expect(eventCommands(raw, "PostToolUse")).To(HaveLen(1))
+			Expect(eventCommands(raw, "Notification")).To(HaveLen(1))
+		})
+	})
+
+	Describe("RemoveClaudeHooks", func() {
+		It("reverses AddClaudeHooks", func() {
+			raw := map[string]any{"model": "opus"}
+
+			settings.AddClaudeHooks(raw, binaryPath)
+
+			Expect(settings.RemoveClaudeHooks(raw, binaryPath)).To(BeTrue())
+			Expect(raw).To(Equal(map[string]any{"model": "opus"}))
+		})
+
+		It("keeps unrelated hooks in shared entries and events", func() {
+			raw := map[string]any{
+				"hooks": map[string]any{
+					"PreToolUse": []any{
+						map[string]any{
+							"matcher": "Bash",
+							"hooks": []any{
+								map[string]any{"type": "command", "command": "other-hook"},
+								map[string]any{
+									"type":    "command",
+									"command": binaryPath + " --hook-type PreToolUse",
+								},
+							},
+						},
+					},
+					"Stop": []any{
+						map[string]any{
+							"hooks": []any{
+								map[string]any{"type": "command", "command": "stop-hook"},
+							},
+						},
+					},
+				},
+			}
+
+			Expect(settings.RemoveClaudeHooks(raw, binaryPath)).To(BeTrue())
+			Expect(eventCommands(raw, "PreToolUse")).To(Equal([]string{"other-hook"}))
+			Expect(eventCommands(raw, "Stop")).To(Equal([]string{"stop-hook"}))
+		})
+
+		It("keeps foreign hooks that mention klaudiush", func() {
+			foreign := []string{
+				"klaudiush-notify --channel ops",
+				"echo klaudiush ran",
+				binaryPath + " --hook-type PostToolUse",
+			}
+			commands := make([]any, 0, len(foreign))
+
+			for _, command := range foreign {
+				commands = append(commands, map[string]any{"type": "command", "command": command})
+			}
+
+			raw := map[string]any{
+				"hooks": map[string]any{
+					"PreToolUse": []any{map[string]any{"hooks": commands}},
+				},
+			}
+
+			Expect(settings.AddClaudeHooks(raw, binaryPath)).To(BeTrue())
+			Expect(eventCommands(raw, "PreToolUse")).To(Equal(append(
+				foreign,
+				settings.ClaudeDispatcherCommand(binaryPath, "PreToolUse"),
+			)))
+
+			Expect(settings.RemoveClaudeHooks(raw, binaryPath)).To(BeTrue())
+			Expect(eventCommands(raw, "PreToolUse")).To(Equal(foreign))
+		})
+
+		It("removes the dispatcher installed under another path", func() {
+			raw := map[string]any{}
+
+			settings.AddClaudeHooks(raw, "/opt/bin/klaudiush")
+
+			Expect(settings.RemoveClaudeHooks(raw, binaryPath)).To(BeTrue())
+			Expect(raw).To(BeEmpty())
+		})
+
+		It("reports no change when klaudiush is not registered", func() {
+			raw := map[string]any{"model": "opus"}
+
+			Expect(settings.RemoveClaudeHooks(raw, binaryPath)).To(BeFalse())
+			Expect(raw).To(Equal(map[string]any{"model": "opus"}))
+		})
+	})
+})
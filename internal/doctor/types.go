@@ -73,6 +73,10 @@ type CheckResult struct {
 
 	// FixID links to a Fixer that can fix this issue, if available
 	FixID string
+
+	// Optional is set for checks of optional dependencies, whose failures
+	// never fail the doctor run. RunCheck sets it from the checker.
+	Optional bool
 }
 
 // HealthChecker performs a health check and returns a result
@@ -87,6 +91,13 @@ type HealthChecker interface {
 	Check(ctx context.Context) CheckResult
 }
 
+// OptionalChecker is implemented by health checkers for optional
+// dependencies, such as linters that only some validators use
+type OptionalChecker interface {
+	// Optional returns true if failures of this check are informational
+	Optional() bool
+}
+
 // Fixer can automatically fix issues identified by health checks
 type Fixer interface {
 	// ID returns the unique identifier for this fixer
@@ -170,6 +181,11 @@ func (r CheckResult) IsError() bool {
 	return r.Status == StatusFail && r.Severity == SeverityError
 }
 
+// IsRequired returns true if a failure of this check fails the doctor run
+func (r CheckResult) IsRequired() bool {
+	return !r.Optional
+}
+
 // IsWarning returns true if the result is a warning
 func (r CheckResult) IsWarning() bool {
 	return r.Status == StatusFail && r.Severity == SeverityWarning
@@ -82,6 +82,44 @@ func (mr *MockHealthCheckerMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockHealthChecker)(nil).Name))
 }
 
+// MockOptionalChecker is a mock of OptionalChecker interface.
+type MockOptionalChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockOptionalCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockOptionalCheckerMockRecorder is the mock recorder for MockOptionalChecker.
+type MockOptionalCheckerMockRecorder struct {
+	mock *MockOptionalChecker
+}
+
+// NewMockOptionalChecker creates a new mock instance.
+func NewMockOptionalChecker(ctrl *gomock.Controller) *MockOptionalChecker {
+	mock := &MockOptionalChecker{ctrl: ctrl}
+	mock.recorder = &MockOptionalCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOptionalChecker) EXPECT() *MockOptionalCheckerMockRecorder {
+	return m.recorder
+}
+
+// Optional mocks base method.
+func (m *MockOptionalChecker) Optional() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Optional")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Optional indicates an expected call of Optional.
+func (mr *MockOptionalCheckerMockRecorder) Optional() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Optional", reflect.TypeOf((*MockOptionalChecker)(nil).Optional))
+}
+
 // MockFixer is a mock of Fixer interface.
 type MockFixer struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,404 @@
+// Package exceptions provides the exception workflow system for klaudiush.
+package exceptions
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+	"github.com/smykla-skalski/klaudiush/internal/xdg"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+// Grant constants.
+const (
+	// GrantTokenEnvVar is the environment variable read by hook invocations
+	// for a one-time exception token.
+	GrantTokenEnvVar = "KLAUDIUSH_EXCEPTION_TOKEN"
+
+	// GrantRateLimitCode is the code grants are counted under in the rate
+	// limit state.
+	GrantRateLimitCode = "GRANT"
+
+	// DefaultGrantTTL is how long a grant stays valid when no TTL is given.
+	DefaultGrantTTL = time.Hour
+
+	// MaxGrantTTL is the longest TTL a grant may have.
+	MaxGrantTTL = 24 * time.Hour
+
+	// grantFileName is the grant store file name, kept next to the rate
+	// limit state file.
+	grantFileName = "grants.json"
+
+	// grantLockExt replaces the extension of the grant file to name the
+	// lock file held while the grants are read and rewritten.
+	grantLockExt = ".lock"
+
+	// grantSecretBytes is the number of random bytes in a grant token.
+	grantSecretBytes = 16
+)
+
+// Grant errors.
+var (
+	// ErrGrantNotFound is returned when a token does not match any grant.
+	ErrGrantNotFound = errors.New("exception token not found or already used")
+
+	// ErrGrantExpired is returned when a token matches an expired grant.
+	ErrGrantExpired = errors.New("exception token expired")
+
+	// ErrGrantCodeMismatch is returned when a token is used for an error
+	// code other than the one it was granted for.
+	ErrGrantCodeMismatch = errors.New("exception token granted for another error code")
+
+	// ErrGrantRateLimited is returned when minting a grant would exceed the
+	// exception rate limits.
+	ErrGrantRateLimited = errors.New("exception grant rate limit exceeded")
+
+	// ErrInvalidGrant is returned when a grant request is invalid.
+	ErrInvalidGrant = errors.New("invalid exception grant")
+)
+
+// Grant is a one-time exception for one error code, minted by `klaudiush
+// exception grant`. Only a hash of the token is stored; the token itself is
+// shown once at grant time.
+type Grant struct {
+	// TokenHash is the hex SHA-256 of the token.
+	TokenHash string `json:"token_hash"`
+
+	// Code is the error code (e.g., "GIT022") the grant bypasses.
+	Code string `json:"code"`
+
+	// Reason is the justification given when the grant was minted.
+	Reason string `json:"reason"`
+
+	// CreatedAt is when the grant was minted.
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt is when the grant stops being valid.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// grantFile is the on-disk format of the grant store.
+type grantFile struct {
+	Grants []*Grant `json:"grants"`
+}
+
+// GrantStore persists one-time exception grants. Grants are removed when
+// used, and expired grants are pruned on every write. Every read-modify-write
+// holds a lock on a file next to the store (grants.lock), so concurrent hook
+// processes can't consume the same token twice or drop each other's grants.
+type GrantStore struct {
+	mu     sync.Mutex
+	path   string
+	prefix string
+	logger logger.Logger
+
+	// now is a function that returns the current time.
+	// Used for testing to control time.
+	now func() time.Time
+}
+
+// GrantStoreOption configures the GrantStore.
+type GrantStoreOption func(*GrantStore)
+
+// WithGrantStoreLogger sets the logger.
+func WithGrantStoreLogger(log logger.Logger) GrantStoreOption {
+	return func(s *GrantStore) {
+		if log != nil {
+			s.logger = log
+		}
+	}
+}
+
+// WithGrantTokenPrefix sets the token prefix (default: "EXC").
+func WithGrantTokenPrefix(prefix string) GrantStoreOption {
+	return func(s *GrantStore) {
+		if prefix != "" {
+			s.prefix = prefix
+		}
+	}
+}
+
+// WithGrantTimeFunc sets a custom time function for testing.
+func WithGrantTimeFunc(fn func() time.Time) GrantStoreOption {
+	return func(s *GrantStore) {
+		if fn != nil {
+			s.now = fn
+		}
+	}
+}
+
+// NewGrantStore creates a grant store backed by the file at path.
+func NewGrantStore(path string, opts ...GrantStoreOption) *GrantStore {
+	s := &GrantStore{
+		path:   xdg.ExpandPathSilent(path),
+		prefix: DefaultTokenPrefix,
+		logger: logger.NewNoOpLogger(),
+		now:    time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// GrantFile returns the grant store path, next to the rate limit state file.
+func GrantFile(rateCfg *config.ExceptionRateLimitConfig) string {
+	stateFile := xdg.ExpandPathSilent(rateCfg.GetStateFile())
+
+	return filepath.Join(filepath.Dir(stateFile), grantFileName)
+}
+
+// Create mints a grant for the error code valid for ttl and returns its
+// token. A zero ttl uses DefaultGrantTTL.
+func (s *GrantStore) Create(code, reason string, ttl time.Duration) (string, *Grant, error) {
+	code = normalizeGrantCode(code)
+	if code == "" {
+		return "", nil, errors.Wrap(ErrInvalidGrant, "an error code is required")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", nil, errors.Wrap(ErrInvalidGrant, "a reason is required")
+	}
+
+	if ttl == 0 {
+		ttl = DefaultGrantTTL
+	}
+
+	if ttl < 0 || ttl > MaxGrantTTL {
+		return "", nil, errors.Wrapf(
+			ErrInvalidGrant,
+			"ttl must be between 0 and %s, got %s",
+			MaxGrantTTL,
+			ttl,
+		)
+	}
+
+	secret := make([]byte, grantSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, errors.Wrap(err, "generating exception token")
+	}
+
+	token := s.prefix + "-" + hex.EncodeToString(secret)
+	now := s.now()
+
+	grant := &Grant{
+		TokenHash: hashGrantToken(token),
+		Code:      code,
+		Reason:    reason,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	unlock, err := s.lock()
+	if err != nil {
+		return "", nil, err
+	}
+	defer unlock()
+
+	grants, err := s.loadLocked()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := s.saveLocked(append(grants, grant)); err != nil {
+		return "", nil, err
+	}
+
+	s.logger.Debug("exception grant created", "expires_at", grant.ExpiresAt)
+
+	return token, grant, nil
+}
+
+// Lookup returns the grant for token without using it up. Returns
+// ErrGrantNotFound for unknown or already used tokens and ErrGrantExpired
+// for expired ones.
+func (s *GrantStore) Lookup(token string) (*Grant, error) {
+	unlock, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	grants, err := s.loadLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	i := findGrant(grants, token)
+	if i < 0 {
+		return nil, ErrGrantNotFound
+	}
+
+	return grants[i], s.checkExpiry(grants[i])
+}
+
+// Consume looks up the grant for token and removes it, so each token works
+// once. Returns ErrGrantNotFound for unknown or already used tokens,
+// ErrGrantExpired for expired ones and ErrGrantCodeMismatch, leaving the
+// grant in place, when it was granted for an error code other than code.
+func (s *GrantStore) Consume(token, code string) (*Grant, error) {
+	unlock, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	grants, err := s.loadLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	i := findGrant(grants, token)
+	if i < 0 {
+		return nil, ErrGrantNotFound
+	}
+
+	grant := grants[i]
+
+	if err := s.checkExpiry(grant); err != nil {
+		// Pruned by the save
+		if saveErr := s.saveLocked(grants); saveErr != nil {
+			return nil, saveErr
+		}
+
+		return nil, err
+	}
+
+	if grant.Code != normalizeGrantCode(code) {
+		return nil, errors.Wrapf(ErrGrantCodeMismatch, "granted for %s", grant.Code)
+	}
+
+	remaining := append(grants[:i:i], grants[i+1:]...)
+	if err := s.saveLocked(remaining); err != nil {
+		return nil, err
+	}
+
+	return grant, nil
+}
+
+// checkExpiry returns ErrGrantExpired when grant is no longer valid.
+func (s *GrantStore) checkExpiry(grant *Grant) error {
+	if s.now().Before(grant.ExpiresAt) {
+		return nil
+	}
+
+	return errors.Wrapf(
+		ErrGrantExpired,
+		"expired at %s",
+		grant.ExpiresAt.Format(time.RFC3339),
+	)
+}
+
+// findGrant returns the index of the grant for token, or -1.
+func findGrant(grants []*Grant, token string) int {
+	hash := hashGrantToken(strings.TrimSpace(token))
+
+	for i, grant := range grants {
+		if subtle.ConstantTimeCompare([]byte(grant.TokenHash), []byte(hash)) == 1 {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// normalizeGrantCode returns code trimmed and upper-cased, the form error
+// codes are compared in.
+func normalizeGrantCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// lock takes the in-process mutex and the file lock guarding the grant file,
+// and returns a function releasing both.
+func (s *GrantStore) lock() (func(), error) {
+	s.mu.Lock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), stateDirPermissions); err != nil {
+		s.mu.Unlock()
+
+		return nil, errors.Wrap(err, "creating grant directory")
+	}
+
+	lockPath := strings.TrimSuffix(s.path, filepath.Ext(s.path)) + grantLockExt
+
+	fileLock, err := fileutil.LockFile(lockPath)
+	if err != nil {
+		s.mu.Unlock()
+
+		return nil, errors.Wrap(err, "locking grant file")
+	}
+
+	return func() {
+		if err := fileLock.Unlock(); err != nil {
+			s.logger.Debug("failed to unlock grant file", "error", err)
+		}
+
+		s.mu.Unlock()
+	}, nil
+}
+
+// loadLocked reads the grants from disk. A missing file means no grants.
+func (s *GrantStore) loadLocked() ([]*Grant, error) {
+	// Path comes from trusted configuration, not user input.
+	data, err := os.ReadFile(s.path) //nolint:gosec // G304: path is from config
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "reading grant file")
+	}
+
+	var file grantFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, errors.Wrap(err, "parsing grant file")
+	}
+
+	return file.Grants, nil
+}
+
+// saveLocked writes the grants to disk, dropping expired ones. Grants whose
+// token is being consumed are removed by the caller before saving.
+func (s *GrantStore) saveLocked(grants []*Grant) error {
+	now := s.now()
+	live := make([]*Grant, 0, len(grants))
+
+	for _, grant := range grants {
+		if now.Before(grant.ExpiresAt) {
+			live = append(live, grant)
+		}
+	}
+
+	data, err := json.MarshalIndent(&grantFile{Grants: live}, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshaling grants")
+	}
+
+	if err := fileutil.WriteFileAtomic(s.path, data, stateFilePermissions); err != nil {
+		return errors.Wrap(err, "writing grant file")
+	}
+
+	return nil
+}
+
+// hashGrantToken returns the hex SHA-256 of a grant token.
+func hashGrantToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,324 @@
+package exceptions_test
+
+import (
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/exceptions"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+var _ = Describe("GrantStore", func() {
+	var (
+		store *exceptions.GrantStore
+		path  string
+		now   time.Time
+	)
+
+	newStore := func() *exceptions.GrantStore {
+		return exceptions.NewGrantStore(
+			path,
+			exceptions.WithGrantTimeFunc(func() time.Time { return now }),
+		)
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+		path = filepath.Join(GinkgoT().TempDir(), "grants.json")
+		store = newStore()
+	})
+
+	It("mints a prefixed token with the given ttl", func() {
+		token, grant, err := store.Create("GIT019", "hotfix", 30*time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(MatchRegexp(`^EXC-[0-9a-f]{32}$`))
+		Expect(grant.Code).To(Equal("GIT019"))
+		Expect(grant.Reason).To(Equal("hotfix"))
+		Expect(grant.ExpiresAt).To(Equal(now.Add(30 * time.Minute)))
+		Expect(grant.TokenHash).NotTo(ContainSubstring(token[4:]))
+	})
+
+	It("defaults the ttl to one hour", func() {
+		_, grant, err := store.Create("GIT019", "hotfix", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(grant.ExpiresAt).To(Equal(now.Add(exceptions.DefaultGrantTTL)))
+	})
+
+	It("rejects a missing code or reason and an out-of-range ttl", func() {
+		_, _, err := store.Create("", "hotfix", time.Hour)
+		Expect(err).To(MatchError(exceptions.ErrInvalidGrant))
+
+		_, _, err = store.Create("GIT019", "  ", time.Hour)
+		Expect(err).To(MatchError(exceptions.ErrInvalidGrant))
+
+		_, _, err = store.Create("GIT019", "hotfix", 48*time.Hour)
+		Expect(err).To(MatchError(exceptions.ErrInvalidGrant))
+	})
+
+	It("consumes a valid token exactly once", func() {
+		token, _, err := store.Create("GIT019", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		grant, err := store.Consume(token, "GIT019")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(grant.Reason).To(Equal("hotfix"))
+
+		_, err = store.Consume(token, "GIT019")
+		Expect(err).To(MatchError(exceptions.ErrGrantNotFound))
+	})
+
+	It("keeps a token used for another error code", func() {
+		token, _, err := store.Create("git019", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = store.Consume(token, "GIT022")
+		Expect(err).To(MatchError(exceptions.ErrGrantCodeMismatch))
+
+		grant, err := store.Lookup(token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(grant.Code).To(Equal("GIT019"))
+
+		_, err = store.Consume(token, "GIT019")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("lets only one of several stores consume the same token", func() {
+		token, _, err := store.Create("GIT019", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		const consumers = 8
+
+		var (
+			wg       sync.WaitGroup
+			consumed atomic.Int32
+			notFound atomic.Int32
+		)
+
+		for range consumers {
+			// Separate stores share no mutex, like separate hook processes.
+			other := newStore()
+
+			wg.Go(func() {
+				defer GinkgoRecover()
+
+				_, err := other.Consume(token, "GIT019")
+				if err == nil {
+					consumed.Add(1)
+
+					return
+				}
+
+				Expect(err).To(MatchError(exceptions.ErrGrantNotFound))
+				notFound.Add(1)
+			})
+		}
+
+		wg.Wait()
+
+		Expect(consumed.Load()).To(Equal(int32(1)))
+		Expect(notFound.Load()).To(Equal(int32(consumers - 1)))
+		Expect(filepath.Join(filepath.Dir(path), "grants.lock")).To(BeAnExistingFile())
+	})
+
+	It("keeps grants created concurrently by several stores", func() {
+		const creators = 8
+
+		tokens := make([]string, creators)
+
+		var wg sync.WaitGroup
+
+		for i := range creators {
+			other := newStore()
+
+			wg.Go(func() {
+				defer GinkgoRecover()
+
+				token, _, err := other.Create("GIT019", "hotfix", time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+
+				tokens[i] = token
+			})
+		}
+
+		wg.Wait()
+
+		for _, token := range tokens {
+			_, err := store.Consume(token, "GIT019")
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("rejects an expired token", func() {
+		token, _, err := store.Create("GIT019", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(time.Hour)
+
+		_, err = store.Consume(token, "GIT019")
+		Expect(err).To(MatchError(exceptions.ErrGrantExpired))
+	})
+
+	It("rejects an unknown token", func() {
+		_, err := store.Consume("EXC-deadbeef", "GIT019")
+		Expect(err).To(MatchError(exceptions.ErrGrantNotFound))
+	})
+})
+
+var _ = Describe("Handler grants", func() {
+	var (
+		tempDir string
+		cfg     *config.ExceptionsConfig
+	)
+
+	request := func(code string) *exceptions.CheckRequest {
+		return &exceptions.CheckRequest{
+			HookContext: &hook.Context{
+				ToolInput: hook.ToolInput{Command: "git push origin main"},
+			},
+			ValidatorName: "git.push",
+			ErrorCode:     code,
+		}
+	}
+
+	newHandler := func(opts ...exceptions.HandlerOption) *exceptions.Handler {
+		return exceptions.NewHandler(cfg, opts...)
+	}
+
+	BeforeEach(func() {
+		tempDir = GinkgoT().TempDir()
+		maxHour := 1
+		cfg = &config.ExceptionsConfig{
+			RateLimit: &config.ExceptionRateLimitConfig{
+				MaxPerHour: &maxHour,
+				StateFile:  filepath.Join(tempDir, "state.json"),
+			},
+			Audit: &config.ExceptionAuditConfig{
+				LogFile: filepath.Join(tempDir, "audit.jsonl"),
+			},
+		}
+	})
+
+	It("stores grants next to the rate limit state file", func() {
+		Expect(exceptions.GrantFile(cfg.RateLimit)).
+			To(Equal(filepath.Join(tempDir, "grants.json")))
+	})
+
+	It("bypasses the granted code for one invocation and records the use", func() {
+		token, _, err := newHandler().Grant("GIT019", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		handler := newHandler(exceptions.WithGrantToken(token))
+
+		first := handler.Check(request("GIT019"))
+		Expect(first.Bypassed).To(BeTrue())
+		Expect(first.TokenReason).To(Equal("hotfix"))
+
+		Expect(handler.Check(request("GIT019")).Bypassed).To(BeTrue())
+
+		entries, err := exceptions.NewAuditLogger(cfg.Audit).Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Source).To(Equal("grant"))
+		Expect(entries[0].Allowed).To(BeTrue())
+		Expect(entries[0].Reason).To(Equal("hotfix"))
+	})
+
+	It("does not bypass other codes and keeps the grant for its own", func() {
+		token, _, err := newHandler().Grant("GIT019", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		handler := newHandler(exceptions.WithGrantToken(token))
+
+		Expect(handler.Check(request("GIT022")).Bypassed).To(BeFalse())
+		Expect(handler.Check(request("GIT019")).Bypassed).To(BeTrue())
+		Expect(handler.Check(request("GIT022")).Bypassed).To(BeFalse())
+	})
+
+	It("still blocks a code whose policy disallows exceptions", func() {
+		token, _, err := newHandler().Grant("GIT019", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		disallowed := false
+		cfg.Policies = map[string]*config.ExceptionPolicyConfig{
+			"GIT019": {AllowException: &disallowed},
+		}
+
+		handler := newHandler(exceptions.WithGrantToken(token))
+		Expect(handler.Check(request("GIT019")).Bypassed).To(BeFalse())
+
+		entries, err := exceptions.NewAuditLogger(cfg.Audit).Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries[len(entries)-1].Allowed).To(BeFalse())
+		Expect(entries[len(entries)-1].DenialReason).To(ContainSubstring("not allowed"))
+	})
+
+	It("refuses to grant a code whose policy disallows exceptions", func() {
+		disallowed := false
+		cfg.Policies = map[string]*config.ExceptionPolicyConfig{
+			"GIT022": {AllowException: &disallowed},
+		}
+
+		_, _, err := newHandler().Grant("GIT022", "hotfix", time.Hour)
+		Expect(err).To(MatchError(exceptions.ErrInvalidGrant))
+		Expect(err.Error()).To(ContainSubstring("exceptions not allowed for GIT022"))
+	})
+
+	It("does not bypass a later invocation with the same token", func() {
+		token, _, err := newHandler().Grant("GIT019", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(newHandler(exceptions.WithGrantToken(token)).Check(request("GIT019")).Bypassed).
+			To(BeTrue())
+
+		resp := newHandler(exceptions.WithGrantToken(token)).Check(request("GIT019"))
+		Expect(resp.Bypassed).To(BeFalse())
+
+		entries, err := exceptions.NewAuditLogger(cfg.Audit).Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries[len(entries)-1].Allowed).To(BeFalse())
+		Expect(entries[len(entries)-1].DenialReason).To(ContainSubstring("already used"))
+	})
+
+	It("does not bypass with an expired token", func() {
+		now := time.Now()
+		store := exceptions.NewGrantStore(
+			exceptions.GrantFile(cfg.RateLimit),
+			exceptions.WithGrantTimeFunc(func() time.Time { return now }),
+		)
+
+		token, _, err := store.Create("GIT019", "hotfix", time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(2 * time.Minute)
+
+		handler := newHandler(
+			exceptions.WithGrantToken(token),
+			exceptions.WithGrantStore(store),
+		)
+		Expect(handler.Check(request("GIT019")).Bypassed).To(BeFalse())
+	})
+
+	It("rejects grants over the rate limit", func() {
+		handler := newHandler()
+
+		_, _, err := handler.Grant("GIT019", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, _, err = handler.Grant("GIT019", "another hotfix", time.Hour)
+		Expect(err).To(MatchError(exceptions.ErrGrantRateLimited))
+	})
+
+	It("refuses to grant when exceptions are disabled", func() {
+		disabled := false
+		cfg.Enabled = &disabled
+
+		_, _, err := newHandler().Grant("GIT019", "hotfix", time.Hour)
+		Expect(err).To(MatchError(exceptions.ErrInvalidGrant))
+	})
+})
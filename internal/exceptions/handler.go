@@ -5,6 +5,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
 
 	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
@@ -18,9 +22,25 @@ type Handler struct {
 	engine      *Engine
 	rateLimiter *RateLimiter
 	auditLogger *AuditLogger
+	grantStore  *GrantStore
 	config      *config.ExceptionsConfig
 	logger      logger.Logger
 	projectDir  string
+
+	// grantToken is the one-time token for this invocation, if any.
+	grantToken string
+
+	// grantMu guards activeGrant and grantRejected.
+	grantMu sync.Mutex
+
+	// activeGrant is the grant consumed by this invocation. Once set, every
+	// later check for its error code in the same invocation is bypassed
+	// under it.
+	activeGrant *Grant
+
+	// grantRejected records that the grant token is unknown, used or
+	// expired, so it isn't looked up again.
+	grantRejected bool
 }
 
 // HandlerOption configures the Handler.
@@ -71,6 +91,25 @@ func WithAuditLogger(a *AuditLogger) HandlerOption {
 	}
 }
 
+// WithGrantStore sets a custom grant store.
+func WithGrantStore(s *GrantStore) HandlerOption {
+	return func(h *Handler) {
+		if s != nil {
+			h.grantStore = s
+		}
+	}
+}
+
+// WithGrantToken sets the one-time token minted by `klaudiush exception
+// grant`, usually read from KLAUDIUSH_EXCEPTION_TOKEN. The token is consumed
+// by the first blocking error with the granted code and bypasses every
+// blocking error with that code in the same invocation.
+func WithGrantToken(token string) HandlerOption {
+	return func(h *Handler) {
+		h.grantToken = strings.TrimSpace(token)
+	}
+}
+
 // NewHandler creates a new exception handler.
 func NewHandler(cfg *config.ExceptionsConfig, opts ...HandlerOption) *Handler {
 	log := logger.NewNoOpLogger()
@@ -113,6 +152,18 @@ func NewHandler(cfg *config.ExceptionsConfig, opts ...HandlerOption) *Handler {
 		h.auditLogger = NewAuditLogger(auditCfg, WithAuditLoggerLogger(h.logger))
 	}
 
+	if h.grantStore == nil {
+		var rateCfg *config.ExceptionRateLimitConfig
+		if cfg != nil {
+			rateCfg = cfg.RateLimit
+		}
+
+		h.grantStore = NewGrantStore(GrantFile(rateCfg),
+			WithGrantStoreLogger(h.logger),
+			WithGrantTokenPrefix(cfg.GetTokenPrefix()),
+		)
+	}
+
 	return h
 }
 
@@ -157,6 +208,12 @@ func (h *Handler) Check(req *CheckRequest) *CheckResponse {
 		return resp
 	}
 
+	// A one-time grant bypasses the error regardless of the command, when
+	// the policy for the error code allows exceptions
+	if resp := h.checkGrant(req); resp != nil {
+		return resp
+	}
+
 	// Get command from hook context
 	command := h.getCommand(req.HookContext)
 	if command == "" {
@@ -277,6 +334,176 @@ func (h *Handler) handleAllowedExeption(
 	}
 }
 
+// Grant mints a one-time exception token for the error code valid for ttl.
+// The policy for the code must allow exceptions with reason. Grants count
+// against the global rate limits under GrantRateLimitCode; call LoadState
+// before and SaveState after to persist the usage.
+func (h *Handler) Grant(code, reason string, ttl time.Duration) (string, *Grant, error) {
+	if !h.IsEnabled() {
+		return "", nil, errors.Wrap(ErrInvalidGrant, "exception system is disabled")
+	}
+
+	code = normalizeGrantCode(code)
+	if code == "" {
+		return "", nil, errors.Wrap(ErrInvalidGrant, "an error code is required")
+	}
+
+	if decision := h.grantPolicy(code, reason); !decision.Allowed {
+		return "", nil, errors.Wrap(ErrInvalidGrant, decision.Reason)
+	}
+
+	if result := h.rateLimiter.Check(GrantRateLimitCode); !result.Allowed {
+		return "", nil, errors.Wrap(ErrGrantRateLimited, result.Reason)
+	}
+
+	token, grant, err := h.grantStore.Create(code, reason, ttl)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := h.rateLimiter.Record(GrantRateLimitCode); err != nil {
+		h.logger.Error("failed to record exception grant", "error", err.Error())
+	}
+
+	h.logger.Info("exception grant created",
+		"code", grant.Code,
+		"reason", grant.Reason,
+		"expires_at", grant.ExpiresAt,
+	)
+
+	return token, grant, nil
+}
+
+// checkGrant bypasses the error when the invocation carries a valid grant
+// token for its error code and the policy for the code allows exceptions.
+// The grant is consumed by the first such error and then covers the errors
+// with the same code in the rest of the invocation. Returns nil when no
+// grant applies.
+func (h *Handler) checkGrant(req *CheckRequest) *CheckResponse {
+	if h.grantToken == "" || req.ErrorCode == "" {
+		return nil
+	}
+
+	h.grantMu.Lock()
+	defer h.grantMu.Unlock()
+
+	grant := h.activeGrant
+
+	if grant == nil {
+		if h.grantRejected {
+			return nil
+		}
+
+		var ok bool
+
+		grant, ok = h.consumeGrant(req)
+		if !ok {
+			return nil
+		}
+
+		h.activeGrant = grant
+	}
+
+	if grant.Code != normalizeGrantCode(req.ErrorCode) {
+		return nil
+	}
+
+	h.logAuditEntry(h.grantAuditEntry(req, grant.Reason, ""), "grant")
+
+	h.logger.Info("exception allowed by grant",
+		"error_code", req.ErrorCode,
+		"validator", req.ValidatorName,
+		"reason", grant.Reason,
+	)
+
+	return &CheckResponse{
+		Bypassed:    true,
+		Reason:      "exception granted",
+		ErrorCode:   req.ErrorCode,
+		TokenReason: grant.Reason,
+	}
+}
+
+// consumeGrant uses up the grant token for the error of req. The token is
+// left unused when it was granted for another code or the policy for the
+// code no longer allows exceptions, and is rejected for good when it is
+// unknown, used or expired. Must be called with grantMu held.
+func (h *Handler) consumeGrant(req *CheckRequest) (*Grant, bool) {
+	grant, err := h.grantStore.Lookup(h.grantToken)
+	if err != nil {
+		h.rejectGrant(req, err)
+
+		return nil, false
+	}
+
+	if grant.Code != normalizeGrantCode(req.ErrorCode) {
+		h.logger.Debug("exception grant is for another error code",
+			"error_code", req.ErrorCode,
+			"grant_code", grant.Code,
+		)
+
+		return nil, false
+	}
+
+	if decision := h.grantPolicy(req.ErrorCode, grant.Reason); !decision.Allowed {
+		h.logger.Info("exception grant denied by policy",
+			"error_code", req.ErrorCode,
+			"reason", decision.Reason,
+		)
+		h.logAuditEntry(h.grantAuditEntry(req, grant.Reason, decision.Reason), "denied grant")
+
+		return nil, false
+	}
+
+	grant, err = h.grantStore.Consume(h.grantToken, req.ErrorCode)
+	if err != nil {
+		h.rejectGrant(req, err)
+
+		return nil, false
+	}
+
+	return grant, true
+}
+
+// rejectGrant records that the grant token can't be used. Must be called
+// with grantMu held.
+func (h *Handler) rejectGrant(req *CheckRequest, err error) {
+	h.grantRejected = true
+
+	h.logger.Info("exception grant rejected", "error", err.Error())
+	h.logAuditEntry(h.grantAuditEntry(req, "", err.Error()), "rejected grant")
+}
+
+// grantPolicy evaluates the policy for code against a grant with reason,
+// the same way as for an exception token.
+func (h *Handler) grantPolicy(code, reason string) *PolicyDecision {
+	return h.engine.matcher.Match(&ExceptionRequest{
+		Token: &Token{
+			ErrorCode: normalizeGrantCode(code),
+			Reason:    reason,
+		},
+		Source:    TokenSourceGrant,
+		ErrorCode: normalizeGrantCode(code),
+	})
+}
+
+// grantAuditEntry builds the audit entry for a grant use. An empty
+// denialReason marks the use as allowed.
+func (h *Handler) grantAuditEntry(req *CheckRequest, reason, denialReason string) *AuditEntry {
+	return &AuditEntry{
+		Timestamp:     time.Now(),
+		ErrorCode:     req.ErrorCode,
+		ValidatorName: req.ValidatorName,
+		Allowed:       denialReason == "",
+		Reason:        reason,
+		DenialReason:  denialReason,
+		Source:        TokenSourceGrant.String(),
+		Command:       truncateCommand(h.getCommand(req.HookContext)),
+		WorkingDir:    h.getWorkingDir(),
+		Repository:    h.getRepository(req.HookContext),
+	}
+}
+
 // logAuditEntry logs an audit entry with error handling.
 func (h *Handler) logAuditEntry(entry *AuditEntry, context string) {
 	if entry == nil {
@@ -44,6 +44,10 @@ const (
 	// TokenSourceEnvVar indicates the token was found in an environment variable.
 	// Example: KLACK="EXC:SEC001:Test+fixture" git commit -sS -m "msg"
 	TokenSourceEnvVar
+
+	// TokenSourceGrant indicates a one-time token minted by
+	// `klaudiush exception grant` and passed via KLAUDIUSH_EXCEPTION_TOKEN.
+	TokenSourceGrant
 )
 
 // String returns a string representation of the token source.
@@ -53,6 +57,8 @@ func (s TokenSource) String() string {
 		return "comment"
 	case TokenSourceEnvVar:
 		return "env_var"
+	case TokenSourceGrant:
+		return "grant"
 	default:
 		return "unknown"
 	}
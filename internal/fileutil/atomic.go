@@ -0,0 +1,122 @@
+package fileutil
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// tempFileSuffix marks the temp files WriteFileAtomic renames into place.
+const tempFileSuffix = ".tmp"
+
+// renameFile is os.Rename, replaceable in tests to simulate a process killed
+// before the rename.
+var renameFile = os.Rename
+
+// WriteFileAtomic writes data to path so that a reader sees either the old or
+// the new content, never a truncated file, even when the process is killed
+// mid-write. The data is written and fsynced to a temp file in the same
+// directory, which is then renamed over path. A symlink at path is followed,
+// so the file it points to is replaced.
+func WriteFileAtomic(path string, data []byte, perm fs.FileMode) error {
+	target, err := resolveWriteTarget(path)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(target)
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(target)+".*"+tempFileSuffix)
+	if err != nil {
+		return errors.Wrap(err, "failed to create temp file")
+	}
+
+	tmpPath := tmp.Name()
+	renamed := false
+
+	defer func() {
+		if !renamed {
+			_ = os.Remove(tmpPath)
+		}
+	}()
+
+	if err := writeAndSync(tmp, data, perm); err != nil {
+		return err
+	}
+
+	if err := renameFile(tmpPath, target); err != nil {
+		return errors.Wrap(err, "failed to rename temp file")
+	}
+
+	renamed = true
+
+	syncDir(dir)
+
+	return nil
+}
+
+// writeAndSync writes data to f, sets its permissions, flushes it to disk and
+// closes it.
+func writeAndSync(f *os.File, data []byte, perm fs.FileMode) error {
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+
+		return errors.Wrap(err, "failed to write temp file")
+	}
+
+	if err := f.Chmod(perm); err != nil {
+		_ = f.Close()
+
+		return errors.Wrap(err, "failed to set temp file permissions")
+	}
+
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+
+		return errors.Wrap(err, "failed to sync temp file")
+	}
+
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "failed to close temp file")
+	}
+
+	return nil
+}
+
+// resolveWriteTarget returns the file a write to path replaces: path itself,
+// or the file a symlink at path points to.
+func resolveWriteTarget(path string) (string, error) {
+	info, err := os.Lstat(path)
+	if err != nil || info.Mode()&fs.ModeSymlink == 0 {
+		return path, nil
+	}
+
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve symlink %s", path)
+	}
+
+	return target, nil
+}
+
+// syncDir flushes the directory entry of a rename to disk. Failures are
+// ignored: not every platform supports syncing a directory, and the rename
+// itself has already happened.
+func syncDir(dir string) {
+	d, err := os.Open(dir) // #nosec G304 - dir is the parent of a file being written
+	if err != nil {
+		return
+	}
+
+	_ = d.Sync()
+	_ = d.Close()
+}
+
+// IsTempFile reports whether name is a temp file left by an interrupted
+// WriteFileAtomic.
+func IsTempFile(name string) bool {
+	return strings.HasPrefix(name, ".") && strings.HasSuffix(name, tempFileSuffix)
+}
@@ -0,0 +1,102 @@
+package fileutil_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+)
+
+var _ = Describe("WriteFileAtomic", func() {
+	var (
+		tmpDir string
+		path   string
+	)
+
+	BeforeEach(func() {
+		tmpDir = GinkgoT().TempDir()
+		path = filepath.Join(tmpDir, "config.toml")
+		Expect(os.WriteFile(path, []byte("original"), 0o644)).To(Succeed())
+	})
+
+	// tempFiles returns the temp files left next to path.
+	tempFiles := func() []string {
+		matches, err := filepath.Glob(filepath.Join(tmpDir, ".config.toml.*.tmp"))
+		Expect(err).NotTo(HaveOccurred())
+
+		return matches
+	}
+
+	It("replaces the content and permissions", func() {
+		Expect(fileutil.WriteFileAtomic(path, []byte("updated"), 0o600)).To(Succeed())
+
+		content, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("updated"))
+
+		info, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+		Expect(tempFiles()).To(BeEmpty())
+	})
+
+	It("creates missing files", func() {
+		newPath := filepath.Join(tmpDir, "new.toml")
+
+		Expect(fileutil.WriteFileAtomic(newPath, []byte("new"), 0o600)).To(Succeed())
+
+		content, err := os.ReadFile(newPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("new"))
+	})
+
+	It("replaces the target of a symlink", func() {
+		link := filepath.Join(tmpDir, "link.toml")
+		Expect(os.Symlink(path, link)).To(Succeed())
+
+		Expect(fileutil.WriteFileAtomic(link, []byte("updated"), 0o600)).To(Succeed())
+
+		info, err := os.Lstat(link)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode() & os.ModeSymlink).NotTo(BeZero())
+
+		content, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("updated"))
+	})
+
+	Context("when the write is interrupted before the rename", func() {
+		It("keeps the original and removes the temp file on failure", func() {
+			restore := fileutil.SetRenameFileForTest(func(_, _ string) error {
+				return errors.New("interrupted")
+			})
+			defer restore()
+
+			Expect(fileutil.WriteFileAtomic(path, []byte("updated"), 0o600)).
+				To(MatchError(ContainSubstring("interrupted")))
+
+			content, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("original"))
+			Expect(tempFiles()).To(BeEmpty())
+		})
+
+		It("keeps the original when the process is killed", func() {
+			// A killed process never renames and never cleans up, leaving
+			// the fully written temp file behind.
+			restore := fileutil.SetRenameFileForTest(func(_, _ string) error { return nil })
+			defer restore()
+
+			Expect(fileutil.WriteFileAtomic(path, []byte("updated"), 0o600)).To(Succeed())
+
+			content, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("original"))
+			Expect(tempFiles()).To(HaveLen(1))
+		})
+	})
+})
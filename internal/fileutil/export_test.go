@@ -0,0 +1,10 @@
+package fileutil
+
+// SetRenameFileForTest replaces the rename used by WriteFileAtomic and
+// returns a function restoring the original.
+func SetRenameFileForTest(fn func(oldpath, newpath string) error) func() {
+	orig := renameFile
+	renameFile = fn
+
+	return func() { renameFile = orig }
+}
@@ -0,0 +1,49 @@
+// Package fileutil provides file helpers shared by packages that keep state
+// on disk and may run in several hook processes at once: atomic writes and
+// exclusive file locks.
+package fileutil
+
+import (
+	"os"
+
+	"github.com/cockroachdb/errors"
+)
+
+// lockFilePermissions is the mode of newly created lock files.
+const lockFilePermissions = 0o600
+
+// Lock is an exclusive advisory lock held on a lock file. Other processes
+// (and other Lock values in the same process) calling LockFile on the same
+// path block until it is released.
+type Lock struct {
+	file *os.File
+}
+
+// LockFile creates the lock file at path if needed and blocks until an
+// exclusive lock on it is held. The lock file itself stays in place.
+func LockFile(path string) (*Lock, error) {
+	//nolint:gosec // path is a lock file next to state the caller owns
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, lockFilePermissions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening lock file %s", path)
+	}
+
+	if err := lockFile(file); err != nil {
+		_ = file.Close()
+
+		return nil, errors.Wrapf(err, "locking %s", path)
+	}
+
+	return &Lock{file: file}, nil
+}
+
+// Unlock releases the lock and closes the lock file.
+func (l *Lock) Unlock() error {
+	if err := unlockFile(l.file); err != nil {
+		_ = l.file.Close()
+
+		return errors.Wrap(err, "unlocking lock file")
+	}
+
+	return l.file.Close()
+}
@@ -0,0 +1,15 @@
+//go:build !unix && !windows
+
+package fileutil
+
+import "os"
+
+// Platforms without advisory locks fall back to in-process serialization
+// done by the callers.
+func lockFile(*os.File) error {
+	return nil
+}
+
+func unlockFile(*os.File) error {
+	return nil
+}
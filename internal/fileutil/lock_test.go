@@ -0,0 +1,56 @@
+package fileutil_test
+
+import (
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+)
+
+var _ = Describe("LockFile", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "state.lock")
+	})
+
+	It("creates the lock file", func() {
+		lock, err := fileutil.LockFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(BeAnExistingFile())
+		Expect(lock.Unlock()).To(Succeed())
+		Expect(path).To(BeAnExistingFile())
+	})
+
+	It("blocks a second holder until the first unlocks", func() {
+		first, err := fileutil.LockFile(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		acquired := make(chan *fileutil.Lock)
+
+		go func() {
+			defer GinkgoRecover()
+
+			second, err := fileutil.LockFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			acquired <- second
+		}()
+
+		Consistently(acquired, 100*time.Millisecond).ShouldNot(Receive())
+		Expect(first.Unlock()).To(Succeed())
+
+		var second *fileutil.Lock
+
+		Eventually(acquired).Should(Receive(&second))
+		Expect(second.Unlock()).To(Succeed())
+	})
+
+	It("fails when the directory does not exist", func() {
+		_, err := fileutil.LockFile(filepath.Join(path, "missing", "state.lock"))
+		Expect(err).To(MatchError(ContainSubstring("opening lock file")))
+	})
+})
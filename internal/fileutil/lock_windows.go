@@ -0,0 +1,28 @@
+//go:build windows
+
+package fileutil
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockedBytes is the length of the region locked; any non-empty region
+// works because every caller locks the same one.
+const lockedBytes = 1
+
+func lockFile(file *os.File) error {
+	return windows.LockFileEx(
+		windows.Handle(file.Fd()),
+		windows.LOCKFILE_EXCLUSIVE_LOCK,
+		0,
+		lockedBytes,
+		0,
+		&windows.Overlapped{},
+	)
+}
+
+func unlockFile(file *os.File) error {
+	return windows.UnlockFileEx(windows.Handle(file.Fd()), 0, lockedBytes, 0, &windows.Overlapped{})
+}
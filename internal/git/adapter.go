@@ -1,5 +1,7 @@
 package git
 
+import "time"
+
 // RepositoryAdapter adapts the Repository interface to implement Runner
 type RepositoryAdapter struct {
 	repo Repository
@@ -57,6 +59,11 @@ func (a *RepositoryAdapter) GetRepoRoot() (string, error) {
 	return a.repo.GetRoot()
 }
 
+// IsWorktree reports whether the working tree is a linked worktree
+func (a *RepositoryAdapter) IsWorktree() (bool, error) {
+	return a.repo.IsWorktree()
+}
+
 // GetRemoteURL returns the URL for the given remote
 func (a *RepositoryAdapter) GetRemoteURL(remote string) (string, error) {
 	return a.repo.GetRemoteURL(remote)
@@ -76,3 +83,37 @@ func (a *RepositoryAdapter) GetBranchRemote(branch string) (string, error) {
 func (a *RepositoryAdapter) GetRemotes() (map[string]string, error) {
 	return a.repo.GetRemotes()
 }
+
+// GetUpstreamStatus returns the upstream tracking status of the given branch
+func (a *RepositoryAdapter) GetUpstreamStatus(branch string) (UpstreamStatus, error) {
+	return a.repo.GetUpstreamStatus(branch)
+}
+
+// GetStagedDiffStats returns per-file line counts of the staged changes
+func (a *RepositoryAdapter) GetStagedDiffStats() ([]DiffStat, error) {
+	return a.repo.GetStagedDiffStats()
+}
+
+// GetUnpushedCommitSubjects returns the subjects of the commits on the given
+// branch that are not on its upstream, newest first
+func (a *RepositoryAdapter) GetUnpushedCommitSubjects(branch string) ([]string, error) {
+	return a.repo.GetUnpushedCommitSubjects(branch)
+}
+
+// GetCommitDiffs returns the lines added by each non-merge commit in
+// revRange, newest first
+func (a *RepositoryAdapter) GetCommitDiffs(revRange string, maxCount int) ([]CommitDiff, error) {
+	return a.repo.GetCommitDiffs(revRange, maxCount)
+}
+
+// GetLastCommitTime returns the committer time of HEAD, or the zero time if
+// the repository has no commits
+func (a *RepositoryAdapter) GetLastCommitTime() (time.Time, error) {
+	return a.repo.GetLastCommitTime()
+}
+
+// IsDirty reports whether the working tree has staged, modified or untracked
+// files
+func (a *RepositoryAdapter) IsDirty() (bool, error) {
+	return a.repo.IsDirty()
+}
@@ -3,6 +3,7 @@ package git_test
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/go-git/go-git/v6"
 	. "github.com/onsi/ginkgo/v2"
@@ -124,6 +125,79 @@ var _ = Describe("RepositoryAdapter", func() {
 			Expect(mockRepo.getRemotesCalled).To(BeTrue())
 		})
 	})
+
+	Describe("GetUpstreamStatus", func() {
+		It("should delegate to repository", func() {
+			mockRepo.upstreams = map[string]internalgit.UpstreamStatus{
+				"main": {HasUpstream: true, Ahead: 2, Behind: 1},
+			}
+			status, err := adapter.GetUpstreamStatus("main")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(mockRepo.upstreams["main"]))
+			Expect(mockRepo.getUpstreamStatusCalled).To(BeTrue())
+		})
+	})
+
+	Describe("GetStagedDiffStats", func() {
+		It("should delegate to repository", func() {
+			mockRepo.diffStats = []internalgit.DiffStat{{Path: "main.go", Added: 3}}
+			stats, err := adapter.GetStagedDiffStats()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats).To(Equal(mockRepo.diffStats))
+			Expect(mockRepo.getStagedDiffStatsCalled).To(BeTrue())
+		})
+	})
+
+	Describe("GetUnpushedCommitSubjects", func() {
+		It("should delegate to repository", func() {
+			mockRepo.unpushed = map[string][]string{"main": {"feat: add api"}}
+			subjects, err := adapter.GetUnpushedCommitSubjects("main")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subjects).To(Equal([]string{"feat: add api"}))
+			Expect(mockRepo.getUnpushedCalled).To(BeTrue())
+		})
+	})
+
+	Describe("GetCommitDiffs", func() {
+		It("should delegate to repository", func() {
+			diffs := []internalgit.CommitDiff{{Hash: "abc123", Subject: "feat: add api"}}
+			mockRepo.commitDiffs = map[string][]internalgit.CommitDiff{"@{u}..HEAD": diffs}
+			commitDiffs, err := adapter.GetCommitDiffs("@{u}..HEAD", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(commitDiffs).To(Equal(diffs))
+			Expect(mockRepo.getCommitDiffsCalled).To(BeTrue())
+		})
+	})
+
+	Describe("GetLastCommitTime", func() {
+		It("should delegate to repository", func() {
+			mockRepo.lastCommit = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+			lastCommit, err := adapter.GetLastCommitTime()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastCommit).To(Equal(mockRepo.lastCommit))
+			Expect(mockRepo.getLastCommitCalled).To(BeTrue())
+		})
+	})
+
+	Describe("IsDirty", func() {
+		It("should delegate to repository", func() {
+			mockRepo.dirty = true
+			dirty, err := adapter.IsDirty()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dirty).To(BeTrue())
+			Expect(mockRepo.isDirtyCalled).To(BeTrue())
+		})
+	})
+
+	Describe("IsWorktree", func() {
+		It("should delegate to repository", func() {
+			mockRepo.worktree = true
+			isWorktree, err := adapter.IsWorktree()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isWorktree).To(BeTrue())
+			Expect(mockRepo.isWorktreeCalled).To(BeTrue())
+		})
+	})
 })
 
 // mockRepository is a mock implementation of the Repository interface for testing
@@ -173,6 +247,34 @@ type mockRepository struct {
 	remotes          map[string]string
 	remotesErr       error
 	getRemotesCalled bool
+
+	// GetUpstreamStatus
+	upstreams               map[string]internalgit.UpstreamStatus
+	getUpstreamStatusCalled bool
+
+	// GetStagedDiffStats
+	diffStats                []internalgit.DiffStat
+	getStagedDiffStatsCalled bool
+
+	// GetUnpushedCommitSubjects
+	unpushed          map[string][]string
+	getUnpushedCalled bool
+
+	// GetCommitDiffs
+	commitDiffs          map[string][]internalgit.CommitDiff
+	getCommitDiffsCalled bool
+
+	// GetLastCommitTime
+	lastCommit          time.Time
+	getLastCommitCalled bool
+
+	// IsWorktree
+	worktree         bool
+	isWorktreeCalled bool
+
+	// IsDirty
+	dirty         bool
+	isDirtyCalled bool
 }
 
 func (m *mockRepository) IsInRepo() bool {
@@ -185,6 +287,11 @@ func (m *mockRepository) GetRoot() (string, error) {
 	return m.root, m.rootErr
 }
 
+func (m *mockRepository) IsWorktree() (bool, error) {
+	m.isWorktreeCalled = true
+	return m.worktree, nil
+}
+
 func (m *mockRepository) GetStagedFiles() ([]string, error) {
 	m.getStagedFilesCalled = true
 	return m.stagedFiles, m.stagedFilesErr
@@ -232,6 +339,39 @@ func (m *mockRepository) GetRemotes() (map[string]string, error) {
 	return m.remotes, m.remotesErr
 }
 
+func (m *mockRepository) GetUpstreamStatus(branch string) (internalgit.UpstreamStatus, error) {
+	m.getUpstreamStatusCalled = true
+	return m.upstreams[branch], nil
+}
+
+func (m *mockRepository) GetStagedDiffStats() ([]internalgit.DiffStat, error) {
+	m.getStagedDiffStatsCalled = true
+	return m.diffStats, nil
+}
+
+func (m *mockRepository) GetUnpushedCommitSubjects(branch string) ([]string, error) {
+	m.getUnpushedCalled = true
+	return m.unpushed[branch], nil
+}
+
+func (m *mockRepository) GetCommitDiffs(
+	revRange string,
+	_ int,
+) ([]internalgit.CommitDiff, error) {
+	m.getCommitDiffsCalled = true
+	return m.commitDiffs[revRange], nil
+}
+
+func (m *mockRepository) GetLastCommitTime() (time.Time, error) {
+	m.getLastCommitCalled = true
+	return m.lastCommit, nil
+}
+
+func (m *mockRepository) IsDirty() (bool, error) {
+	m.isDirtyCalled = true
+	return m.dirty, nil
+}
+
 var _ = Describe("NewSDKRunnerForPath", func() {
 	var (
 		tempDir string
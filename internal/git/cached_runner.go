@@ -3,6 +3,7 @@ package git
 
 import (
 	"sync"
+	"time"
 )
 
 // CachedRunner wraps a Runner and caches results for the duration of its lifetime.
@@ -36,6 +37,11 @@ type CachedRunner struct {
 	repoRoot     string
 	repoRootErr  error
 
+	// IsWorktree cache
+	isWorktreeOnce sync.Once
+	isWorktree     bool
+	isWorktreeErr  error
+
 	// IsInRepo cache
 	isInRepoOnce sync.Once
 	isInRepo     bool
@@ -45,6 +51,21 @@ type CachedRunner struct {
 	remotes     map[string]string
 	remotesErr  error
 
+	// Staged diff stats cache
+	diffStatsOnce sync.Once
+	diffStats     []DiffStat
+	diffStatsErr  error
+
+	// Last commit time cache
+	lastCommitOnce sync.Once
+	lastCommit     time.Time
+	lastCommitErr  error
+
+	// Dirty working tree cache
+	dirtyOnce sync.Once
+	dirty     bool
+	dirtyErr  error
+
 	// Remote URL cache (per remote name)
 	remoteURLMu    sync.RWMutex
 	remoteURLCache map[string]remoteURLCacheEntry
@@ -52,6 +73,18 @@ type CachedRunner struct {
 	// Branch remote cache (per branch name)
 	branchRemoteMu    sync.RWMutex
 	branchRemoteCache map[string]branchRemoteCacheEntry
+
+	// Upstream status cache (per branch name)
+	upstreamMu    sync.RWMutex
+	upstreamCache map[string]upstreamCacheEntry
+
+	// Unpushed commit subjects cache (per branch name)
+	unpushedMu    sync.RWMutex
+	unpushedCache map[string]unpushedCacheEntry
+
+	// Commit diffs cache (per range and count)
+	commitDiffsMu    sync.RWMutex
+	commitDiffsCache map[commitDiffsKey]commitDiffsCacheEntry
 }
 
 type remoteURLCacheEntry struct {
@@ -64,6 +97,26 @@ type branchRemoteCacheEntry struct {
 	err    error
 }
 
+type upstreamCacheEntry struct {
+	status UpstreamStatus
+	err    error
+}
+
+type unpushedCacheEntry struct {
+	subjects []string
+	err      error
+}
+
+type commitDiffsKey struct {
+	revRange string
+	maxCount int
+}
+
+type commitDiffsCacheEntry struct {
+	diffs []CommitDiff
+	err   error
+}
+
 // NewCachedRunner creates a new CachedRunner that wraps the given Runner.
 // The cached runner memoizes results for the duration of its lifetime.
 func NewCachedRunner(delegate Runner) Runner {
@@ -71,6 +124,9 @@ func NewCachedRunner(delegate Runner) Runner {
 		delegate:          delegate,
 		remoteURLCache:    make(map[string]remoteURLCacheEntry),
 		branchRemoteCache: make(map[string]branchRemoteCacheEntry),
+		upstreamCache:     make(map[string]upstreamCacheEntry),
+		unpushedCache:     make(map[string]unpushedCacheEntry),
+		commitDiffsCache:  make(map[commitDiffsKey]commitDiffsCacheEntry),
 	}
 }
 
@@ -142,6 +198,16 @@ func (c *CachedRunner) GetRepoRoot() (string, error) {
 	return c.repoRoot, c.repoRootErr
 }
 
+// IsWorktree reports whether the working tree is a linked worktree.
+// Result is cached.
+func (c *CachedRunner) IsWorktree() (bool, error) {
+	c.isWorktreeOnce.Do(func() {
+		c.isWorktree, c.isWorktreeErr = c.delegate.IsWorktree()
+	})
+
+	return c.isWorktree, c.isWorktreeErr
+}
+
 // GetCurrentBranch returns the current branch name.
 // Result is cached.
 func (c *CachedRunner) GetCurrentBranch() (string, error) {
@@ -222,5 +288,112 @@ func (c *CachedRunner) GetRemotes() (map[string]string, error) {
 	return c.remotes, c.remotesErr
 }
 
+// GetUpstreamStatus returns the upstream tracking status of the given branch.
+// Results are cached per branch name.
+//
+//nolint:dupl // Similar pattern to GetBranchRemote but different types
+func (c *CachedRunner) GetUpstreamStatus(branch string) (UpstreamStatus, error) {
+	c.upstreamMu.RLock()
+	entry, ok := c.upstreamCache[branch]
+	c.upstreamMu.RUnlock()
+
+	if ok {
+		return entry.status, entry.err
+	}
+
+	c.upstreamMu.Lock()
+	defer c.upstreamMu.Unlock()
+
+	if entry, ok := c.upstreamCache[branch]; ok {
+		return entry.status, entry.err
+	}
+
+	status, err := c.delegate.GetUpstreamStatus(branch)
+	c.upstreamCache[branch] = upstreamCacheEntry{status: status, err: err}
+
+	return status, err
+}
+
+// GetStagedDiffStats returns per-file line counts of the staged changes.
+// Result is cached.
+func (c *CachedRunner) GetStagedDiffStats() ([]DiffStat, error) {
+	c.diffStatsOnce.Do(func() {
+		c.diffStats, c.diffStatsErr = c.delegate.GetStagedDiffStats()
+	})
+
+	return c.diffStats, c.diffStatsErr
+}
+
+// GetUnpushedCommitSubjects returns the subjects of the commits on the given
+// branch that are not on its upstream. Results are cached per branch name.
+//
+//nolint:dupl // Similar pattern to GetUpstreamStatus but different types
+func (c *CachedRunner) GetUnpushedCommitSubjects(branch string) ([]string, error) {
+	c.unpushedMu.RLock()
+	entry, ok := c.unpushedCache[branch]
+	c.unpushedMu.RUnlock()
+
+	if ok {
+		return entry.subjects, entry.err
+	}
+
+	c.unpushedMu.Lock()
+	defer c.unpushedMu.Unlock()
+
+	if entry, ok := c.unpushedCache[branch]; ok {
+		return entry.subjects, entry.err
+	}
+
+	subjects, err := c.delegate.GetUnpushedCommitSubjects(branch)
+	c.unpushedCache[branch] = unpushedCacheEntry{subjects: subjects, err: err}
+
+	return subjects, err
+}
+
+// GetCommitDiffs returns the lines added by each non-merge commit in
+// revRange. Results are cached per range and count.
+func (c *CachedRunner) GetCommitDiffs(revRange string, maxCount int) ([]CommitDiff, error) {
+	key := commitDiffsKey{revRange: revRange, maxCount: maxCount}
+
+	c.commitDiffsMu.RLock()
+	entry, ok := c.commitDiffsCache[key]
+	c.commitDiffsMu.RUnlock()
+
+	if ok {
+		return entry.diffs, entry.err
+	}
+
+	c.commitDiffsMu.Lock()
+	defer c.commitDiffsMu.Unlock()
+
+	if entry, ok := c.commitDiffsCache[key]; ok {
+		return entry.diffs, entry.err
+	}
+
+	diffs, err := c.delegate.GetCommitDiffs(revRange, maxCount)
+	c.commitDiffsCache[key] = commitDiffsCacheEntry{diffs: diffs, err: err}
+
+	return diffs, err
+}
+
+// GetLastCommitTime returns the committer time of HEAD. Result is cached.
+func (c *CachedRunner) GetLastCommitTime() (time.Time, error) {
+	c.lastCommitOnce.Do(func() {
+		c.lastCommit, c.lastCommitErr = c.delegate.GetLastCommitTime()
+	})
+
+	return c.lastCommit, c.lastCommitErr
+}
+
+// IsDirty reports whether the working tree has uncommitted changes. Result
+// is cached.
+func (c *CachedRunner) IsDirty() (bool, error) {
+	c.dirtyOnce.Do(func() {
+		c.dirty, c.dirtyErr = c.delegate.IsDirty()
+	})
+
+	return c.dirty, c.dirtyErr
+}
+
 // Ensure CachedRunner implements Runner.
 var _ Runner = (*CachedRunner)(nil)
@@ -347,6 +347,26 @@ var _ = Describe("CachedRunner", func() {
 		})
 	})
 
+	Describe("GetCommitDiffs", func() {
+		It("caches results per range and count", func() {
+			diffs := []git.CommitDiff{{Hash: "abc123", Subject: "Add key"}}
+			mockRunner.EXPECT().GetCommitDiffs("@{u}..HEAD", 0).Return(diffs, nil).Times(1)
+			mockRunner.EXPECT().GetCommitDiffs("HEAD", 10).Return(nil, nil).Times(1)
+
+			result, err := cached.GetCommitDiffs("@{u}..HEAD", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(diffs))
+
+			result, err = cached.GetCommitDiffs("HEAD", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeEmpty())
+
+			// Second call - should use cache
+			result, _ = cached.GetCommitDiffs("@{u}..HEAD", 0)
+			Expect(result).To(Equal(diffs))
+		})
+	})
+
 	Describe("Concurrent access", func() {
 		It("handles concurrent calls to IsInRepo", func() {
 			mockRunner.EXPECT().IsInRepo().Return(true).Times(1)
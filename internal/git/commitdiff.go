@@ -0,0 +1,301 @@
+package git
+
+import (
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/go-git/go-git/v6"
+	"github.com/go-git/go-git/v6/plumbing"
+	"github.com/go-git/go-git/v6/plumbing/object"
+	"github.com/go-git/go-git/v6/utils/diff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// upstreamSuffixes are the revision suffixes naming the upstream of a branch.
+var upstreamSuffixes = []string{"@{u}", "@{upstream}"}
+
+// CommitDiff holds the lines a single commit adds, like one entry of
+// "git log --patch".
+type CommitDiff struct {
+	// Hash is the full commit hash.
+	Hash string
+
+	// Subject is the first line of the commit message.
+	Subject string
+
+	// Added holds the lines the commit adds, in file order.
+	Added []AddedLine
+}
+
+// AddedLine is a line added by a commit.
+type AddedLine struct {
+	// Path is the file path relative to the repository root.
+	Path string
+
+	// Line is the 1-based line number in the new version of the file.
+	Line int
+
+	// Text is the line content without the trailing newline.
+	Text string
+}
+
+// GetCommitDiffs returns the lines added by each non-merge commit in
+// revRange, newest first. revRange is either "base..head", selecting the
+// commits reachable from head but not from base, or a single revision,
+// selecting its history. Either side may be "@{u}" or "<branch>@{u}" for the
+// upstream of the current or the given branch, which yields ErrNoTracking
+// when there is none. A positive maxCount limits the number of commits, like
+// "git log -n".
+func (r *SDKRepository) GetCommitDiffs(revRange string, maxCount int) ([]CommitDiff, error) {
+	commits, err := r.rangeCommits(revRange, maxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make([]CommitDiff, 0, len(commits))
+
+	for _, commit := range commits {
+		if commit.NumParents() > 1 {
+			continue
+		}
+
+		added, err := commitAddedLines(commit)
+		if err != nil {
+			return nil, err
+		}
+
+		subject, _, _ := strings.Cut(strings.TrimSpace(commit.Message), "\n")
+		diffs = append(diffs, CommitDiff{
+			Hash:    commit.Hash.String(),
+			Subject: subject,
+			Added:   added,
+		})
+	}
+
+	return diffs, nil
+}
+
+// rangeCommits returns the commits selected by revRange, newest first.
+func (r *SDKRepository) rangeCommits(revRange string, maxCount int) ([]*object.Commit, error) {
+	baseRev, headRev, isRange := strings.Cut(revRange, "..")
+
+	if !isRange {
+		head, err := r.resolveRevision(revRange)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.historyCommits(head, maxCount)
+	}
+
+	base, err := r.resolveRevision(baseRev)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := r.resolveRevision(headRev)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := r.commitsAhead(head, base)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxCount > 0 && len(commits) > maxCount {
+		commits = commits[:maxCount]
+	}
+
+	return commits, nil
+}
+
+// historyCommits returns up to maxCount commits of the history of head,
+// newest first, or the whole history when maxCount is not positive.
+func (r *SDKRepository) historyCommits(head plumbing.Hash, maxCount int) ([]*object.Commit, error) {
+	iter, err := r.repo.Log(&git.LogOptions{From: head})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to walk history")
+	}
+	defer iter.Close()
+
+	var commits []*object.Commit
+
+	for maxCount <= 0 || len(commits) < maxCount {
+		commit, err := iter.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to walk history")
+		}
+
+		commits = append(commits, commit)
+	}
+
+	return commits, nil
+}
+
+// resolveRevision resolves a revision to a commit hash. An empty revision is
+// HEAD.
+func (r *SDKRepository) resolveRevision(rev string) (plumbing.Hash, error) {
+	if rev == "" {
+		rev = "HEAD"
+	}
+
+	for _, suffix := range upstreamSuffixes {
+		if branch, ok := strings.CutSuffix(rev, suffix); ok {
+			return r.resolveUpstreamRevision(branch)
+		}
+	}
+
+	hash, err := r.repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return plumbing.ZeroHash, errors.Wrapf(err, "failed to resolve revision %q", rev)
+	}
+
+	return *hash, nil
+}
+
+// resolveUpstreamRevision returns the upstream tip of branch, or of the
+// current branch when branch is empty.
+func (r *SDKRepository) resolveUpstreamRevision(branch string) (plumbing.Hash, error) {
+	if branch == "" {
+		current, err := r.GetCurrentBranch()
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		branch = current
+	}
+
+	_, upstream, ok, err := r.resolveUpstream(branch)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	if !ok {
+		return plumbing.ZeroHash, errors.Wrapf(ErrNoTracking, "branch %q", branch)
+	}
+
+	return upstream, nil
+}
+
+// commitAddedLines returns the lines commit adds compared to its first
+// parent, or to an empty tree for a root commit. Binary files and deletions
+// add no lines.
+func commitAddedLines(commit *object.Commit) ([]AddedLine, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get tree of %s", commit.Hash)
+	}
+
+	var parentTree *object.Tree
+
+	if commit.NumParents() > 0 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get parent of %s", commit.Hash)
+		}
+
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get tree of %s", parent.Hash)
+		}
+	}
+
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to diff %s", commit.Hash)
+	}
+
+	var added []AddedLine
+
+	for _, change := range changes {
+		from, to, err := change.Files()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read changes of %s", commit.Hash)
+		}
+
+		if to == nil {
+			continue
+		}
+
+		lines, err := fileAddedLines(from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		added = append(added, lines...)
+	}
+
+	return added, nil
+}
+
+// fileAddedLines returns the lines added between two versions of a file.
+// from is nil for a new file.
+func fileAddedLines(from, to *object.File) ([]AddedLine, error) {
+	newContent, ok, err := textContent(to)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	var oldContent string
+
+	if from != nil {
+		oldContent, ok, err = textContent(from)
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+
+	return addedLines(to.Name, oldContent, newContent), nil
+}
+
+// textContent returns the content of f. ok is false for binary files.
+func textContent(f *object.File) (content string, ok bool, err error) {
+	isBinary, err := f.IsBinary()
+	if err != nil {
+		return "", false, errors.Wrapf(err, "failed to read %s", f.Name)
+	}
+
+	if isBinary {
+		return "", false, nil
+	}
+
+	content, err = f.Contents()
+	if err != nil {
+		return "", false, errors.Wrapf(err, "failed to read %s", f.Name)
+	}
+
+	return content, true, nil
+}
+
+// addedLines returns the lines of newContent that are not in oldContent,
+// numbered by their position in newContent.
+func addedLines(path, oldContent, newContent string) []AddedLine {
+	var added []AddedLine
+
+	line := 1
+
+	for _, d := range diff.Do(oldContent, newContent) {
+		switch d.Type {
+		case diffmatchpatch.DiffEqual:
+			line += countLines(d.Text)
+		case diffmatchpatch.DiffInsert:
+			for text := range strings.Lines(d.Text) {
+				added = append(added, AddedLine{
+					Path: path,
+					Line: line,
+					Text: strings.TrimSuffix(text, "\n"),
+				})
+				line++
+			}
+		case diffmatchpatch.DiffDelete:
+		}
+	}
+
+	return added
+}
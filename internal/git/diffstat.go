@@ -0,0 +1,196 @@
+package git
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/go-git/go-git/v6/plumbing"
+	"github.com/go-git/go-git/v6/plumbing/object"
+	"github.com/go-git/go-git/v6/utils/binary"
+	"github.com/go-git/go-git/v6/utils/diff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// DiffStat describes the staged changes to a single file, like one line of
+// "git diff --cached --numstat".
+type DiffStat struct {
+	// Path is the file path relative to the repository root.
+	Path string
+
+	// Added is the number of added lines.
+	Added int
+
+	// Deleted is the number of deleted lines.
+	Deleted int
+
+	// Bytes is the size of the added and deleted lines.
+	Bytes int
+
+	// Binary is true for binary files, which have no line counts.
+	Binary bool
+}
+
+// Lines returns the number of added and deleted lines.
+func (s DiffStat) Lines() int {
+	return s.Added + s.Deleted
+}
+
+// GetStagedDiffStats returns per-file statistics for the changes staged in
+// the index, compared to HEAD. Renames are reported as a deletion and an
+// addition.
+func (r *SDKRepository) GetStagedDiffStats() ([]DiffStat, error) {
+	headFiles, err := r.headBlobs()
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := r.repo.Storer.Index()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read index")
+	}
+
+	stagedFiles := make(map[string]plumbing.Hash, len(idx.Entries))
+	for _, entry := range idx.Entries {
+		stagedFiles[entry.Name] = entry.Hash
+	}
+
+	paths := make([]string, 0)
+
+	for path, hash := range stagedFiles {
+		if headHash, ok := headFiles[path]; !ok || headHash != hash {
+			paths = append(paths, path)
+		}
+	}
+
+	for path := range headFiles {
+		if _, ok := stagedFiles[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+
+	stats := make([]DiffStat, 0, len(paths))
+
+	for _, path := range paths {
+		oldContent, err := r.blobContent(headFiles, path)
+		if err != nil {
+			return nil, err
+		}
+
+		newContent, err := r.blobContent(stagedFiles, path)
+		if err != nil {
+			return nil, err
+		}
+
+		stats = append(stats, diffStat(path, oldContent, newContent))
+	}
+
+	return stats, nil
+}
+
+// headBlobs returns the blob hash of every file in the HEAD commit, or an
+// empty map before the first commit.
+func (r *SDKRepository) headBlobs() (map[string]plumbing.Hash, error) {
+	blobs := make(map[string]plumbing.Hash)
+
+	head, err := r.repo.Head()
+	if err != nil {
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			return blobs, nil
+		}
+
+		return nil, errors.Wrap(err, "failed to get HEAD")
+	}
+
+	commit, err := r.repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get HEAD commit")
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get HEAD tree")
+	}
+
+	err = tree.Files().ForEach(func(f *object.File) error {
+		blobs[f.Name] = f.Hash
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to walk HEAD tree")
+	}
+
+	return blobs, nil
+}
+
+// blobContent returns the content of the blob for path, or nil if path is
+// not in blobs.
+func (r *SDKRepository) blobContent(blobs map[string]plumbing.Hash, path string) ([]byte, error) {
+	hash, ok := blobs[path]
+	if !ok {
+		return nil, nil
+	}
+
+	blob, err := r.repo.BlobObject(hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read blob for %s", path)
+	}
+
+	reader, err := blob.Reader()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read blob for %s", path)
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+// diffStat computes line and byte counts between two versions of a file.
+func diffStat(path string, oldContent, newContent []byte) DiffStat {
+	stat := DiffStat{Path: path}
+
+	if isBinaryContent(oldContent) || isBinaryContent(newContent) {
+		stat.Binary = true
+
+		return stat
+	}
+
+	for _, d := range diff.Do(string(oldContent), string(newContent)) {
+		switch d.Type {
+		case diffmatchpatch.DiffInsert:
+			stat.Added += countLines(d.Text)
+			stat.Bytes += len(d.Text)
+		case diffmatchpatch.DiffDelete:
+			stat.Deleted += countLines(d.Text)
+			stat.Bytes += len(d.Text)
+		case diffmatchpatch.DiffEqual:
+		}
+	}
+
+	return stat
+}
+
+// countLines counts lines in s, including a final line without a newline.
+func countLines(s string) int {
+	if s == "" {
+		return 0
+	}
+
+	lines := strings.Count(s, "\n")
+	if !strings.HasSuffix(s, "\n") {
+		lines++
+	}
+
+	return lines
+}
+
+func isBinaryContent(content []byte) bool {
+	isBinary, err := binary.IsBinary(bytes.NewReader(content))
+
+	return err == nil && isBinary
+}
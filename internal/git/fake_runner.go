@@ -1,5 +1,7 @@
 package git
 
+import "time"
+
 // FakeRunner implements Runner for testing without executing git commands.
 // This is a struct-based fake (not a mock) that allows tests to set state directly.
 // For expectation-based testing, use the generated MockRunner from runner_mock.go.
@@ -9,9 +11,15 @@ type FakeRunner struct {
 	ModifiedFiles  []string
 	UntrackedFiles []string
 	RepoRoot       string
+	Worktree       bool
 	Remotes        map[string]string
 	CurrentBranch  string
 	BranchRemotes  map[string]string
+	Upstreams      map[string]UpstreamStatus
+	DiffStats      []DiffStat
+	Unpushed       map[string][]string
+	CommitDiffs    map[string][]CommitDiff
+	LastCommit     time.Time
 	Err            error
 }
 
@@ -76,6 +84,15 @@ func (f *FakeRunner) GetRepoRoot() (string, error) {
 	return f.RepoRoot, nil
 }
 
+// IsWorktree returns Worktree.
+func (f *FakeRunner) IsWorktree() (bool, error) {
+	if f.Err != nil {
+		return false, f.Err
+	}
+
+	return f.Worktree, nil
+}
+
 // GetRemoteURL returns the URL for the given remote.
 func (f *FakeRunner) GetRemoteURL(remote string) (string, error) {
 	if f.Err != nil {
@@ -120,6 +137,71 @@ func (f *FakeRunner) GetRemotes() (map[string]string, error) {
 	return f.Remotes, nil
 }
 
+// GetUpstreamStatus returns the upstream tracking status of the given branch.
+// Branches without an entry in Upstreams have no upstream.
+func (f *FakeRunner) GetUpstreamStatus(branch string) (UpstreamStatus, error) {
+	if f.Err != nil {
+		return UpstreamStatus{}, f.Err
+	}
+
+	return f.Upstreams[branch], nil
+}
+
+// GetStagedDiffStats returns per-file line counts of the staged changes.
+func (f *FakeRunner) GetStagedDiffStats() ([]DiffStat, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+
+	return f.DiffStats, nil
+}
+
+// GetUnpushedCommitSubjects returns the subjects of the commits on the given
+// branch that are not on its upstream. Branches without an entry in Unpushed
+// have no unpushed commits.
+func (f *FakeRunner) GetUnpushedCommitSubjects(branch string) ([]string, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+
+	return f.Unpushed[branch], nil
+}
+
+// GetCommitDiffs returns the entry of CommitDiffs for revRange, limited to
+// maxCount commits when it is positive. Ranges without an entry have no
+// commits.
+func (f *FakeRunner) GetCommitDiffs(revRange string, maxCount int) ([]CommitDiff, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+
+	diffs := f.CommitDiffs[revRange]
+	if maxCount > 0 && len(diffs) > maxCount {
+		diffs = diffs[:maxCount]
+	}
+
+	return diffs, nil
+}
+
+// GetLastCommitTime returns LastCommit. The zero time stands for a
+// repository without commits.
+func (f *FakeRunner) GetLastCommitTime() (time.Time, error) {
+	if f.Err != nil {
+		return time.Time{}, f.Err
+	}
+
+	return f.LastCommit, nil
+}
+
+// IsDirty reports whether any staged, modified or untracked files are set.
+func (f *FakeRunner) IsDirty() (bool, error) {
+	if f.Err != nil {
+		return false, f.Err
+	}
+
+	return len(f.StagedFiles) > 0 || len(f.ModifiedFiles) > 0 || len(f.UntrackedFiles) > 0, nil
+}
+
 // FakeRunnerError is a simple error type for testing.
 type FakeRunnerError struct {
 	Msg string
@@ -2,8 +2,12 @@
 package git
 
 import (
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/go-git/go-git/v6"
@@ -46,6 +50,10 @@ type Repository interface {
 	// GetRoot returns the git repository root directory
 	GetRoot() (string, error)
 
+	// IsWorktree reports whether the working tree is a linked worktree
+	// created by git worktree add rather than the main working tree
+	IsWorktree() (bool, error)
+
 	// GetStagedFiles returns the list of staged files
 	GetStagedFiles() ([]string, error)
 
@@ -66,6 +74,29 @@ type Repository interface {
 
 	// GetRemotes returns the list of all remotes with their URLs
 	GetRemotes() (map[string]string, error)
+
+	// GetUpstreamStatus returns the upstream tracking status of the given branch
+	GetUpstreamStatus(branch string) (UpstreamStatus, error)
+
+	// GetStagedDiffStats returns per-file line counts of the staged changes
+	GetStagedDiffStats() ([]DiffStat, error)
+
+	// GetUnpushedCommitSubjects returns the subjects of the commits on the
+	// given branch that are not on its upstream, newest first
+	GetUnpushedCommitSubjects(branch string) ([]string, error)
+
+	// GetCommitDiffs returns the lines added by each non-merge commit in
+	// revRange ("base..head" or a single revision), newest first. A positive
+	// maxCount limits the number of commits
+	GetCommitDiffs(revRange string, maxCount int) ([]CommitDiff, error)
+
+	// GetLastCommitTime returns the committer time of HEAD, or the zero time
+	// if the repository has no commits
+	GetLastCommitTime() (time.Time, error)
+
+	// IsDirty reports whether the working tree has staged, modified or
+	// untracked files
+	IsDirty() (bool, error)
 }
 
 // SDKRepository implements Repository using go-git SDK
@@ -156,6 +187,51 @@ func (r *SDKRepository) GetRoot() (string, error) {
 	return worktree.Filesystem.Root(), nil
 }
 
+// IsWorktree reports whether the working tree is a linked worktree. A linked
+// worktree has a .git file pointing at <common dir>/worktrees/<name>, which
+// holds a commondir file. Submodules also use a .git file, but their git
+// directory has no commondir.
+func (r *SDKRepository) IsWorktree() (bool, error) {
+	root, err := r.GetRoot()
+	if err != nil {
+		return false, err
+	}
+
+	dotGit := filepath.Join(root, ".git")
+
+	info, err := os.Lstat(dotGit)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "failed to stat .git")
+	}
+
+	if info.IsDir() {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(dotGit) //nolint:gosec // G304: path is inside the repository
+	if err != nil {
+		return false, errors.Wrap(err, "failed to read .git file")
+	}
+
+	gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return false, nil
+	}
+
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(root, gitDir)
+	}
+
+	_, err = os.Stat(filepath.Join(gitDir, "commondir"))
+
+	return err == nil, nil
+}
+
 // getStatus returns the worktree status, calling worktree.Status() at most once.
 // go-git's worktree.Status() walks the entire working directory, so we cache it.
 func (r *SDKRepository) getStatus() (git.Status, error) {
@@ -251,6 +327,37 @@ func (r *SDKRepository) GetCurrentBranch() (string, error) {
 	return head.Name().Short(), nil
 }
 
+// IsDirty reports whether the working tree has staged, modified or untracked
+// files. It shares the cached status with GetStagedFiles.
+func (r *SDKRepository) IsDirty() (bool, error) {
+	status, err := r.getStatus()
+	if err != nil {
+		return false, err
+	}
+
+	return !status.IsClean(), nil
+}
+
+// GetLastCommitTime returns the committer time of HEAD. A repository without
+// commits yields the zero time and no error.
+func (r *SDKRepository) GetLastCommitTime() (time.Time, error) {
+	head, err := r.repo.Head()
+	if err != nil {
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			return time.Time{}, nil
+		}
+
+		return time.Time{}, errors.Wrap(err, "failed to get HEAD")
+	}
+
+	commit, err := r.repo.CommitObject(head.Hash())
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to load commit %s", head.Hash())
+	}
+
+	return commit.Committer.When, nil
+}
+
 // GetBranchRemote returns the tracking remote for the given branch
 func (r *SDKRepository) GetBranchRemote(branch string) (string, error) {
 	// First verify the branch exists
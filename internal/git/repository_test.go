@@ -4,6 +4,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"time"
 
 	"github.com/go-git/go-git/v6"
 	"github.com/go-git/go-git/v6/config"
@@ -233,6 +234,19 @@ var _ = Describe("SDKRepository", func() {
 		})
 	})
 
+	Describe("IsWorktree", func() {
+		BeforeEach(func() {
+			sdkRepo, err = internalgit.DiscoverRepository()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return false for the main working tree", func() {
+			isWorktree, err := sdkRepo.IsWorktree() //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isWorktree).To(BeFalse())
+		})
+	})
+
 	Describe("GetStagedFiles", func() {
 		BeforeEach(func() {
 			sdkRepo, err = internalgit.DiscoverRepository()
@@ -292,6 +306,58 @@ var _ = Describe("SDKRepository", func() {
 		})
 	})
 
+	Describe("GetStagedDiffStats", func() {
+		BeforeEach(func() {
+			worktree, err := repo.Worktree() //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+
+			write := func(name, content string) {
+				err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0o644)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Add(name)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			write("a.txt", "a\nb\nc\n")
+			write("old.txt", "gone\n")
+
+			_, err = worktree.Commit("Initial commit", &git.CommitOptions{Author: testAuthor})
+			Expect(err).NotTo(HaveOccurred())
+
+			write("a.txt", "a\nB\nc\nd\n")
+			write("new.txt", "x\ny\n")
+			write("bin.dat", "\x00\x01\x02")
+
+			_, err = worktree.Remove("old.txt")
+			Expect(err).NotTo(HaveOccurred())
+
+			sdkRepo, err = internalgit.DiscoverRepository()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should count staged lines and bytes per file", func() {
+			stats, err := sdkRepo.GetStagedDiffStats() //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats).To(Equal([]internalgit.DiffStat{
+				{Path: "a.txt", Added: 2, Deleted: 1, Bytes: 6},
+				{Path: "bin.dat", Binary: true},
+				{Path: "new.txt", Added: 2, Bytes: 4},
+				{Path: "old.txt", Deleted: 1, Bytes: 5},
+			}))
+		})
+
+		It("should ignore unstaged changes", func() {
+			path := filepath.Join(tempDir, "new.txt")
+			err := os.WriteFile(path, []byte("x\ny\nz\n"), 0o644) //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+
+			stats, err := sdkRepo.GetStagedDiffStats()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats).To(ContainElement(internalgit.DiffStat{Path: "new.txt", Added: 2, Bytes: 4}))
+		})
+	})
+
 	Describe("GetModifiedFiles", func() {
 		BeforeEach(func() {
 			sdkRepo, err = internalgit.DiscoverRepository()
@@ -415,6 +481,72 @@ var _ = Describe("SDKRepository", func() {
 		})
 	})
 
+	Describe("GetLastCommitTime", func() {
+		BeforeEach(func() {
+			sdkRepo, err = internalgit.DiscoverRepository()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the zero time without commits", func() {
+			lastCommit, err := sdkRepo.GetLastCommitTime() //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastCommit.IsZero()).To(BeTrue())
+		})
+
+		It("should return the committer time of HEAD", func() {
+			when := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+			err := os.WriteFile( //nolint:govet // shadow
+				filepath.Join(tempDir, "initial.txt"),
+				[]byte("initial"),
+				0o644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			worktree, err := repo.Worktree()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worktree.Add("initial.txt")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worktree.Commit("Initial commit", &git.CommitOptions{
+				Author:    testAuthor,
+				Committer: &object.Signature{Name: "Test User", When: when},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			lastCommit, err := sdkRepo.GetLastCommitTime()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastCommit.Equal(when)).To(BeTrue())
+		})
+	})
+
+	Describe("IsDirty", func() {
+		BeforeEach(func() {
+			sdkRepo, err = internalgit.DiscoverRepository()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should report a clean working tree", func() {
+			dirty, err := sdkRepo.IsDirty() //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dirty).To(BeFalse())
+		})
+
+		It("should report untracked files as dirty", func() {
+			err := os.WriteFile( //nolint:govet // shadow
+				filepath.Join(tempDir, "untracked.txt"),
+				[]byte("content"),
+				0o644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			dirty, err := sdkRepo.IsDirty()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dirty).To(BeTrue())
+		})
+	})
+
 	Describe("GetBranchRemote", func() {
 		BeforeEach(func() {
 			sdkRepo, err = internalgit.DiscoverRepository()
@@ -482,6 +614,264 @@ var _ = Describe("SDKRepository", func() {
 		})
 	})
 
+	Describe("GetUpstreamStatus", func() {
+		var commitFile func(name string) plumbing.Hash
+
+		BeforeEach(func() {
+			sdkRepo, err = internalgit.DiscoverRepository()
+			Expect(err).NotTo(HaveOccurred())
+
+			commitFile = func(name string) plumbing.Hash {
+				err := os.WriteFile( //nolint:govet // shadow
+					filepath.Join(tempDir, name),
+					[]byte(name),
+					0o644,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				worktree, err := repo.Worktree()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Add(name)
+				Expect(err).NotTo(HaveOccurred())
+
+				hash, err := worktree.Commit("Add "+name, &git.CommitOptions{
+					Author: testAuthor,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				return hash
+			}
+
+			commitFile("initial.txt")
+		})
+
+		trackOrigin := func() {
+			cfg, err := repo.Config() //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg.Branches["master"] = &config.Branch{
+				Name:   "master",
+				Remote: "origin",
+				Merge:  "refs/heads/master",
+			}
+
+			Expect(repo.SetConfig(cfg)).To(Succeed())
+		}
+
+		setRemoteRef := func(hash plumbing.Hash) {
+			ref := plumbing.NewHashReference(
+				plumbing.NewRemoteReferenceName("origin", "master"),
+				hash,
+			)
+			Expect(repo.Storer.SetReference(ref)).To(Succeed())
+		}
+
+		It("should report no upstream for an untracked branch", func() {
+			status, err := sdkRepo.GetUpstreamStatus("master") //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(internalgit.UpstreamStatus{}))
+		})
+
+		It("should report no upstream when the remote ref is missing", func() {
+			trackOrigin()
+
+			status, err := sdkRepo.GetUpstreamStatus("master") //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.HasUpstream).To(BeFalse())
+		})
+
+		It("should report no upstream for detached HEAD", func() {
+			status, err := sdkRepo.GetUpstreamStatus("") //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(internalgit.UpstreamStatus{}))
+		})
+
+		It("should count commits ahead of the upstream", func() {
+			head, err := repo.Head() //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+
+			trackOrigin()
+			setRemoteRef(head.Hash())
+
+			commitFile("a.txt")
+			commitFile("b.txt")
+
+			status, err := sdkRepo.GetUpstreamStatus("master")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(internalgit.UpstreamStatus{
+				HasUpstream: true,
+				Ahead:       2,
+			}))
+		})
+
+		It("should count commits behind the upstream", func() {
+			head, err := repo.Head() //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+
+			upstreamHash := commitFile("remote.txt")
+
+			// Rewind master so the remote commit is only on the upstream.
+			Expect(repo.Storer.SetReference(
+				plumbing.NewHashReference(plumbing.NewBranchReferenceName("master"), head.Hash()),
+			)).To(Succeed())
+
+			trackOrigin()
+			setRemoteRef(upstreamHash)
+
+			status, err := sdkRepo.GetUpstreamStatus("master")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(internalgit.UpstreamStatus{
+				HasUpstream: true,
+				Behind:      1,
+			}))
+		})
+
+		It("should count both sides when diverged", func() {
+			head, err := repo.Head() //nolint:govet // shadow
+			Expect(err).NotTo(HaveOccurred())
+
+			upstreamHash := commitFile("remote.txt")
+
+			Expect(repo.Storer.SetReference(
+				plumbing.NewHashReference(plumbing.NewBranchReferenceName("master"), head.Hash()),
+			)).To(Succeed())
+
+			trackOrigin()
+			setRemoteRef(upstreamHash)
+
+			commitFile("local1.txt")
+			commitFile("local2.txt")
+
+			status, err := sdkRepo.GetUpstreamStatus("master")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(internalgit.UpstreamStatus{
+				HasUpstream: true,
+				Ahead:       2,
+				Behind:      1,
+			}))
+		})
+
+		It("should return ErrBranchNotFound for unknown branch", func() {
+			_, err := sdkRepo.GetUpstreamStatus("nonexistent") //nolint:govet // shadow
+			Expect(err).To(MatchError(internalgit.ErrBranchNotFound))
+		})
+
+		Describe("GetUnpushedCommitSubjects", func() {
+			It("should list commits ahead of the upstream, newest first", func() {
+				head, err := repo.Head() //nolint:govet // shadow
+				Expect(err).NotTo(HaveOccurred())
+
+				trackOrigin()
+				setRemoteRef(head.Hash())
+
+				commitFile("a.txt")
+				commitFile("b.txt")
+
+				subjects, err := sdkRepo.GetUnpushedCommitSubjects("master")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subjects).To(Equal([]string{"Add b.txt", "Add a.txt"}))
+			})
+
+			It("should skip commits that are only on the upstream", func() {
+				head, err := repo.Head() //nolint:govet // shadow
+				Expect(err).NotTo(HaveOccurred())
+
+				upstreamHash := commitFile("remote.txt")
+
+				Expect(repo.Storer.SetReference(plumbing.NewHashReference(
+					plumbing.NewBranchReferenceName("master"),
+					head.Hash(),
+				))).To(Succeed())
+
+				trackOrigin()
+				setRemoteRef(upstreamHash)
+
+				subjects, err := sdkRepo.GetUnpushedCommitSubjects("master")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subjects).To(BeEmpty())
+			})
+
+			It("should return no subjects without an upstream", func() {
+				commitFile("a.txt")
+
+				subjects, err := sdkRepo.GetUnpushedCommitSubjects("master") //nolint:govet // shadow
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subjects).To(BeNil())
+			})
+		})
+
+		Describe("GetCommitDiffs", func() {
+			commitContent := func(name, content string) plumbing.Hash {
+				Expect(os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0o644)).
+					To(Succeed())
+
+				worktree, err := repo.Worktree()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Add(name)
+				Expect(err).NotTo(HaveOccurred())
+
+				hash, err := worktree.Commit("Update "+name, &git.CommitOptions{
+					Author: testAuthor,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				return hash
+			}
+
+			It("should list the added lines of commits ahead of the upstream", func() {
+				commitContent("config.env", "A=1\nB=2\n")
+
+				head, err := repo.Head() //nolint:govet // shadow
+				Expect(err).NotTo(HaveOccurred())
+
+				trackOrigin()
+				setRemoteRef(head.Hash())
+
+				first := commitContent("config.env", "A=1\nTOKEN=secret\nB=2\n")
+				second := commitContent("other.txt", "hello\n")
+
+				diffs, err := sdkRepo.GetCommitDiffs("@{u}..HEAD", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(diffs).To(Equal([]internalgit.CommitDiff{
+					{
+						Hash:    second.String(),
+						Subject: "Update other.txt",
+						Added: []internalgit.AddedLine{
+							{Path: "other.txt", Line: 1, Text: "hello"},
+						},
+					},
+					{
+						Hash:    first.String(),
+						Subject: "Update config.env",
+						Added: []internalgit.AddedLine{
+							{Path: "config.env", Line: 2, Text: "TOKEN=secret"},
+						},
+					},
+				}))
+			})
+
+			It("should limit the commits of a single revision", func() {
+				commitFile("a.txt")
+				commitFile("b.txt")
+
+				diffs, err := sdkRepo.GetCommitDiffs("HEAD", 2) //nolint:govet // shadow
+				Expect(err).NotTo(HaveOccurred())
+				Expect(diffs).To(HaveLen(2))
+				Expect(diffs[0].Subject).To(Equal("Add b.txt"))
+				Expect(diffs[1].Added).To(Equal([]internalgit.AddedLine{
+					{Path: "a.txt", Line: 1, Text: "a.txt"},
+				}))
+			})
+
+			It("should return ErrNoTracking without an upstream", func() {
+				_, err := sdkRepo.GetCommitDiffs("@{u}..HEAD", 0) //nolint:govet // shadow
+				Expect(err).To(MatchError(internalgit.ErrNoTracking))
+			})
+		})
+	})
+
 	Describe("GetRemoteURL", func() {
 		BeforeEach(func() {
 			sdkRepo, err = internalgit.DiscoverRepository()
@@ -703,4 +1093,45 @@ var _ = Describe("DiscoverRepository with linked worktrees", func() {
 		Expect(urlErr).NotTo(HaveOccurred())
 		Expect(url).To(Equal("https://github.com/upstream/repo.git"))
 	})
+
+	It("should return the worktree checkout as root", func() {
+		err = os.Chdir(worktreeDir)
+		Expect(err).NotTo(HaveOccurred())
+
+		sdkRepo, discoverErr := internalgit.DiscoverRepository()
+		Expect(discoverErr).NotTo(HaveOccurred())
+
+		root, rootErr := sdkRepo.GetRoot()
+		Expect(rootErr).NotTo(HaveOccurred())
+		Expect(root).To(Equal(worktreeDir))
+
+		isWorktree, wtErr := sdkRepo.IsWorktree()
+		Expect(wtErr).NotTo(HaveOccurred())
+		Expect(isWorktree).To(BeTrue())
+	})
+
+	It("should detect the worktree from a subdirectory", func() {
+		subDir := filepath.Join(worktreeDir, "sub")
+		Expect(os.Mkdir(subDir, 0o755)).To(Succeed())
+
+		sdkRepo, openErr := internalgit.OpenRepository(subDir)
+		Expect(openErr).NotTo(HaveOccurred())
+
+		root, rootErr := sdkRepo.GetRoot()
+		Expect(rootErr).NotTo(HaveOccurred())
+		Expect(root).To(Equal(worktreeDir))
+
+		isWorktree, wtErr := sdkRepo.IsWorktree()
+		Expect(wtErr).NotTo(HaveOccurred())
+		Expect(isWorktree).To(BeTrue())
+	})
+
+	It("should not report the main working tree as a worktree", func() {
+		sdkRepo, openErr := internalgit.OpenRepository(mainRepoDir)
+		Expect(openErr).NotTo(HaveOccurred())
+
+		isWorktree, wtErr := sdkRepo.IsWorktree()
+		Expect(wtErr).NotTo(HaveOccurred())
+		Expect(isWorktree).To(BeFalse())
+	})
 })
@@ -1,5 +1,7 @@
 package git
 
+import "time"
+
 //go:generate mockgen -source=runner.go -destination=runner_mock.go -package=git
 
 // Runner defines the interface for git operations
@@ -16,9 +18,14 @@ type Runner interface {
 	// GetUntrackedFiles returns the list of untracked files
 	GetUntrackedFiles() ([]string, error)
 
-	// GetRepoRoot returns the git repository root directory
+	// GetRepoRoot returns the git repository root directory. In a linked
+	// worktree this is the worktree's own checkout directory
 	GetRepoRoot() (string, error)
 
+	// IsWorktree reports whether the working tree is a linked worktree
+	// created by git worktree add rather than the main working tree
+	IsWorktree() (bool, error)
+
 	// GetRemoteURL returns the URL for the given remote
 	GetRemoteURL(remote string) (string, error)
 
@@ -30,4 +37,27 @@ type Runner interface {
 
 	// GetRemotes returns the list of all remotes with their URLs
 	GetRemotes() (map[string]string, error)
+
+	// GetUpstreamStatus returns the upstream tracking status of the given branch
+	GetUpstreamStatus(branch string) (UpstreamStatus, error)
+
+	// GetStagedDiffStats returns per-file line counts of the staged changes
+	GetStagedDiffStats() ([]DiffStat, error)
+
+	// GetUnpushedCommitSubjects returns the subjects of the commits on the
+	// given branch that are not on its upstream, newest first
+	GetUnpushedCommitSubjects(branch string) ([]string, error)
+
+	// GetCommitDiffs returns the lines added by each non-merge commit in
+	// revRange ("base..head" or a single revision), newest first. A positive
+	// maxCount limits the number of commits
+	GetCommitDiffs(revRange string, maxCount int) ([]CommitDiff, error)
+
+	// GetLastCommitTime returns the committer time of HEAD, or the zero time
+	// if the repository has no commits
+	GetLastCommitTime() (time.Time, error)
+
+	// IsDirty reports whether the repository has staged, modified or
+	// untracked files, the changes git status --porcelain lists
+	IsDirty() (bool, error)
 }
@@ -11,6 +11,7 @@ package git
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -54,6 +55,21 @@ func (mr *MockRunnerMockRecorder) GetBranchRemote(branch any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranchRemote", reflect.TypeOf((*MockRunner)(nil).GetBranchRemote), branch)
 }
 
+// GetCommitDiffs mocks base method.
+func (m *MockRunner) GetCommitDiffs(revRange string, maxCount int) ([]CommitDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitDiffs", revRange, maxCount)
+	ret0, _ := ret[0].([]CommitDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitDiffs indicates an expected call of GetCommitDiffs.
+func (mr *MockRunnerMockRecorder) GetCommitDiffs(revRange, maxCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitDiffs", reflect.TypeOf((*MockRunner)(nil).GetCommitDiffs), revRange, maxCount)
+}
+
 // GetCurrentBranch mocks base method.
 func (m *MockRunner) GetCurrentBranch() (string, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +85,21 @@ func (mr *MockRunnerMockRecorder) GetCurrentBranch() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentBranch", reflect.TypeOf((*MockRunner)(nil).GetCurrentBranch))
 }
 
+// GetLastCommitTime mocks base method.
+func (m *MockRunner) GetLastCommitTime() (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastCommitTime")
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastCommitTime indicates an expected call of GetLastCommitTime.
+func (mr *MockRunnerMockRecorder) GetLastCommitTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastCommitTime", reflect.TypeOf((*MockRunner)(nil).GetLastCommitTime))
+}
+
 // GetModifiedFiles mocks base method.
 func (m *MockRunner) GetModifiedFiles() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +160,21 @@ func (mr *MockRunnerMockRecorder) GetRepoRoot() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoRoot", reflect.TypeOf((*MockRunner)(nil).GetRepoRoot))
 }
 
+// GetStagedDiffStats mocks base method.
+func (m *MockRunner) GetStagedDiffStats() ([]DiffStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStagedDiffStats")
+	ret0, _ := ret[0].([]DiffStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStagedDiffStats indicates an expected call of GetStagedDiffStats.
+func (mr *MockRunnerMockRecorder) GetStagedDiffStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStagedDiffStats", reflect.TypeOf((*MockRunner)(nil).GetStagedDiffStats))
+}
+
 // GetStagedFiles mocks base method.
 func (m *MockRunner) GetStagedFiles() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -144,6 +190,21 @@ func (mr *MockRunnerMockRecorder) GetStagedFiles() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStagedFiles", reflect.TypeOf((*MockRunner)(nil).GetStagedFiles))
 }
 
+// GetUnpushedCommitSubjects mocks base method.
+func (m *MockRunner) GetUnpushedCommitSubjects(branch string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnpushedCommitSubjects", branch)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnpushedCommitSubjects indicates an expected call of GetUnpushedCommitSubjects.
+func (mr *MockRunnerMockRecorder) GetUnpushedCommitSubjects(branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnpushedCommitSubjects", reflect.TypeOf((*MockRunner)(nil).GetUnpushedCommitSubjects), branch)
+}
+
 // GetUntrackedFiles mocks base method.
 func (m *MockRunner) GetUntrackedFiles() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +220,36 @@ func (mr *MockRunnerMockRecorder) GetUntrackedFiles() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUntrackedFiles", reflect.TypeOf((*MockRunner)(nil).GetUntrackedFiles))
 }
 
+// GetUpstreamStatus mocks base method.
+func (m *MockRunner) GetUpstreamStatus(branch string) (UpstreamStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpstreamStatus", branch)
+	ret0, _ := ret[0].(UpstreamStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpstreamStatus indicates an expected call of GetUpstreamStatus.
+func (mr *MockRunnerMockRecorder) GetUpstreamStatus(branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamStatus", reflect.TypeOf((*MockRunner)(nil).GetUpstreamStatus), branch)
+}
+
+// IsDirty mocks base method.
+func (m *MockRunner) IsDirty() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDirty")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDirty indicates an expected call of IsDirty.
+func (mr *MockRunnerMockRecorder) IsDirty() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDirty", reflect.TypeOf((*MockRunner)(nil).IsDirty))
+}
+
 // IsInRepo mocks base method.
 func (m *MockRunner) IsInRepo() bool {
 	m.ctrl.T.Helper()
@@ -172,3 +263,18 @@ func (mr *MockRunnerMockRecorder) IsInRepo() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInRepo", reflect.TypeOf((*MockRunner)(nil).IsInRepo))
 }
+
+// IsWorktree mocks base method.
+func (m *MockRunner) IsWorktree() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorktree")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsWorktree indicates an expected call of IsWorktree.
+func (mr *MockRunnerMockRecorder) IsWorktree() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorktree", reflect.TypeOf((*MockRunner)(nil).IsWorktree))
+}
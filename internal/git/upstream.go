@@ -0,0 +1,291 @@
+package git
+
+import (
+	"container/heap"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/go-git/go-git/v6/plumbing"
+	"github.com/go-git/go-git/v6/plumbing/object"
+)
+
+// UpstreamStatus describes how a branch relates to its upstream tracking branch.
+type UpstreamStatus struct {
+	// HasUpstream is true when the branch tracks an upstream branch that exists.
+	HasUpstream bool
+
+	// Ahead is the number of commits on the branch that are not on the upstream.
+	Ahead int
+
+	// Behind is the number of commits on the upstream that are not on the branch.
+	Behind int
+}
+
+// GetUpstreamStatus returns the upstream tracking status of the given branch.
+// A branch without tracking configuration, or whose upstream ref has not been
+// fetched, yields a zero status and no error. An empty branch name (detached
+// HEAD) also yields a zero status.
+func (r *SDKRepository) GetUpstreamStatus(branch string) (UpstreamStatus, error) {
+	local, upstream, ok, err := r.resolveUpstream(branch)
+	if err != nil || !ok {
+		return UpstreamStatus{}, err
+	}
+
+	ahead, behind, err := r.countAheadBehind(local, upstream)
+	if err != nil {
+		return UpstreamStatus{}, err
+	}
+
+	return UpstreamStatus{HasUpstream: true, Ahead: ahead, Behind: behind}, nil
+}
+
+// GetUnpushedCommitSubjects returns the subject lines of the commits on the
+// given branch that are not on its upstream, newest first (like
+// "git log @{u}..branch"). A branch without an upstream yields no subjects.
+func (r *SDKRepository) GetUnpushedCommitSubjects(branch string) ([]string, error) {
+	local, upstream, ok, err := r.resolveUpstream(branch)
+	if err != nil || !ok || local == upstream {
+		return nil, err
+	}
+
+	commits, err := r.commitsAhead(local, upstream)
+	if err != nil {
+		return nil, err
+	}
+
+	subjects := make([]string, 0, len(commits))
+
+	for _, commit := range commits {
+		subject, _, _ := strings.Cut(strings.TrimSpace(commit.Message), "\n")
+		subjects = append(subjects, subject)
+	}
+
+	return subjects, nil
+}
+
+// commitsAhead returns the commits reachable from local but not from
+// upstream (like "git log upstream..local"), children before parents and
+// newest first among the ready commits, so commits with equal timestamps
+// still come out in history order.
+func (r *SDKRepository) commitsAhead(local, upstream plumbing.Hash) ([]*object.Commit, error) {
+	if local == upstream {
+		return nil, nil
+	}
+
+	sides, err := r.walkAheadBehind(local, upstream)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make(map[plumbing.Hash]*object.Commit)
+	children := make(map[plumbing.Hash]int)
+
+	for hash, side := range sides {
+		if side != sideLocal {
+			continue
+		}
+
+		commit, err := r.repo.CommitObject(hash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load commit %s", hash)
+		}
+
+		commits[hash] = commit
+
+		for _, parentHash := range commit.ParentHashes {
+			children[parentHash]++
+		}
+	}
+
+	// The tip is on the upstream too when the branch is only behind
+	if len(commits) == 0 {
+		return nil, nil
+	}
+
+	queue := &commitQueue{commits[local]}
+	ordered := make([]*object.Commit, 0, len(commits))
+
+	for queue.Len() > 0 {
+		commit, _ := heap.Pop(queue).(*object.Commit)
+		ordered = append(ordered, commit)
+
+		for _, parentHash := range commit.ParentHashes {
+			if _, ok := commits[parentHash]; !ok {
+				continue
+			}
+
+			children[parentHash]--
+
+			if children[parentHash] == 0 {
+				heap.Push(queue, commits[parentHash])
+			}
+		}
+	}
+
+	return ordered, nil
+}
+
+// resolveUpstream returns the tips of the branch and its upstream. ok is
+// false when the branch name is empty, the branch has no tracking
+// configuration, or its upstream ref has not been fetched.
+func (r *SDKRepository) resolveUpstream(
+	branch string,
+) (local, upstream plumbing.Hash, ok bool, err error) {
+	if branch == "" {
+		return plumbing.ZeroHash, plumbing.ZeroHash, false, nil
+	}
+
+	localRef, err := r.repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+	if err != nil {
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			return plumbing.ZeroHash, plumbing.ZeroHash, false,
+				errors.Wrapf(ErrBranchNotFound, "branch %q", branch)
+		}
+
+		return plumbing.ZeroHash, plumbing.ZeroHash, false,
+			errors.Wrap(err, "failed to lookup branch")
+	}
+
+	cfg, err := r.repo.Config()
+	if err != nil {
+		return plumbing.ZeroHash, plumbing.ZeroHash, false,
+			errors.Wrap(err, "failed to get config")
+	}
+
+	branchCfg, found := cfg.Branches[branch]
+	if !found || branchCfg.Remote == "" || branchCfg.Merge == "" {
+		return plumbing.ZeroHash, plumbing.ZeroHash, false, nil
+	}
+
+	upstreamRef, err := r.repo.Reference(upstreamRefName(branchCfg.Remote, branchCfg.Merge), true)
+	if err != nil {
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			return plumbing.ZeroHash, plumbing.ZeroHash, false, nil
+		}
+
+		return plumbing.ZeroHash, plumbing.ZeroHash, false,
+			errors.Wrap(err, "failed to lookup upstream")
+	}
+
+	return localRef.Hash(), upstreamRef.Hash(), true, nil
+}
+
+// upstreamRefName maps a branch's tracking configuration to the local ref
+// holding the upstream commit. A remote of "." tracks a local branch.
+func upstreamRefName(remote string, merge plumbing.ReferenceName) plumbing.ReferenceName {
+	if remote == "." {
+		return merge
+	}
+
+	return plumbing.NewRemoteReferenceName(remote, merge.Short())
+}
+
+const (
+	sideLocal uint8 = 1 << iota
+	sideUpstream
+
+	sideBoth = sideLocal | sideUpstream
+)
+
+// countAheadBehind counts commits reachable from only one of the two tips.
+func (r *SDKRepository) countAheadBehind(local, upstream plumbing.Hash) (int, int, error) {
+	if local == upstream {
+		return 0, 0, nil
+	}
+
+	sides, err := r.walkAheadBehind(local, upstream)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var ahead, behind int
+
+	for _, side := range sides {
+		switch side {
+		case sideLocal:
+			ahead++
+		case sideUpstream:
+			behind++
+		}
+	}
+
+	return ahead, behind, nil
+}
+
+// walkAheadBehind marks each commit above the merge base with the tips it is
+// reachable from. Both histories are walked together, newest first, and the
+// walk stops once every queued commit is reachable from both sides, i.e.
+// below the merge base.
+func (r *SDKRepository) walkAheadBehind(
+	local, upstream plumbing.Hash,
+) (map[plumbing.Hash]uint8, error) {
+	sides := map[plumbing.Hash]uint8{local: sideLocal, upstream: sideUpstream}
+	queue := &commitQueue{}
+
+	for _, hash := range []plumbing.Hash{local, upstream} {
+		commit, err := r.repo.CommitObject(hash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load commit %s", hash)
+		}
+
+		heap.Push(queue, commit)
+	}
+
+	for queue.Len() > 0 && !queue.stale(sides) {
+		commit, _ := heap.Pop(queue).(*object.Commit)
+		side := sides[commit.Hash]
+
+		for _, parentHash := range commit.ParentHashes {
+			if sides[parentHash]|side == sides[parentHash] {
+				continue
+			}
+
+			sides[parentHash] |= side
+
+			parent, err := r.repo.CommitObject(parentHash)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to load commit %s", parentHash)
+			}
+
+			heap.Push(queue, parent)
+		}
+	}
+
+	return sides, nil
+}
+
+// commitQueue is a max-heap of commits ordered by committer time.
+type commitQueue []*object.Commit
+
+func (q commitQueue) Len() int { return len(q) }
+
+func (q commitQueue) Less(i, j int) bool {
+	return q[i].Committer.When.After(q[j].Committer.When)
+}
+
+func (q commitQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *commitQueue) Push(x any) {
+	commit, _ := x.(*object.Commit)
+	*q = append(*q, commit)
+}
+
+func (q *commitQueue) Pop() any {
+	old := *q
+	n := len(old)
+	commit := old[n-1]
+	*q = old[:n-1]
+
+	return commit
+}
+
+// stale reports whether every queued commit is reachable from both tips.
+func (q commitQueue) stale(sides map[plumbing.Hash]uint8) bool {
+	for _, commit := range q {
+		if sides[commit.Hash] != sideBoth {
+			return false
+		}
+	}
+
+	return true
+}
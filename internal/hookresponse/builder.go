@@ -1,14 +1,20 @@
 package hookresponse
 
 import (
+	"strings"
+
 	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 )
 
 // Build constructs a HookResponse from validation errors.
 // Returns nil when there are no errors (clean pass, no output needed).
-func Build(eventName string, errs []*dispatcher.ValidationError) *HookResponse {
-	return BuildWithPatterns(eventName, errs, nil)
+func Build(
+	eventName string,
+	errs []*dispatcher.ValidationError,
+	opts ...Option,
+) *HookResponse {
+	return BuildWithPatterns(eventName, errs, nil, opts...)
 }
 
 // BuildWithPatterns constructs a HookResponse with optional pattern warnings.
@@ -17,7 +23,9 @@ func BuildWithPatterns(
 	eventName string,
 	errs []*dispatcher.ValidationError,
 	patternWarnings []string,
+	opts ...Option,
 ) *HookResponse {
+	errs = dispatcher.Reported(errs, newOptions(opts).verbose)
 	if len(errs) == 0 {
 		return nil
 	}
@@ -25,7 +33,7 @@ func BuildWithPatterns(
 	blocking, warnings, bypassed := categorize(errs)
 
 	resp := &HookResponse{
-		SystemMessage: FormatSystemMessage(errs),
+		SystemMessage: FormatSystemMessage(errs, opts...),
 	}
 
 	switch {
@@ -64,27 +72,29 @@ func BuildForContext(
 	hookCtx *hook.Context,
 	errs []*dispatcher.ValidationError,
 	patternWarnings []string,
+	opts ...Option,
 ) any {
+	errs = dispatcher.Reported(errs, newOptions(opts).verbose)
 	if len(errs) == 0 {
 		return nil
 	}
 
 	if hookCtx != nil && hookCtx.Provider == hook.ProviderCodex {
-		return BuildCodex(hookCtx, errs, patternWarnings)
+		return BuildCodex(hookCtx, errs, patternWarnings, opts...)
 	}
 
 	if hookCtx != nil && hookCtx.Provider == hook.ProviderGemini {
-		return BuildGemini(hookCtx, errs, patternWarnings)
+		return BuildGemini(hookCtx, errs, patternWarnings, opts...)
 	}
 
 	if hookCtx != nil && hookCtx.IsElicitationEvent() {
-		return BuildElicitation(hookCtx, errs, patternWarnings)
+		return BuildElicitation(hookCtx, errs, patternWarnings, opts...)
 	}
 
 	if hookCtx != nil &&
 		hookCtx.Provider == hook.ProviderClaude &&
 		hookCtx.Event == hook.CanonicalEventAfterTool {
-		return BuildClaudeAfterTool(hookCtx, errs, patternWarnings)
+		return BuildClaudeAfterTool(hookCtx, errs, patternWarnings, opts...)
 	}
 
 	eventName := ""
@@ -92,14 +102,23 @@ func BuildForContext(
 		eventName = hookCtx.EventName()
 	}
 
-	return BuildWithPatterns(eventName, errs, patternWarnings)
+	resp := BuildWithPatterns(eventName, errs, patternWarnings, opts...)
+
+	if hookCtx != nil && hookCtx.Event == hook.CanonicalEventBeforeTool {
+		applyUpdatedInput(resp, hookCtx, errs)
+	}
+
+	return resp
 }
 
-// BuildClaudeAfterTool constructs a Claude PostToolUse response.
+// BuildClaudeAfterTool constructs a Claude PostToolUse response. With
+// WithPostToolUseSummary the additionalContext starts with a summary of the
+// findings.
 func BuildClaudeAfterTool(
 	hookCtx *hook.Context,
 	errs []*dispatcher.ValidationError,
 	patternWarnings []string,
+	opts ...Option,
 ) *HookResponse {
 	if len(errs) == 0 {
 		return nil
@@ -107,8 +126,15 @@ func BuildClaudeAfterTool(
 
 	blocking, warnings, bypassed := categorize(errs)
 	additionalContext := formatAdditionalContext(blocking, warnings, bypassed, patternWarnings)
+
+	if newOptions(opts).summary {
+		additionalContext = strings.TrimSpace(
+			formatAfterToolSummary(hookCtx, errs) + " " + additionalContext,
+		)
+	}
+
 	resp := &HookResponse{
-		SystemMessage: FormatSystemMessage(errs),
+		SystemMessage: FormatSystemMessage(errs, opts...),
 	}
 
 	if len(blocking) > 0 {
@@ -131,6 +157,7 @@ func BuildCodex(
 	hookCtx *hook.Context,
 	errs []*dispatcher.ValidationError,
 	patternWarnings []string,
+	opts ...Option,
 ) *CodexCommandResponse {
 	if len(errs) == 0 {
 		return nil
@@ -141,7 +168,7 @@ func BuildCodex(
 
 	resp := &CodexCommandResponse{
 		Continue:      true,
-		SystemMessage: FormatSystemMessage(errs),
+		SystemMessage: FormatSystemMessage(errs, opts...),
 	}
 
 	switch hookCtx.Event {
@@ -196,6 +223,7 @@ func BuildGemini(
 	hookCtx *hook.Context,
 	errs []*dispatcher.ValidationError,
 	patternWarnings []string,
+	opts ...Option,
 ) *GeminiCommandResponse {
 	if len(errs) == 0 {
 		return nil
@@ -205,7 +233,7 @@ func BuildGemini(
 	additionalContext := formatAdditionalContext(blocking, warnings, bypassed, patternWarnings)
 
 	resp := &GeminiCommandResponse{
-		SystemMessage: FormatSystemMessage(errs),
+		SystemMessage: FormatSystemMessage(errs, opts...),
 	}
 
 	switch hookCtx.Event {
@@ -257,6 +285,7 @@ func BuildElicitation(
 	_ *hook.Context,
 	errs []*dispatcher.ValidationError,
 	_ []string,
+	opts ...Option,
 ) *ElicitationHookResponse {
 	if len(errs) == 0 {
 		return nil
@@ -269,11 +298,12 @@ func BuildElicitation(
 
 	return &ElicitationHookResponse{
 		Action:        "decline",
-		SystemMessage: FormatSystemMessage(errs),
+		SystemMessage: FormatSystemMessage(errs, opts...),
 	}
 }
 
 // categorize splits errors into blocking, warnings, and bypassed.
+// Informational errors belong to none of them.
 func categorize(errs []*dispatcher.ValidationError) (
 	blocking, warnings, bypassed []*dispatcher.ValidationError,
 ) {
@@ -283,6 +313,7 @@ func categorize(errs []*dispatcher.ValidationError) (
 			bypassed = append(bypassed, e)
 		case e.ShouldBlock:
 			blocking = append(blocking, e)
+		case e.Informational:
 		default:
 			warnings = append(warnings, e)
 		}
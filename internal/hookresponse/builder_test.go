@@ -395,4 +395,238 @@ var _ = Describe("Build", func() {
 		Expect(claudeResp.HookSpecificOutput.AdditionalContext).To(ContainSubstring("Fix ALL"))
 		Expect(claudeResp.HookSpecificOutput.PermissionDecision).To(BeEmpty())
 	})
+
+	Describe("PostToolUse summary", func() {
+		errs := []*dispatcher.ValidationError{
+			{
+				Validator:   "validate-markdown",
+				Message:     "Markdown formatting errors",
+				ShouldBlock: true,
+				Reference:   validator.RefMarkdownLint,
+				Details:     map[string]string{"errors": "Line 1: heading\nLine 4: list\n"},
+			},
+			{
+				Validator: "validate-secrets",
+				Message:   "Possible secret",
+			},
+		}
+		hookContext := func(rawEventName string, event hook.CanonicalEvent) *hook.Context {
+			return &hook.Context{
+				Provider:     hook.ProviderClaude,
+				Event:        event,
+				RawEventName: rawEventName,
+				ToolName:     hook.ToolTypeWrite,
+				ToolInput:    hook.ToolInput{FilePath: "README.md"},
+			}
+		}
+
+		It("starts additionalContext with a per-validator summary", func() {
+			resp := hookresponse.BuildForContext(
+				hookContext("PostToolUse", hook.CanonicalEventAfterTool),
+				errs,
+				nil,
+				hookresponse.WithPostToolUseSummary(true),
+			)
+
+			data, err := json.Marshal(resp)
+			Expect(err).NotTo(HaveOccurred())
+
+			var payload struct {
+				Decision           string `json:"decision"`
+				HookSpecificOutput struct {
+					HookEventName     string `json:"hookEventName"`
+					AdditionalContext string `json:"additionalContext"`
+				} `json:"hookSpecificOutput"`
+			}
+			Expect(json.Unmarshal(data, &payload)).To(Succeed())
+			Expect(payload.Decision).To(Equal("block"))
+			Expect(payload.HookSpecificOutput.HookEventName).To(Equal("PostToolUse"))
+			Expect(payload.HookSpecificOutput.AdditionalContext).To(HavePrefix(
+				"klaudiush found 3 issues after Write on README.md: markdown (2), secrets (1). ",
+			))
+			Expect(payload.HookSpecificOutput.AdditionalContext).To(ContainSubstring("Fix ALL"))
+		})
+
+		It("is omitted unless enabled", func() {
+			resp := hookresponse.BuildForContext(
+				hookContext("PostToolUse", hook.CanonicalEventAfterTool),
+				errs,
+				nil,
+			)
+
+			claudeResp, ok := resp.(*hookresponse.HookResponse)
+			Expect(ok).To(BeTrue())
+			Expect(claudeResp.HookSpecificOutput.AdditionalContext).
+				NotTo(ContainSubstring("klaudiush found"))
+		})
+
+		It("leaves PreToolUse responses unchanged", func() {
+			resp := hookresponse.BuildForContext(
+				hookContext("PreToolUse", hook.CanonicalEventBeforeTool),
+				errs,
+				nil,
+				hookresponse.WithPostToolUseSummary(true),
+			)
+
+			claudeResp, ok := resp.(*hookresponse.HookResponse)
+			Expect(ok).To(BeTrue())
+			Expect(claudeResp.HookSpecificOutput.PermissionDecision).To(Equal("deny"))
+			Expect(claudeResp.HookSpecificOutput.AdditionalContext).
+				NotTo(ContainSubstring("klaudiush found"))
+		})
+	})
+
+	Describe("info findings", func() {
+		info := &dispatcher.ValidationError{
+			Validator:     "git.commit",
+			Message:       "Missing -s flag",
+			Informational: true,
+			Reference:     validator.RefGitNoSignoff,
+		}
+		warning := &dispatcher.ValidationError{
+			Validator: "file.markdown",
+			Message:   "Trailing whitespace",
+		}
+
+		It("returns nil when only info findings are reported", func() {
+			Expect(hookresponse.Build("PreToolUse", []*dispatcher.ValidationError{info})).
+				To(BeNil())
+		})
+
+		It("leaves info findings out of warnings", func() {
+			resp := hookresponse.Build("PreToolUse", []*dispatcher.ValidationError{info, warning})
+			Expect(resp).NotTo(BeNil())
+			Expect(resp.HookSpecificOutput.PermissionDecision).To(Equal("allow"))
+			Expect(resp.HookSpecificOutput.AdditionalContext).To(ContainSubstring("Trailing"))
+			Expect(resp.HookSpecificOutput.AdditionalContext).NotTo(ContainSubstring("-s flag"))
+			Expect(resp.SystemMessage).NotTo(ContainSubstring("GIT001"))
+		})
+
+		It("shows info findings in the system message when verbose", func() {
+			resp := hookresponse.Build(
+				"PreToolUse",
+				[]*dispatcher.ValidationError{info},
+				hookresponse.WithVerbose(true),
+			)
+			Expect(resp).NotTo(BeNil())
+			Expect(resp.SystemMessage).To(ContainSubstring("\u2139\ufe0f GIT001: Missing -s flag"))
+			Expect(resp.SystemMessage).NotTo(ContainSubstring("klaudiush disable"))
+			Expect(resp.HookSpecificOutput).To(BeNil())
+		})
+	})
+
+	Describe("updatedInput", func() {
+		beforeTool := func(command string) *hook.Context {
+			return &hook.Context{
+				Provider:     hook.ProviderClaude,
+				Event:        hook.CanonicalEventBeforeTool,
+				RawEventName: "PreToolUse",
+				ToolInput: hook.ToolInput{
+					Command: command,
+					Additional: map[string]json.RawMessage{
+						"description": json.RawMessage(`"Push"`),
+						"timeout":     json.RawMessage(`60000`),
+					},
+				},
+			}
+		}
+
+		rewrite := &dispatcher.ValidationError{
+			Validator: "git.push",
+			Message:   "Using --force-with-lease",
+			UpdatedInput: map[string]string{
+				"command": "git push --force-with-lease",
+			},
+		}
+
+		It("sends the whole tool input with the rewritten field", func() {
+			resp := hookresponse.BuildForContext(
+				beforeTool("git push --force"),
+				[]*dispatcher.ValidationError{rewrite},
+				nil,
+			)
+
+			claudeResp, ok := resp.(*hookresponse.HookResponse)
+			Expect(ok).To(BeTrue())
+			Expect(claudeResp.HookSpecificOutput.PermissionDecision).To(Equal("allow"))
+			Expect(claudeResp.HookSpecificOutput.UpdatedInput).To(Equal(map[string]any{
+				"command":     "git push --force-with-lease",
+				"description": "Push",
+				"timeout":     float64(60000),
+			}))
+		})
+
+		It("is omitted when another validator blocks", func() {
+			resp := hookresponse.BuildForContext(
+				beforeTool("git push --force"),
+				[]*dispatcher.ValidationError{rewrite, {
+					Validator:   "git.push",
+					Message:     "protected branch",
+					ShouldBlock: true,
+				}},
+				nil,
+			)
+
+			claudeResp, ok := resp.(*hookresponse.HookResponse)
+			Expect(ok).To(BeTrue())
+			Expect(claudeResp.HookSpecificOutput.PermissionDecision).To(Equal("deny"))
+			Expect(claudeResp.HookSpecificOutput.UpdatedInput).To(BeNil())
+
+			data, err := json.Marshal(claudeResp)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).NotTo(ContainSubstring("updatedInput"))
+		})
+
+		It("applies identical rewrites of the same field once", func() {
+			resp := hookresponse.BuildForContext(
+				beforeTool("git push --force"),
+				[]*dispatcher.ValidationError{rewrite, rewrite},
+				nil,
+			)
+
+			claudeResp, ok := resp.(*hookresponse.HookResponse)
+			Expect(ok).To(BeTrue())
+			Expect(claudeResp.HookSpecificOutput.UpdatedInput).To(
+				HaveKeyWithValue("command", "git push --force-with-lease"),
+			)
+			Expect(claudeResp.SystemMessage).NotTo(ContainSubstring("conflicting rewrites"))
+		})
+
+		It("leaves a field rewritten to different values unchanged and says so", func() {
+			resp := hookresponse.BuildForContext(
+				beforeTool("git push --force"),
+				[]*dispatcher.ValidationError{rewrite, {
+					Validator: "shell",
+					Message:   "Adding --dry-run",
+					UpdatedInput: map[string]string{
+						"command": "git push --force --dry-run",
+					},
+				}},
+				nil,
+			)
+
+			claudeResp, ok := resp.(*hookresponse.HookResponse)
+			Expect(ok).To(BeTrue())
+			Expect(claudeResp.HookSpecificOutput.PermissionDecision).To(Equal("allow"))
+			Expect(claudeResp.HookSpecificOutput.UpdatedInput).To(BeNil())
+			Expect(claudeResp.SystemMessage).To(
+				ContainSubstring("klaudiush: conflicting rewrites of command were not applied."),
+			)
+			Expect(claudeResp.HookSpecificOutput.AdditionalContext).To(
+				HaveSuffix("klaudiush: conflicting rewrites of command were not applied."),
+			)
+		})
+
+		It("is omitted without rewrites", func() {
+			resp := hookresponse.BuildForContext(
+				beforeTool("git push"),
+				[]*dispatcher.ValidationError{{Validator: "git.push", Message: "heads up"}},
+				nil,
+			)
+
+			claudeResp, ok := resp.(*hookresponse.HookResponse)
+			Expect(ok).To(BeTrue())
+			Expect(claudeResp.HookSpecificOutput.UpdatedInput).To(BeNil())
+		})
+	})
 })
@@ -1,6 +1,8 @@
 package hookresponse
 
 import (
+	"maps"
+	"slices"
 	"strings"
 	"unicode"
 
@@ -141,7 +143,11 @@ func truncateTableSuggestion(suggestion string) string {
 
 // FormatSystemMessage builds the human-readable message shown in the UI.
 // This replaces the old FormatErrors function in the dispatcher package.
-func FormatSystemMessage(errs []*dispatcher.ValidationError) string {
+// Informational errors are included only when verbose.
+func FormatSystemMessage(errs []*dispatcher.ValidationError, opts ...Option) string {
+	o := newOptions(opts)
+
+	errs = dispatcher.Reported(errs, o.verbose)
 	if len(errs) == 0 {
 		return ""
 	}
@@ -149,7 +155,7 @@ func FormatSystemMessage(errs []*dispatcher.ValidationError) string {
 	var b strings.Builder
 
 	for _, e := range errs {
-		formatSingleError(&b, e)
+		formatSingleError(&b, e, o.verbose)
 	}
 
 	// Append disable hint for blocking error codes
@@ -186,11 +192,16 @@ func FormatSystemMessage(errs []*dispatcher.ValidationError) string {
 }
 
 // formatSingleError writes one error entry with compact, non-duplicating format.
-func formatSingleError(b *strings.Builder, e *dispatcher.ValidationError) {
+// Plugin details are rendered as key: value lines when verbose is set, in
+// place of their plain values.
+func formatSingleError(b *strings.Builder, e *dispatcher.ValidationError, verbose bool) {
 	code := extractCode(e.Reference)
 	emoji := "\u274c"
 
-	if !e.ShouldBlock {
+	switch {
+	case e.Informational:
+		emoji = "\u2139\ufe0f"
+	case !e.ShouldBlock:
 		emoji = "\u26a0\ufe0f"
 	}
 
@@ -220,14 +231,19 @@ func formatSingleError(b *strings.Builder, e *dispatcher.ValidationError) {
 		b.WriteString("\n")
 	}
 
-	// Details (supplementary only - skip keys rendered elsewhere)
+	// Details (supplementary only - skip keys rendered elsewhere), in key
+	// order so that e.g. errors come before their snippets
 	if len(e.Details) > 0 {
-		for k, v := range e.Details {
+		for _, k := range slices.Sorted(maps.Keys(e.Details)) {
 			if k == "suggested_table" || k == "commit_preview" || k == "all_codes" {
 				continue
 			}
 
-			trimmed := strings.TrimSpace(v)
+			if _, ok := e.PluginDetails[k]; ok && verbose {
+				continue
+			}
+
+			trimmed := strings.TrimSpace(e.Details[k])
 			if trimmed != "" {
 				b.WriteString("\n")
 				b.WriteString(trimmed)
@@ -236,6 +252,18 @@ func formatSingleError(b *strings.Builder, e *dispatcher.ValidationError) {
 		}
 	}
 
+	if verbose && len(e.PluginDetails) > 0 {
+		b.WriteString("  Details:\n")
+
+		for _, k := range slices.Sorted(maps.Keys(e.PluginDetails)) {
+			b.WriteString("    ")
+			b.WriteString(k)
+			b.WriteString(": ")
+			b.WriteString(strings.TrimSpace(e.PluginDetails[k]))
+			b.WriteString("\n")
+		}
+	}
+
 	b.WriteString("\n")
 }
 
@@ -35,6 +35,39 @@ var _ = Describe("FormatSystemMessage", func() {
 		Expect(result).To(ContainSubstring("Wrong for your workflow? klaudiush disable GIT001"))
 	})
 
+	Context("with plugin details", func() {
+		errs := []*dispatcher.ValidationError{
+			{
+				Validator:   "plugin:branch-guard",
+				Message:     "push to protected branch",
+				ShouldBlock: true,
+				Details: map[string]string{
+					"rule":   "protected",
+					"branch": "main",
+				},
+				PluginDetails: map[string]string{
+					"rule":   "protected",
+					"branch": "main",
+				},
+			},
+		}
+
+		It("renders plugin detail values by default", func() {
+			result := hookresponse.FormatSystemMessage(errs)
+			Expect(result).To(ContainSubstring("\nmain\n"))
+			Expect(result).To(ContainSubstring("\nprotected\n"))
+			Expect(result).NotTo(ContainSubstring("Details:"))
+		})
+
+		It("renders sorted plugin details by key instead when verbose", func() {
+			result := hookresponse.FormatSystemMessage(errs, hookresponse.WithVerbose(true))
+			Expect(result).To(ContainSubstring(
+				"  Details:\n    branch: main\n    rule: protected\n",
+			))
+			Expect(result).NotTo(ContainSubstring("\nmain\n"))
+		})
+	})
+
 	It("formats warnings with warning emoji header", func() {
 		errs := []*dispatcher.ValidationError{
 			{
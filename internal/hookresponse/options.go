@@ -0,0 +1,35 @@
+package hookresponse
+
+// Option configures how hook responses are rendered.
+type Option func(*options)
+
+type options struct {
+	verbose bool
+	summary bool
+}
+
+// WithVerbose renders plugin-provided details and informational findings in
+// the system message.
+func WithVerbose(verbose bool) Option {
+	return func(o *options) {
+		o.verbose = verbose
+	}
+}
+
+// WithPostToolUseSummary prepends a summary of the findings to the
+// additionalContext of Claude PostToolUse responses.
+func WithPostToolUseSummary(enabled bool) Option {
+	return func(o *options) {
+		o.summary = enabled
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
@@ -11,10 +11,11 @@ type HookResponse struct {
 
 // HookSpecificOutput carries the permission decision and context for Claude.
 type HookSpecificOutput struct {
-	HookEventName            string `json:"hookEventName"`
-	PermissionDecision       string `json:"permissionDecision"`                 // "allow" or "deny"
-	PermissionDecisionReason string `json:"permissionDecisionReason,omitempty"` // shown to Claude
-	AdditionalContext        string `json:"additionalContext,omitempty"`        // behavioral framing for Claude
+	HookEventName            string         `json:"hookEventName"`
+	PermissionDecision       string         `json:"permissionDecision"`                 // "allow" or "deny"
+	PermissionDecisionReason string         `json:"permissionDecisionReason,omitempty"` // shown to Claude
+	AdditionalContext        string         `json:"additionalContext,omitempty"`        // behavioral framing for Claude
+	UpdatedInput             map[string]any `json:"updatedInput,omitempty"`             // rewritten tool input
 }
 
 // CodexCommandResponse is the top-level JSON structure for Codex command hooks.
@@ -0,0 +1,81 @@
+package hookresponse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+// formatAfterToolSummary summarizes findings for a PostToolUse response, e.g.
+// "klaudiush found 3 issues after Write on README.md: markdown (2), secrets (1)."
+// Validators are listed in the order they reported.
+func formatAfterToolSummary(hookCtx *hook.Context, errs []*dispatcher.ValidationError) string {
+	if len(errs) == 0 {
+		return ""
+	}
+
+	var (
+		names  []string
+		counts = make(map[string]int)
+		total  int
+	)
+
+	for _, e := range errs {
+		name := strings.TrimPrefix(e.Validator, "validate-")
+		if _, seen := counts[name]; !seen {
+			names = append(names, name)
+		}
+
+		n := issueCount(e)
+		counts[name] += n
+		total += n
+	}
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s (%d)", name, counts[name]))
+	}
+
+	noun := "issues"
+	if total == 1 {
+		noun = "issue"
+	}
+
+	return fmt.Sprintf("klaudiush found %d %s%s: %s.",
+		total, noun, afterToolTarget(hookCtx), strings.Join(parts, ", "))
+}
+
+// afterToolTarget describes the tool call a summary refers to, e.g.
+// " after Write on README.md". Empty without a tool name.
+func afterToolTarget(hookCtx *hook.Context) string {
+	if hookCtx == nil {
+		return ""
+	}
+
+	tool := hookCtx.ToolNameString()
+	if tool == "" {
+		return ""
+	}
+
+	if filePath := hookCtx.GetFilePath(); filePath != "" {
+		return " after " + tool + " on " + filePath
+	}
+
+	return " after " + tool
+}
+
+// issueCount returns how many issues a finding stands for. Linters list one
+// issue per line in the "errors" detail; other findings count once.
+func issueCount(e *dispatcher.ValidationError) int {
+	n := 0
+
+	for line := range strings.SplitSeq(e.Details["errors"], "\n") {
+		if strings.TrimSpace(line) != "" {
+			n++
+		}
+	}
+
+	return max(n, 1)
+}
@@ -0,0 +1,66 @@
+package hookresponse
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+// applyUpdatedInput sets updatedInput on an allowing PreToolUse response when
+// a validator rewrote tool input fields. Claude replaces the whole tool input
+// with updatedInput, so the original input is sent with the fields replaced.
+// A field rewritten to different values by several findings is left
+// unchanged, and the response says so.
+func applyUpdatedInput(
+	resp *HookResponse,
+	hookCtx *hook.Context,
+	errs []*dispatcher.ValidationError,
+) {
+	if resp == nil || resp.HookSpecificOutput == nil ||
+		resp.HookSpecificOutput.PermissionDecision != "allow" {
+		return
+	}
+
+	updates, conflicts := dispatcher.CollectUpdates(errs)
+
+	if len(conflicts) > 0 {
+		notice := "klaudiush: conflicting rewrites of " + strings.Join(conflicts, ", ") +
+			" were not applied."
+		resp.SystemMessage = strings.TrimRight(resp.SystemMessage, "\n") + "\n\n" + notice + "\n"
+		resp.HookSpecificOutput.AdditionalContext = strings.TrimSpace(
+			resp.HookSpecificOutput.AdditionalContext + " " + notice,
+		)
+	}
+
+	if len(updates) == 0 {
+		return
+	}
+
+	input := toolInputMap(hookCtx.ToolInput)
+	for field, value := range updates {
+		input[field] = value
+	}
+
+	resp.HookSpecificOutput.UpdatedInput = input
+}
+
+// toolInputMap returns the tool input as the JSON object Claude sent,
+// including fields klaudiush does not parse.
+func toolInputMap(toolInput hook.ToolInput) map[string]any {
+	input := make(map[string]any)
+
+	for key, raw := range toolInput.Additional {
+		var value any
+		if err := json.Unmarshal(raw, &value); err == nil {
+			input[key] = value
+		}
+	}
+
+	if data, err := json.Marshal(toolInput); err == nil {
+		_ = json.Unmarshal(data, &input)
+	}
+
+	return input
+}
@@ -43,6 +43,7 @@ type finding struct {
 	ShouldBlock   bool              `json:"should_block"`
 	Reference     string            `json:"reference,omitempty"`
 	FixHint       string            `json:"fix_hint,omitempty"`
+	PluginDetails map[string]string `json:"plugin_details,omitempty"`
 	Bypassed      bool              `json:"bypassed,omitempty"`
 	BypassReason  string            `json:"bypass_reason,omitempty"`
 	Event         string            `json:"event,omitempty"`
@@ -212,14 +213,15 @@ func (s *Store) CombinedErrors(
 		}
 
 		combined = append(combined, &dispatcher.ValidationError{
-			Validator:    item.Validator,
-			Message:      item.Message,
-			Details:      details,
-			ShouldBlock:  item.ShouldBlock,
-			Reference:    validator.Reference(item.Reference),
-			FixHint:      item.FixHint,
-			Bypassed:     item.Bypassed,
-			BypassReason: item.BypassReason,
+			Validator:     item.Validator,
+			Message:       item.Message,
+			Details:       details,
+			ShouldBlock:   item.ShouldBlock,
+			Reference:     validator.Reference(item.Reference),
+			FixHint:       item.FixHint,
+			PluginDetails: cloneDetails(item.PluginDetails),
+			Bypassed:      item.Bypassed,
+			BypassReason:  item.BypassReason,
 		})
 	}
 
@@ -377,17 +379,18 @@ func findingFromValidationError(
 	now time.Time,
 ) *finding {
 	item := &finding{
-		Validator:    verr.Validator,
-		Message:      verr.Message,
-		Details:      cloneDetails(verr.Details),
-		ShouldBlock:  verr.ShouldBlock,
-		Reference:    string(verr.Reference),
-		FixHint:      verr.FixHint,
-		Bypassed:     verr.Bypassed,
-		BypassReason: verr.BypassReason,
-		Count:        1,
-		FirstSeen:    now,
-		LastSeen:     now,
+		Validator:     verr.Validator,
+		Message:       verr.Message,
+		Details:       cloneDetails(verr.Details),
+		ShouldBlock:   verr.ShouldBlock,
+		Reference:     string(verr.Reference),
+		FixHint:       verr.FixHint,
+		PluginDetails: cloneDetails(verr.PluginDetails),
+		Bypassed:      verr.Bypassed,
+		BypassReason:  verr.BypassReason,
+		Count:         1,
+		FirstSeen:     now,
+		LastSeen:      now,
 	}
 
 	if hookCtx != nil {
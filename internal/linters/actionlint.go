@@ -21,7 +21,8 @@ type ActionLinter interface {
 
 // RealActionLinter implements ActionLinter using the actionlint CLI tool
 type RealActionLinter struct {
-	linter *ContentLinter
+	linter   *ContentLinter
+	toolPath string
 }
 
 // NewActionLinter creates a new RealActionLinter
@@ -31,6 +32,15 @@ func NewActionLinter(runner execpkg.CommandRunner) *RealActionLinter {
 	}
 }
 
+// NewActionLinterWithPath creates a RealActionLinter that invokes the actionlint
+// binary at toolPath. An empty toolPath falls back to a PATH lookup.
+func NewActionLinterWithPath(runner execpkg.CommandRunner, toolPath string) *RealActionLinter {
+	return &RealActionLinter{
+		linter:   NewContentLinter(runner),
+		toolPath: toolPath,
+	}
+}
+
 // NewActionLinterWithDeps creates a RealActionLinter with a custom ContentLinter (for testing).
 func NewActionLinterWithDeps(linter *ContentLinter) *RealActionLinter {
 	return &RealActionLinter{
@@ -42,7 +52,7 @@ func NewActionLinterWithDeps(linter *ContentLinter) *RealActionLinter {
 func (a *RealActionLinter) Lint(ctx context.Context, content string, _ string) *LintResult {
 	return a.linter.LintContent(
 		ctx,
-		"actionlint",
+		toolOrDefault(a.toolPath, "actionlint"),
 		"workflow-*.yml",
 		content,
 		parseActionlintOutput,
@@ -2,6 +2,7 @@ package linters_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	. "github.com/onsi/ginkgo/v2"
@@ -151,4 +152,20 @@ jobs:
 			})
 		})
 	})
+	Describe("NewActionLinterWithPath", func() {
+		It("invokes the binary at the configured path", func() {
+			toolPath := writeFakeTool(
+				"custom-actionlint",
+				`echo "workflow.yml:4:9: fake finding [syntax-check]"; exit 1`,
+			)
+
+			linter := linters.NewActionLinterWithPath(execpkg.NewCommandRunner(5*time.Second), toolPath)
+			result := linter.Lint(context.Background(), "on: push\n", "workflow.yml")
+
+			Expect(result.Success).To(BeFalse())
+			Expect(result.Findings).To(HaveLen(1))
+			Expect(result.Findings[0].Rule).To(Equal("syntax-check"))
+			Expect(result.Findings[0].Message).To(Equal("fake finding"))
+		})
+	})
 })
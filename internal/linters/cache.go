@@ -0,0 +1,382 @@
+package linters
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	execpkg "github.com/smykla-skalski/klaudiush/internal/exec"
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+	"github.com/smykla-skalski/klaudiush/internal/xdg"
+)
+
+const (
+	cacheFileMode   = 0o600
+	cacheFileSuffix = ".json"
+)
+
+// toolConfigFiles lists, by tool name, the config files each tool looks up
+// from the working directory and its parents. Their contents are part of
+// the cache key, so editing them invalidates cached results.
+var toolConfigFiles = map[string][]string{
+	"actionlint": {".github/actionlint.yaml", ".github/actionlint.yml"},
+	"gofumpt":    {"go.mod"},
+	"markdownlint": {
+		".markdownlint.json",
+		".markdownlint.jsonc",
+		".markdownlint.yaml",
+		".markdownlint.yml",
+		".markdownlintrc",
+	},
+	"markdownlint-cli2": {
+		".markdownlint-cli2.jsonc",
+		".markdownlint-cli2.yaml",
+		".markdownlint.json",
+		".markdownlint.jsonc",
+		".markdownlint.yaml",
+		".markdownlint.yml",
+	},
+	"oxlint":     {".oxlintrc.json"},
+	"ruff":       {"ruff.toml", ".ruff.toml", "pyproject.toml"},
+	"rustfmt":    {"rustfmt.toml", ".rustfmt.toml"},
+	"shellcheck": {".shellcheckrc", "shellcheckrc"},
+	"tflint":     {".tflint.hcl"},
+}
+
+// ResultCache stores linter command results by key.
+type ResultCache interface {
+	// Get returns the result stored under key, if any.
+	Get(key string) (execpkg.CommandResult, bool)
+
+	// Put stores result under key. Failures are ignored; the cache is an
+	// optimization only.
+	Put(key string, result execpkg.CommandResult)
+}
+
+// cacheEntry is the on-disk form of a cached CommandResult.
+type cacheEntry struct {
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+	ExitCode int    `json:"exit_code"`
+}
+
+// FileResultCache is a ResultCache keeping one JSON file per key in a
+// directory. Entries older than the TTL are treated as missing and removed
+// on the next Put.
+type FileResultCache struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+// NewFileResultCache creates a FileResultCache storing entries in dir.
+// A ttl of 0 keeps entries forever.
+func NewFileResultCache(dir string, ttl time.Duration) *FileResultCache {
+	return &FileResultCache{
+		dir: dir,
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// Get returns the result stored under key, if present and not expired.
+func (c *FileResultCache) Get(key string) (execpkg.CommandResult, bool) {
+	path := c.path(key)
+
+	info, err := os.Stat(path)
+	if err != nil || c.expired(info) {
+		return execpkg.CommandResult{}, false
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is built from a hex digest
+	if err != nil {
+		return execpkg.CommandResult{}, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return execpkg.CommandResult{}, false
+	}
+
+	result := execpkg.CommandResult{
+		Stdout:   entry.Stdout,
+		Stderr:   entry.Stderr,
+		ExitCode: entry.ExitCode,
+	}
+
+	if entry.ExitCode != 0 {
+		result.Err = errors.Newf("exit status %d", entry.ExitCode)
+	}
+
+	return result, true
+}
+
+// Put stores result under key, replacing any earlier entry, and removes
+// expired entries.
+func (c *FileResultCache) Put(key string, result execpkg.CommandResult) {
+	data, err := json.Marshal(cacheEntry{
+		Stdout:   result.Stdout,
+		Stderr:   result.Stderr,
+		ExitCode: result.ExitCode,
+	})
+	if err != nil {
+		return
+	}
+
+	if err := xdg.EnsureDir(c.dir); err != nil {
+		return
+	}
+
+	_ = fileutil.WriteFileAtomic(c.path(key), data, cacheFileMode)
+
+	c.prune()
+}
+
+// prune removes the entries, and temp files left by interrupted writes, that
+// are older than the TTL. Nothing is removed when entries are kept forever.
+func (c *FileResultCache) prune() {
+	if c.ttl <= 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, cacheFileSuffix) && !fileutil.IsTempFile(name) {
+			continue
+		}
+
+		if info, err := entry.Info(); err == nil && c.expired(info) {
+			_ = os.Remove(filepath.Join(c.dir, name))
+		}
+	}
+}
+
+func (c *FileResultCache) expired(info os.FileInfo) bool {
+	return c.ttl > 0 && c.now().Sub(info.ModTime()) > c.ttl
+}
+
+func (c *FileResultCache) path(key string) string {
+	return filepath.Join(c.dir, key+cacheFileSuffix)
+}
+
+// CachedRunner is a CommandRunner that reuses the result of an earlier run
+// with the same command, the same arguments and the same contents of every
+// file argument. The key also covers the resolved tool binary and the
+// tool's config files found from the working directory, so upgrading the
+// tool or editing its config runs it again. Linters write content to a
+// fresh temp file on each run, so file arguments are keyed by their
+// directory and content hash rather than their name, and the name is
+// replaced in the cached output.
+//
+// Only Run is cached. Runs that did not finish, such as a missing tool or a
+// cancelled context, are not stored.
+type CachedRunner struct {
+	runner execpkg.CommandRunner
+	cache  ResultCache
+}
+
+// NewCachedRunner creates a CachedRunner running commands with runner and
+// storing their results in cache.
+func NewCachedRunner(runner execpkg.CommandRunner, cache ResultCache) *CachedRunner {
+	return &CachedRunner{
+		runner: runner,
+		cache:  cache,
+	}
+}
+
+// Run returns the cached result of the command, running it on a miss.
+func (c *CachedRunner) Run(ctx context.Context, name string, args ...string) execpkg.CommandResult {
+	key, fileNames, ok := commandCacheKey(name, args)
+	if !ok {
+		return c.runner.Run(ctx, name, args...)
+	}
+
+	if cached, hit := c.cache.Get(key); hit {
+		return replaceFileNames(cached, fileNames, false)
+	}
+
+	result := c.runner.Run(ctx, name, args...)
+
+	if ctx.Err() == nil && (result.Err == nil || result.ExitCode > 0) {
+		c.cache.Put(key, replaceFileNames(result, fileNames, true))
+	}
+
+	return result
+}
+
+// RunWithStdin runs the command without caching.
+func (c *CachedRunner) RunWithStdin(
+	ctx context.Context,
+	stdin io.Reader,
+	name string,
+	args ...string,
+) execpkg.CommandResult {
+	return c.runner.RunWithStdin(ctx, stdin, name, args...)
+}
+
+// RunWithTimeout runs the command through Run with a specific timeout.
+func (c *CachedRunner) RunWithTimeout(
+	timeout time.Duration,
+	name string,
+	args ...string,
+) execpkg.CommandResult {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return c.Run(ctx, name, args...)
+}
+
+// commandCacheKey hashes name and args into a cache key. Arguments naming a
+// regular file are hashed as their directory and content; their base names
+// are returned in argument order. ok is false when a file cannot be read.
+func commandCacheKey(name string, args []string) (key string, fileNames []string, ok bool) {
+	h := sha256.New()
+
+	writeKeyPart(h, "cmd", name)
+	writeToolKey(h, name)
+
+	if !writeConfigKey(h, name) {
+		return "", nil, false
+	}
+
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil || !info.Mode().IsRegular() {
+			writeKeyPart(h, "arg", arg)
+
+			continue
+		}
+
+		content, err := os.ReadFile(arg) //nolint:gosec // G304: reading the linted file is intended
+		if err != nil {
+			return "", nil, false
+		}
+
+		sum := sha256.Sum256(content)
+
+		writeKeyPart(h, "file", filepath.Dir(arg), hex.EncodeToString(sum[:]))
+
+		fileNames = append(fileNames, filepath.Base(arg))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), fileNames, true
+}
+
+// writeToolKey hashes the binary name resolves to: its path, with symlinks
+// followed, and its size and modification time, which change when the tool
+// is upgraded. A tool that can't be found is keyed by name only; running it
+// fails, and failed runs aren't cached.
+func writeToolKey(w io.Writer, name string) {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return
+	}
+
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return
+	}
+
+	writeKeyPart(w, "tool", path,
+		strconv.FormatInt(info.Size(), 10),
+		strconv.FormatInt(info.ModTime().UnixNano(), 10),
+	)
+}
+
+// writeConfigKey hashes the config files of the tool name found in the
+// working directory and its parents. Returns false when a config file
+// exists but can't be read.
+func writeConfigKey(w io.Writer, name string) bool {
+	tool := strings.TrimSuffix(filepath.Base(name), ".exe")
+
+	names := toolConfigFiles[tool]
+	if len(names) == 0 {
+		return true
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return false
+	}
+
+	for {
+		for _, configName := range names {
+			path := filepath.Join(dir, configName)
+
+			info, err := os.Stat(path)
+			if err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+
+			content, err := os.ReadFile(path) //nolint:gosec // G304: path is a known config name
+			if err != nil {
+				return false
+			}
+
+			sum := sha256.Sum256(content)
+
+			writeKeyPart(w, "config", path, hex.EncodeToString(sum[:]))
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return true
+		}
+
+		dir = parent
+	}
+}
+
+func writeKeyPart(w io.Writer, parts ...string) {
+	for _, part := range parts {
+		_, _ = io.WriteString(w, part)
+		_, _ = w.Write([]byte{0})
+	}
+}
+
+// replaceFileNames swaps file names in the output of result for numbered
+// placeholders (toPlaceholder) or back.
+func replaceFileNames(
+	result execpkg.CommandResult,
+	fileNames []string,
+	toPlaceholder bool,
+) execpkg.CommandResult {
+	var pairs []string
+
+	for i, fileName := range fileNames {
+		placeholder := "\x00file" + strconv.Itoa(i) + "\x00"
+
+		if toPlaceholder {
+			pairs = append(pairs, fileName, placeholder)
+		} else {
+			pairs = append(pairs, placeholder, fileName)
+		}
+	}
+
+	if len(pairs) > 0 {
+		replacer := strings.NewReplacer(pairs...)
+		result.Stdout = replacer.Replace(result.Stdout)
+		result.Stderr = replacer.Replace(result.Stderr)
+	}
+
+	return result
+}
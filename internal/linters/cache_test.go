@@ -0,0 +1,188 @@
+package linters_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	execpkg "github.com/smykla-skalski/klaudiush/internal/exec"
+	"github.com/smykla-skalski/klaudiush/internal/linters"
+)
+
+var _ = Describe("CachedRunner", func() {
+	const script = "#!/bin/bash\nvar=`ls`"
+
+	var (
+		ctrl            *gomock.Controller
+		mockRunner      *execpkg.MockCommandRunner
+		mockToolChecker *execpkg.MockToolChecker
+		cacheDir        string
+		checker         linters.ShellChecker
+		ctx             context.Context
+	)
+
+	// shellcheckRun reports a finding in the linted temp file, like shellcheck.
+	shellcheckRun := func(_ context.Context, _ string, args ...string) execpkg.CommandResult {
+		return execpkg.CommandResult{
+			Stdout:   args[len(args)-1] + ":2:5: warning: Use $(...) notation",
+			ExitCode: 1,
+			Err:      errShellcheckFailed,
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRunner = execpkg.NewMockCommandRunner(ctrl)
+		mockToolChecker = execpkg.NewMockToolChecker(ctrl)
+		mockToolChecker.EXPECT().IsAvailable("shellcheck").Return(true).AnyTimes()
+		cacheDir = GinkgoT().TempDir()
+		ctx = context.Background()
+
+		runner := linters.NewCachedRunner(
+			mockRunner,
+			linters.NewFileResultCache(cacheDir, time.Hour),
+		)
+		checker = linters.NewShellCheckerWithDeps(linters.NewContentLinterWithDeps(
+			runner,
+			mockToolChecker,
+			execpkg.NewTempFileManager(),
+		))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should skip the subprocess when the same content was linted", func() {
+		mockRunner.EXPECT().Run(ctx, "shellcheck", "--format=json", gomock.Any()).
+			DoAndReturn(shellcheckRun).
+			Times(1)
+
+		first := checker.Check(ctx, script)
+		second := checker.Check(ctx, script)
+
+		Expect(second.Success).To(BeFalse())
+		Expect(second.Err).To(HaveOccurred())
+		Expect(second.RawOut).To(ContainSubstring(":2:5: warning: Use $(...) notation"))
+		Expect(second.RawOut).NotTo(Equal(first.RawOut), "should name the new temp file")
+	})
+
+	It("should run the linter again when the content changes", func() {
+		mockRunner.EXPECT().Run(ctx, "shellcheck", "--format=json", gomock.Any()).
+			DoAndReturn(shellcheckRun).
+			Times(2)
+
+		checker.Check(ctx, script)
+		checker.Check(ctx, script+"\necho done")
+	})
+
+	It("should run the linter again when the options change", func() {
+		mockRunner.EXPECT().Run(ctx, "shellcheck", "--format=json", gomock.Any()).
+			DoAndReturn(shellcheckRun)
+		mockRunner.EXPECT().
+			Run(ctx, "shellcheck", "--format=json", "--exclude=SC2006", gomock.Any()).
+			Return(execpkg.CommandResult{Stdout: "[]"})
+
+		checker.Check(ctx, script)
+
+		result := checker.CheckWithOptions(
+			ctx,
+			script,
+			&linters.ShellCheckOptions{ExcludeCodes: []int{2006}},
+		)
+		Expect(result.Success).To(BeTrue())
+	})
+
+	It("should not cache runs that did not finish", func() {
+		mockRunner.EXPECT().Run(ctx, "shellcheck", "--format=json", gomock.Any()).
+			Return(execpkg.CommandResult{Err: errors.New("executable file not found")}).
+			Times(2)
+
+		checker.Check(ctx, script)
+		checker.Check(ctx, script)
+	})
+
+	It("should run the linter again when the tool binary changes", func() {
+		mockRunner.EXPECT().Run(ctx, "shellcheck", "--format=json", gomock.Any()).
+			DoAndReturn(shellcheckRun).
+			Times(2)
+
+		binDir := GinkgoT().TempDir()
+		tool := filepath.Join(binDir, "shellcheck")
+		GinkgoT().Setenv("PATH", binDir)
+
+		Expect(os.WriteFile(tool, []byte("#!/bin/sh\n"), 0o700)).To(Succeed())
+		checker.Check(ctx, script)
+		checker.Check(ctx, script)
+
+		Expect(os.WriteFile(tool, []byte("#!/bin/sh\n# v2\n"), 0o700)).To(Succeed())
+		checker.Check(ctx, script)
+	})
+
+	It("should run the linter again when its config changes", func() {
+		mockRunner.EXPECT().Run(ctx, "shellcheck", "--format=json", gomock.Any()).
+			DoAndReturn(shellcheckRun).
+			Times(2)
+
+		workDir := GinkgoT().TempDir()
+		GinkgoT().Chdir(workDir)
+
+		rc := filepath.Join(workDir, ".shellcheckrc")
+
+		Expect(os.WriteFile(rc, []byte("disable=SC2034\n"), 0o600)).To(Succeed())
+		checker.Check(ctx, script)
+		checker.Check(ctx, script)
+
+		Expect(os.WriteFile(rc, []byte("disable=SC2006\n"), 0o600)).To(Succeed())
+		checker.Check(ctx, script)
+	})
+
+	It("should run the linter again when the entry expired", func() {
+		mockRunner.EXPECT().Run(ctx, "shellcheck", "--format=json", gomock.Any()).
+			DoAndReturn(shellcheckRun).
+			Times(2)
+
+		checker.Check(ctx, script)
+
+		entries, err := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+
+		old := time.Now().Add(-2 * time.Hour)
+		Expect(os.Chtimes(entries[0], old, old)).To(Succeed())
+
+		checker.Check(ctx, script)
+	})
+	It("should remove expired entries when storing a result", func() {
+		mockRunner.EXPECT().Run(ctx, "shellcheck", "--format=json", gomock.Any()).
+			DoAndReturn(shellcheckRun)
+
+		old := time.Now().Add(-2 * time.Hour)
+		stale := filepath.Join(cacheDir, "stale.json")
+		leftover := filepath.Join(cacheDir, ".stale.json.123.tmp")
+		fresh := filepath.Join(cacheDir, "fresh.json")
+
+		for _, path := range []string{stale, leftover, fresh} {
+			Expect(os.WriteFile(path, []byte("{}"), 0o600)).To(Succeed())
+		}
+
+		Expect(os.Chtimes(stale, old, old)).To(Succeed())
+		Expect(os.Chtimes(leftover, old, old)).To(Succeed())
+
+		checker.Check(ctx, script)
+
+		Expect(stale).NotTo(BeAnExistingFile())
+		Expect(leftover).NotTo(BeAnExistingFile())
+		Expect(fresh).To(BeAnExistingFile())
+
+		entries, err := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+	})
+})
@@ -27,7 +27,8 @@ type GofumptChecker interface {
 
 // RealGofumptChecker implements GofumptChecker using the gofumpt CLI tool
 type RealGofumptChecker struct {
-	linter *ContentLinter
+	linter   *ContentLinter
+	toolPath string
 }
 
 // NewGofumptChecker creates a new RealGofumptChecker
@@ -37,6 +38,15 @@ func NewGofumptChecker(runner execpkg.CommandRunner) *RealGofumptChecker {
 	}
 }
 
+// NewGofumptCheckerWithPath creates a RealGofumptChecker that invokes the gofumpt
+// binary at toolPath. An empty toolPath falls back to a PATH lookup.
+func NewGofumptCheckerWithPath(runner execpkg.CommandRunner, toolPath string) *RealGofumptChecker {
+	return &RealGofumptChecker{
+		linter:   NewContentLinter(runner),
+		toolPath: toolPath,
+	}
+}
+
 // NewGofumptCheckerWithDeps creates a RealGofumptChecker with a custom ContentLinter (for testing).
 func NewGofumptCheckerWithDeps(linter *ContentLinter) *RealGofumptChecker {
 	return &RealGofumptChecker{
@@ -77,7 +87,7 @@ func (g *RealGofumptChecker) CheckWithOptions(
 
 	return g.linter.LintContent(
 		ctx,
-		"gofumpt",
+		toolOrDefault(g.toolPath, "gofumpt"),
 		"code-*.go",
 		content,
 		parseGofumptOutput,
@@ -1,6 +1,8 @@
 package linters_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -11,3 +13,14 @@ func TestLinters(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Linters Suite")
 }
+
+// writeFakeTool writes an executable shell script named name into a temp dir
+// and returns its path. Used to verify custom tool path overrides.
+func writeFakeTool(name, script string) string {
+	path := filepath.Join(GinkgoT().TempDir(), name)
+
+	err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o755)
+	Expect(err).NotTo(HaveOccurred())
+
+	return path
+}
@@ -40,7 +40,8 @@ type OxlintChecker interface {
 
 // RealOxlintChecker implements OxlintChecker using the oxlint CLI tool
 type RealOxlintChecker struct {
-	linter *ContentLinter
+	linter   *ContentLinter
+	toolPath string
 }
 
 // NewOxlintChecker creates a new RealOxlintChecker
@@ -50,6 +51,15 @@ func NewOxlintChecker(runner execpkg.CommandRunner) *RealOxlintChecker {
 	}
 }
 
+// NewOxlintCheckerWithPath creates a RealOxlintChecker that invokes the oxlint
+// binary at toolPath. An empty toolPath falls back to a PATH lookup.
+func NewOxlintCheckerWithPath(runner execpkg.CommandRunner, toolPath string) *RealOxlintChecker {
+	return &RealOxlintChecker{
+		linter:   NewContentLinter(runner),
+		toolPath: toolPath,
+	}
+}
+
 // NewOxlintCheckerWithDeps creates a RealOxlintChecker with a custom ContentLinter (for testing).
 func NewOxlintCheckerWithDeps(linter *ContentLinter) *RealOxlintChecker {
 	return &RealOxlintChecker{
@@ -84,7 +94,7 @@ func (o *RealOxlintChecker) CheckWithOptions(
 
 	return o.linter.LintContent(
 		ctx,
-		"oxlint",
+		toolOrDefault(o.toolPath, "oxlint"),
 		"script-*.js",
 		content,
 		parseOxlintOutput,
@@ -40,7 +40,8 @@ type RuffChecker interface {
 
 // RealRuffChecker implements RuffChecker using the ruff CLI tool
 type RealRuffChecker struct {
-	linter *ContentLinter
+	linter   *ContentLinter
+	toolPath string
 }
 
 // NewRuffChecker creates a new RealRuffChecker
@@ -50,6 +51,15 @@ func NewRuffChecker(runner execpkg.CommandRunner) *RealRuffChecker {
 	}
 }
 
+// NewRuffCheckerWithPath creates a RealRuffChecker that invokes the ruff
+// binary at toolPath. An empty toolPath falls back to a PATH lookup.
+func NewRuffCheckerWithPath(runner execpkg.CommandRunner, toolPath string) *RealRuffChecker {
+	return &RealRuffChecker{
+		linter:   NewContentLinter(runner),
+		toolPath: toolPath,
+	}
+}
+
 // NewRuffCheckerWithDeps creates a RealRuffChecker with a custom ContentLinter (for testing).
 func NewRuffCheckerWithDeps(linter *ContentLinter) *RealRuffChecker {
 	return &RealRuffChecker{
@@ -84,7 +94,7 @@ func (r *RealRuffChecker) CheckWithOptions(
 
 	return r.linter.LintContent(
 		ctx,
-		"ruff",
+		toolOrDefault(r.toolPath, "ruff"),
 		"script-*.py",
 		content,
 		parseRuffOutput,
@@ -9,6 +9,16 @@ import (
 // OutputParser is a function that parses command output into LintFindings
 type OutputParser func(output string) []LintFinding
 
+// toolOrDefault returns the configured tool path, or the default tool name
+// (resolved via PATH) when no path is configured.
+func toolOrDefault(toolPath, defaultTool string) string {
+	if toolPath != "" {
+		return toolPath
+	}
+
+	return defaultTool
+}
+
 // ContentLinter provides common functionality for content-based linters
 type ContentLinter struct {
 	runner      execpkg.CommandRunner
@@ -24,7 +24,8 @@ type RustfmtChecker interface {
 
 // RealRustfmtChecker implements RustfmtChecker using the rustfmt CLI tool
 type RealRustfmtChecker struct {
-	linter *ContentLinter
+	linter   *ContentLinter
+	toolPath string
 }
 
 // NewRustfmtChecker creates a new RealRustfmtChecker
@@ -34,6 +35,15 @@ func NewRustfmtChecker(runner execpkg.CommandRunner) *RealRustfmtChecker {
 	}
 }
 
+// NewRustfmtCheckerWithPath creates a RealRustfmtChecker that invokes the rustfmt
+// binary at toolPath. An empty toolPath falls back to a PATH lookup.
+func NewRustfmtCheckerWithPath(runner execpkg.CommandRunner, toolPath string) *RealRustfmtChecker {
+	return &RealRustfmtChecker{
+		linter:   NewContentLinter(runner),
+		toolPath: toolPath,
+	}
+}
+
 // NewRustfmtCheckerWithDeps creates a RealRustfmtChecker with a custom ContentLinter (for testing).
 func NewRustfmtCheckerWithDeps(linter *ContentLinter) *RealRustfmtChecker {
 	return &RealRustfmtChecker{
@@ -69,7 +79,7 @@ func (r *RealRustfmtChecker) CheckWithOptions(
 
 	return r.linter.LintContent(
 		ctx,
-		"rustfmt",
+		toolOrDefault(r.toolPath, "rustfmt"),
 		"code-*.rs",
 		content,
 		parseRustfmtOutput,
@@ -38,7 +38,8 @@ type ShellChecker interface {
 
 // RealShellChecker implements ShellChecker using the shellcheck CLI tool
 type RealShellChecker struct {
-	linter *ContentLinter
+	linter   *ContentLinter
+	toolPath string
 }
 
 // NewShellChecker creates a new RealShellChecker
@@ -48,6 +49,15 @@ func NewShellChecker(runner execpkg.CommandRunner) *RealShellChecker {
 	}
 }
 
+// NewShellCheckerWithPath creates a RealShellChecker that invokes the shellcheck
+// binary at toolPath. An empty toolPath falls back to a PATH lookup.
+func NewShellCheckerWithPath(runner execpkg.CommandRunner, toolPath string) *RealShellChecker {
+	return &RealShellChecker{
+		linter:   NewContentLinter(runner),
+		toolPath: toolPath,
+	}
+}
+
 // NewShellCheckerWithDeps creates a RealShellChecker with a custom ContentLinter (for testing).
 func NewShellCheckerWithDeps(linter *ContentLinter) *RealShellChecker {
 	return &RealShellChecker{
@@ -81,7 +91,7 @@ func (s *RealShellChecker) CheckWithOptions(
 
 	return s.linter.LintContent(
 		ctx,
-		"shellcheck",
+		toolOrDefault(s.toolPath, "shellcheck"),
 		"script-*.sh",
 		content,
 		parseShellcheckOutput,
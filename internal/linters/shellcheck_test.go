@@ -2,6 +2,7 @@ package linters_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	. "github.com/onsi/ginkgo/v2"
@@ -145,4 +146,32 @@ var _ = Describe("ShellChecker", func() {
 			})
 		})
 	})
+	Describe("NewShellCheckerWithPath", func() {
+		It("invokes the binary at the configured path", func() {
+			toolPath := writeFakeTool(
+				"custom-shellcheck",
+				`echo '[{"file":"script.sh","line":3,"column":5,"level":"warning",`+
+					`"code":2086,"message":"fake finding"}]'; exit 1`,
+			)
+
+			checker := linters.NewShellCheckerWithPath(execpkg.NewCommandRunner(5*time.Second), toolPath)
+			result := checker.Check(context.Background(), "#!/bin/bash\necho $1")
+
+			Expect(result.Success).To(BeFalse())
+			Expect(result.Findings).To(HaveLen(1))
+			Expect(result.Findings[0].Rule).To(Equal("SC2086"))
+			Expect(result.Findings[0].Message).To(Equal("fake finding"))
+		})
+
+		It("skips validation when the configured path does not exist", func() {
+			checker := linters.NewShellCheckerWithPath(
+				execpkg.NewCommandRunner(5*time.Second),
+				"/nonexistent/shellcheck",
+			)
+			result := checker.Check(context.Background(), "#!/bin/bash\necho $1")
+
+			Expect(result.Success).To(BeTrue())
+			Expect(result.Err).To(BeNil())
+		})
+	})
 })
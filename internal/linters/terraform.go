@@ -19,6 +19,10 @@ type RealTerraformFormatter struct {
 	runner      execpkg.CommandRunner
 	toolChecker execpkg.ToolChecker
 	tempManager execpkg.TempFileManager
+
+	// terraformPath and tofuPath override the PATH lookup for each tool.
+	terraformPath string
+	tofuPath      string
 }
 
 // NewTerraformFormatter creates a new RealTerraformFormatter
@@ -30,6 +34,23 @@ func NewTerraformFormatter(runner execpkg.CommandRunner) *RealTerraformFormatter
 	}
 }
 
+// NewTerraformFormatterWithPaths creates a RealTerraformFormatter that invokes
+// the terraform and tofu binaries at the given paths. Empty paths fall back to
+// a PATH lookup.
+func NewTerraformFormatterWithPaths(
+	runner execpkg.CommandRunner,
+	terraformPath string,
+	tofuPath string,
+) *RealTerraformFormatter {
+	return &RealTerraformFormatter{
+		runner:        runner,
+		toolChecker:   execpkg.NewToolChecker(),
+		tempManager:   execpkg.NewTempFileManager(),
+		terraformPath: terraformPath,
+		tofuPath:      tofuPath,
+	}
+}
+
 // NewTerraformFormatterWithDeps creates a RealTerraformFormatter with all dependencies injected (for testing).
 func NewTerraformFormatterWithDeps(
 	runner execpkg.CommandRunner,
@@ -43,9 +64,31 @@ func NewTerraformFormatterWithDeps(
 	}
 }
 
-// DetectTool detects whether to use tofu or terraform
+// DetectTool detects whether to use tofu or terraform. A configured binary
+// wins over one found on PATH, so an explicit terraform path is used even
+// when tofu is installed; tofu is preferred otherwise.
 func (t *RealTerraformFormatter) DetectTool() string {
-	return t.toolChecker.FindTool("tofu", "terraform")
+	const maxTools = 2
+
+	tools := make([]string, 0, maxTools)
+
+	if t.tofuPath != "" {
+		tools = append(tools, t.tofuPath)
+	}
+
+	if t.terraformPath != "" {
+		tools = append(tools, t.terraformPath)
+	}
+
+	if t.tofuPath == "" {
+		tools = append(tools, "tofu")
+	}
+
+	if t.terraformPath == "" {
+		tools = append(tools, "terraform")
+	}
+
+	return t.toolChecker.FindTool(tools...)
 }
 
 // CheckFormat validates Terraform file formatting
@@ -2,6 +2,8 @@ package linters_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 
 	"github.com/cockroachdb/errors"
 	. "github.com/onsi/ginkgo/v2"
@@ -79,6 +81,53 @@ var _ = Describe("TerraformFormatter", func() {
 		})
 	})
 
+	Describe("DetectTool with configured paths", func() {
+		var binDir string
+
+		writeTool := func(dir, name string) string {
+			path := filepath.Join(dir, name)
+			Expect(os.WriteFile(path, []byte("#!/bin/sh\n"), 0o700)).To(Succeed())
+
+			return path
+		}
+
+		BeforeEach(func() {
+			binDir = GinkgoT().TempDir()
+			writeTool(binDir, "tofu")
+			writeTool(binDir, "terraform")
+			GinkgoT().Setenv("PATH", binDir)
+		})
+
+		It("should prefer tofu from PATH without configured paths", func() {
+			formatter = linters.NewTerraformFormatterWithPaths(mockRunner, "", "")
+
+			Expect(formatter.DetectTool()).To(Equal("tofu"))
+		})
+
+		It("should use a configured terraform path over tofu on PATH", func() {
+			terraformPath := writeTool(GinkgoT().TempDir(), "terraform")
+			formatter = linters.NewTerraformFormatterWithPaths(mockRunner, terraformPath, "")
+
+			Expect(formatter.DetectTool()).To(Equal(terraformPath))
+		})
+
+		It("should prefer a configured tofu path when both are configured", func() {
+			toolDir := GinkgoT().TempDir()
+			terraformPath := writeTool(toolDir, "terraform")
+			tofuPath := writeTool(toolDir, "tofu")
+			formatter = linters.NewTerraformFormatterWithPaths(mockRunner, terraformPath, tofuPath)
+
+			Expect(formatter.DetectTool()).To(Equal(tofuPath))
+		})
+
+		It("should fall back to PATH when the configured path is missing", func() {
+			missing := filepath.Join(GinkgoT().TempDir(), "terraform")
+			formatter = linters.NewTerraformFormatterWithPaths(mockRunner, missing, "")
+
+			Expect(formatter.DetectTool()).To(Equal("tofu"))
+		})
+	})
+
 	Describe("CheckFormat", func() {
 		BeforeEach(func() {
 			formatter = linters.NewTerraformFormatterWithDeps(
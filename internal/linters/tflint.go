@@ -23,6 +23,7 @@ type TfLinter interface {
 type RealTfLinter struct {
 	runner      execpkg.CommandRunner
 	toolChecker execpkg.ToolChecker
+	toolPath    string
 }
 
 // NewTfLinter creates a new RealTfLinter
@@ -33,6 +34,16 @@ func NewTfLinter(runner execpkg.CommandRunner) *RealTfLinter {
 	}
 }
 
+// NewTfLinterWithPath creates a RealTfLinter that invokes the tflint binary at
+// toolPath. An empty toolPath falls back to a PATH lookup.
+func NewTfLinterWithPath(runner execpkg.CommandRunner, toolPath string) *RealTfLinter {
+	return &RealTfLinter{
+		runner:      runner,
+		toolChecker: execpkg.NewToolChecker(),
+		toolPath:    toolPath,
+	}
+}
+
 // NewTfLinterWithDeps creates a RealTfLinter with all dependencies injected (for testing).
 func NewTfLinterWithDeps(
 	runner execpkg.CommandRunner,
@@ -46,8 +57,10 @@ func NewTfLinterWithDeps(
 
 // Lint validates Terraform file using tflint
 func (t *RealTfLinter) Lint(ctx context.Context, filePath string) *LintResult {
+	tool := toolOrDefault(t.toolPath, "tflint")
+
 	// Check if tflint is available
-	if !t.toolChecker.IsAvailable("tflint") {
+	if !t.toolChecker.IsAvailable(tool) {
 		return &LintResult{
 			Success: true,
 			Err:     nil,
@@ -55,7 +68,7 @@ func (t *RealTfLinter) Lint(ctx context.Context, filePath string) *LintResult {
 	}
 
 	// Run tflint with compact format
-	result := t.runner.Run(ctx, "tflint", "--format=compact", filePath)
+	result := t.runner.Run(ctx, tool, "--format=compact", filePath)
 
 	// tflint returns non-zero when findings are detected
 	if result.Err != nil {
@@ -2,6 +2,7 @@ package linters_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	. "github.com/onsi/ginkgo/v2"
@@ -127,4 +128,20 @@ main.tf:10:5: Error - "instance_type" is a required field (aws_instance_invalid_
 			})
 		})
 	})
+	Describe("NewTfLinterWithPath", func() {
+		It("invokes the binary at the configured path", func() {
+			toolPath := writeFakeTool(
+				"custom-tflint",
+				`echo "$2:2:1: Warning - fake finding (terraform_unused)"; exit 2`,
+			)
+
+			linter := linters.NewTfLinterWithPath(execpkg.NewCommandRunner(5*time.Second), toolPath)
+			result := linter.Lint(context.Background(), "main.tf")
+
+			Expect(result.Success).To(BeFalse())
+			Expect(result.Findings).To(HaveLen(1))
+			Expect(result.Findings[0].File).To(Equal("main.tf"))
+			Expect(result.Findings[0].Rule).To(Equal("terraform_unused"))
+		})
+	})
 })
@@ -20,8 +20,14 @@ var (
 
 	// ErrInvalidJSON is returned when the input is not valid JSON.
 	ErrInvalidJSON = errors.New("invalid JSON")
+
+	// ErrInputTooLarge is returned when the input exceeds the size limit.
+	ErrInputTooLarge = errors.New("input too large")
 )
 
+// DefaultMaxInputBytes is the default limit on the size of hook input (16MB).
+const DefaultMaxInputBytes int64 = 16 << 20
+
 var patchPathPattern = regexp.MustCompile(`(?m)^\*\*\* (?:Add|Update|Delete) File: (.+)$`)
 
 const (
@@ -65,6 +71,8 @@ type JSONInput struct {
 	Content          json.RawMessage `json:"content,omitempty"`
 	CompactSummary   string          `json:"compact_summary,omitempty"`
 	Trigger          string          `json:"trigger,omitempty"`
+	Prompt           string          `json:"prompt,omitempty"`
+	UserMessage      string          `json:"user_message,omitempty"`
 }
 
 // CodexAfterToolEvent represents the nested Codex AfterToolUse payload.
@@ -82,14 +90,36 @@ type CodexAfterToolEvent struct {
 
 // JSONParser parses JSON input from stdin or environment variable.
 type JSONParser struct {
-	reader io.Reader
+	reader        io.Reader
+	maxInputBytes int64
+}
+
+// JSONParserOption configures a JSONParser.
+type JSONParserOption func(*JSONParser)
+
+// WithMaxInputBytes limits the size of the input. Larger input is rejected
+// with ErrInputTooLarge without being read in full. Non-positive values
+// keep the default.
+func WithMaxInputBytes(limit int64) JSONParserOption {
+	return func(p *JSONParser) {
+		if limit > 0 {
+			p.maxInputBytes = limit
+		}
+	}
 }
 
 // NewJSONParser creates a new JSONParser that reads from the given reader.
-func NewJSONParser(reader io.Reader) *JSONParser {
-	return &JSONParser{
-		reader: reader,
+func NewJSONParser(reader io.Reader, opts ...JSONParserOption) *JSONParser {
+	p := &JSONParser{
+		reader:        reader,
+		maxInputBytes: DefaultMaxInputBytes,
+	}
+
+	for _, opt := range opts {
+		opt(p)
 	}
+
+	return p
 }
 
 // Parse parses the JSON input and extracts the hook context.
@@ -133,6 +163,11 @@ func (p *JSONParser) ParseWithOptions(opts ParseOptions) (*hook.Context, error)
 		ToolUseID:        toolUseID,
 		TranscriptPath:   input.TranscriptPath,
 		AffectedPaths:    deriveAffectedPaths(toolName, toolInput),
+		Prompt:           input.Prompt,
+	}
+
+	if ctx.Prompt == "" {
+		ctx.Prompt = input.UserMessage
 	}
 
 	populateElicitationFields(ctx, input, canonicalEvent)
@@ -155,11 +190,15 @@ func (p *JSONParser) ParseWithOptions(opts ParseOptions) (*hook.Context, error)
 }
 
 func (p *JSONParser) readInput(opts ParseOptions) ([]byte, JSONInput, error) {
-	jsonBytes, err := io.ReadAll(p.reader)
+	jsonBytes, err := io.ReadAll(io.LimitReader(p.reader, p.maxInputBytes+1))
 	if err != nil {
 		return nil, JSONInput{}, errors.Wrap(err, "failed to read input")
 	}
 
+	if int64(len(jsonBytes)) > p.maxInputBytes {
+		return nil, JSONInput{}, p.inputTooLarge()
+	}
+
 	if len(jsonBytes) == 0 {
 		envInput := os.Getenv("CLAUDE_TOOL_INPUT")
 		if envInput == "" && opts.Provider == hook.ProviderCodex {
@@ -170,6 +209,10 @@ func (p *JSONParser) readInput(opts ParseOptions) ([]byte, JSONInput, error) {
 			return nil, JSONInput{}, ErrEmptyInput
 		}
 
+		if int64(len(envInput)) > p.maxInputBytes {
+			return nil, JSONInput{}, p.inputTooLarge()
+		}
+
 		jsonBytes = []byte(envInput)
 	}
 
@@ -181,6 +224,11 @@ func (p *JSONParser) readInput(opts ParseOptions) ([]byte, JSONInput, error) {
 	return jsonBytes, input, nil
 }
 
+// inputTooLarge returns the error for input over the size limit.
+func (p *JSONParser) inputTooLarge() error {
+	return errors.Wrapf(ErrInputTooLarge, "input exceeds %d bytes", p.maxInputBytes)
+}
+
 func resolveEventMetadata(
 	opts ParseOptions,
 	input JSONInput,
@@ -330,6 +378,8 @@ func parseToolInput(
 			_ = json.Unmarshal(value, &toolInput.NewString)
 		case "pattern":
 			_ = json.Unmarshal(value, &toolInput.Pattern)
+		case "edits":
+			_ = json.Unmarshal(value, &toolInput.Edits)
 		case "input":
 			assignProviderSpecificInput(&toolInput, rawToolName, value)
 			toolInput.Additional[key] = value
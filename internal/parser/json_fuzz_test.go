@@ -2,11 +2,17 @@ package parser
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
+	"github.com/cockroachdb/errors"
+
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 )
 
+// fuzzMaxInputBytes is the input size limit the fuzz targets check.
+const fuzzMaxInputBytes = 256
+
 func FuzzJSONParse(f *testing.F) {
 	// Seed corpus with various JSON inputs
 	f.Add([]byte(`{"tool_name":"Bash","tool_input":{"command":"git status"}}`))
@@ -23,8 +29,17 @@ func FuzzJSONParse(f *testing.F) {
 	f.Add([]byte(`null`))
 	f.Add([]byte(`"string"`))
 	f.Add([]byte{})
+	f.Add([]byte(`{"tool_input":` + strings.Repeat("[", 20000) + `}`))
+	f.Add([]byte(`{"tool_name":"Bash","tool_input":{"command":"` + strings.Repeat("a", 300) + `"}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		limited := NewJSONParser(bytes.NewReader(data), WithMaxInputBytes(fuzzMaxInputBytes))
+
+		_, err := limited.Parse(hook.EventTypePreToolUse)
+		if len(data) > fuzzMaxInputBytes && !errors.Is(err, ErrInputTooLarge) {
+			t.Fatalf("input of %d bytes was not rejected: %v", len(data), err)
+		}
 
-	f.Fuzz(func(_ *testing.T, data []byte) {
 		p := NewJSONParser(bytes.NewReader(data))
 
 		// Test with different event types
@@ -54,3 +69,28 @@ func FuzzJSONParse(f *testing.F) {
 		}
 	})
 }
+
+func FuzzJSONParseWithOptions(f *testing.F) {
+	f.Add([]byte(`{"hook_event_name":"PreToolUse","tool_name":"Bash",`+
+		`"tool_input":{"command":"git push"}}`), "claude")
+	f.Add([]byte(`{"hook_event":{"event_type":"AfterToolUse","tool_name":"apply_patch",`+
+		`"tool_input":{"input":"*** Begin Patch\n*** Add File: a.md\n*** End Patch"}}}`), "codex")
+	f.Add([]byte(`{"hook_event":"not an object"}`), "codex")
+	f.Add([]byte(`{"hook_event_name":"BeforeTool","tool_name":"write_file",`+
+		`"tool_input":{"file_path":"a.go","content":"x"}}`), "gemini")
+	f.Add([]byte(`{"hook_event_name":"Elicitation","requested_schema":{"type":"object"}}`), "")
+	f.Add([]byte(`{"hook_event_name":"PreCompact","trigger":"auto"}`), "claude")
+
+	f.Fuzz(func(_ *testing.T, data []byte, provider string) {
+		p := NewJSONParser(bytes.NewReader(data))
+
+		ctx, err := p.ParseWithOptions(ParseOptions{Provider: hook.Provider(provider)})
+		if err == nil && ctx != nil {
+			// Derived fields - should not panic
+			_ = ctx.GetFilePath()
+			_ = ctx.GetContent()
+			_ = ctx.GetCommand()
+			_ = ctx.AffectedPaths
+		}
+	})
+}
@@ -2,6 +2,9 @@ package parser_test
 
 import (
 	"bytes"
+	"fmt"
+	"io"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -308,6 +311,51 @@ var _ = Describe("JSONParser", func() {
 		})
 	})
 
+	Describe("Input size limit", func() {
+		input := `{"tool_name":"Bash","tool_input":{"command":"echo test"}}`
+
+		It("parses input at the limit", func() {
+			p := parser.NewJSONParser(
+				strings.NewReader(input),
+				parser.WithMaxInputBytes(int64(len(input))),
+			)
+
+			ctx, err := p.Parse(hook.EventTypePreToolUse)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ctx.GetCommand()).To(Equal("echo test"))
+		})
+
+		It("rejects input over the limit", func() {
+			p := parser.NewJSONParser(
+				strings.NewReader(input),
+				parser.WithMaxInputBytes(int64(len(input)-1)),
+			)
+
+			_, err := p.Parse(hook.EventTypePreToolUse)
+			Expect(err).To(MatchError(parser.ErrInputTooLarge))
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("exceeds %d bytes", len(input)-1)))
+		})
+
+		It("rejects environment input over the limit", func() {
+			GinkgoT().Setenv("CLAUDE_TOOL_INPUT", input)
+
+			p := parser.NewJSONParser(strings.NewReader(""), parser.WithMaxInputBytes(16))
+
+			_, err := p.Parse(hook.EventTypePreToolUse)
+			Expect(err).To(MatchError(parser.ErrInputTooLarge))
+		})
+
+		It("stops reading at the limit", func() {
+			p := parser.NewJSONParser(
+				io.MultiReader(strings.NewReader(input), neverEndingReader{}),
+				parser.WithMaxInputBytes(1024),
+			)
+
+			_, err := p.Parse(hook.EventTypePreToolUse)
+			Expect(err).To(MatchError(parser.ErrInputTooLarge))
+		})
+	})
+
 	Describe("Backward compatibility", func() {
 		It("works with inputs without session fields", func() {
 			input := `{
@@ -467,6 +515,116 @@ var _ = Describe("Parse with PostCompact input", func() {
 	})
 })
 
+var _ = Describe("Parse with MultiEdit input", func() {
+	It("parses edits and exposes their new strings as content", func() {
+		input := `{
+			"hook_event_name": "PreToolUse",
+			"tool_name": "MultiEdit",
+			"tool_input": {
+				"file_path": "/tmp/README.md",
+				"edits": [
+					{"old_string": "# Old", "new_string": "# New"},
+					{"old_string": "foo", "new_string": "bar", "replace_all": true}
+				]
+			}
+		}`
+
+		p := parser.NewJSONParser(bytes.NewReader([]byte(input)))
+		ctx, err := p.Parse(hook.EventTypePreToolUse)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ctx.ToolName).To(Equal(hook.ToolTypeMultiEdit))
+		Expect(ctx.ToolFamily).To(Equal(hook.ToolFamilyMultiEdit))
+		Expect(ctx.GetFilePath()).To(Equal("/tmp/README.md"))
+		Expect(ctx.ToolInput.Edits).To(Equal([]hook.Edit{
+			{OldString: "# Old", NewString: "# New"},
+			{OldString: "foo", NewString: "bar", ReplaceAll: true},
+		}))
+		Expect(ctx.ToolInput.Additional).NotTo(HaveKey("edits"))
+		Expect(ctx.GetContent()).To(Equal("# New\nbar"))
+	})
+
+	It("ignores malformed edits", func() {
+		input := `{
+			"hook_event_name": "PreToolUse",
+			"tool_name": "MultiEdit",
+			"tool_input": {"file_path": "/tmp/README.md", "edits": "not-an-array"}
+		}`
+
+		p := parser.NewJSONParser(bytes.NewReader([]byte(input)))
+		ctx, err := p.Parse(hook.EventTypePreToolUse)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ctx.ToolInput.Edits).To(BeEmpty())
+		Expect(ctx.GetContent()).To(BeEmpty())
+	})
+
+	It("prefers content over edits", func() {
+		ctx := &hook.Context{
+			ToolInput: hook.ToolInput{
+				Content: "full content",
+				Edits:   []hook.Edit{{OldString: "a", NewString: "b"}},
+			},
+		}
+
+		Expect(ctx.GetContent()).To(Equal("full content"))
+	})
+})
+
+var _ = Describe("Parse with prompt input", func() {
+	parse := func(input string) *hook.Context {
+		p := parser.NewJSONParser(bytes.NewReader([]byte(input)))
+		ctx, err := p.Parse(hook.EventTypePreToolUse)
+		Expect(err).NotTo(HaveOccurred())
+
+		return ctx
+	}
+
+	It("captures the prompt field", func() {
+		ctx := parse(`{
+			"hook_event_name": "PreToolUse",
+			"tool_name": "Bash",
+			"tool_input": {"command": "git push"},
+			"prompt": "ship the release"
+		}`)
+
+		Expect(ctx.Prompt).To(Equal("ship the release"))
+	})
+
+	It("falls back to the user_message field", func() {
+		ctx := parse(`{
+			"hook_event_name": "PreToolUse",
+			"tool_name": "Bash",
+			"tool_input": {"command": "git push"},
+			"user_message": "push it"
+		}`)
+
+		Expect(ctx.Prompt).To(Equal("push it"))
+	})
+
+	It("prefers prompt over user_message", func() {
+		ctx := parse(`{
+			"hook_event_name": "PreToolUse",
+			"tool_name": "Bash",
+			"tool_input": {"command": "git push"},
+			"prompt": "ship the release",
+			"user_message": "push it"
+		}`)
+
+		Expect(ctx.Prompt).To(Equal("ship the release"))
+	})
+
+	It("leaves the prompt empty when the payload has none", func() {
+		ctx := parse(`{
+			"hook_event_name": "PreToolUse",
+			"tool_name": "Bash",
+			"tool_input": {"command": "git push"}
+		}`)
+
+		Expect(ctx.Prompt).To(BeEmpty())
+	})
+})
+
 var _ = Describe("Context session helpers", func() {
 	Describe("HasSessionID", func() {
 		It("returns true when session ID is present", func() {
@@ -492,3 +650,14 @@ var _ = Describe("Context session helpers", func() {
 		})
 	})
 })
+
+// neverEndingReader is an input stream that never ends.
+type neverEndingReader struct{}
+
+func (neverEndingReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = ' '
+	}
+
+	return len(b), nil
+}
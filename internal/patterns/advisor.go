@@ -36,6 +36,14 @@ var codeDescriptions = map[string]string{
 	"GIT023": "PR validation",
 	"GIT024": "fetch no remote",
 	"GIT025": "blocked remote",
+	"GIT026": "missing trailer",
+	"GIT027": "large commit",
+	"GIT028": "amend pushed commit",
+	"GIT029": "emoji policy",
+	"GIT030": "blocked commit marker",
+	"GIT031": "large or binary file",
+	"GIT032": "missing changelog fragment",
+	"GIT033": "tag push",
 	// File
 	"FILE001": "shellcheck",
 	"FILE002": "terraform fmt",
@@ -47,6 +55,9 @@ var codeDescriptions = map[string]string{
 	"FILE008": "oxlint",
 	"FILE009": "rustfmt",
 	"FILE010": "linter ignore",
+	"FILE011": "terraform versions",
+	"FILE012": "markdown front matter",
+	"FILE013": "workflow runner label",
 	// Security
 	"SEC001": "API key detected",
 	"SEC002": "password detected",
@@ -57,6 +68,8 @@ var codeDescriptions = map[string]string{
 	"SHELL001": "backtick substitution",
 	// GitHub
 	"GH001": "issue validation",
+	"GH002": "release validation",
+	"GH003": "PR merge validation",
 	// Plugin
 	"PLUG001": "path traversal",
 	"PLUG002": "path not allowed",
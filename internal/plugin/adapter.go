@@ -2,6 +2,9 @@ package plugin
 
 import (
 	"context"
+	"maps"
+
+	"github.com/cockroachdb/errors"
 
 	"github.com/smykla-skalski/klaudiush/internal/validator"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
@@ -16,6 +19,9 @@ type ValidatorAdapter struct {
 	*validator.BaseValidator
 	plugin   Plugin
 	category validator.ValidatorCategory
+
+	// replacement returns the plugin that replaced a closed plugin, or nil.
+	replacement func() Plugin
 }
 
 // NewValidatorAdapter creates a new validator adapter for a plugin.
@@ -62,6 +68,18 @@ func (a *ValidatorAdapter) Validate(ctx context.Context, hookCtx *hook.Context)
 
 	// Call the plugin
 	resp, err := a.plugin.Validate(ctx, req)
+	if errors.Is(err, errPluginClosed) {
+		resp, err = a.validateReplacement(ctx, req)
+	}
+
+	if errors.Is(err, errPluginClosed) {
+		a.Logger().Info("plugin unloaded during validation", "plugin", a.plugin.Info().Name)
+
+		return validator.Warn(
+			"Plugin " + a.plugin.Info().Name + " was unloaded by a reload and did not validate",
+		)
+	}
+
 	if err != nil {
 		a.Logger().Error("plugin validation error",
 			"plugin", a.plugin.Info().Name,
@@ -71,21 +89,58 @@ func (a *ValidatorAdapter) Validate(ctx context.Context, hookCtx *hook.Context)
 		return validator.Fail("Plugin error: " + err.Error())
 	}
 
-	// Convert plugin response to validator result
+	return toResult(resp)
+}
+
+// validateReplacement runs the plugin that replaced the closed plugin in a
+// reload. It returns errPluginClosed when the plugin was removed or its
+// replacement was closed too.
+func (a *ValidatorAdapter) validateReplacement(
+	ctx context.Context,
+	req *plugin.ValidateRequest,
+) (*plugin.ValidateResponse, error) {
+	if a.replacement == nil {
+		return nil, errPluginClosed
+	}
+
+	next := a.replacement()
+	if next == nil || next == a.plugin {
+		return nil, errPluginClosed
+	}
+
+	a.Logger().Debug("validating with reloaded plugin", "plugin", a.plugin.Info().Name)
+
+	return next.Validate(ctx, req)
+}
+
+// toResult converts a plugin response to a validator result.
+//
+// Plugins manage their own error metadata, mapped as follows:
+//   - DocLink becomes the Reference, so its last path segment is the error code
+//     used by overrides and exceptions.
+//   - ErrorCode becomes the Reference when DocLink is empty.
+//   - FixHint is passed through unchanged.
+//   - Details are kept as Details, rendered as before, and also carried as
+//     PluginDetails, which verbose output renders as key: value lines.
+func toResult(resp *plugin.ValidateResponse) *validator.Result {
 	result := &validator.Result{
 		Passed:      resp.Passed,
 		Message:     resp.Message,
 		ShouldBlock: resp.ShouldBlock,
 		Details:     resp.Details,
+		FixHint:     resp.FixHint,
 	}
 
-	// Use plugin's own error metadata if provided
-	// Plugins manage their own error codes and documentation URLs
-	if resp.DocLink != "" {
+	switch {
+	case resp.DocLink != "":
 		result.Reference = validator.Reference(resp.DocLink)
+	case resp.ErrorCode != "":
+		result.Reference = validator.Reference(resp.ErrorCode)
 	}
 
-	result.FixHint = resp.FixHint
+	if len(resp.Details) > 0 {
+		result.PluginDetails = maps.Clone(resp.Details)
+	}
 
 	return result
 }
@@ -357,6 +357,23 @@ var _ = Describe("ValidatorAdapter", func() {
 			Expect(result.Reference).To(Equal(validator.Reference("")))
 		})
 
+		It("should use ErrorCode as reference when plugin provides no DocLink", func() {
+			mockPlugin.EXPECT().
+				Validate(gomock.Any(), gomock.Any()).
+				Return(pluginapi.FailWithCode("TEST002", "validation failed", "", ""), nil)
+
+			hookCtx := &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+			}
+
+			result := adapter.Validate(ctx, hookCtx)
+
+			Expect(result).NotTo(BeNil())
+			Expect(result.Reference).To(Equal(validator.Reference("TEST002")))
+			Expect(result.Reference.Code()).To(Equal("TEST002"))
+		})
+
 		It("should convert details map", func() {
 			mockPlugin.EXPECT().
 				Validate(gomock.Any(), gomock.Any()).
@@ -380,6 +397,7 @@ var _ = Describe("ValidatorAdapter", func() {
 			Expect(result).NotTo(BeNil())
 			Expect(result.Details).To(HaveKeyWithValue("key1", "value1"))
 			Expect(result.Details).To(HaveKeyWithValue("key2", "value2"))
+			Expect(result.PluginDetails).To(Equal(result.Details))
 		})
 
 		It("should handle plugin errors", func() {
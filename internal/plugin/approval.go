@@ -0,0 +1,76 @@
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+)
+
+// Sentinel errors for plugin approval.
+var (
+	// ErrPluginNotApproved is returned when approval is required but the
+	// plugin has no approved checksum.
+	ErrPluginNotApproved = errors.New("plugin is not approved")
+
+	// ErrChecksumMismatch is returned when the plugin executable does not
+	// match its approved checksum.
+	ErrChecksumMismatch = errors.New("plugin checksum does not match approved checksum")
+)
+
+// Checksum returns the hex-encoded SHA-256 of the file at path.
+// A leading ~ is expanded to the home directory.
+func Checksum(path string) (string, error) {
+	expandedPath, err := expandPath(path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to expand path")
+	}
+
+	f, err := os.Open(expandedPath) //nolint:gosec // path comes from plugin config
+	if err != nil {
+		return "", errors.Wrap(err, "failed to open plugin executable")
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", errors.Wrap(err, "failed to read plugin executable")
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// VerifyApproval checks that the plugin executable matches the approved
+// checksum in cfg. It must run before the plugin is executed.
+func VerifyApproval(cfg *config.PluginInstanceConfig) error {
+	if cfg.Type != config.PluginTypeExec {
+		return errors.Errorf("approval is not supported for plugin type: %s", cfg.Type)
+	}
+
+	if cfg.ApprovedChecksum == "" {
+		return errors.Wrapf(ErrPluginNotApproved,
+			"no approved_checksum (review the plugin, then run 'klaudiush plugins approve %s')",
+			cfg.Name,
+		)
+	}
+
+	actual, err := Checksum(cfg.Path)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(actual, strings.TrimSpace(cfg.ApprovedChecksum)) {
+		return errors.Wrapf(ErrChecksumMismatch,
+			"%s has checksum %s, approved %s (review the change, then run "+
+				"'klaudiush plugins approve %s')",
+			cfg.Path, actual, cfg.ApprovedChecksum, cfg.Name,
+		)
+	}
+
+	return nil
+}
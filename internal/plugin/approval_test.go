@@ -0,0 +1,77 @@
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/plugin"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+)
+
+// helloChecksum is the SHA-256 of "hello\n".
+const helloChecksum = "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03"
+
+var _ = Describe("Approval", func() {
+	var pluginPath string
+
+	BeforeEach(func() {
+		pluginPath = filepath.Join(GinkgoT().TempDir(), "hello.sh")
+		Expect(os.WriteFile(pluginPath, []byte("hello\n"), 0o755)).To(Succeed())
+	})
+
+	Describe("Checksum", func() {
+		It("should return the hex-encoded SHA-256 of the file", func() {
+			sum, err := plugin.Checksum(pluginPath)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sum).To(Equal(helloChecksum))
+		})
+
+		It("should return error for a missing file", func() {
+			_, err := plugin.Checksum(filepath.Join(filepath.Dir(pluginPath), "missing.sh"))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("VerifyApproval", func() {
+		newCfg := func(checksum string) *config.PluginInstanceConfig {
+			return &config.PluginInstanceConfig{
+				Name:             "hello",
+				Type:             config.PluginTypeExec,
+				Path:             pluginPath,
+				ApprovedChecksum: checksum,
+			}
+		}
+
+		It("should accept a matching checksum", func() {
+			Expect(plugin.VerifyApproval(newCfg(helloChecksum))).To(Succeed())
+		})
+
+		It("should compare checksums case-insensitively", func() {
+			Expect(plugin.VerifyApproval(newCfg(
+				"5891B5B522D5DF086D0FF0B110FBD9D21BB4FC7163AF34D08286A2E846F6BE03",
+			))).To(Succeed())
+		})
+
+		It("should reject a mismatching checksum", func() {
+			err := plugin.VerifyApproval(newCfg(
+				"0000000000000000000000000000000000000000000000000000000000000000",
+			))
+
+			Expect(err).To(MatchError(plugin.ErrChecksumMismatch))
+			Expect(err.Error()).To(ContainSubstring(helloChecksum))
+			Expect(err.Error()).To(ContainSubstring("klaudiush plugins approve hello"))
+		})
+
+		It("should reject a plugin without an approved checksum", func() {
+			err := plugin.VerifyApproval(newCfg(""))
+
+			Expect(err).To(MatchError(plugin.ErrPluginNotApproved))
+			Expect(err.Error()).To(ContainSubstring("klaudiush plugins approve hello"))
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package plugin
+
+import (
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+// PluginCheck is the result of loading a configured plugin.
+type PluginCheck struct {
+	// Name is the plugin name.
+	Name string
+
+	// Err is the load error, or nil when the plugin loaded.
+	Err error
+}
+
+// CheckPlugins loads each enabled plugin in cfg, verifying approval when it
+// is required, and reports the result per plugin. The plugins are closed
+// before it returns. Returns nil when plugins are disabled.
+func CheckPlugins(cfg *config.PluginConfig, log logger.Logger) []PluginCheck {
+	if cfg == nil || !cfg.IsEnabled() {
+		return nil
+	}
+
+	r := NewRegistry(log)
+	r.requireApproval = cfg.IsRequireApproval()
+
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Debug("failed to close plugins", "error", err)
+		}
+	}()
+
+	checks := make([]PluginCheck, 0, len(cfg.Plugins))
+
+	for _, pluginCfg := range cfg.Plugins {
+		if pluginCfg == nil || !pluginCfg.IsInstanceEnabled() {
+			continue
+		}
+
+		checks = append(checks, PluginCheck{
+			Name: pluginCfg.Name,
+			Err:  r.LoadPlugin(pluginCfg),
+		})
+	}
+
+	return checks
+}
@@ -0,0 +1,255 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/plugin"
+)
+
+const (
+	// defaultHTTPPluginTimeout is the default timeout for HTTP plugin requests.
+	defaultHTTPPluginTimeout = 5 * time.Second
+
+	// maxHTTPPluginResponseSize limits how much of a plugin response is read.
+	maxHTTPPluginResponseSize = 1 << 20
+
+	// httpPluginInfoPath is the path of the info endpoint, relative to the
+	// plugin URL.
+	httpPluginInfoPath = "info"
+
+	// maxHTTPPluginRedirects limits how many redirects a plugin request
+	// follows.
+	maxHTTPPluginRedirects = 10
+)
+
+// ErrPluginHTTPStatus is returned when an HTTP plugin responds with a
+// non-2xx status.
+var ErrPluginHTTPStatus = errors.New("plugin returned non-success HTTP status")
+
+// HTTPLoader loads plugins served as HTTP endpoints that communicate via JSON.
+//
+// Protocol:
+// - Request: POST of the JSON-encoded plugin.ValidateRequest to the plugin URL
+// - Response: JSON-encoded plugin.ValidateResponse with a 2xx status
+// - Info: GET <url>/info, returns JSON-encoded plugin.Info
+//
+// Redirects are only followed to URLs that pass ValidateURL, so a plugin
+// can't be moved off https (or off localhost for plain http) by a redirect.
+type HTTPLoader struct {
+	client *http.Client
+}
+
+// NewHTTPLoader creates a new HTTP plugin loader. A nil client uses a new
+// http.Client. A client without a CheckRedirect policy gets one validating
+// every redirect target.
+func NewHTTPLoader(client *http.Client) *HTTPLoader {
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	if client.CheckRedirect == nil {
+		withPolicy := *client
+		withPolicy.CheckRedirect = checkPluginRedirect
+		client = &withPolicy
+	}
+
+	return &HTTPLoader{
+		client: client,
+	}
+}
+
+// checkPluginRedirect refuses redirects to URLs ValidateURL rejects, and
+// long redirect chains.
+func checkPluginRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxHTTPPluginRedirects {
+		return errors.Newf("stopped after %d redirects", maxHTTPPluginRedirects)
+	}
+
+	if err := ValidateURL(req.URL.String()); err != nil {
+		return errors.Wrapf(err, "refusing redirect to %s", req.URL.Redacted())
+	}
+
+	return nil
+}
+
+// clientWithTimeout returns a copy of the loader's client bounded by
+// timeout. The copy shares the transport, so connections are still pooled.
+func (l *HTTPLoader) clientWithTimeout(timeout time.Duration) *http.Client {
+	client := *l.client
+	client.Timeout = timeout
+
+	return &client
+}
+
+// Load loads an HTTP plugin from the specified URL.
+func (l *HTTPLoader) Load(cfg *config.PluginInstanceConfig) (Plugin, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("url is required for http plugins")
+	}
+
+	if urlErr := ValidateURL(cfg.URL); urlErr != nil {
+		return nil, errors.Wrapf(urlErr, "plugin url validation failed: %s", cfg.URL)
+	}
+
+	infoURL, err := url.JoinPath(cfg.URL, httpPluginInfoPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build plugin info URL")
+	}
+
+	timeout := cfg.GetTimeout(defaultHTTPPluginTimeout)
+
+	// Every request is bounded by the plugin timeout, even when the caller's
+	// context has a later deadline
+	client := l.clientWithTimeout(timeout)
+
+	// Fetch plugin info
+	info, err := fetchInfo(client, infoURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch plugin info")
+	}
+
+	return &httpPluginAdapter{
+		url:     cfg.URL,
+		timeout: timeout,
+		config:  cfg.Config,
+		info:    info,
+		client:  client,
+	}, nil
+}
+
+// Close releases any resources held by the loader.
+func (l *HTTPLoader) Close() error {
+	l.client.CloseIdleConnections()
+
+	return nil
+}
+
+// fetchInfo fetches plugin metadata from the info endpoint. The request is
+// bounded by the client's timeout.
+func fetchInfo(client *http.Client, infoURL string) (plugin.Info, error) {
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodGet,
+		infoURL,
+		http.NoBody,
+	)
+	if err != nil {
+		return plugin.Info{}, errors.Wrap(err, "failed to create info request")
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	var info plugin.Info
+	if err := doJSON(client, req, &info); err != nil {
+		return plugin.Info{}, errors.Wrap(err, "plugin info request failed")
+	}
+
+	return info, nil
+}
+
+// httpPluginAdapter adapts an HTTP endpoint to the internal Plugin interface.
+type httpPluginAdapter struct {
+	url     string
+	timeout time.Duration
+	config  map[string]any
+	info    plugin.Info
+	client  *http.Client
+}
+
+// Info returns metadata about the plugin.
+func (a *httpPluginAdapter) Info() plugin.Info {
+	return a.info
+}
+
+// Validate performs validation by POSTing the request JSON to the plugin URL.
+func (a *httpPluginAdapter) Validate(
+	ctx context.Context,
+	req *plugin.ValidateRequest,
+) (*plugin.ValidateResponse, error) {
+	// Add plugin-specific config to the request
+	if req.Config == nil && len(a.config) > 0 {
+		req.Config = a.config
+	}
+
+	req.PopulateNormalizedFields()
+
+	// Marshal request to JSON
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request to JSON")
+	}
+
+	// Apply timeout if context doesn't have one
+	reqCtx := ctx
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+
+		reqCtx, cancel = context.WithTimeout(ctx, a.timeout)
+
+		defer cancel()
+	}
+
+	httpReq, err := http.NewRequestWithContext(
+		reqCtx,
+		http.MethodPost,
+		a.url,
+		bytes.NewReader(reqJSON),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create plugin request")
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	var resp plugin.ValidateResponse
+	if err := doJSON(a.client, httpReq, &resp); err != nil {
+		return nil, errors.Wrap(err, "plugin request failed")
+	}
+
+	return &resp, nil
+}
+
+// Close releases any resources held by the plugin.
+func (*httpPluginAdapter) Close() error {
+	// Connections are pooled by the loader's client
+	return nil
+}
+
+// doJSON sends req and decodes a 2xx JSON response body into out.
+func doJSON(client *http.Client, req *http.Request, out any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send request")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPPluginResponseSize))
+	if err != nil {
+		return errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Wrapf(
+			ErrPluginHTTPStatus,
+			"status %d: %s",
+			resp.StatusCode,
+			bytes.TrimSpace(body),
+		)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return errors.Wrap(err, "failed to parse response JSON")
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package plugin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/plugin"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	pluginapi "github.com/smykla-skalski/klaudiush/pkg/plugin"
+)
+
+// httpPluginServer is a test HTTP plugin that records the validate requests
+// it receives.
+type httpPluginServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []*pluginapi.ValidateRequest
+}
+
+// newHTTPPluginServer starts an HTTP plugin that answers every validate
+// request with response. Stop it with Close.
+func newHTTPPluginServer(
+	name string,
+	response *pluginapi.ValidateResponse,
+) *httpPluginServer {
+	s := &httpPluginServer{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /info", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(pluginapi.Info{Name: name, Version: "1.0.0"})
+	})
+	mux.HandleFunc("POST /", func(w http.ResponseWriter, r *http.Request) {
+		var req pluginapi.ValidateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		s.mu.Lock()
+		s.requests = append(s.requests, &req)
+		s.mu.Unlock()
+
+		_ = json.NewEncoder(w).Encode(response)
+	})
+
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// Requests returns the validate requests received so far.
+func (s *httpPluginServer) Requests() []*pluginapi.ValidateRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*pluginapi.ValidateRequest(nil), s.requests...)
+}
+
+var _ = Describe("HTTPLoader", func() {
+	var loader *plugin.HTTPLoader
+
+	BeforeEach(func() {
+		loader = plugin.NewHTTPLoader(nil)
+	})
+
+	AfterEach(func() {
+		Expect(loader.Close()).To(Succeed())
+	})
+
+	Describe("Load", func() {
+		It("should fetch plugin info from the info endpoint", func() {
+			server := newHTTPPluginServer(
+				"remote-lint",
+				&pluginapi.ValidateResponse{Passed: true},
+			)
+			defer server.Close()
+
+			p, err := loader.Load(&config.PluginInstanceConfig{
+				Name: "remote-lint",
+				Type: config.PluginTypeHTTP,
+				URL:  server.URL,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.Info().Name).To(Equal("remote-lint"))
+			Expect(p.Info().Version).To(Equal("1.0.0"))
+		})
+
+		It("should require a url", func() {
+			_, err := loader.Load(&config.PluginInstanceConfig{
+				Name: "remote-lint",
+				Type: config.PluginTypeHTTP,
+			})
+			Expect(err).To(MatchError(ContainSubstring("url is required")))
+		})
+
+		It("should reject plain http to a remote host", func() {
+			_, err := loader.Load(&config.PluginInstanceConfig{
+				Name: "remote-lint",
+				Type: config.PluginTypeHTTP,
+				URL:  "http://plugins.example.com/validate",
+			})
+			Expect(err).To(MatchError(plugin.ErrInsecureURL))
+		})
+
+		It("should refuse a redirect to plain http on a remote host", func() {
+			server := httptest.NewServer(http.RedirectHandler(
+				"http://plugins.example.com/validate/info",
+				http.StatusFound,
+			))
+			defer server.Close()
+
+			_, err := loader.Load(&config.PluginInstanceConfig{
+				Name: "remote-lint",
+				Type: config.PluginTypeHTTP,
+				URL:  server.URL,
+			})
+			Expect(err).To(MatchError(plugin.ErrInsecureURL))
+			Expect(err.Error()).To(ContainSubstring("refusing redirect"))
+		})
+
+		It("should follow a redirect to an allowed URL", func() {
+			target := newHTTPPluginServer(
+				"remote-lint",
+				&pluginapi.ValidateResponse{Passed: true},
+			)
+			defer target.Close()
+
+			server := httptest.NewServer(http.RedirectHandler(
+				target.URL+"/info",
+				http.StatusFound,
+			))
+			defer server.Close()
+
+			p, err := loader.Load(&config.PluginInstanceConfig{
+				Name: "remote-lint",
+				Type: config.PluginTypeHTTP,
+				URL:  server.URL,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.Info().Name).To(Equal("remote-lint"))
+		})
+
+		It("should fail when the info endpoint returns an error status", func() {
+			server := httptest.NewServer(http.NotFoundHandler())
+			defer server.Close()
+
+			_, err := loader.Load(&config.PluginInstanceConfig{
+				Name: "remote-lint",
+				Type: config.PluginTypeHTTP,
+				URL:  server.URL,
+			})
+			Expect(err).To(MatchError(plugin.ErrPluginHTTPStatus))
+			Expect(err.Error()).To(ContainSubstring("status 404"))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("should POST the request and return the response", func() {
+			server := newHTTPPluginServer("remote-lint", &pluginapi.ValidateResponse{
+				Passed:      false,
+				ShouldBlock: true,
+				Message:     "blocked by remote policy",
+				ErrorCode:   "REMOTE001",
+			})
+			defer server.Close()
+
+			p, err := loader.Load(&config.PluginInstanceConfig{
+				Name:   "remote-lint",
+				Type:   config.PluginTypeHTTP,
+				URL:    server.URL,
+				Config: map[string]any{"strict": true},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := p.Validate(context.Background(), &pluginapi.ValidateRequest{
+				ToolName: "Bash",
+				Command:  "git push --force",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.ShouldBlock).To(BeTrue())
+			Expect(resp.Message).To(Equal("blocked by remote policy"))
+			Expect(resp.ErrorCode).To(Equal("REMOTE001"))
+
+			requests := server.Requests()
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Command).To(Equal("git push --force"))
+			Expect(requests[0].Config).To(HaveKeyWithValue("strict", true))
+		})
+
+		It("should fail on a non-success status", func() {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /info", func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(pluginapi.Info{Name: "flaky", Version: "1.0.0"})
+			})
+			mux.HandleFunc("POST /", func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "upstream unavailable", http.StatusServiceUnavailable)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			p, err := loader.Load(&config.PluginInstanceConfig{
+				Name: "flaky",
+				Type: config.PluginTypeHTTP,
+				URL:  server.URL,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = p.Validate(context.Background(), &pluginapi.ValidateRequest{})
+			Expect(err).To(MatchError(plugin.ErrPluginHTTPStatus))
+			Expect(err.Error()).To(ContainSubstring("upstream unavailable"))
+		})
+
+		It("should time out slow plugins", func() {
+			release := make(chan struct{})
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /info", func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(pluginapi.Info{Name: "slow", Version: "1.0.0"})
+			})
+			mux.HandleFunc("POST /", func(_ http.ResponseWriter, r *http.Request) {
+				select {
+				case <-release:
+				case <-r.Context().Done():
+				}
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+			defer close(release)
+
+			p, err := loader.Load(&config.PluginInstanceConfig{
+				Name:    "slow",
+				Type:    config.PluginTypeHTTP,
+				URL:     server.URL,
+				Timeout: config.Duration(50 * time.Millisecond),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = p.Validate(context.Background(), &pluginapi.ValidateRequest{})
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			start := time.Now()
+			_, err = p.Validate(ctx, &pluginapi.ValidateRequest{})
+			Expect(err).To(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+		})
+	})
+})
@@ -484,6 +484,271 @@ var _ = Describe("Plugin Integration Tests", func() {
 		})
 	})
 
+	Describe("HTTP Plugin Integration", func() {
+		var server *httpPluginServer
+
+		BeforeEach(func() {
+			server = newHTTPPluginServer("http-policy", &pluginapi.ValidateResponse{
+				Passed:      false,
+				ShouldBlock: true,
+				Message:     "force push denied by policy",
+			})
+			DeferCleanup(server.Close)
+		})
+
+		loadHTTPPlugin := func(pluginCfg *config.PluginConfig) (*plugin.Registry, error) {
+			registry := plugin.NewRegistry(log)
+			DeferCleanup(registry.Close)
+
+			return registry, registry.LoadPlugins(pluginCfg)
+		}
+
+		httpPluginConfig := func() *config.PluginInstanceConfig {
+			return &config.PluginInstanceConfig{
+				Name: "http-policy",
+				Type: config.PluginTypeHTTP,
+				URL:  server.URL,
+				Predicate: &config.PluginPredicate{
+					CommandPatterns: []string{"--force"},
+				},
+			}
+		}
+
+		forcePushCtx := &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{Command: "git push --force origin main"},
+		}
+
+		It("should validate matching commands through the endpoint", func() {
+			registry, err := loadHTTPPlugin(&config.PluginConfig{
+				Enabled: new(true),
+				Plugins: []*config.PluginInstanceConfig{httpPluginConfig()},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			validators := registry.GetValidators(forcePushCtx)
+			Expect(validators).To(HaveLen(1))
+			Expect(validators[0].Name()).To(Equal("plugin:http-policy"))
+
+			result := validators[0].Validate(context.Background(), forcePushCtx)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Message).To(Equal("force push denied by policy"))
+
+			Expect(server.Requests()).To(HaveLen(1))
+			Expect(server.Requests()[0].Command).To(Equal("git push --force origin main"))
+		})
+
+		It("should skip commands outside the predicate", func() {
+			registry, err := loadHTTPPlugin(&config.PluginConfig{
+				Enabled: new(true),
+				Plugins: []*config.PluginInstanceConfig{httpPluginConfig()},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(registry.GetValidators(&hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+				ToolInput: hook.ToolInput{Command: "git push origin main"},
+			})).To(BeEmpty())
+			Expect(server.Requests()).To(BeEmpty())
+		})
+
+		It("should block when the endpoint is unreachable", func() {
+			registry, err := loadHTTPPlugin(&config.PluginConfig{
+				Enabled: new(true),
+				Plugins: []*config.PluginInstanceConfig{httpPluginConfig()},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			server.Close()
+
+			result := registry.GetValidators(forcePushCtx)[0].
+				Validate(context.Background(), forcePushCtx)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("Plugin error"))
+		})
+
+		It("should refuse http plugins when approval is required", func() {
+			registry, err := loadHTTPPlugin(&config.PluginConfig{
+				Enabled:         new(true),
+				RequireApproval: new(true),
+				Plugins:         []*config.PluginInstanceConfig{httpPluginConfig()},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("approval is not supported"))
+			Expect(registry.GetValidators(forcePushCtx)).To(BeEmpty())
+		})
+	})
+
+	Describe("Plugin Approval", func() {
+		var (
+			pluginPath string
+			checksum   string
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			pluginPath, err = createExecPlugin(
+				pluginDir,
+				"approved-plugin",
+				&pluginapi.ValidateResponse{Passed: true, Message: "ok"},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			checksum, err = plugin.Checksum(pluginPath)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		loadWithApproval := func(approvedChecksum string) (*plugin.Registry, error) {
+			registry := plugin.NewRegistry(log)
+			DeferCleanup(registry.Close)
+
+			err := registry.LoadPlugins(&config.PluginConfig{
+				Enabled:         new(true),
+				RequireApproval: new(true),
+				Plugins: []*config.PluginInstanceConfig{
+					{
+						Name:             "approved-plugin",
+						Type:             config.PluginTypeExec,
+						Path:             pluginPath,
+						ApprovedChecksum: approvedChecksum,
+						ProjectRoot:      projectRoot,
+					},
+				},
+			})
+
+			return registry, err
+		}
+
+		bashCtx := &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{Command: "echo test"},
+		}
+
+		It("should load a plugin with a matching checksum", func() {
+			registry, err := loadWithApproval(checksum)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registry.GetValidators(bashCtx)).To(HaveLen(1))
+		})
+
+		It("should refuse a plugin with a mismatching checksum", func() {
+			Expect(os.WriteFile(pluginPath, []byte("#!/bin/sh\necho changed\n"), 0o755)).
+				To(Succeed())
+
+			registry, err := loadWithApproval(checksum)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("approved-plugin"))
+			Expect(err.Error()).To(ContainSubstring("does not match approved checksum"))
+			Expect(registry.GetValidators(bashCtx)).To(BeEmpty())
+		})
+
+		It("should refuse a plugin without an approved checksum", func() {
+			registry, err := loadWithApproval("")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("plugin is not approved"))
+			Expect(registry.GetValidators(bashCtx)).To(BeEmpty())
+		})
+
+		It("should ignore checksums when approval is not required", func() {
+			registry := plugin.NewRegistry(log)
+			defer registry.Close()
+
+			err := registry.LoadPlugins(&config.PluginConfig{
+				Enabled: new(true),
+				Plugins: []*config.PluginInstanceConfig{
+					{
+						Name:             "approved-plugin",
+						Type:             config.PluginTypeExec,
+						Path:             pluginPath,
+						ApprovedChecksum: "stale",
+						ProjectRoot:      projectRoot,
+					},
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registry.GetValidators(bashCtx)).To(HaveLen(1))
+		})
+	})
+
+	Describe("CheckPlugins", func() {
+		It("should report the load result of each enabled plugin", func() {
+			pluginPath, err := createExecPlugin(
+				pluginDir,
+				"good-plugin",
+				&pluginapi.ValidateResponse{Passed: true},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			checks := plugin.CheckPlugins(&config.PluginConfig{
+				Enabled: new(true),
+				Plugins: []*config.PluginInstanceConfig{
+					{
+						Name:        "good-plugin",
+						Type:        config.PluginTypeExec,
+						Path:        pluginPath,
+						ProjectRoot: projectRoot,
+					},
+					{
+						Name:        "missing-plugin",
+						Type:        config.PluginTypeExec,
+						Path:        filepath.Join(pluginDir, "missing"),
+						ProjectRoot: projectRoot,
+					},
+					{
+						Name:    "disabled-plugin",
+						Type:    config.PluginTypeExec,
+						Path:    filepath.Join(pluginDir, "missing"),
+						Enabled: new(false),
+					},
+				},
+			}, log)
+
+			Expect(checks).To(HaveLen(2))
+			Expect(checks[0].Name).To(Equal("good-plugin"))
+			Expect(checks[0].Err).NotTo(HaveOccurred())
+			Expect(checks[1].Name).To(Equal("missing-plugin"))
+			Expect(checks[1].Err).To(MatchError(ContainSubstring("plugin missing-plugin")))
+		})
+
+		It("should verify approval when it is required", func() {
+			pluginPath, err := createExecPlugin(
+				pluginDir,
+				"unapproved-plugin",
+				&pluginapi.ValidateResponse{Passed: true},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			checks := plugin.CheckPlugins(&config.PluginConfig{
+				Enabled:         new(true),
+				RequireApproval: new(true),
+				Plugins: []*config.PluginInstanceConfig{
+					{
+						Name:        "unapproved-plugin",
+						Type:        config.PluginTypeExec,
+						Path:        pluginPath,
+						ProjectRoot: projectRoot,
+					},
+				},
+			}, log)
+
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].Err).To(MatchError(plugin.ErrPluginNotApproved))
+		})
+
+		It("should return nil when plugins are disabled", func() {
+			Expect(plugin.CheckPlugins(&config.PluginConfig{}, log)).To(BeNil())
+			Expect(plugin.CheckPlugins(nil, log)).To(BeNil())
+		})
+	})
+
 	Describe("Plugin Lifecycle", func() {
 		It("should properly close all plugins on registry close", func() {
 			execPath, err := createExecPlugin(
@@ -6,7 +6,7 @@ import (
 	"github.com/smykla-skalski/klaudiush/pkg/config"
 )
 
-// Loader loads plugins from various sources (Go plugins, gRPC, exec).
+// Loader loads plugins from various sources (Go plugins, gRPC, exec, HTTP).
 type Loader interface {
 	// Load loads a plugin based on the provided configuration.
 	// Returns an error if the plugin cannot be loaded.
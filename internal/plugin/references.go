@@ -0,0 +1,36 @@
+package plugin
+
+import "github.com/smykla-skalski/klaudiush/internal/validator"
+
+func init() {
+	validator.RegisterReferences(
+		validator.ReferenceInfo{
+			Code:  validator.RefPluginPathTraversal.Code(),
+			Title: "Path traversal in plugin path",
+			Description: "The plugin path contains directory traversal patterns (`../`) that " +
+				"could escape the allowed plugin directory.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefPluginPathNotAllowed.Code(),
+			Title: "Plugin path outside allowed directories",
+			Description: "The plugin path resolves to a location outside the allowed plugin " +
+				"directories.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefPluginInvalidExtension.Code(),
+			Title:       "Invalid plugin file extension",
+			Description: "The plugin file has an extension that is not in the allowed list.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefPluginInsecureRemote.Code(),
+			Title:       "Insecure gRPC plugin connection",
+			Description: "A gRPC plugin is configured to connect without TLS encryption.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefPluginDangerousChars.Code(),
+			Title: "Dangerous characters in plugin path",
+			Description: "The plugin path contains shell metacharacters (`;`, `|`, `&`, `$`, " +
+				"backticks, quotes, `<`, `>`, `(`, `)`).",
+		},
+	)
+}
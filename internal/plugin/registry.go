@@ -3,6 +3,7 @@ package plugin
 import (
 	"path/filepath"
 	"regexp"
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -19,11 +20,22 @@ const (
 	defaultRegistryTimeout = 10 * time.Second
 )
 
-// Registry manages plugin loading and lifecycle.
+// ErrDuplicatePlugin is returned when a plugin name is already registered.
+var ErrDuplicatePlugin = errors.New("duplicate plugin name")
+
+// Registry manages plugin loading and lifecycle. It is safe for concurrent
+// use; Reload swaps the loaded plugins at once.
 type Registry struct {
-	loaders map[config.PluginType]Loader
-	plugins []*PluginEntry
-	logger  logger.Logger
+	loaders         map[config.PluginType]Loader
+	mu              sync.RWMutex
+	plugins         []*PluginEntry
+	logger          logger.Logger
+	requireApproval bool
+	maxConcurrency  int
+
+	// live is the registry Reload swaps the plugins loaded here into. Nil
+	// for a registry created by NewRegistry, which is its own.
+	live *Registry
 }
 
 // PluginEntry represents a loaded plugin with its configuration and predicate.
@@ -50,9 +62,11 @@ func NewRegistry(log logger.Logger) *Registry {
 	return &Registry{
 		loaders: map[config.PluginType]Loader{
 			config.PluginTypeExec: NewExecLoader(runner),
+			config.PluginTypeHTTP: NewHTTPLoader(nil),
 		},
-		plugins: make([]*PluginEntry, 0),
-		logger:  log,
+		plugins:        make([]*PluginEntry, 0),
+		logger:         log,
+		maxConcurrency: config.DefaultPluginMaxConcurrency,
 	}
 }
 
@@ -62,6 +76,9 @@ func (r *Registry) LoadPlugins(cfg *config.PluginConfig) error {
 		return nil
 	}
 
+	r.requireApproval = cfg.IsRequireApproval()
+	r.maxConcurrency = cfg.GetMaxConcurrency()
+
 	var loadErrors []error
 
 	for _, pluginCfg := range cfg.Plugins {
@@ -83,7 +100,7 @@ func (r *Registry) LoadPlugins(cfg *config.PluginConfig) error {
 				loadErrors = []error{}
 			}
 
-			loadErrors = append(loadErrors, errors.Wrapf(err, "plugin %s", pluginCfg.Name))
+			loadErrors = append(loadErrors, err)
 
 			continue
 		}
@@ -103,43 +120,159 @@ func (r *Registry) LoadPlugins(cfg *config.PluginConfig) error {
 }
 
 // LoadPlugin loads a single plugin.
+// The name and predicate are validated before the plugin itself is loaded,
+// so misconfigured plugins fail fast. When approval is required, the plugin
+// checksum is verified before the plugin is executed. Errors include the
+// plugin name.
 func (r *Registry) LoadPlugin(cfg *config.PluginInstanceConfig) error {
+	predicate, err := r.prepare(cfg)
+	if err != nil {
+		return err
+	}
+
 	loader, ok := r.loaders[cfg.Type]
 	if !ok {
-		return errors.Errorf("unsupported plugin type: %s", cfg.Type)
+		return errors.Errorf("plugin %s: unsupported plugin type: %s", cfg.Name, cfg.Type)
+	}
+
+	if r.requireApproval {
+		if err := VerifyApproval(cfg); err != nil {
+			return errors.Wrapf(err, "plugin %s", cfg.Name)
+		}
 	}
 
 	plugin, err := loader.Load(cfg)
 	if err != nil {
-		return err
+		return errors.Wrapf(err, "plugin %s", cfg.Name)
+	}
+
+	r.register(plugin, cfg, predicate)
+
+	return nil
+}
+
+// prepare checks the plugin name for duplicates and builds its predicate matcher.
+func (r *Registry) prepare(cfg *config.PluginInstanceConfig) (*PredicateMatcher, error) {
+	if cfg.Name != "" && r.hasPlugin(cfg.Name) {
+		return nil, errors.Wrapf(ErrDuplicatePlugin, "plugin %s", cfg.Name)
 	}
 
-	// Build predicate matcher
 	predicate, err := NewPredicateMatcher(cfg.Predicate)
 	if err != nil {
-		return errors.Wrap(err, "failed to build predicate matcher")
+		return nil, errors.Wrapf(err, "plugin %s: failed to build predicate matcher", cfg.Name)
 	}
 
-	// Exec plugins are I/O-bound (process spawning)
+	return predicate, nil
+}
+
+// register adds a loaded plugin to the registry.
+func (r *Registry) register(
+	p Plugin,
+	cfg *config.PluginInstanceConfig,
+	predicate *PredicateMatcher,
+) {
+	// Plugins are I/O-bound (process spawning or network requests)
 	category := validator.CategoryIO
 
+	// Validators returned before a Reload may still call the plugin
+	p = newSharedPlugin(p)
+
 	// Create validator adapter
-	validatorAdapter := NewValidatorAdapter(plugin, category, r.logger)
+	validatorAdapter := NewValidatorAdapter(p, category, r.logger)
+
+	// A validator running into a reload validates with the plugin loaded
+	// under the same name in its place
+	live := r.liveRegistry()
+	validatorAdapter.replacement = func() Plugin {
+		return live.pluginNamed(cfg.Name)
+	}
 
-	entry := &PluginEntry{
-		Plugin:    plugin,
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.plugins = append(r.plugins, &PluginEntry{
+		Plugin:    p,
 		Config:    cfg,
 		Predicate: predicate,
 		Validator: validatorAdapter,
+	})
+}
+
+// liveRegistry returns the registry the plugins loaded here are served from.
+func (r *Registry) liveRegistry() *Registry {
+	if r.live != nil {
+		return r.live
 	}
 
-	r.plugins = append(r.plugins, entry)
+	return r
+}
+
+// pluginNamed returns the loaded plugin with the given name, or nil.
+func (r *Registry) pluginNamed(name string) Plugin {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entry := range r.plugins {
+		if entry.Config != nil && entry.Config.Name == name {
+			return entry.Plugin
+		}
+	}
 
 	return nil
 }
 
+// hasPlugin reports whether a plugin with the given name is loaded.
+func (r *Registry) hasPlugin(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entry := range r.plugins {
+		if entry.Config != nil && entry.Config.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reload loads the plugins in cfg and replaces the loaded plugins with them
+// at once. Plugins that fail to load are skipped, as in LoadPlugins, and the
+// error is returned after the swap. The replaced plugins are closed once no
+// longer reachable through GetValidators, after their Validate calls already
+// running return. Validators returned earlier run the plugin loaded under
+// the same name in place of a closed one, and warn when there is none.
+func (r *Registry) Reload(cfg *config.PluginConfig) error {
+	next := &Registry{
+		loaders:        r.loaders,
+		plugins:        make([]*PluginEntry, 0),
+		logger:         r.logger,
+		maxConcurrency: r.maxConcurrency,
+		live:           r.liveRegistry(),
+	}
+
+	err := next.LoadPlugins(cfg)
+
+	r.mu.Lock()
+	previous := r.plugins
+	r.plugins = next.plugins
+	r.requireApproval = next.requireApproval
+	r.maxConcurrency = next.maxConcurrency
+	r.mu.Unlock()
+
+	for _, entry := range previous {
+		if closeErr := entry.Plugin.Close(); closeErr != nil {
+			r.logger.Error("failed to close plugin", "error", closeErr)
+		}
+	}
+
+	return err
+}
+
 // GetValidators returns validators for plugins that match the given context.
 func (r *Registry) GetValidators(hookCtx *hook.Context) []validator.Validator {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	validators := make([]validator.Validator, 0)
 
 	for _, entry := range r.plugins {
@@ -153,6 +286,9 @@ func (r *Registry) GetValidators(hookCtx *hook.Context) []validator.Validator {
 
 // Close releases all plugin resources.
 func (r *Registry) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	var firstErr error
 
 	for _, entry := range r.plugins {
@@ -378,26 +514,12 @@ func (r *Registry) LoadPluginForTesting(
 	p Plugin,
 	cfg *config.PluginInstanceConfig,
 ) error {
-	// Build predicate matcher
-	predicate, err := NewPredicateMatcher(cfg.Predicate)
+	predicate, err := r.prepare(cfg)
 	if err != nil {
-		return errors.Wrap(err, "failed to build predicate matcher")
-	}
-
-	// Exec plugins are I/O-bound (process spawning)
-	category := validator.CategoryIO
-
-	// Create validator adapter
-	validatorAdapter := NewValidatorAdapter(p, category, r.logger)
-
-	entry := &PluginEntry{
-		Plugin:    p,
-		Config:    cfg,
-		Predicate: predicate,
-		Validator: validatorAdapter,
+		return err
 	}
 
-	r.plugins = append(r.plugins, entry)
+	r.register(p, cfg, predicate)
 
 	return nil
 }
@@ -1,6 +1,11 @@
 package plugin_test
 
 import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
@@ -116,6 +121,82 @@ var _ = Describe("Registry", func() {
 		})
 	})
 
+	Describe("LoadPlugin", func() {
+		var mockPlugin *plugin.MockPlugin
+
+		BeforeEach(func() {
+			mockPlugin = plugin.NewMockPlugin(ctrl)
+			mockPlugin.EXPECT().Info().Return(pluginapi.Info{
+				Name:    "test-plugin",
+				Version: "1.0.0",
+			}).AnyTimes()
+		})
+
+		It("should reject a duplicate plugin name", func() {
+			cfg := &config.PluginInstanceConfig{
+				Name: "test-plugin",
+				Type: config.PluginTypeExec,
+			}
+
+			Expect(registry.LoadPluginForTesting(mockPlugin, cfg)).To(Succeed())
+
+			err := registry.LoadPlugin(&config.PluginInstanceConfig{
+				Name: "test-plugin",
+				Type: config.PluginTypeExec,
+				Path: "/usr/local/bin/other-plugin",
+			})
+
+			Expect(err).To(MatchError(plugin.ErrDuplicatePlugin))
+			Expect(err.Error()).To(ContainSubstring("plugin test-plugin"))
+		})
+
+		It("should reject an invalid command pattern before loading", func() {
+			err := registry.LoadPlugin(&config.PluginInstanceConfig{
+				Name: "bad-predicate",
+				Type: config.PluginTypeExec,
+				Path: "/nonexistent/plugin",
+				Predicate: &config.PluginPredicate{
+					CommandPatterns: []string{"[invalid"},
+				},
+			})
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("plugin bad-predicate"))
+			Expect(err.Error()).To(ContainSubstring("invalid command pattern"))
+		})
+
+		It("should reject an invalid file pattern", func() {
+			err := registry.LoadPluginForTesting(mockPlugin, &config.PluginInstanceConfig{
+				Name: "bad-glob",
+				Type: config.PluginTypeExec,
+				Predicate: &config.PluginPredicate{
+					FilePatterns: []string{"[invalid"},
+				},
+			})
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("plugin bad-glob"))
+			Expect(err.Error()).To(ContainSubstring("invalid file pattern"))
+		})
+
+		It("should not register a rejected plugin", func() {
+			err := registry.LoadPluginForTesting(mockPlugin, &config.PluginInstanceConfig{
+				Name: "bad-predicate",
+				Type: config.PluginTypeExec,
+				Predicate: &config.PluginPredicate{
+					CommandPatterns: []string{"("},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+
+			validators := registry.GetValidators(&hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+			})
+			Expect(validators).To(BeEmpty())
+		})
+	})
+
 	Describe("GetValidators", func() {
 		var mockPlugin *plugin.MockPlugin
 
@@ -167,6 +248,50 @@ var _ = Describe("Registry", func() {
 			})
 		})
 
+		Context("with a plugin returning structured metadata", func() {
+			It("should carry error metadata and details into the validator result", func() {
+				mockPlugin.EXPECT().
+					Validate(gomock.Any(), gomock.Any()).
+					Return(
+						pluginapi.FailWithCode(
+							"BRANCH001",
+							"push to protected branch",
+							"Push to a feature branch",
+							"https://example.com/errors/BRANCH001",
+						).AddDetail("branch", "main").AddDetail("rule", "protected"),
+						nil,
+					)
+
+				cfg := &config.PluginInstanceConfig{
+					Name: "test-plugin",
+					Type: config.PluginTypeExec,
+				}
+
+				err := registry.LoadPluginForTesting(mockPlugin, cfg)
+
+				Expect(err).NotTo(HaveOccurred())
+
+				hookCtx := &hook.Context{
+					EventType: hook.EventTypePreToolUse,
+					ToolName:  hook.ToolTypeBash,
+				}
+
+				validators := registry.GetValidators(hookCtx)
+				Expect(validators).To(HaveLen(1))
+
+				result := validators[0].Validate(context.Background(), hookCtx)
+
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(result.Reference.Code()).To(Equal("BRANCH001"))
+				Expect(result.FixHint).To(Equal("Push to a feature branch"))
+				Expect(result.PluginDetails).To(Equal(map[string]string{
+					"branch": "main",
+					"rule":   "protected",
+				}))
+			})
+		})
+
 		Context("with plugins that don't match", func() {
 			It("should not return validators for wrong event type", func() {
 				cfg := &config.PluginInstanceConfig{
@@ -217,6 +342,141 @@ var _ = Describe("Registry", func() {
 		})
 	})
 
+	Describe("Reload", func() {
+		var (
+			mockPlugin *plugin.MockPlugin
+			hookCtx    *hook.Context
+		)
+
+		BeforeEach(func() {
+			mockPlugin = plugin.NewMockPlugin(ctrl)
+			mockPlugin.EXPECT().Info().Return(pluginapi.Info{
+				Name:    "remote-lint",
+				Version: "1.0.0",
+			}).AnyTimes()
+
+			Expect(registry.LoadPluginForTesting(mockPlugin, &config.PluginInstanceConfig{
+				Name: "remote-lint",
+				Type: config.PluginTypeHTTP,
+			})).To(Succeed())
+
+			hookCtx = &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+			}
+		})
+
+		It("should replace loaded plugins with the same names", func() {
+			mockPlugin.EXPECT().Close().Return(nil).Times(1)
+
+			server := newHTTPPluginServer("remote-lint", &pluginapi.ValidateResponse{Passed: true})
+			defer server.Close()
+
+			err := registry.Reload(&config.PluginConfig{
+				Enabled: new(true),
+				Plugins: []*config.PluginInstanceConfig{
+					{Name: "remote-lint", Type: config.PluginTypeHTTP, URL: server.URL},
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registry.GetValidators(hookCtx)).To(HaveLen(1))
+		})
+
+		It("should drop all plugins when plugins are disabled", func() {
+			mockPlugin.EXPECT().Close().Return(nil).Times(1)
+
+			Expect(registry.Reload(&config.PluginConfig{Enabled: new(false)})).To(Succeed())
+			Expect(registry.GetValidators(hookCtx)).To(BeEmpty())
+		})
+
+		It("should warn from validators already returned when their plugin was removed", func() {
+			mockPlugin.EXPECT().Close().Return(nil).Times(1)
+			mockPlugin.EXPECT().Validate(gomock.Any(), gomock.Any()).Times(0)
+
+			validators := registry.GetValidators(hookCtx)
+			Expect(validators).To(HaveLen(1))
+
+			Expect(registry.Reload(nil)).To(Succeed())
+
+			result := validators[0].Validate(context.Background(), hookCtx)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("remote-lint"))
+		})
+
+		It("should run the reloaded plugin from validators already returned", func() {
+			mockPlugin.EXPECT().Close().Return(nil).Times(1)
+			mockPlugin.EXPECT().Validate(gomock.Any(), gomock.Any()).Times(0)
+
+			server := newHTTPPluginServer("remote-lint", &pluginapi.ValidateResponse{
+				Passed:      false,
+				ShouldBlock: true,
+				Message:     "blocked by reloaded plugin",
+			})
+			defer server.Close()
+
+			validators := registry.GetValidators(hookCtx)
+			Expect(validators).To(HaveLen(1))
+
+			Expect(registry.Reload(&config.PluginConfig{
+				Enabled: new(true),
+				Plugins: []*config.PluginInstanceConfig{
+					{Name: "remote-lint", Type: config.PluginTypeHTTP, URL: server.URL},
+				},
+			})).To(Succeed())
+
+			result := validators[0].Validate(context.Background(), hookCtx)
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Message).To(Equal("blocked by reloaded plugin"))
+		})
+	})
+
+	Describe("Reload with a validation in flight", func() {
+		It("should close the replaced plugin only after the validation returns", func() {
+			slow := &closingPlugin{
+				started: make(chan struct{}),
+				release: make(chan struct{}),
+			}
+
+			Expect(registry.LoadPluginForTesting(slow, &config.PluginInstanceConfig{
+				Name: "slow-lint",
+				Type: config.PluginTypeExec,
+			})).To(Succeed())
+
+			hookCtx := &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+			}
+
+			validators := registry.GetValidators(hookCtx)
+			Expect(validators).To(HaveLen(1))
+
+			results := make(chan bool, 1)
+
+			go func() {
+				results <- validators[0].Validate(context.Background(), hookCtx).Passed
+			}()
+
+			Eventually(slow.started).Should(BeClosed())
+
+			reloaded := make(chan error, 1)
+
+			go func() {
+				reloaded <- registry.Reload(nil)
+			}()
+
+			Consistently(reloaded, 100*time.Millisecond).ShouldNot(Receive())
+			Expect(slow.closed.Load()).To(BeFalse())
+
+			close(slow.release)
+
+			Eventually(results).Should(Receive(BeTrue()))
+			Eventually(reloaded).Should(Receive(BeNil()))
+			Expect(slow.closed.Load()).To(BeTrue())
+		})
+	})
+
 	Describe("Close", func() {
 		It("should not return error when no plugins loaded", func() {
 			err := registry.Close()
@@ -569,3 +829,35 @@ var _ = Describe("PredicateMatcher", func() {
 })
 
 // Helper functions
+
+// closingPlugin blocks Validate until released and fails it when the plugin
+// was closed in the meantime.
+type closingPlugin struct {
+	started chan struct{}
+	release chan struct{}
+	closed  atomic.Bool
+}
+
+func (*closingPlugin) Info() pluginapi.Info {
+	return pluginapi.Info{Name: "slow-lint", Version: "1.0.0"}
+}
+
+func (p *closingPlugin) Validate(
+	context.Context,
+	*pluginapi.ValidateRequest,
+) (*pluginapi.ValidateResponse, error) {
+	close(p.started)
+	<-p.release
+
+	if p.closed.Load() {
+		return nil, errors.New("validated after close")
+	}
+
+	return &pluginapi.ValidateResponse{Passed: true}, nil
+}
+
+func (p *closingPlugin) Close() error {
+	p.closed.Store(true)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+// indexedResult is the result of the validator at index in a run.
+type indexedResult struct {
+	index  int
+	result *validator.Result
+}
+
+// Validate runs the plugins matching hookCtx, at most MaxConcurrency at once,
+// and returns their results in load order.
+//
+// Plugins share the deadline of ctx. When it expires, Validate returns
+// without waiting: plugins still running or not yet started get a failed
+// result of their own, like a plugin returning an error, and the results of
+// the finished plugins are kept.
+func (r *Registry) Validate(ctx context.Context, hookCtx *hook.Context) []*validator.Result {
+	validators := r.GetValidators(hookCtx)
+	if len(validators) == 0 {
+		return nil
+	}
+
+	r.mu.RLock()
+	limit := r.maxConcurrency
+	r.mu.RUnlock()
+
+	return runValidators(ctx, hookCtx, validators, limit)
+}
+
+// runValidators runs validators with at most limit running at once.
+func runValidators(
+	ctx context.Context,
+	hookCtx *hook.Context,
+	validators []validator.Validator,
+	limit int,
+) []*validator.Result {
+	// Buffered so plugins finishing after the deadline never block.
+	done := make(chan indexedResult, len(validators))
+	slots := make(chan struct{}, max(limit, 1))
+
+	go func() {
+		for i, v := range validators {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			go func() {
+				defer func() { <-slots }()
+
+				done <- indexedResult{index: i, result: v.Validate(ctx, hookCtx)}
+			}()
+		}
+	}()
+
+	results := make([]*validator.Result, len(validators))
+
+	for range validators {
+		select {
+		case res := <-done:
+			results[res.index] = res.result
+		case <-ctx.Done():
+			return unfinishedResults(ctx, validators, results, done)
+		}
+	}
+
+	return results
+}
+
+// unfinishedResults collects results that arrived together with the deadline
+// and fails the validators that did not finish.
+func unfinishedResults(
+	ctx context.Context,
+	validators []validator.Validator,
+	results []*validator.Result,
+	done <-chan indexedResult,
+) []*validator.Result {
+	for len(done) > 0 {
+		res := <-done
+		results[res.index] = res.result
+	}
+
+	for i, result := range results {
+		if result == nil {
+			results[i] = validator.Fail(fmt.Sprintf(
+				"Plugin error: %s did not finish: %v",
+				validators[i].Name(),
+				ctx.Err(),
+			))
+		}
+	}
+
+	return results
+}
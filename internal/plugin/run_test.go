@@ -0,0 +1,175 @@
+package plugin_test
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/smykla-skalski/klaudiush/internal/plugin"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+	pluginapi "github.com/smykla-skalski/klaudiush/pkg/plugin"
+)
+
+// validateFunc is the signature of Plugin.Validate.
+type validateFunc = func(
+	context.Context,
+	*pluginapi.ValidateRequest,
+) (*pluginapi.ValidateResponse, error)
+
+var _ = Describe("Registry.Validate", func() {
+	const pluginDelay = 200 * time.Millisecond
+
+	var (
+		registry *plugin.Registry
+		ctrl     *gomock.Controller
+		hookCtx  *hook.Context
+	)
+
+	// addPlugin loads a mock plugin named name that validates with fn.
+	addPlugin := func(name string, fn validateFunc) {
+		mockPlugin := plugin.NewMockPlugin(ctrl)
+		mockPlugin.EXPECT().Info().Return(pluginapi.Info{Name: name}).AnyTimes()
+		mockPlugin.EXPECT().Validate(gomock.Any(), gomock.Any()).DoAndReturn(fn).AnyTimes()
+
+		Expect(registry.LoadPluginForTesting(mockPlugin, &config.PluginInstanceConfig{
+			Name: name,
+			Type: config.PluginTypeExec,
+		})).To(Succeed())
+	}
+
+	// sleeping returns a plugin that passes with message after pluginDelay.
+	sleeping := func(message string) validateFunc {
+		return func(
+			context.Context,
+			*pluginapi.ValidateRequest,
+		) (*pluginapi.ValidateResponse, error) {
+			time.Sleep(pluginDelay)
+
+			return pluginapi.WarnResponse(message), nil
+		}
+	}
+
+	BeforeEach(func() {
+		registry = plugin.NewRegistry(logger.NewNoOpLogger())
+		ctrl = gomock.NewController(GinkgoT())
+		hookCtx = &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should return nil when no plugin matches", func() {
+		Expect(registry.Validate(context.Background(), hookCtx)).To(BeNil())
+	})
+
+	It("should run matching plugins concurrently and keep load order", func() {
+		addPlugin("first", sleeping("first"))
+		addPlugin("second", sleeping("second"))
+		addPlugin("third", sleeping("third"))
+
+		start := time.Now()
+		results := registry.Validate(context.Background(), hookCtx)
+
+		Expect(time.Since(start)).To(BeNumerically("<", 2*pluginDelay))
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].Message).To(Equal("first"))
+		Expect(results[1].Message).To(Equal("second"))
+		Expect(results[2].Message).To(Equal("third"))
+	})
+
+	It("should run at most MaxConcurrency plugins at once", func() {
+		Expect(registry.LoadPlugins(&config.PluginConfig{
+			Enabled:        new(true),
+			MaxConcurrency: 2,
+		})).To(Succeed())
+
+		var running, peak atomic.Int32
+
+		counting := func(
+			context.Context,
+			*pluginapi.ValidateRequest,
+		) (*pluginapi.ValidateResponse, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				old := peak.Load()
+				if n <= old || peak.CompareAndSwap(old, n) {
+					break
+				}
+			}
+
+			time.Sleep(pluginDelay / 4)
+
+			return pluginapi.PassResponse(), nil
+		}
+
+		for _, name := range []string{"a", "b", "c", "d", "e"} {
+			addPlugin(name, counting)
+		}
+
+		results := registry.Validate(context.Background(), hookCtx)
+
+		Expect(results).To(HaveLen(5))
+		Expect(peak.Load()).To(BeNumerically("<=", 2))
+	})
+
+	It("should not wait for a slow plugin beyond the deadline", func() {
+		release := make(chan struct{})
+		DeferCleanup(func() { close(release) })
+
+		addPlugin("fast", sleeping("fast"))
+		addPlugin("slow", func(
+			context.Context,
+			*pluginapi.ValidateRequest,
+		) (*pluginapi.ValidateResponse, error) {
+			// Ignores cancellation, like a plugin stuck on I/O.
+			<-release
+
+			return pluginapi.PassResponse(), nil
+		})
+		addPlugin("also-fast", sleeping("also fast"))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*pluginDelay)
+		defer cancel()
+
+		start := time.Now()
+		results := registry.Validate(ctx, hookCtx)
+
+		Expect(time.Since(start)).To(BeNumerically("<", 4*pluginDelay))
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].Message).To(Equal("fast"))
+		Expect(results[1].Passed).To(BeFalse())
+		Expect(results[1].Message).
+			To(Equal("Plugin error: plugin:slow did not finish: context deadline exceeded"))
+		Expect(results[2].Message).To(Equal("also fast"))
+	})
+
+	It("should fail only the plugin that returned an error", func() {
+		addPlugin("broken", func(
+			context.Context,
+			*pluginapi.ValidateRequest,
+		) (*pluginapi.ValidateResponse, error) {
+			return nil, errors.New("connection refused")
+		})
+		addPlugin("healthy", sleeping("healthy"))
+
+		results := registry.Validate(context.Background(), hookCtx)
+
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Passed).To(BeFalse())
+		Expect(results[0].Message).To(Equal("Plugin error: connection refused"))
+		Expect(results[1].Message).To(Equal("healthy"))
+	})
+})
@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -40,6 +42,9 @@ var (
 
 	// ErrLoaderClosed is returned when attempting to use a closed loader.
 	ErrLoaderClosed = errors.New("loader has been closed")
+
+	// ErrInsecureURL is returned when an HTTP plugin URL is not allowed.
+	ErrInsecureURL = errors.New("insecure plugin URL")
 )
 
 // dangerousChars contains shell metacharacters to reject in paths.
@@ -211,6 +216,44 @@ func ValidateMetachars(path string) error {
 	return nil
 }
 
+// ValidateURL checks that an HTTP plugin URL is absolute and uses https.
+// Plain http is only allowed for loopback hosts, so hook payloads (commands,
+// file contents) are never sent unencrypted over the network.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse URL")
+	}
+
+	if u.Host == "" {
+		return errors.Wrap(ErrInsecureURL, "URL must be absolute")
+	}
+
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLoopbackHost(u.Hostname()) {
+			return nil
+		}
+
+		return errors.Wrap(ErrInsecureURL, "http is only allowed for localhost, use https")
+	default:
+		return errors.Wrapf(ErrInsecureURL, "unsupported scheme %q", u.Scheme)
+	}
+}
+
+// isLoopbackHost reports whether host is localhost or a loopback IP.
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
 // GetAllowedDirs returns the list of allowed plugin directories.
 // Returns both the global (~/.klaudiush/plugins) and project (.klaudiush/plugins) directories.
 func GetAllowedDirs(projectRoot string) ([]string, error) {
@@ -294,6 +294,27 @@ var _ = Describe("Security", func() {
 		})
 	})
 
+	Describe("ValidateURL", func() {
+		DescribeTable("should accept",
+			func(rawURL string) {
+				Expect(plugin.ValidateURL(rawURL)).To(Succeed())
+			},
+			Entry("https", "https://plugins.example.com/validate"),
+			Entry("http on localhost", "http://localhost:8080/validate"),
+			Entry("http on IPv4 loopback", "http://127.0.0.1:8080"),
+			Entry("http on IPv6 loopback", "http://[::1]:8080/validate"),
+		)
+
+		DescribeTable("should reject",
+			func(rawURL string) {
+				Expect(plugin.ValidateURL(rawURL)).To(MatchError(plugin.ErrInsecureURL))
+			},
+			Entry("http on a remote host", "http://plugins.example.com/validate"),
+			Entry("relative URL", "/validate"),
+			Entry("other scheme", "ftp://localhost/validate"),
+		)
+	})
+
 	Describe("GetAllowedDirs", func() {
 		It("should return global and project directories", func() {
 			dirs, err := plugin.GetAllowedDirs(tempDir)
@@ -359,6 +380,7 @@ var _ = Describe("Security", func() {
 				plugin.ErrInvalidExtension,
 				plugin.ErrDangerousChars,
 				plugin.ErrLoaderClosed,
+				plugin.ErrInsecureURL,
 			}
 
 			for i, err1 := range errors {
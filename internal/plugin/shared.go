@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/pkg/plugin"
+)
+
+// errPluginClosed is returned by Validate once the plugin has been closed,
+// e.g. after Reload replaced it.
+var errPluginClosed = errors.New("plugin closed")
+
+// sharedPlugin wraps a loaded plugin whose validators may still be running
+// when Reload replaces it. Close waits for Validate calls already running
+// before closing the plugin, and calls starting after Close return
+// errPluginClosed without reaching it.
+type sharedPlugin struct {
+	Plugin
+
+	mu       sync.Mutex
+	inflight sync.WaitGroup
+	closed   bool
+}
+
+// newSharedPlugin wraps p.
+func newSharedPlugin(p Plugin) *sharedPlugin {
+	return &sharedPlugin{Plugin: p}
+}
+
+// Validate calls the plugin unless it has been closed.
+func (p *sharedPlugin) Validate(
+	ctx context.Context,
+	req *plugin.ValidateRequest,
+) (*plugin.ValidateResponse, error) {
+	p.mu.Lock()
+
+	if p.closed {
+		p.mu.Unlock()
+
+		return nil, errPluginClosed
+	}
+
+	p.inflight.Add(1)
+	p.mu.Unlock()
+
+	defer p.inflight.Done()
+
+	return p.Plugin.Validate(ctx, req)
+}
+
+// Close stops new Validate calls, waits for running ones to return and
+// closes the plugin. Later calls do nothing.
+func (p *sharedPlugin) Close() error {
+	p.mu.Lock()
+
+	if p.closed {
+		p.mu.Unlock()
+
+		return nil
+	}
+
+	p.closed = true
+	p.mu.Unlock()
+
+	p.inflight.Wait()
+
+	return p.Plugin.Close()
+}
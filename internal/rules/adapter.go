@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
 )
@@ -82,8 +83,9 @@ func (a *RuleValidatorAdapter) CheckRules(
 
 	// Build match context.
 	matchCtx := &MatchContext{
-		HookContext:   hookCtx,
-		ValidatorType: a.validatorType,
+		HookContext:    hookCtx,
+		ValidatorType:  a.validatorType,
+		SkipTransforms: validator.TransformsSkipped(ctx),
 	}
 
 	if hookCtx != nil {
@@ -126,10 +128,11 @@ func (a *RuleValidatorAdapter) CheckRulesWithContext(
 
 	// Build match context.
 	matchCtx := &MatchContext{
-		HookContext:   hookCtx,
-		GitContext:    gitCtx,
-		FileContext:   fileCtx,
-		ValidatorType: a.validatorType,
+		HookContext:    hookCtx,
+		GitContext:     gitCtx,
+		FileContext:    fileCtx,
+		ValidatorType:  a.validatorType,
+		SkipTransforms: validator.TransformsSkipped(ctx),
 	}
 
 	if hookCtx != nil {
@@ -152,26 +155,15 @@ func (a *RuleValidatorAdapter) CheckRulesWithContext(
 func (*RuleValidatorAdapter) convertResult(result *RuleResult) *validator.Result {
 	switch result.Action {
 	case ActionBlock:
-		if result.Reference != "" {
-			return validator.FailWithRef(
-				validator.Reference(result.Reference),
-				result.Message,
-			)
-		}
-
-		return validator.Fail(result.Message)
+		return findingResult(result, config.SeverityError)
 
 	case ActionWarn:
-		if result.Reference != "" {
-			return validator.WarnWithRef(
-				validator.Reference(result.Reference),
-				result.Message,
-			)
-		}
+		return findingResult(result, config.SeverityWarning)
 
-		return validator.Warn(result.Message)
+	case ActionTransform:
+		return transformResult(result)
 
-	case ActionAllow:
+	case ActionAllow, ActionLog:
 		return validator.Pass()
 
 	default:
@@ -179,6 +171,64 @@ func (*RuleValidatorAdapter) convertResult(result *RuleResult) *validator.Result
 	}
 }
 
+// findingResult converts a block or warn match to a failing result enforced
+// with the action's severity override, or with implied when there is none.
+func findingResult(result *RuleResult, implied config.Severity) *validator.Result {
+	severity := result.Severity
+	if severity == config.SeverityUnknown {
+		severity = implied
+	}
+
+	var converted *validator.Result
+
+	switch {
+	case severity.ShouldBlock() && result.Reference != "":
+		converted = validator.FailWithRef(validator.Reference(result.Reference), result.Message)
+	case severity.ShouldBlock():
+		converted = validator.Fail(result.Message)
+	case result.Reference != "":
+		converted = validator.WarnWithRef(validator.Reference(result.Reference), result.Message)
+	default:
+		converted = validator.Warn(result.Message)
+	}
+
+	converted.Informational = severity == config.SeverityInfo
+
+	return converted
+}
+
+// transformResult converts a transform match to a non-blocking result that
+// carries the rewritten field. The rewrite is reported as a warning so it is
+// visible to the user and reaches the hook response. The dispatcher validates
+// the rewritten input again, and a block there overrides the rewrite.
+func transformResult(result *RuleResult) *validator.Result {
+	if result.Rewrite == nil {
+		return validator.Pass()
+	}
+
+	message := result.Message
+	if message == "" {
+		message = "Rewrote " + string(result.Rewrite.Field) + " by rule " + result.Rule.Name
+	}
+
+	var converted *validator.Result
+	if result.Reference != "" {
+		converted = validator.WarnWithRef(validator.Reference(result.Reference), message)
+	} else {
+		converted = validator.Warn(message)
+	}
+
+	converted.UpdatedInput = map[string]string{
+		string(result.Rewrite.Field): result.Rewrite.Value,
+	}
+
+	if result.Rewrite.Field == TransformCommand {
+		converted.AddDetail("command", result.Rewrite.Value)
+	}
+
+	return converted
+}
+
 // HasRulesForValidator returns true if there are any rules for this validator type.
 func (a *RuleValidatorAdapter) HasRulesForValidator() bool {
 	if a.engine == nil {
@@ -7,6 +7,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/smykla-skalski/klaudiush/internal/rules"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 )
 
@@ -163,6 +165,94 @@ var _ = Describe("RuleValidatorAdapter", func() {
 			})
 		})
 
+		Context("with log rule", func() {
+			BeforeEach(func() {
+				var err error
+
+				engine, err = rules.NewRuleEngine([]*rules.Rule{
+					{
+						Name:    "observe-all",
+						Enabled: true,
+						Action: &rules.RuleAction{
+							Type:    rules.ActionLog,
+							Message: "observed",
+						},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				adapter = rules.NewRuleValidatorAdapter(
+					engine,
+					rules.ValidatorGitPush,
+				)
+			})
+
+			It("should surface nothing but count the match", func() {
+				result := adapter.CheckRules(ctx, &hook.Context{})
+				Expect(result).To(BeNil())
+				Expect(engine.Observations()).To(HaveKeyWithValue("observe-all", 1))
+			})
+		})
+
+		Context("with transform rule", func() {
+			BeforeEach(func() {
+				var err error
+
+				engine, err = rules.NewRuleEngine([]*rules.Rule{
+					{
+						Name:    "force-with-lease",
+						Enabled: true,
+						Match:   &rules.RuleMatch{ValidatorType: rules.ValidatorGitPush},
+						Action: &rules.RuleAction{
+							Type: rules.ActionTransform,
+							Transform: &rules.Transform{
+								Find:    `--force(\s|$)`,
+								Replace: "--force-with-lease$1",
+							},
+						},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				adapter = rules.NewRuleValidatorAdapter(
+					engine,
+					rules.ValidatorGitPush,
+				)
+			})
+
+			pushCtx := func(command string) *hook.Context {
+				return &hook.Context{
+					EventType: hook.EventTypePreToolUse,
+					ToolName:  hook.ToolTypeBash,
+					ToolInput: hook.ToolInput{Command: command},
+				}
+			}
+
+			It("should warn with the rewritten command", func() {
+				result := adapter.CheckRules(ctx, pushCtx("git push --force origin main"))
+				Expect(result).NotTo(BeNil())
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("force-with-lease"))
+				Expect(result.UpdatedInput).To(Equal(map[string]string{
+					"command": "git push --force-with-lease origin main",
+				}))
+			})
+
+			It("should pass through commands the find pattern doesn't match", func() {
+				result := adapter.CheckRules(ctx, pushCtx("git push origin main"))
+				Expect(result).To(BeNil())
+			})
+
+			It("should skip the transform when transforms are skipped", func() {
+				result := adapter.CheckRules(
+					validator.WithoutTransforms(ctx),
+					pushCtx("git push --force origin main"),
+				)
+				Expect(result).To(BeNil())
+			})
+		})
+
 		Context("with nil engine", func() {
 			BeforeEach(func() {
 				adapter = rules.NewRuleValidatorAdapter(
@@ -467,6 +557,84 @@ var _ = Describe("RuleValidatorAdapter", func() {
 		})
 	})
 
+	Describe("Severity override", func() {
+		checkWithSeverity := func(
+			actionType rules.ActionType,
+			severity config.Severity,
+		) *validator.Result {
+			ruleList := []*rules.Rule{
+				{
+					Name:    "severity-override",
+					Enabled: true,
+					Match: &rules.RuleMatch{
+						Remote: "origin",
+					},
+					Action: &rules.RuleAction{
+						Type:      actionType,
+						Severity:  severity,
+						Message:   "overridden",
+						Reference: "GIT019",
+					},
+				},
+			}
+
+			var err error
+
+			engine, err = rules.NewRuleEngine(ruleList)
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter = rules.NewRuleValidatorAdapter(
+				engine,
+				rules.ValidatorGitPush,
+				rules.WithGitContextProvider(func() *rules.GitContext {
+					return &rules.GitContext{Remote: "origin"}
+				}),
+			)
+
+			result := adapter.CheckRules(ctx, &hook.Context{})
+			Expect(result).NotTo(BeNil())
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(Equal("overridden"))
+			Expect(string(result.Reference)).To(Equal("GIT019"))
+
+			return result
+		}
+
+		It("should block a warn action with error severity", func() {
+			result := checkWithSeverity(rules.ActionWarn, config.SeverityError)
+			Expect(result.ShouldBlock).To(BeTrue())
+		})
+
+		It("should only warn for a block action with warning severity", func() {
+			result := checkWithSeverity(rules.ActionBlock, config.SeverityWarning)
+			Expect(result.ShouldBlock).To(BeFalse())
+			Expect(result.Informational).To(BeFalse())
+		})
+
+		It("should block a block action with error severity", func() {
+			result := checkWithSeverity(rules.ActionBlock, config.SeverityError)
+			Expect(result.ShouldBlock).To(BeTrue())
+		})
+
+		It("should warn for a warn action with warning severity", func() {
+			result := checkWithSeverity(rules.ActionWarn, config.SeverityWarning)
+			Expect(result.ShouldBlock).To(BeFalse())
+		})
+
+		It("should mark a block action with info severity as informational", func() {
+			result := checkWithSeverity(rules.ActionBlock, config.SeverityInfo)
+			Expect(result.ShouldBlock).To(BeFalse())
+			Expect(result.Informational).To(BeTrue())
+		})
+
+		It("should keep the severity implied by the action type without an override", func() {
+			Expect(checkWithSeverity(rules.ActionBlock, config.SeverityUnknown).ShouldBlock).
+				To(BeTrue())
+			Expect(checkWithSeverity(rules.ActionWarn, config.SeverityUnknown).ShouldBlock).
+				To(BeFalse())
+		})
+	})
+
 	Describe("Unknown action type", func() {
 		It("should return nil for unknown action type", func() {
 			ruleList := []*rules.Rule{
@@ -278,6 +278,56 @@ func BenchmarkMatcherEvaluation(b *testing.B) {
 	})
 }
 
+// BenchmarkMatcherBuild compares building a rule's matcher for every match
+// with the matcher compiled once, as the registry does.
+func BenchmarkMatcherBuild(b *testing.B) {
+	match := &rules.RuleMatch{
+		ValidatorType:  rules.ValidatorGitPush,
+		RepoPattern:    "**/github.com/**/kong-mesh",
+		BranchPattern:  "feat/*",
+		CommandPattern: "*--force*",
+	}
+
+	ctx := &rules.MatchContext{
+		ValidatorType: rules.ValidatorGitPush,
+		Command:       "git push --force origin feat/new-feature",
+		GitContext: &rules.GitContext{
+			RepoRoot: "/home/user/Projects/github.com/company/kong-mesh",
+			Remote:   "origin",
+			Branch:   "feat/new-feature",
+			IsInRepo: true,
+		},
+	}
+
+	b.Run("PerCall", func(b *testing.B) {
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for range b.N {
+			matcher, err := rules.BuildMatcher(match)
+			if err != nil {
+				b.Fatalf("failed to build matcher: %v", err)
+			}
+
+			matcher.Match(ctx)
+		}
+	})
+
+	b.Run("Cached", func(b *testing.B) {
+		matcher, err := rules.BuildMatcher(match)
+		if err != nil {
+			b.Fatalf("failed to build matcher: %v", err)
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for range b.N {
+			matcher.Match(ctx)
+		}
+	})
+}
+
 // BenchmarkRuleEvaluation benchmarks rule engine evaluation performance.
 // Target: < 1ms per evaluation.
 func BenchmarkRuleEvaluation(b *testing.B) {
@@ -437,6 +487,75 @@ func BenchmarkRuleEvaluation(b *testing.B) {
 	})
 }
 
+// BenchmarkRuleIndex compares indexed and linear evaluation of a large rule
+// set spread over many validator types.
+func BenchmarkRuleIndex(b *testing.B) {
+	validatorTypes := []rules.ValidatorType{
+		rules.ValidatorGitPush,
+		rules.ValidatorGitCommit,
+		rules.ValidatorGitAdd,
+		rules.ValidatorGitBranch,
+		rules.ValidatorGitHubIssue,
+		rules.ValidatorFileMarkdown,
+		rules.ValidatorFileShell,
+		rules.ValidatorFileTerraform,
+		rules.ValidatorSecrets,
+		rules.ValidatorShellBacktick,
+	}
+
+	createRules := func(count int) []*rules.Rule {
+		result := make([]*rules.Rule, count)
+
+		for i := range count {
+			result[i] = &rules.Rule{
+				Name:     fmt.Sprintf("rule-%d", i),
+				Enabled:  true,
+				Priority: count - i,
+				Match: &rules.RuleMatch{
+					ValidatorType:  validatorTypes[i%len(validatorTypes)],
+					CommandPattern: fmt.Sprintf("*--flag-%d*", i),
+				},
+				Action: &rules.RuleAction{Type: rules.ActionBlock},
+			}
+		}
+
+		return result
+	}
+
+	matchCtx := &rules.MatchContext{
+		ValidatorType: rules.ValidatorGitPush,
+		Command:       "git push origin main",
+	}
+
+	for _, count := range []int{100, 500} {
+		for _, indexed := range []bool{false, true} {
+			name := fmt.Sprintf("%dRules/Linear", count)
+			if indexed {
+				name = fmt.Sprintf("%dRules/Indexed", count)
+			}
+
+			b.Run(name, func(b *testing.B) {
+				engine, err := rules.NewRuleEngine(
+					createRules(count),
+					rules.WithEngineIndex(indexed),
+				)
+				if err != nil {
+					b.Fatalf("failed to create engine: %v", err)
+				}
+
+				ctx := context.Background()
+
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				for range b.N {
+					engine.Evaluate(ctx, matchCtx)
+				}
+			})
+		}
+	}
+}
+
 // BenchmarkBuildMatcher benchmarks matcher construction.
 func BenchmarkBuildMatcher(b *testing.B) {
 	b.Run("Simple", func(b *testing.B) {
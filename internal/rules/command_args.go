@@ -0,0 +1,160 @@
+package rules
+
+import (
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+// subcommandTools are commands whose first non-flag argument names a
+// subcommand rather than a path (e.g. "git rm a b").
+var subcommandTools = map[string]bool{
+	"git": true,
+}
+
+// commandPathCount returns the largest number of path-like arguments passed
+// to a single command in command. Chained commands are counted separately,
+// so "cd dir && rm a b" counts 2. Commands the bash parser rejects are split
+// with splitCommandArgs and counted as one command.
+func commandPathCount(p *parser.BashParser, command string) int {
+	result, err := p.Parse(command)
+	if err != nil {
+		words := splitCommandArgs(command)
+		if len(words) == 0 {
+			return 0
+		}
+
+		return countPathArgs(words[0], words[1:])
+	}
+
+	largest := 0
+
+	for _, cmd := range result.Commands {
+		largest = max(largest, countPathArgs(cmd.Name, cmd.Args))
+	}
+
+	return largest
+}
+
+// countPathArgs counts the path-like arguments of name: every non-empty
+// argument after a bare "--", and before it every argument that is not a
+// flag. Wrappers such as sudo and env are looked through, and the subcommand
+// of subcommandTools is not counted. Globs are not expanded, so "*.log"
+// counts once.
+func countPathArgs(name string, args []string) int {
+	name, args = unwrapCommand(name, args)
+	skipSubcommand := subcommandTools[path.Base(name)]
+	count := 0
+
+	for i, arg := range args {
+		if arg == "--" {
+			for _, rest := range args[i+1:] {
+				if rest != "" {
+					count++
+				}
+			}
+
+			return count
+		}
+
+		if arg == "" || strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		if skipSubcommand {
+			skipSubcommand = false
+
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+// unwrapCommand returns the command run behind sudo and sudoWrappers, e.g.
+// "rm" with its arguments for "sudo env FOO=1 rm a b".
+func unwrapCommand(name string, args []string) (string, []string) {
+	for isSudoWord(name) || sudoWrappers[path.Base(name)] {
+		next := -1
+
+		for i, arg := range args {
+			if !strings.HasPrefix(arg, "-") && !isEnvAssignment(arg) {
+				next = i
+
+				break
+			}
+		}
+
+		if next < 0 {
+			return name, nil
+		}
+
+		name, args = args[next], args[next+1:]
+	}
+
+	return name, args
+}
+
+// splitCommandArgs splits command into words the way a shell splits a simple
+// command: whitespace separates words, single quotes keep everything literal,
+// double quotes keep whitespace, and a backslash escapes the next character.
+// An unterminated quote runs to the end of the command. Operators such as
+// && and pipes are not recognized.
+func splitCommandArgs(command string) []string {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range command {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				word.WriteRune(r)
+			}
+		case r == '\\':
+			escaped, inWord = true, true
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case unicode.IsSpace(r):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+
+			inWord = true
+		}
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words
+}
@@ -2,6 +2,8 @@ package rules
 
 import (
 	"context"
+	"maps"
+	"sync"
 
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
@@ -15,7 +17,18 @@ type RuleEngine struct {
 
 	// Configuration options.
 	stopOnFirstMatch bool
+	allowWins        bool
 	defaultAction    ActionType
+	indexRules       bool
+
+	loadFileContent    bool
+	maxFileContentSize int64
+
+	rateLimiter RateLimiter
+
+	// observations counts matches of log rules by rule name.
+	observationsMu sync.Mutex
+	observations   map[string]int
 }
 
 // EngineOption configures a RuleEngine.
@@ -35,6 +48,42 @@ func WithEngineStopOnFirstMatch(stop bool) EngineOption {
 	}
 }
 
+// WithEngineAllowWins makes a matching allow rule win over higher-priority
+// block and warn rules.
+func WithEngineAllowWins(allowWins bool) EngineOption {
+	return func(e *RuleEngine) {
+		e.allowWins = allowWins
+	}
+}
+
+// WithEngineIndex makes the engine index rules by validator type, so each
+// evaluation only checks rules that can apply to the validator. Results are
+// the same as without the index.
+func WithEngineIndex(enabled bool) EngineOption {
+	return func(e *RuleEngine) {
+		e.indexRules = enabled
+	}
+}
+
+// WithEngineFileContent configures loading file content from disk for
+// content matching when the hook payload has none. With all set, every rule
+// sees loaded content; otherwise only rules that set LoadFileContent do.
+// Files larger than maxSize bytes are not loaded.
+func WithEngineFileContent(all bool, maxSize int64) EngineOption {
+	return func(e *RuleEngine) {
+		e.loadFileContent = all
+		e.maxFileContentSize = maxSize
+	}
+}
+
+// WithEngineRateLimiter sets the limiter deciding when rate-limited rules
+// surface. Without one, rate limits are ignored.
+func WithEngineRateLimiter(limiter RateLimiter) EngineOption {
+	return func(e *RuleEngine) {
+		e.rateLimiter = limiter
+	}
+}
+
 // WithEngineDefaultAction sets the default action when no rules match.
 func WithEngineDefaultAction(action ActionType) EngineOption {
 	return func(e *RuleEngine) {
@@ -42,12 +91,16 @@ func WithEngineDefaultAction(action ActionType) EngineOption {
 	}
 }
 
-// NewRuleEngine creates a new RuleEngine with the given rules.
+// NewRuleEngine creates a new RuleEngine with the given rules. Each rule's
+// matcher is compiled here, once, and shared by every evaluation and every
+// adapter using the engine.
 func NewRuleEngine(rules []*Rule, opts ...EngineOption) (*RuleEngine, error) {
 	engine := &RuleEngine{
-		registry:         NewRegistry(),
-		stopOnFirstMatch: true,
-		defaultAction:    ActionAllow,
+		registry:           NewRegistry(),
+		stopOnFirstMatch:   true,
+		defaultAction:      ActionAllow,
+		maxFileContentSize: DefaultMaxFileContentSize,
+		observations:       make(map[string]int),
 	}
 
 	// Apply options.
@@ -65,20 +118,33 @@ func NewRuleEngine(rules []*Rule, opts ...EngineOption) (*RuleEngine, error) {
 		return nil, err
 	}
 
+	if engine.indexRules {
+		engine.registry.EnableIndex()
+	}
+
 	// Create evaluator.
 	engine.evaluator = NewEvaluator(
 		engine.registry,
 		WithStopOnFirstMatch(engine.stopOnFirstMatch),
+		WithAllowWins(engine.allowWins),
 		WithDefaultAction(engine.defaultAction),
+		WithFileContentLoading(engine.loadFileContent, engine.maxFileContentSize),
+		WithRateLimiter(engine.rateLimiter),
 	)
 
 	return engine, nil
 }
 
 // Evaluate evaluates rules against the given match context.
+// Matching log rules, and rate-limited rules within their window, are
+// recorded in the log and counted.
 func (e *RuleEngine) Evaluate(_ context.Context, matchCtx *MatchContext) *RuleResult {
 	result := e.evaluator.Evaluate(matchCtx)
 
+	for _, rule := range result.Observed {
+		e.observe(rule, matchCtx)
+	}
+
 	if result.Matched {
 		e.logger.Debug("rule matched",
 			"rule", result.Rule.Name,
@@ -90,6 +156,45 @@ func (e *RuleEngine) Evaluate(_ context.Context, matchCtx *MatchContext) *RuleRe
 	return result
 }
 
+// observe records a match of a log rule.
+func (e *RuleEngine) observe(rule *Rule, matchCtx *MatchContext) {
+	e.observationsMu.Lock()
+	e.observations[rule.Name]++
+	e.observationsMu.Unlock()
+
+	args := []any{
+		"rule", rule.Name,
+		"validator", matchCtx.ValidatorType,
+	}
+
+	if rule.Action.Type != ActionLog {
+		args = append(args, "rate_limited", true)
+	}
+
+	if matchCtx.Command != "" {
+		args = append(args, "command", matchCtx.Command)
+	}
+
+	if matchCtx.FileContext != nil && matchCtx.FileContext.Path != "" {
+		args = append(args, "file", matchCtx.FileContext.Path)
+	}
+
+	if rule.Action.Message != "" {
+		args = append(args, "message", rule.Action.Message)
+	}
+
+	e.logger.Info("rule observed", args...)
+}
+
+// Observations returns how many times each log rule, or rate-limited rule
+// within its window, matched, by rule name.
+func (e *RuleEngine) Observations() map[string]int {
+	e.observationsMu.Lock()
+	defer e.observationsMu.Unlock()
+
+	return maps.Clone(e.observations)
+}
+
 // EvaluateHook evaluates rules for a hook context with additional git/file context.
 // This is a convenience method that builds the match context from hook context.
 func (e *RuleEngine) EvaluateHook(
@@ -10,6 +10,19 @@ type Evaluator struct {
 
 	// defaultAction is the action to take when no rules match.
 	defaultAction ActionType
+
+	// allowWins makes any matching allow rule override other matches.
+	allowWins bool
+
+	// loadFileContent loads file content from disk for every rule, not only
+	// rules that set LoadFileContent.
+	loadFileContent bool
+
+	// maxFileContentSize caps the size of files loaded from disk.
+	maxFileContentSize int64
+
+	// rateLimiter suppresses rate-limited rules that fired recently.
+	rateLimiter RateLimiter
 }
 
 // EvaluatorOption configures an Evaluator.
@@ -22,6 +35,33 @@ func WithStopOnFirstMatch(stop bool) EvaluatorOption {
 	}
 }
 
+// WithAllowWins makes a matching allow rule win over higher-priority block
+// and warn rules.
+func WithAllowWins(allowWins bool) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.allowWins = allowWins
+	}
+}
+
+// WithFileContentLoading configures loading file content from disk. With
+// all set, every rule sees loaded content; otherwise only rules that set
+// LoadFileContent do. Files larger than maxSize bytes are not loaded.
+func WithFileContentLoading(all bool, maxSize int64) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.loadFileContent = all
+		e.maxFileContentSize = maxSize
+	}
+}
+
+// WithRateLimiter makes the evaluator treat rate-limited rules that fired
+// within their window as log rules, and record the rate-limited rules it
+// decides on.
+func WithRateLimiter(limiter RateLimiter) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.rateLimiter = limiter
+	}
+}
+
 // WithDefaultAction sets the default action when no rules match.
 func WithDefaultAction(action ActionType) EvaluatorOption {
 	return func(e *Evaluator) {
@@ -32,9 +72,10 @@ func WithDefaultAction(action ActionType) EvaluatorOption {
 // NewEvaluator creates a new rule evaluator.
 func NewEvaluator(registry *Registry, opts ...EvaluatorOption) *Evaluator {
 	e := &Evaluator{
-		registry:         registry,
-		stopOnFirstMatch: true,
-		defaultAction:    ActionAllow,
+		registry:           registry,
+		stopOnFirstMatch:   true,
+		defaultAction:      ActionAllow,
+		maxFileContentSize: DefaultMaxFileContentSize,
 	}
 
 	for _, opt := range opts {
@@ -44,9 +85,14 @@ func NewEvaluator(registry *Registry, opts ...EvaluatorOption) *Evaluator {
 	return e
 }
 
-// Evaluate evaluates all enabled rules against the given context.
+// Evaluate evaluates all enabled rules against the given context. When the
+// registry is indexed, rules whose validator_type cannot match are skipped.
 // Returns the result of the first matching rule (if stopOnFirstMatch is true)
-// or the highest priority matching rule.
+// or the highest priority matching rule. With allowWins, the highest priority
+// matching allow rule is returned instead whenever one matches. Matching log
+// rules never decide the result; all of them are listed in Observed. With a
+// rate limiter, so are rate-limited rules within their window, and a
+// rate-limited rule that decides the result starts a new window.
 func (e *Evaluator) Evaluate(ctx *MatchContext) *RuleResult {
 	if e.registry == nil {
 		return &RuleResult{
@@ -55,7 +101,7 @@ func (e *Evaluator) Evaluate(ctx *MatchContext) *RuleResult {
 		}
 	}
 
-	rules := e.registry.GetEnabled()
+	rules := e.registry.GetEnabledFor(ctx.ValidatorType)
 	if len(rules) == 0 {
 		return &RuleResult{
 			Matched: false,
@@ -64,23 +110,120 @@ func (e *Evaluator) Evaluate(ctx *MatchContext) *RuleResult {
 	}
 
 	// Rules are already sorted by priority (highest first).
+	var (
+		first, decided *CompiledRule
+		observed       []*Rule
+	)
+
+	contextFor := e.ruleContexts(ctx)
+
 	for _, compiled := range rules {
-		if compiled.Matcher.Match(ctx) {
-			return &RuleResult{
-				Matched:   true,
-				Rule:      compiled.Rule,
-				Action:    compiled.Rule.Action.Type,
-				Message:   compiled.Rule.Action.Message,
-				Reference: compiled.Rule.Action.Reference,
-			}
+		isLog := compiled.Rule.Action.Type == ActionLog
+
+		// Once decided, only log rules are left to check.
+		if decided != nil && !isLog {
+			continue
+		}
+
+		if ctx.SkipTransforms && compiled.Rule.Action.Type == ActionTransform {
+			continue
+		}
+
+		if !compiled.Matcher.Match(contextFor(compiled)) {
+			continue
+		}
+
+		if isLog || e.isSuppressed(compiled.Rule, ctx) {
+			observed = append(observed, compiled.Rule)
+
+			continue
+		}
+
+		if first == nil {
+			first = compiled
+		}
+
+		// Keep looking for an allow rule that overrides this match.
+		if !e.allowWins || compiled.Rule.Action.Type == ActionAllow {
+			decided = compiled
 		}
 	}
 
-	// No rules matched.
-	return &RuleResult{
-		Matched: false,
-		Action:  e.defaultAction,
+	if decided == nil {
+		decided = first
 	}
+
+	if decided == nil {
+		// No rules matched.
+		return &RuleResult{
+			Matched:  false,
+			Action:   e.defaultAction,
+			Observed: observed,
+		}
+	}
+
+	if e.rateLimiter != nil && decided.Rule.RateLimit != nil {
+		e.rateLimiter.Record(decided.Rule, ruleRepo(ctx))
+	}
+
+	result := matchedResult(decided, ctx)
+	result.Observed = observed
+
+	return result
+}
+
+// isSuppressed reports whether rule is rate limited and fired within its
+// window in the repository of ctx.
+func (e *Evaluator) isSuppressed(rule *Rule, ctx *MatchContext) bool {
+	if e.rateLimiter == nil || rule.RateLimit == nil {
+		return false
+	}
+
+	return e.rateLimiter.Suppressed(rule, ruleRepo(ctx))
+}
+
+// ruleContexts returns a function giving the context each rule is matched
+// against: ctx itself, or ctx with file content loaded from disk for rules
+// that ask for it. The file is read at most once per evaluation.
+func (e *Evaluator) ruleContexts(ctx *MatchContext) func(*CompiledRule) *MatchContext {
+	// Paths are resolved once for all the rules of this evaluation
+	shared := *ctx
+	shared.resolved = &resolvedPaths{}
+	ctx = &shared
+
+	var loaded *MatchContext
+
+	return func(compiled *CompiledRule) *MatchContext {
+		if !e.loadFileContent &&
+			(compiled.Rule.Match == nil || !compiled.Rule.Match.LoadFileContent) {
+			return ctx
+		}
+
+		if loaded == nil {
+			loaded = loadFileContent(ctx, e.maxFileContentSize)
+		}
+
+		return loaded
+	}
+}
+
+// matchedResult builds the result for a matching rule, applying its
+// transform to ctx.
+func matchedResult(compiled *CompiledRule, ctx *MatchContext) *RuleResult {
+	result := &RuleResult{
+		Matched:   true,
+		Rule:      compiled.Rule,
+		Action:    compiled.Rule.Action.Type,
+		Message:   compiled.Rule.Action.Message,
+		Reference: compiled.Rule.Action.Reference,
+		Severity:  compiled.Rule.Action.Severity,
+	}
+
+	if compiled.Transformer != nil {
+		result.Rewrite = compiled.Transformer.Apply(ctx)
+	}
+
+	return result
 }
 
 // EvaluateAll evaluates all enabled rules and returns all matching results.
@@ -90,22 +233,18 @@ func (e *Evaluator) EvaluateAll(ctx *MatchContext) []*RuleResult {
 		return nil
 	}
 
-	rules := e.registry.GetEnabled()
+	rules := e.registry.GetEnabledFor(ctx.ValidatorType)
 	if len(rules) == 0 {
 		return nil
 	}
 
 	var results []*RuleResult
 
+	contextFor := e.ruleContexts(ctx)
+
 	for _, compiled := range rules {
-		if compiled.Matcher.Match(ctx) {
-			results = append(results, &RuleResult{
-				Matched:   true,
-				Rule:      compiled.Rule,
-				Action:    compiled.Rule.Action.Type,
-				Message:   compiled.Rule.Action.Message,
-				Reference: compiled.Rule.Action.Reference,
-			})
+		if compiled.Matcher.Match(contextFor(compiled)) {
+			results = append(results, matchedResult(compiled, ctx))
 		}
 	}
 
@@ -119,15 +258,17 @@ func (e *Evaluator) FindMatchingRules(ctx *MatchContext) []*Rule {
 		return nil
 	}
 
-	rules := e.registry.GetEnabled()
+	rules := e.registry.GetEnabledFor(ctx.ValidatorType)
 	if len(rules) == 0 {
 		return nil
 	}
 
 	var matching []*Rule
 
+	contextFor := e.ruleContexts(ctx)
+
 	for _, compiled := range rules {
-		if compiled.Matcher.Match(ctx) {
+		if compiled.Matcher.Match(contextFor(compiled)) {
 			matching = append(matching, compiled.Rule)
 		}
 	}
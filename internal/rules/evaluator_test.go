@@ -293,4 +293,147 @@ var _ = Describe("Evaluator", func() {
 			Expect(result.Action).To(Equal(rules.ActionBlock))
 		})
 	})
+
+	Describe("AllowWins", func() {
+		pushCtx := &rules.MatchContext{
+			ValidatorType: rules.ValidatorGitPush,
+			GitContext:    &rules.GitContext{Branch: "main"},
+		}
+
+		BeforeEach(func() {
+			Expect(registry.AddAll([]*rules.Rule{
+				{
+					Name:     "block-all-git",
+					Priority: 100,
+					Enabled:  true,
+					Match:    &rules.RuleMatch{ValidatorType: rules.ValidatorGitAll},
+					Action:   &rules.RuleAction{Type: rules.ActionBlock},
+				},
+				{
+					Name:     "warn-push",
+					Priority: 50,
+					Enabled:  true,
+					Match:    &rules.RuleMatch{ValidatorType: rules.ValidatorGitPush},
+					Action:   &rules.RuleAction{Type: rules.ActionWarn},
+				},
+				{
+					Name:     "allow-main",
+					Priority: 10,
+					Enabled:  true,
+					Match:    &rules.RuleMatch{BranchPattern: "main"},
+					Action:   &rules.RuleAction{Type: rules.ActionAllow},
+				},
+			})).To(Succeed())
+		})
+
+		It("should use the highest priority match by default", func() {
+			evaluator = rules.NewEvaluator(registry)
+
+			result := evaluator.Evaluate(pushCtx)
+			Expect(result.Rule.Name).To(Equal("block-all-git"))
+			Expect(result.Action).To(Equal(rules.ActionBlock))
+		})
+
+		DescribeTable("should let a lower-priority allow rule win",
+			func(stopOnFirstMatch bool) {
+				evaluator = rules.NewEvaluator(
+					registry,
+					rules.WithAllowWins(true),
+					rules.WithStopOnFirstMatch(stopOnFirstMatch),
+				)
+
+				result := evaluator.Evaluate(pushCtx)
+				Expect(result.Matched).To(BeTrue())
+				Expect(result.Rule.Name).To(Equal("allow-main"))
+				Expect(result.Action).To(Equal(rules.ActionAllow))
+			},
+			Entry("with stop_on_first_match", true),
+			Entry("without stop_on_first_match", false),
+		)
+
+		It("should fall back to the highest priority match without a matching allow rule", func() {
+			evaluator = rules.NewEvaluator(registry, rules.WithAllowWins(true))
+
+			result := evaluator.Evaluate(&rules.MatchContext{
+				ValidatorType: rules.ValidatorGitPush,
+				GitContext:    &rules.GitContext{Branch: "feature"},
+			})
+			Expect(result.Rule.Name).To(Equal("block-all-git"))
+		})
+
+		It("should pick the highest priority allow rule", func() {
+			Expect(registry.Add(&rules.Rule{
+				Name:     "allow-push",
+				Priority: 20,
+				Enabled:  true,
+				Match:    &rules.RuleMatch{ValidatorType: rules.ValidatorGitPush},
+				Action:   &rules.RuleAction{Type: rules.ActionAllow, Message: "push ok"},
+			})).To(Succeed())
+
+			evaluator = rules.NewEvaluator(registry, rules.WithAllowWins(true))
+
+			result := evaluator.Evaluate(pushCtx)
+			Expect(result.Rule.Name).To(Equal("allow-push"))
+			Expect(result.Message).To(Equal("push ok"))
+		})
+	})
+
+	Describe("Log rules", func() {
+		pushCtx := &rules.MatchContext{
+			ValidatorType: rules.ValidatorGitPush,
+			GitContext:    &rules.GitContext{Branch: "main"},
+		}
+
+		newRule := func(name string, priority int, action rules.ActionType) *rules.Rule {
+			return &rules.Rule{
+				Name:     name,
+				Priority: priority,
+				Enabled:  true,
+				Match:    &rules.RuleMatch{ValidatorType: rules.ValidatorGitPush},
+				Action:   &rules.RuleAction{Type: action},
+			}
+		}
+
+		It("should not decide the result", func() {
+			Expect(registry.AddAll([]*rules.Rule{
+				newRule("observe-push", 100, rules.ActionLog),
+				newRule("warn-push", 10, rules.ActionWarn),
+			})).To(Succeed())
+
+			result := rules.NewEvaluator(registry).Evaluate(pushCtx)
+			Expect(result.Matched).To(BeTrue())
+			Expect(result.Rule.Name).To(Equal("warn-push"))
+			Expect(result.Observed).To(HaveLen(1))
+			Expect(result.Observed[0].Name).To(Equal("observe-push"))
+		})
+
+		It("should observe log rules with lower priority than the deciding rule", func() {
+			Expect(registry.AddAll([]*rules.Rule{
+				newRule("block-push", 100, rules.ActionBlock),
+				newRule("observe-push", 10, rules.ActionLog),
+			})).To(Succeed())
+
+			result := rules.NewEvaluator(registry).Evaluate(pushCtx)
+			Expect(result.Rule.Name).To(Equal("block-push"))
+			Expect(result.Observed).To(HaveLen(1))
+		})
+
+		It("should report no match when only log rules match", func() {
+			Expect(registry.Add(newRule("observe-push", 100, rules.ActionLog))).To(Succeed())
+
+			result := rules.NewEvaluator(registry).Evaluate(pushCtx)
+			Expect(result.Matched).To(BeFalse())
+			Expect(result.Action).To(Equal(rules.ActionAllow))
+			Expect(result.Observed).To(HaveLen(1))
+		})
+
+		It("should not observe log rules that do not match", func() {
+			Expect(registry.Add(newRule("observe-push", 100, rules.ActionLog))).To(Succeed())
+
+			result := rules.NewEvaluator(registry).Evaluate(&rules.MatchContext{
+				ValidatorType: rules.ValidatorGitCommit,
+			})
+			Expect(result.Observed).To(BeEmpty())
+		})
+	})
 })
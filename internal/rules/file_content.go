@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+// DefaultMaxFileContentSize is the largest file, in bytes, whose content is
+// loaded from disk for rules that set LoadFileContent (1MB).
+const DefaultMaxFileContentSize int64 = 1 << 20
+
+// loadFileContent returns ctx with FileContext.Content read from the target
+// file when neither the file context nor the hook payload carries content,
+// as for Read and Edit tool calls. Write payloads are never replaced, since
+// an empty Write content is the new file content. Missing files, non-regular
+// files and files larger than maxSize leave ctx unchanged.
+func loadFileContent(ctx *MatchContext, maxSize int64) *MatchContext {
+	hookCtx := ctx.HookContext
+	if hookCtx == nil || hookCtx.ToolName == hook.ToolTypeWrite || hookCtx.GetContent() != "" {
+		return ctx
+	}
+
+	filePath := hookCtx.GetFilePath()
+
+	if ctx.FileContext != nil {
+		if ctx.FileContext.Content != "" {
+			return ctx
+		}
+
+		if ctx.FileContext.Path != "" {
+			filePath = ctx.FileContext.Path
+		}
+	}
+
+	if filePath == "" {
+		return ctx
+	}
+
+	diskPath := filePath
+	if !filepath.IsAbs(diskPath) && hookCtx.WorkingDir != "" {
+		diskPath = filepath.Join(hookCtx.WorkingDir, diskPath)
+	}
+
+	content, ok := readFileContent(diskPath, maxSize)
+	if !ok {
+		return ctx
+	}
+
+	loaded := *ctx
+	loaded.FileContext = &FileContext{Path: filePath, Content: content}
+
+	return &loaded
+}
+
+// readFileContent reads a regular file of at most maxSize bytes.
+func readFileContent(path string, maxSize int64) (string, bool) {
+	f, err := os.Open(path) //nolint:gosec // G304: path is from the hook payload
+	if err != nil {
+		return "", false
+	}
+
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() || info.Size() > maxSize {
+		return "", false
+	}
+
+	data, err := io.ReadAll(io.LimitReader(f, maxSize+1))
+	if err != nil || int64(len(data)) > maxSize {
+		return "", false
+	}
+
+	return string(data), true
+}
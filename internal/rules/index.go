@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// ruleIndex buckets compiled rules by the validator types they can match,
+// so evaluation only visits rules relevant to the current validator.
+//
+// A rule's validator_type is always ANDed with its other conditions, so a
+// rule with an exact validator_type can only match that validator, and a
+// category wildcard ("git.*") can only match validators in the category.
+type ruleIndex struct {
+	// exact holds rules with an exact validator_type.
+	exact map[ValidatorType][]indexedRule
+
+	// wildcard holds rules with a category wildcard, keyed by the category
+	// ("git" for "git.*").
+	wildcard map[string][]indexedRule
+
+	// universal holds rules without validator_type, or with "*".
+	universal []indexedRule
+}
+
+// indexedRule is a compiled rule with its position in the priority order.
+type indexedRule struct {
+	pos  int
+	rule *CompiledRule
+}
+
+// newRuleIndex builds an index of rules, which must be in priority order.
+func newRuleIndex(rules []*CompiledRule) *ruleIndex {
+	idx := &ruleIndex{
+		exact:    make(map[ValidatorType][]indexedRule),
+		wildcard: make(map[string][]indexedRule),
+	}
+
+	for pos, compiled := range rules {
+		entry := indexedRule{pos: pos, rule: compiled}
+
+		var validatorType ValidatorType
+		if compiled.Rule.Match != nil {
+			validatorType = compiled.Rule.Match.ValidatorType
+		}
+
+		switch {
+		case validatorType == "" || validatorType == ValidatorAll:
+			idx.universal = append(idx.universal, entry)
+		case strings.HasSuffix(string(validatorType), ".*"):
+			category := strings.TrimSuffix(string(validatorType), ".*")
+			idx.wildcard[category] = append(idx.wildcard[category], entry)
+		default:
+			idx.exact[validatorType] = append(idx.exact[validatorType], entry)
+		}
+	}
+
+	return idx
+}
+
+// candidates returns the enabled rules that can match validatorType, in
+// priority order.
+func (idx *ruleIndex) candidates(validatorType ValidatorType) []*CompiledRule {
+	entries := slices.Clone(idx.universal)
+
+	if validatorType != "" {
+		entries = append(entries, idx.exact[validatorType]...)
+
+		// "git.*" matches "git.push"; "a.*" and "a.b.*" both match "a.b.c".
+		name := string(validatorType)
+		for i := range len(name) {
+			if name[i] == '.' {
+				entries = append(entries, idx.wildcard[name[:i]]...)
+			}
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b indexedRule) int {
+		return cmp.Compare(a.pos, b.pos)
+	})
+
+	result := make([]*CompiledRule, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.rule.Rule.Enabled {
+			result = append(result, entry.rule)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,471 @@
+package rules
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// LintKind identifies the kind of problem found by Lint.
+type LintKind string
+
+const (
+	// LintShadowed marks a rule that can never fire because a rule evaluated
+	// before it matches everything it matches.
+	LintShadowed LintKind = "shadowed"
+
+	// LintConflict marks two rules with identical conditions but different
+	// actions.
+	LintConflict LintKind = "conflict"
+
+	// LintInvalidPattern marks a rule whose patterns fail to compile.
+	LintInvalidPattern LintKind = "invalid_pattern"
+)
+
+// LintIssue describes a problem found in a rule set.
+type LintIssue struct {
+	// Kind is the kind of problem.
+	Kind LintKind
+
+	// Rule is the name of the affected rule.
+	Rule string
+
+	// Other is the name of the rule that causes the problem, if any.
+	Other string
+
+	// Message is a human-readable description of the problem.
+	Message string
+}
+
+// LintOption configures Lint.
+type LintOption func(*lintOptions)
+
+type lintOptions struct {
+	allowWins bool
+}
+
+// WithLintAllowWins lints rules as evaluated with allow_wins enabled, where a
+// matching allow rule overrides block and warn rules of any priority.
+func WithLintAllowWins(allowWins bool) LintOption {
+	return func(o *lintOptions) {
+		o.allowWins = allowWins
+	}
+}
+
+// String returns the issue as a single line.
+func (i LintIssue) String() string {
+	return string(i.Kind) + ": " + i.Message
+}
+
+// Lint statically analyzes rules and reports rules whose patterns fail to
+// compile, rules that can never fire because a rule evaluated before them
+// matches a superset of what they match, and pairs of rules with identical
+// conditions but different actions.
+//
+// Subset detection is conservative: patterns are compared as strings, so two
+// different patterns that happen to match the same input are never treated
+// as overlapping. Disabled rules are only checked for invalid patterns.
+func Lint(rules []*Rule, opts ...LintOption) []LintIssue {
+	var options lintOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var (
+		issues []LintIssue
+		active []*Rule
+	)
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		if err := compileRulePatterns(rule); err != nil {
+			issues = append(issues, LintIssue{
+				Kind:    LintInvalidPattern,
+				Rule:    rule.Name,
+				Message: fmt.Sprintf("rule %q has an invalid pattern: %v", rule.Name, err),
+			})
+
+			continue
+		}
+
+		// Log rules never decide the outcome, so they neither shadow nor get shadowed
+		if rule.Enabled && actionType(rule) != ActionLog {
+			active = append(active, rule)
+		}
+	}
+
+	// Same order as the registry: higher priority first, then by name.
+	slices.SortStableFunc(active, func(a, b *Rule) int {
+		if result := cmp.Compare(b.Priority, a.Priority); result != 0 {
+			return result
+		}
+
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	for i, rule := range active {
+		if winner := shadowingRule(active, i, options.allowWins); winner != nil {
+			issues = append(issues, shadowIssue(winner, rule))
+		}
+	}
+
+	return issues
+}
+
+// shadowingRule returns the rule that always wins over active[i], or nil.
+// Rules are evaluated in order, so normally only earlier rules can shadow a
+// rule. With allowWins, only allow rules can shadow an allow rule, while any
+// covering allow rule shadows a block or warn rule regardless of priority.
+func shadowingRule(active []*Rule, i int, allowWins bool) *Rule {
+	rule := active[i]
+	isAllow := actionType(rule) == ActionAllow
+
+	if allowWins && !isAllow {
+		for j, other := range active {
+			if j != i && actionType(other) == ActionAllow && matchCovers(other.Match, rule.Match) {
+				return other
+			}
+		}
+	}
+
+	for _, earlier := range active[:i] {
+		if allowWins && isAllow && actionType(earlier) != ActionAllow {
+			continue
+		}
+
+		// A transform rule only matches when its find pattern does
+		if actionType(earlier) == ActionTransform {
+			continue
+		}
+
+		if matchCovers(earlier.Match, rule.Match) {
+			return earlier
+		}
+	}
+
+	return nil
+}
+
+// shadowIssue reports rule as unreachable behind winner. Identical
+// conditions with different actions are reported as a conflict instead.
+func shadowIssue(winner, rule *Rule) LintIssue {
+	winnerAction, ruleAction := actionType(winner), actionType(rule)
+
+	if matchCovers(rule.Match, winner.Match) && winnerAction != ruleAction {
+		return LintIssue{
+			Kind:  LintConflict,
+			Rule:  rule.Name,
+			Other: winner.Name,
+			Message: fmt.Sprintf(
+				"rules %q (%s) and %q (%s) have identical conditions; %q always wins",
+				winner.Name, winnerAction, rule.Name, ruleAction, winner.Name,
+			),
+		}
+	}
+
+	return LintIssue{
+		Kind:  LintShadowed,
+		Rule:  rule.Name,
+		Other: winner.Name,
+		Message: fmt.Sprintf(
+			"rule %q can never fire: %q (priority %d) matches everything it matches",
+			rule.Name, winner.Name, winner.Priority,
+		),
+	}
+}
+
+// compileRulePatterns compiles the match conditions of rule and, for
+// transform actions, the find pattern.
+func compileRulePatterns(rule *Rule) error {
+	if _, err := BuildMatcher(rule.Match); err != nil {
+		return err
+	}
+
+	if actionType(rule) == ActionTransform {
+		if _, err := NewTransformer(rule.Action.Transform); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// actionType returns the rule's action type, or an empty string if unset.
+func actionType(rule *Rule) ActionType {
+	if rule.Action == nil {
+		return ""
+	}
+
+	return rule.Action.Type
+}
+
+// matchCovers reports whether every context matched by b is also matched
+// by a. A nil match has no conditions and matches everything.
+func matchCovers(a, b *RuleMatch) bool {
+	if a == nil {
+		a = &RuleMatch{}
+	}
+
+	if b == nil {
+		b = &RuleMatch{}
+	}
+
+	if !validatorTypeCovers(a.ValidatorType, b.ValidatorType) ||
+		!exactCovers(a.Provider, b.Provider) ||
+		!exactCovers(a.Remote, b.Remote) ||
+		!exactCovers(a.ToolType, b.ToolType) ||
+		!exactCovers(a.EventType, b.EventType) ||
+		!exactCovers(string(a.Scope), string(b.Scope)) ||
+		!extensionsCover(a.FileExtensions, b.FileExtensions) ||
+		!platformsCover(a.OS, b.OS) ||
+		!trackingCovers(a, b) ||
+		a.MinDaysSinceCommit > b.MinDaysSinceCommit ||
+		(a.RequireDirty && !b.RequireDirty) ||
+		(a.RequireClean && !b.RequireClean) ||
+		(a.IsBinary && !b.IsBinary) ||
+		(a.UsesSudo && !b.UsesSudo) ||
+		a.MinCommandPaths > b.MinCommandPaths ||
+		a.MinConsecutiveBlocks > b.MinConsecutiveBlocks ||
+		a.MinSubjectLength > b.MinSubjectLength ||
+		!maxCovers(a.MaxSubjectLength, b.MaxSubjectLength) ||
+		!contentInFilesCover(a, b) ||
+		!pathModeCovers(a, b) ||
+		!loadFileContentCovers(a, b) {
+		return false
+	}
+
+	aConds, bConds := patternConditions(a), patternConditions(b)
+
+	for i := range aConds {
+		if len(aConds[i]) == 0 {
+			continue
+		}
+
+		// Case-sensitive patterns match less than the same patterns
+		// matched case-insensitively.
+		if b.CaseInsensitive && !a.CaseInsensitive {
+			return false
+		}
+
+		if !patternsCover(aConds[i], bConds[i], a.PatternMode, b.PatternMode) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validatorTypeCovers reports whether validator type a matches everything b
+// matches, taking "*" and category wildcards into account.
+func validatorTypeCovers(a, b ValidatorType) bool {
+	switch {
+	case a == "" || a == ValidatorAll:
+		return true
+	case b == "" || b == ValidatorAll:
+		return false
+	case a == b:
+		return true
+	}
+
+	category, ok := strings.CutSuffix(string(a), ".*")
+
+	return ok && strings.HasPrefix(string(b), category+".")
+}
+
+// exactCovers reports whether an exact-match condition a covers b.
+func exactCovers(a, b string) bool {
+	return a == "" || a == b
+}
+
+// extensionsCover reports whether extension list a accepts every extension
+// accepted by b.
+func extensionsCover(a, b []string) bool {
+	if len(a) == 0 {
+		return true
+	}
+
+	if len(b) == 0 {
+		return false
+	}
+
+	return isSubset(normalizeExtensions(b), normalizeExtensions(a))
+}
+
+// maxCovers reports whether upper bound a accepts every value accepted by
+// upper bound b, where zero means no bound.
+func maxCovers(a, b int) bool {
+	return a <= 0 || (b > 0 && b <= a)
+}
+
+// platformsCover reports whether OS list a accepts every OS accepted by b.
+func platformsCover(a, b []string) bool {
+	if len(a) == 0 {
+		return true
+	}
+
+	if len(b) == 0 {
+		return false
+	}
+
+	return isSubset(normalizePlatforms(b), normalizePlatforms(a))
+}
+
+func normalizePlatforms(systems []string) []string {
+	result := make([]string, 0, len(systems))
+
+	for _, system := range systems {
+		result = append(result, strings.ToLower(strings.TrimSpace(system)))
+	}
+
+	return result
+}
+
+func normalizeExtensions(extensions []string) []string {
+	result := make([]string, 0, len(extensions))
+
+	for _, ext := range extensions {
+		result = append(result, NewFileExtensionMatcher(ext).extension)
+	}
+
+	return result
+}
+
+// contentInFilesCover reports whether the content-in-files pairs of a accept
+// everything accepted by those of b. Pairs are compared as written, so a
+// covers b only when each pair of b is also a pair of a.
+func contentInFilesCover(a, b *RuleMatch) bool {
+	if len(a.ContentInFiles) == 0 {
+		return true
+	}
+
+	if len(b.ContentInFiles) == 0 || (b.CaseInsensitive && !a.CaseInsensitive) {
+		return false
+	}
+
+	for _, pair := range b.ContentInFiles {
+		if !slices.Contains(a.ContentInFiles, pair) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pathModeCovers reports whether the file path conditions of a see the same
+// form of the path as those of b. Modes are compared as written, with empty
+// meaning "both", so rules with different modes never cover each other.
+func pathModeCovers(a, b *RuleMatch) bool {
+	if effectivePatterns(a.FilePattern, a.FilePatterns) == nil && len(a.ContentInFiles) == 0 {
+		return true
+	}
+
+	return normalizePathMode(a.PathMode) == normalizePathMode(b.PathMode)
+}
+
+func normalizePathMode(mode string) string {
+	if mode == "" {
+		return PathModeBoth
+	}
+
+	return mode
+}
+
+// loadFileContentCovers reports whether the content conditions of a see file
+// content whenever those of b do. Loading content from disk only adds
+// content, so a rule that loads it covers one that does not.
+func loadFileContentCovers(a, b *RuleMatch) bool {
+	if effectivePatterns(a.ContentPattern, a.ContentPatterns) == nil {
+		return true
+	}
+
+	return a.LoadFileContent || !b.LoadFileContent
+}
+
+// trackingCovers reports whether the tracking requirements of a are no
+// stricter than those of b.
+func trackingCovers(a, b *RuleMatch) bool {
+	aRequires := a.RequireUpstream || a.MinAhead > 0 || a.MinBehind > 0
+	bRequires := b.RequireUpstream || b.MinAhead > 0 || b.MinBehind > 0
+
+	if aRequires && !bRequires {
+		return false
+	}
+
+	return a.MinAhead <= b.MinAhead && a.MinBehind <= b.MinBehind
+}
+
+// patternConditions returns the patterns BuildMatcher uses for each
+// pattern condition: the multi-pattern list when set, otherwise the single
+// pattern. Command substrings are compared like patterns.
+func patternConditions(m *RuleMatch) [][]string {
+	return [][]string{
+		effectivePatterns(m.RepoPattern, m.RepoPatterns),
+		effectivePatterns(m.BranchPattern, m.BranchPatterns),
+		effectivePatterns(m.FilePattern, m.FilePatterns),
+		effectivePatterns(m.ContentPattern, m.ContentPatterns),
+		effectivePatterns(m.CommandPattern, m.CommandPatterns),
+		m.CommandContains,
+		effectivePatterns(m.PromptPattern, nil),
+		effectivePatterns(m.CommitMessagePattern, nil),
+		effectivePatterns(m.StagedPathPattern, nil),
+		effectivePatterns(m.RequireStagedPath, nil),
+	}
+}
+
+func effectivePatterns(pattern string, patterns []string) []string {
+	if len(patterns) > 0 {
+		return patterns
+	}
+
+	if pattern == "" {
+		return nil
+	}
+
+	return []string{pattern}
+}
+
+// patternsCover reports whether patterns a, combined using aMode, match
+// everything matched by patterns b combined using bMode.
+func patternsCover(a, b []string, aMode, bMode string) bool {
+	if len(a) == 0 {
+		return true
+	}
+
+	if len(b) == 0 {
+		return false
+	}
+
+	// A single pattern behaves the same in "any" and "all" mode.
+	aAll := parsePatternMode(aMode) == MultiPatternAll && len(a) > 1
+	bAll := parsePatternMode(bMode) == MultiPatternAll || len(b) == 1
+
+	switch {
+	case !aAll && !bAll:
+		// Any of b implies any of a when every b pattern is also in a.
+		return isSubset(b, a)
+	case !aAll && bAll:
+		// All of b implies any of a when they share a pattern.
+		return slices.ContainsFunc(b, func(p string) bool { return slices.Contains(a, p) })
+	case aAll && bAll:
+		// All of b implies all of a when every a pattern is also in b.
+		return isSubset(a, b)
+	default:
+		return false
+	}
+}
+
+// isSubset reports whether every element of sub is in set.
+func isSubset(sub, set []string) bool {
+	for _, s := range sub {
+		if !slices.Contains(set, s) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,321 @@
+package rules_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/rules"
+)
+
+var _ = Describe("Lint", func() {
+	newRule := func(name string, priority int, action rules.ActionType, match *rules.RuleMatch) *rules.Rule {
+		return &rules.Rule{
+			Name:     name,
+			Enabled:  true,
+			Priority: priority,
+			Match:    match,
+			Action:   &rules.RuleAction{Type: action},
+		}
+	}
+
+	It("should report nothing for independent rules", func() {
+		issues := rules.Lint([]*rules.Rule{
+			newRule("push", 10, rules.ActionBlock, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+			}),
+			newRule("commit", 10, rules.ActionWarn, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitCommit,
+			}),
+		})
+
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("should report invalid patterns", func() {
+		issues := rules.Lint([]*rules.Rule{
+			newRule("bad", 10, rules.ActionBlock, &rules.RuleMatch{
+				CommandPattern: "^(git",
+			}),
+		})
+
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Kind).To(Equal(rules.LintInvalidPattern))
+		Expect(issues[0].Rule).To(Equal("bad"))
+	})
+
+	It("should check disabled rules only for invalid patterns", func() {
+		broad := newRule("broad", 100, rules.ActionBlock, nil)
+		broad.Enabled = false
+
+		issues := rules.Lint([]*rules.Rule{
+			broad,
+			newRule("push", 10, rules.ActionBlock, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+			}),
+		})
+
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("should report a rule shadowed by a broader higher-priority rule", func() {
+		issues := rules.Lint([]*rules.Rule{
+			newRule("main-only", 10, rules.ActionWarn, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+				BranchPattern: "main",
+			}),
+			newRule("all-git", 100, rules.ActionBlock, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitAll,
+			}),
+		})
+
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Kind).To(Equal(rules.LintShadowed))
+		Expect(issues[0].Rule).To(Equal("main-only"))
+		Expect(issues[0].Other).To(Equal("all-git"))
+	})
+
+	It("should not report a narrower higher-priority rule", func() {
+		issues := rules.Lint([]*rules.Rule{
+			newRule("main-only", 100, rules.ActionAllow, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+				BranchPattern: "main",
+			}),
+			newRule("all-git", 10, rules.ActionBlock, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitAll,
+			}),
+		})
+
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("should ignore log rules", func() {
+		issues := rules.Lint([]*rules.Rule{
+			newRule("observe-all", 100, rules.ActionLog, nil),
+			newRule("push", 10, rules.ActionBlock, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+			}),
+			newRule("observe-push", 5, rules.ActionLog, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+			}),
+		})
+
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("should not let transform rules shadow others", func() {
+		transform := newRule("lease", 100, rules.ActionTransform, &rules.RuleMatch{
+			ValidatorType: rules.ValidatorGitPush,
+		})
+		transform.Action.Transform = &rules.Transform{Find: "--force", Replace: "--force-with-lease"}
+
+		issues := rules.Lint([]*rules.Rule{
+			transform,
+			newRule("push", 10, rules.ActionBlock, &rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+			}),
+		})
+
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("should report invalid transform patterns", func() {
+		transform := newRule("lease", 100, rules.ActionTransform, nil)
+		transform.Action.Transform = &rules.Transform{Find: "(--force"}
+
+		issues := rules.Lint([]*rules.Rule{transform})
+
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Kind).To(Equal(rules.LintInvalidPattern))
+	})
+
+	It("should report identical conditions with conflicting actions", func() {
+		match := &rules.RuleMatch{
+			ValidatorType: rules.ValidatorGitPush,
+			Remote:        "origin",
+		}
+
+		issues := rules.Lint([]*rules.Rule{
+			newRule("allow-push", 50, rules.ActionAllow, match),
+			newRule("block-push", 50, rules.ActionBlock, match),
+		})
+
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Kind).To(Equal(rules.LintConflict))
+		Expect(issues[0].Rule).To(Equal("block-push"))
+		Expect(issues[0].Other).To(Equal("allow-push"))
+		Expect(issues[0].String()).To(ContainSubstring(`"allow-push" always wins`))
+	})
+
+	Context("with allow wins", func() {
+		It("should not report an allow rule behind a broader block rule", func() {
+			issues := rules.Lint([]*rules.Rule{
+				newRule("block-git", 100, rules.ActionBlock, &rules.RuleMatch{
+					ValidatorType: rules.ValidatorGitAll,
+				}),
+				newRule("allow-main", 10, rules.ActionAllow, &rules.RuleMatch{
+					ValidatorType: rules.ValidatorGitPush,
+					BranchPattern: "main",
+				}),
+			}, rules.WithLintAllowWins(true))
+
+			Expect(issues).To(BeEmpty())
+		})
+
+		It("should report a block rule covered by a lower-priority allow rule", func() {
+			issues := rules.Lint([]*rules.Rule{
+				newRule("block-push", 100, rules.ActionBlock, &rules.RuleMatch{
+					ValidatorType: rules.ValidatorGitPush,
+				}),
+				newRule("allow-git", 10, rules.ActionAllow, &rules.RuleMatch{
+					ValidatorType: rules.ValidatorGitAll,
+				}),
+			}, rules.WithLintAllowWins(true))
+
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Kind).To(Equal(rules.LintShadowed))
+			Expect(issues[0].Rule).To(Equal("block-push"))
+			Expect(issues[0].Other).To(Equal("allow-git"))
+		})
+
+		It("should name the allow rule as the winner of a conflict", func() {
+			match := &rules.RuleMatch{ValidatorType: rules.ValidatorGitPush}
+
+			issues := rules.Lint([]*rules.Rule{
+				newRule("block-push", 100, rules.ActionBlock, match),
+				newRule("allow-push", 10, rules.ActionAllow, match),
+			}, rules.WithLintAllowWins(true))
+
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Kind).To(Equal(rules.LintConflict))
+			Expect(issues[0].Rule).To(Equal("block-push"))
+			Expect(issues[0].String()).To(ContainSubstring(`"allow-push" always wins`))
+		})
+	})
+
+	DescribeTable("superset detection",
+		func(broad, narrow *rules.RuleMatch, shadowed bool) {
+			issues := rules.Lint([]*rules.Rule{
+				newRule("broad", 100, rules.ActionBlock, broad),
+				newRule("narrow", 10, rules.ActionBlock, narrow),
+			})
+
+			if shadowed {
+				Expect(issues).To(HaveLen(1))
+				Expect(issues[0].Rule).To(Equal("narrow"))
+			} else {
+				Expect(issues).To(BeEmpty())
+			}
+		},
+		Entry("no conditions covers everything",
+			nil, &rules.RuleMatch{ValidatorType: rules.ValidatorFileMarkdown}, true),
+		Entry("category wildcard does not cover another category",
+			&rules.RuleMatch{ValidatorType: rules.ValidatorGitAll},
+			&rules.RuleMatch{ValidatorType: rules.ValidatorGitHubIssue}, false),
+		Entry("any-mode patterns cover a subset",
+			&rules.RuleMatch{BranchPatterns: []string{"main", "master"}},
+			&rules.RuleMatch{BranchPattern: "main"}, true),
+		Entry("any-mode patterns do not cover a superset",
+			&rules.RuleMatch{BranchPattern: "main"},
+			&rules.RuleMatch{BranchPatterns: []string{"main", "master"}}, false),
+		Entry("all-mode patterns cover more patterns",
+			&rules.RuleMatch{FilePatterns: []string{"*.go", "!*_test.go"}, PatternMode: "all"},
+			&rules.RuleMatch{
+				FilePatterns: []string{"*.go", "!*_test.go", "cmd/**"},
+				PatternMode:  "all",
+			}, true),
+		Entry("different patterns are never assumed to overlap",
+			&rules.RuleMatch{FilePattern: "**/*.go"},
+			&rules.RuleMatch{FilePattern: "*.go"}, false),
+		Entry("case-insensitive covers case-sensitive",
+			&rules.RuleMatch{BranchPattern: "main", CaseInsensitive: true},
+			&rules.RuleMatch{BranchPattern: "main"}, true),
+		Entry("case-sensitive does not cover case-insensitive",
+			&rules.RuleMatch{BranchPattern: "main"},
+			&rules.RuleMatch{BranchPattern: "main", CaseInsensitive: true}, false),
+		Entry("extensions cover a subset",
+			&rules.RuleMatch{FileExtensions: []string{"go", "ts"}},
+			&rules.RuleMatch{FileExtensions: []string{".TS"}}, true),
+		Entry("longer subject minimum does not cover shorter minimum",
+			&rules.RuleMatch{MinSubjectLength: 73},
+			&rules.RuleMatch{MinSubjectLength: 51}, false),
+		Entry("higher subject maximum covers lower maximum",
+			&rules.RuleMatch{MaxSubjectLength: 20},
+			&rules.RuleMatch{MaxSubjectLength: 10, MinSubjectLength: 5}, true),
+		Entry("OS list covers a subset",
+			&rules.RuleMatch{OS: []string{"darwin", "linux"}},
+			&rules.RuleMatch{OS: []string{"Linux"}}, true),
+		Entry("OS list does not cover any OS",
+			&rules.RuleMatch{OS: []string{"linux"}},
+			&rules.RuleMatch{ToolType: "Bash"}, false),
+		Entry("lower minimum covers higher minimum",
+			&rules.RuleMatch{MinBehind: 1},
+			&rules.RuleMatch{MinBehind: 3, MinAhead: 1}, true),
+		Entry("higher day count does not cover lower day count",
+			&rules.RuleMatch{MinDaysSinceCommit: 30},
+			&rules.RuleMatch{MinDaysSinceCommit: 7}, false),
+		Entry("command substring covers narrower rules with the same substring",
+			&rules.RuleMatch{CommandContains: []string{"rm -rf"}},
+			&rules.RuleMatch{CommandContains: []string{"rm -rf"}, ToolType: "Bash"}, true),
+		Entry("lower path count covers higher path count",
+			&rules.RuleMatch{MinCommandPaths: 5},
+			&rules.RuleMatch{MinCommandPaths: 20}, true),
+		Entry("path count does not cover rules without it",
+			&rules.RuleMatch{MinCommandPaths: 5},
+			&rules.RuleMatch{ToolType: "Bash"}, false),
+		Entry("command substring does not cover rules without it",
+			&rules.RuleMatch{CommandContains: []string{"rm -rf"}},
+			&rules.RuleMatch{ToolType: "Bash"}, false),
+		Entry("staged path pattern does not cover rules without it",
+			&rules.RuleMatch{StagedPathPattern: "**/.env"},
+			&rules.RuleMatch{ValidatorType: rules.ValidatorGitCommit}, false),
+		Entry("same staged path requirement covers itself",
+			&rules.RuleMatch{RequireStagedPath: "go.mod"},
+			&rules.RuleMatch{RequireStagedPath: "go.mod", StagedPathPattern: "!go.sum"}, true),
+		Entry("dirty tree requirement does not cover rules without it",
+			&rules.RuleMatch{RequireDirty: true},
+			&rules.RuleMatch{ValidatorType: rules.ValidatorGitBranch}, false),
+		Entry("no tree requirement covers clean tree rules",
+			&rules.RuleMatch{ValidatorType: rules.ValidatorGitBranch},
+			&rules.RuleMatch{ValidatorType: rules.ValidatorGitBranch, RequireClean: true}, true),
+		Entry("upstream requirement does not cover rules without it",
+			&rules.RuleMatch{RequireUpstream: true},
+			&rules.RuleMatch{ValidatorType: rules.ValidatorGitPush}, false),
+		Entry("binary requirement does not cover rules without it",
+			&rules.RuleMatch{IsBinary: true},
+			&rules.RuleMatch{ValidatorType: rules.ValidatorSecrets}, false),
+		Entry("rules without binary requirement cover binary rules",
+			&rules.RuleMatch{ValidatorType: rules.ValidatorSecrets},
+			&rules.RuleMatch{ValidatorType: rules.ValidatorSecrets, IsBinary: true}, true),
+		Entry("sudo requirement does not cover rules without it",
+			&rules.RuleMatch{UsesSudo: true},
+			&rules.RuleMatch{ValidatorType: rules.ValidatorGitPush}, false),
+		Entry("content-in-files pairs cover a subset of pairs",
+			&rules.RuleMatch{ContentInFiles: []rules.ContentInFile{
+				{FilePattern: "*.go", ContentPattern: "TODO"},
+				{FilePattern: "*.py", ContentPattern: "TODO"},
+			}},
+			&rules.RuleMatch{ContentInFiles: []rules.ContentInFile{
+				{FilePattern: "*.py", ContentPattern: "TODO"},
+			}}, true),
+		Entry("content-in-files pairs do not cover rules without them",
+			&rules.RuleMatch{ContentInFiles: []rules.ContentInFile{
+				{FilePattern: "*.go", ContentPattern: "TODO"},
+			}},
+			&rules.RuleMatch{ValidatorType: rules.ValidatorFileAll}, false),
+		Entry("file patterns with another path mode do not cover each other",
+			&rules.RuleMatch{FilePattern: "src/**", PathMode: rules.PathModeRelative},
+			&rules.RuleMatch{FilePattern: "src/**"}, false),
+		Entry("empty path mode covers both path mode",
+			&rules.RuleMatch{FilePattern: "src/**"},
+			&rules.RuleMatch{FilePattern: "src/**", PathMode: rules.PathModeBoth}, true),
+		Entry("path mode is ignored without file patterns",
+			&rules.RuleMatch{ToolType: "Write", PathMode: rules.PathModeAbsolute},
+			&rules.RuleMatch{ToolType: "Write", FilePattern: "*.go"}, true),
+		Entry("content pattern without loaded content does not cover loaded content",
+			&rules.RuleMatch{ContentPattern: "TODO"},
+			&rules.RuleMatch{ContentPattern: "TODO", LoadFileContent: true}, false),
+		Entry("content pattern with loaded content covers one without it",
+			&rules.RuleMatch{ContentPattern: "TODO", LoadFileContent: true},
+			&rules.RuleMatch{ContentPattern: "TODO", ToolType: "Write"}, true),
+	)
+})
@@ -1,9 +1,17 @@
 package rules
 
 import (
+	"path"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/smykla-skalski/klaudiush/internal/validators"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
 )
 
 // RepoPatternMatcher matches against the repository root path.
@@ -58,7 +66,7 @@ func (m *RepoPatternMatcher) Match(ctx *MatchContext) bool {
 		return false
 	}
 
-	return m.pattern.Match(ctx.GitContext.RepoRoot)
+	return m.pattern.Match(normalizePath(ctx.GitContext.RepoRoot))
 }
 
 // Name returns the matcher name.
@@ -68,25 +76,41 @@ func (m *RepoPatternMatcher) Name() string {
 
 // RemoteMatcher matches against the git remote name.
 type RemoteMatcher struct {
-	remote string
+	remote  string
+	negated bool
 }
 
-// NewRemoteMatcher creates a matcher for exact remote name matching.
+// NewRemoteMatcher creates a matcher for exact remote name matching. A "!"
+// prefix negates it, as with patterns: "!origin" matches any remote except
+// origin.
 func NewRemoteMatcher(remote string) *RemoteMatcher {
-	return &RemoteMatcher{remote: remote}
+	return &RemoteMatcher{
+		remote:  StripNegation(remote),
+		negated: IsNegated(remote),
+	}
 }
 
-// Match returns true if the remote matches exactly.
+// Match returns true if the remote matches exactly, or for a negated remote,
+// if it differs. Like negated branch patterns, a negated remote does not
+// match when the remote is unknown.
 func (m *RemoteMatcher) Match(ctx *MatchContext) bool {
 	if ctx.GitContext == nil {
 		return false
 	}
 
+	if m.negated {
+		return ctx.GitContext.Remote != "" && ctx.GitContext.Remote != m.remote
+	}
+
 	return ctx.GitContext.Remote == m.remote
 }
 
 // Name returns the matcher name.
 func (m *RemoteMatcher) Name() string {
+	if m.negated {
+		return "remote:!" + m.remote
+	}
+
 	return "remote:" + m.remote
 }
 
@@ -150,9 +174,22 @@ func (m *BranchPatternMatcher) Name() string {
 	return "branch_pattern:" + m.pattern.String()
 }
 
+// Path modes select which form of a file path file patterns match.
+const (
+	// PathModeAbsolute matches the absolute path.
+	PathModeAbsolute = "absolute"
+
+	// PathModeRelative matches the path relative to the repository root.
+	PathModeRelative = "relative"
+
+	// PathModeBoth matches when either form matches.
+	PathModeBoth = "both"
+)
+
 // FilePatternMatcher matches against file paths.
 type FilePatternMatcher struct {
-	pattern Pattern
+	pattern  Pattern
+	pathMode string
 }
 
 // NewFilePatternMatcher creates a matcher for file path patterns.
@@ -196,18 +233,199 @@ func NewFileMultiPatternMatcher(
 	return &FilePatternMatcher{pattern: pattern}, nil
 }
 
-// Match returns true if the file path matches the pattern.
+// WithPathMode sets which form of the file path the pattern matches and
+// returns the matcher. Empty means PathModeBoth.
+func (m *FilePatternMatcher) WithPathMode(pathMode string) *FilePatternMatcher {
+	m.pathMode = pathMode
+
+	return m
+}
+
+// Match returns true if the file path matches the pattern. When the git
+// context has a repository root, the path is matched in its absolute and
+// repo-relative forms as selected by the path mode.
 func (m *FilePatternMatcher) Match(ctx *MatchContext) bool {
-	if ctx.FileContext == nil || ctx.FileContext.Path == "" {
+	var path string
+
+	switch {
+	case ctx.FileContext != nil && ctx.FileContext.Path != "":
+		path = ctx.FileContext.Path
+	case ctx.HookContext != nil:
 		// Fall back to hook context file path.
-		if ctx.HookContext != nil {
-			return m.pattern.Match(ctx.HookContext.GetFilePath())
+		path = ctx.HookContext.GetFilePath()
+	default:
+		return false
+	}
+
+	var repoRoot string
+	if ctx.GitContext != nil {
+		repoRoot = ctx.GitContext.RepoRoot
+	}
+
+	var workDir string
+	if ctx.HookContext != nil {
+		workDir = ctx.HookContext.GetWorkingDir()
+	}
+
+	return matchPathForms(m.pattern, m.pathForms(path, repoRoot, workDir, ctx.resolved))
+}
+
+// pathForms returns the normalized forms of path selected by the path mode.
+// Without a repository root, the path is used as given. A relative path is
+// taken as relative to workDir, or to the repository root without one. A
+// path outside the repository has no relative form. When the path as given
+// is outside the repository, symlinks are resolved in both paths and they
+// are compared again, so a repository reached through a symlinked directory
+// still gives its files a relative form. resolved caches the resolved
+// paths and may be nil.
+func (m *FilePatternMatcher) pathForms(
+	path, repoRoot, workDir string,
+	resolved *resolvedPaths,
+) []string {
+	path = normalizePath(path)
+
+	if repoRoot == "" || path == "" {
+		return []string{path}
+	}
+
+	repoRoot = normalizePath(repoRoot)
+	absPath := path
+
+	if !filepath.IsAbs(path) && !strings.HasPrefix(path, "/") {
+		base := repoRoot
+		if workDir != "" {
+			base = normalizePath(workDir)
 		}
 
-		return false
+		absPath = filepath.ToSlash(filepath.Join(base, path))
+	}
+
+	relPath := relativePath(repoRoot, absPath)
+	if relPath == "" {
+		relPath = relativePath(resolved.resolve(repoRoot), resolved.resolve(absPath))
+	}
+
+	switch m.pathMode {
+	case PathModeAbsolute:
+		return []string{absPath}
+	case PathModeRelative:
+		if relPath == "" {
+			return nil
+		}
+
+		return []string{relPath}
+	default:
+		if relPath == "" {
+			return []string{absPath}
+		}
+
+		return []string{absPath, relPath}
 	}
+}
+
+// relativePath returns absPath relative to repoRoot with forward slashes, or
+// "" when it is outside repoRoot.
+func relativePath(repoRoot, absPath string) string {
+	rel, err := filepath.Rel(repoRoot, absPath)
+	if err != nil {
+		return ""
+	}
+
+	rel = filepath.ToSlash(rel)
+	if rel == ".." || strings.HasPrefix(rel, "../") {
+		return ""
+	}
+
+	return rel
+}
+
+// resolvedPaths caches paths with symlinks resolved, so that the rules of an
+// evaluation resolve each path once. A nil cache resolves every time.
+type resolvedPaths struct {
+	paths map[string]string
+}
+
+// resolve returns p with symlinks resolved.
+func (r *resolvedPaths) resolve(p string) string {
+	if r == nil {
+		return resolveSymlinks(p)
+	}
+
+	if resolved, ok := r.paths[p]; ok {
+		return resolved
+	}
+
+	if r.paths == nil {
+		r.paths = make(map[string]string)
+	}
+
+	resolved := resolveSymlinks(p)
+	r.paths[p] = resolved
+
+	return resolved
+}
+
+// resolveSymlinks returns p with symlinks resolved. Components that don't
+// exist yet, such as the file of a Write creating it, are kept as given.
+func resolveSymlinks(p string) string {
+	if resolved, err := filepath.EvalSymlinks(p); err == nil {
+		return resolved
+	}
+
+	dir := filepath.Dir(p)
+	if dir == p {
+		return p
+	}
+
+	return filepath.Join(resolveSymlinks(dir), filepath.Base(p))
+}
+
+// normalizePath returns filePath with forward slashes and cleaned, so that
+// patterns written with "/" match paths given with "\" separators, mixed
+// separators or a "./" prefix. Backslashes are converted on every platform,
+// as hook payloads may carry Windows paths. An empty path stays empty.
+func normalizePath(filePath string) string {
+	if filePath == "" {
+		return ""
+	}
+
+	return path.Clean(strings.ReplaceAll(filepath.ToSlash(filePath), `\`, "/"))
+}
+
+// matchPathForms reports whether pattern matches any of the path forms.
+// Negation and multi-pattern modes apply across the forms, so "!src/**"
+// rejects a file whose relative form is under src even though its absolute
+// form is not.
+func matchPathForms(pattern Pattern, forms []string) bool {
+	switch p := pattern.(type) {
+	case *NegatedPattern:
+		return !matchPathForms(p.inner, forms)
+	case *MultiPattern:
+		if len(p.patterns) == 0 {
+			return true
+		}
+
+		for _, sub := range p.patterns {
+			matched := matchPathForms(sub, forms)
+			if p.mode == MultiPatternAny && matched {
+				return true
+			}
+
+			if p.mode == MultiPatternAll && !matched {
+				return false
+			}
+		}
+
+		return p.mode == MultiPatternAll
+	default:
+		for _, form := range forms {
+			if pattern.Match(form) {
+				return true
+			}
+		}
 
-	return m.pattern.Match(ctx.FileContext.Path)
+		return false
+	}
 }
 
 // Name returns the matcher name.
@@ -215,6 +433,68 @@ func (m *FilePatternMatcher) Name() string {
 	return "file_pattern:" + m.pattern.String()
 }
 
+// FileExtensionMatcher matches file paths by extension, ignoring case.
+type FileExtensionMatcher struct {
+	extension string
+}
+
+// NewFileExtensionMatcher creates a matcher for a file extension.
+// The extension is given without the leading dot ("go"), but a leading
+// dot (".go") is tolerated.
+func NewFileExtensionMatcher(extension string) *FileExtensionMatcher {
+	return &FileExtensionMatcher{
+		extension: strings.ToLower(strings.TrimPrefix(strings.TrimSpace(extension), ".")),
+	}
+}
+
+// Match returns true if the file path has the configured extension.
+func (m *FileExtensionMatcher) Match(ctx *MatchContext) bool {
+	if m.extension == "" {
+		return false
+	}
+
+	path := ""
+
+	switch {
+	case ctx.FileContext != nil && ctx.FileContext.Path != "":
+		path = ctx.FileContext.Path
+	case ctx.HookContext != nil:
+		path = ctx.HookContext.GetFilePath()
+	}
+
+	if path == "" {
+		return false
+	}
+
+	// Suffix comparison on the base name so multi-part extensions
+	// such as "d.ts" or "tar.gz" work too.
+	return strings.HasSuffix(strings.ToLower(filepath.Base(normalizePath(path))), "."+m.extension)
+}
+
+// Name returns the matcher name.
+func (m *FileExtensionMatcher) Name() string {
+	return "file_extension:" + m.extension
+}
+
+// newFileExtensionsMatcher builds an OR matcher over the given extensions.
+// Returns nil if no extensions are given.
+func newFileExtensionsMatcher(extensions []string) Matcher {
+	matchers := make([]Matcher, 0, len(extensions))
+
+	for _, ext := range extensions {
+		matchers = append(matchers, NewFileExtensionMatcher(ext))
+	}
+
+	switch len(matchers) {
+	case 0:
+		return nil
+	case 1:
+		return matchers[0]
+	default:
+		return NewOrMatcher(matchers...)
+	}
+}
+
 // ContentPatternMatcher matches against file content using regex.
 type ContentPatternMatcher struct {
 	pattern Pattern
@@ -237,29 +517,45 @@ func NewContentPatternMatcherWithOpts(
 	patternStr string,
 	opts PatternOptions,
 ) (*ContentPatternMatcher, error) {
-	// For content, force regex detection by using CompilePatternWithOptions
-	// but handle case-insensitivity here since content is always regex.
+	pattern, err := compileContentPattern(patternStr, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContentPatternMatcher{pattern: pattern}, nil
+}
+
+// compileContentPattern compiles a content pattern, which is always a regex,
+// with negation via ! prefix or options and case-insensitivity via options.
+// String() of the result returns patternStr as given.
+func compileContentPattern(patternStr string, opts PatternOptions) (Pattern, error) {
+	source := patternStr
+
 	negated := opts.Negate || IsNegated(patternStr)
 	if IsNegated(patternStr) {
 		patternStr = StripNegation(patternStr)
 	}
 
-	// Add case-insensitive flag if needed.
-	if opts.CaseInsensitive && !strings.HasPrefix(patternStr, "(?i)") {
-		patternStr = "(?i)" + patternStr
+	var (
+		pattern *RegexPattern
+		err     error
+	)
+
+	if opts.CaseInsensitive {
+		pattern, err = newCaseInsensitiveRegexPattern(patternStr)
+	} else {
+		pattern, err = NewRegexPattern(patternStr)
 	}
 
-	pattern, err := NewRegexPattern(patternStr)
 	if err != nil {
 		return nil, err
 	}
 
-	// Wrap in NegatedPattern if needed.
 	if negated {
-		return &ContentPatternMatcher{pattern: NewNegatedPattern(pattern)}, nil
+		return newNegatedPatternFrom(pattern, source), nil
 	}
 
-	return &ContentPatternMatcher{pattern: pattern}, nil
+	return pattern, nil
 }
 
 // NewContentMultiPatternMatcher creates a matcher for multiple content patterns.
@@ -281,27 +577,12 @@ func NewContentMultiPatternMatcher(
 	compiled := make([]Pattern, 0, len(patterns))
 
 	for _, p := range patterns {
-		negated := opts.Negate || IsNegated(p)
-		patternStr := p
-
-		if IsNegated(p) {
-			patternStr = StripNegation(p)
-		}
-
-		if opts.CaseInsensitive && !strings.HasPrefix(patternStr, "(?i)") {
-			patternStr = "(?i)" + patternStr
-		}
-
-		pattern, err := NewRegexPattern(patternStr)
+		pattern, err := compileContentPattern(p, opts)
 		if err != nil {
 			return nil, err
 		}
 
-		if negated {
-			compiled = append(compiled, NewNegatedPattern(pattern))
-		} else {
-			compiled = append(compiled, pattern)
-		}
+		compiled = append(compiled, pattern)
 	}
 
 	// Build string representation.
@@ -334,6 +615,34 @@ func (m *ContentPatternMatcher) Name() string {
 	return "content_pattern:" + m.pattern.String()
 }
 
+// BinaryContentMatcher matches when the file content looks like binary data
+// (null bytes or a high share of non-printable characters).
+type BinaryContentMatcher struct{}
+
+// NewBinaryContentMatcher creates a matcher for binary file content.
+func NewBinaryContentMatcher() *BinaryContentMatcher {
+	return &BinaryContentMatcher{}
+}
+
+// Match returns true if the file content is binary.
+func (*BinaryContentMatcher) Match(ctx *MatchContext) bool {
+	if ctx.FileContext == nil || ctx.FileContext.Content == "" {
+		// Fall back to hook context content.
+		if ctx.HookContext != nil {
+			return validators.IsBinaryContent(ctx.HookContext.GetContent())
+		}
+
+		return false
+	}
+
+	return validators.IsBinaryContent(ctx.FileContext.Content)
+}
+
+// Name returns the matcher name.
+func (*BinaryContentMatcher) Name() string {
+	return "binary_content"
+}
+
 // CommandPatternMatcher matches against bash commands.
 type CommandPatternMatcher struct {
 	pattern Pattern
@@ -390,12 +699,396 @@ func (m *CommandPatternMatcher) Match(ctx *MatchContext) bool {
 		return m.pattern.Match(ctx.HookContext.GetCommand())
 	}
 
-	return false
+	return false
+}
+
+// Name returns the matcher name.
+func (m *CommandPatternMatcher) Name() string {
+	return "command_pattern:" + m.pattern.String()
+}
+
+// PromptPatternMatcher matches the user prompt of the hook payload using
+// regex.
+type PromptPatternMatcher struct {
+	pattern Pattern
+}
+
+// NewPromptPatternMatcher creates a matcher for prompt patterns. Prompt
+// patterns always use regex, like content patterns.
+func NewPromptPatternMatcher(
+	patternStr string,
+	opts PatternOptions,
+) (*PromptPatternMatcher, error) {
+	pattern, err := compileContentPattern(patternStr, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PromptPatternMatcher{pattern: pattern}, nil
+}
+
+// Match returns true if the prompt matches the pattern. Without a prompt it
+// never matches, even for a negated pattern.
+func (m *PromptPatternMatcher) Match(ctx *MatchContext) bool {
+	if ctx.HookContext == nil || ctx.HookContext.Prompt == "" {
+		return false
+	}
+
+	return m.pattern.Match(ctx.HookContext.Prompt)
+}
+
+// Name returns the matcher name.
+func (m *PromptPatternMatcher) Name() string {
+	return "prompt_pattern:" + m.pattern.String()
+}
+
+// CommandContainsMatcher matches commands containing literal substrings.
+type CommandContainsMatcher struct {
+	substrings      []string
+	mode            MultiPatternMode
+	caseInsensitive bool
+}
+
+// NewCommandContainsMatcher creates a matcher for literal command substrings.
+// In MultiPatternAll mode every substring must be present, otherwise any one
+// is enough. Returns nil when there are no substrings.
+func NewCommandContainsMatcher(
+	substrings []string,
+	mode MultiPatternMode,
+	opts PatternOptions,
+) *CommandContainsMatcher {
+	if len(substrings) == 0 {
+		return nil
+	}
+
+	m := &CommandContainsMatcher{
+		substrings:      slices.Clone(substrings),
+		mode:            mode,
+		caseInsensitive: opts.CaseInsensitive,
+	}
+
+	if m.caseInsensitive {
+		for i, s := range m.substrings {
+			m.substrings[i] = strings.ToLower(s)
+		}
+	}
+
+	return m
+}
+
+// Match returns true if the command contains any (or all) of the substrings.
+func (m *CommandContainsMatcher) Match(ctx *MatchContext) bool {
+	command := ctx.Command
+	if command == "" && ctx.HookContext != nil {
+		command = ctx.HookContext.GetCommand()
+	}
+
+	if command == "" {
+		return false
+	}
+
+	if m.caseInsensitive {
+		command = strings.ToLower(command)
+	}
+
+	all := m.mode == MultiPatternAll
+
+	for _, s := range m.substrings {
+		if strings.Contains(command, s) != all {
+			return !all
+		}
+	}
+
+	return all
+}
+
+// Name returns the matcher name.
+func (m *CommandContainsMatcher) Name() string {
+	modeStr := PatternModeAny
+	if m.mode == MultiPatternAll {
+		modeStr = PatternModeAll
+	}
+
+	return "command_contains:" + modeStr + ":" + strings.Join(m.substrings, ",")
+}
+
+// sudoWrappers are commands that run their arguments as another command, so a
+// sudo behind them (e.g. "env FOO=1 sudo x") still counts as a sudo invocation.
+var sudoWrappers = map[string]bool{
+	"command": true,
+	"env":     true,
+	"exec":    true,
+	"nohup":   true,
+	"time":    true,
+}
+
+// SudoMatcher matches when the command runs anything through sudo. Leading
+// environment assignments, chained commands (&&, ||, ;, pipes), subshells and
+// wrappers such as env are taken into account.
+type SudoMatcher struct {
+	parser *parser.BashParser
+}
+
+// NewSudoMatcher creates a matcher for commands using sudo.
+func NewSudoMatcher() *SudoMatcher {
+	return &SudoMatcher{parser: parser.NewBashParser()}
+}
+
+// Match returns true if the command invokes sudo.
+func (m *SudoMatcher) Match(ctx *MatchContext) bool {
+	command := ctx.Command
+	if command == "" && ctx.HookContext != nil {
+		command = ctx.HookContext.GetCommand()
+	}
+
+	if strings.TrimSpace(command) == "" {
+		return false
+	}
+
+	result, err := m.parser.Parse(command)
+	if err != nil {
+		// Unparseable commands fall back to looking for a sudo word.
+		return slices.ContainsFunc(strings.Fields(command), isSudoWord)
+	}
+
+	for _, cmd := range result.Commands {
+		if isSudoCommand(cmd.Name, cmd.Args) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Name returns the matcher name.
+func (*SudoMatcher) Name() string {
+	return "uses_sudo"
+}
+
+// isSudoCommand reports whether name (with args) runs sudo, either directly or
+// behind one of the sudoWrappers.
+func isSudoCommand(name string, args []string) bool {
+	if isSudoWord(name) {
+		return true
+	}
+
+	if !sudoWrappers[path.Base(name)] {
+		return false
+	}
+
+	for i, arg := range args {
+		// Skip wrapper flags and env-style NAME=value assignments.
+		if strings.HasPrefix(arg, "-") || isEnvAssignment(arg) {
+			continue
+		}
+
+		return isSudoCommand(arg, args[i+1:])
+	}
+
+	return false
+}
+
+// isSudoWord reports whether word names the sudo binary (e.g. "/usr/bin/sudo").
+func isSudoWord(word string) bool {
+	return path.Base(word) == "sudo"
+}
+
+// isEnvAssignment reports whether arg is a NAME=value assignment.
+func isEnvAssignment(arg string) bool {
+	name, _, ok := strings.Cut(arg, "=")
+
+	return ok && name != "" && !strings.ContainsAny(name, "/ ")
+}
+
+// CommandArgCountMatcher matches when a single command passes at least a
+// minimum number of path-like arguments, e.g. "rm" with many files or
+// "git checkout -- a b c".
+type CommandArgCountMatcher struct {
+	parser   *parser.BashParser
+	minPaths int
+}
+
+// NewCommandArgCountMatcher creates a matcher for commands touching at least
+// minPaths paths.
+func NewCommandArgCountMatcher(minPaths int) *CommandArgCountMatcher {
+	return &CommandArgCountMatcher{parser: parser.NewBashParser(), minPaths: minPaths}
+}
+
+// Match returns true if a command has at least minPaths path arguments.
+func (m *CommandArgCountMatcher) Match(ctx *MatchContext) bool {
+	command := ctx.Command
+	if command == "" && ctx.HookContext != nil {
+		command = ctx.HookContext.GetCommand()
+	}
+
+	if strings.TrimSpace(command) == "" {
+		return false
+	}
+
+	return commandPathCount(m.parser, command) >= m.minPaths
+}
+
+// Name returns the matcher name.
+func (m *CommandArgCountMatcher) Name() string {
+	return "min_command_paths:" + strconv.Itoa(m.minPaths)
+}
+
+// ConsecutiveBlocksMatcher matches when the previous invocations of the same
+// tool blocked at least a minimum number of times in a row on the same
+// reference, as recorded by loop detection in hook.Context.ConsecutiveBlocks.
+type ConsecutiveBlocksMatcher struct {
+	minBlocks int
+}
+
+// NewConsecutiveBlocksMatcher creates a matcher for invocations following at
+// least minBlocks consecutive blocks.
+func NewConsecutiveBlocksMatcher(minBlocks int) *ConsecutiveBlocksMatcher {
+	return &ConsecutiveBlocksMatcher{minBlocks: minBlocks}
+}
+
+// Match returns true if at least minBlocks consecutive blocks preceded the
+// invocation.
+func (m *ConsecutiveBlocksMatcher) Match(ctx *MatchContext) bool {
+	if ctx.HookContext == nil {
+		return false
+	}
+
+	return ctx.HookContext.ConsecutiveBlocks >= m.minBlocks
+}
+
+// Name returns the matcher name.
+func (m *ConsecutiveBlocksMatcher) Name() string {
+	return "min_consecutive_blocks:" + strconv.Itoa(m.minBlocks)
+}
+
+// commitMessageSources are the git commit flags that take the message from
+// somewhere other than the command line.
+var commitMessageSources = []string{
+	"-F", "--file", "-C", "--reuse-message", "-c", "--reedit-message",
+}
+
+// CommitMessageMatcher matches the message of git commit commands that pass
+// it with -m/--message, without running git. Repeated message flags are
+// joined as paragraphs, like git does. Commits taking the message from a
+// file, another commit or the editor have unknown content and never match.
+type CommitMessageMatcher struct {
+	parser     *parser.BashParser
+	pattern    Pattern
+	minSubject int
+	maxSubject int
+}
+
+// NewCommitMessageMatcher creates a matcher for commit messages. The pattern
+// is optional and always uses regex, like content patterns. Subject lengths
+// that are not positive are not checked.
+func NewCommitMessageMatcher(
+	patternStr string,
+	minSubject, maxSubject int,
+	opts PatternOptions,
+) (*CommitMessageMatcher, error) {
+	m := &CommitMessageMatcher{
+		parser:     parser.NewBashParser(),
+		minSubject: minSubject,
+		maxSubject: maxSubject,
+	}
+
+	if patternStr != "" {
+		pattern, err := compileContentPattern(patternStr, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		m.pattern = pattern
+	}
+
+	return m, nil
+}
+
+// Match returns true if a git commit in the command has an inline message
+// meeting every condition.
+func (m *CommitMessageMatcher) Match(ctx *MatchContext) bool {
+	command := ctx.Command
+	if command == "" && ctx.HookContext != nil {
+		command = ctx.HookContext.GetCommand()
+	}
+
+	if strings.TrimSpace(command) == "" {
+		return false
+	}
+
+	result, err := m.parser.Parse(command)
+	if err != nil {
+		return false
+	}
+
+	for _, cmd := range result.Commands {
+		message, ok := inlineCommitMessage(cmd)
+		if ok && m.matchMessage(message) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *CommitMessageMatcher) matchMessage(message string) bool {
+	subject, _, _ := strings.Cut(message, "\n")
+	length := utf8.RuneCountInString(strings.TrimSpace(subject))
+
+	if m.minSubject > 0 && length < m.minSubject {
+		return false
+	}
+
+	if m.maxSubject > 0 && length > m.maxSubject {
+		return false
+	}
+
+	return m.pattern == nil || m.pattern.Match(message)
+}
+
+// Name returns the matcher name.
+func (m *CommitMessageMatcher) Name() string {
+	parts := []string{"commit_message"}
+
+	if m.pattern != nil {
+		parts = append(parts, m.pattern.String())
+	}
+
+	if m.minSubject > 0 {
+		parts = append(parts, "min_subject:"+strconv.Itoa(m.minSubject))
+	}
+
+	if m.maxSubject > 0 {
+		parts = append(parts, "max_subject:"+strconv.Itoa(m.maxSubject))
+	}
+
+	return strings.Join(parts, ":")
+}
+
+// inlineCommitMessage returns the message cmd passes with -m/--message when
+// it is a git commit. ok is false for other commands and for commits whose
+// message comes from elsewhere.
+func inlineCommitMessage(cmd parser.Command) (string, bool) {
+	gitCmd, err := parser.ParseGitCommand(cmd)
+	if err != nil || gitCmd.Subcommand != "commit" || len(gitCmd.Messages) == 0 {
+		return "", false
+	}
+
+	for _, source := range commitMessageSources {
+		if gitCmd.HasFlag(source) || hasFlagAssignment(gitCmd.Flags, source) {
+			return "", false
+		}
+	}
+
+	return strings.TrimSpace(gitCmd.ExtractFullCommitMessage()), true
 }
 
-// Name returns the matcher name.
-func (m *CommandPatternMatcher) Name() string {
-	return "command_pattern:" + m.pattern.String()
+// hasFlagAssignment reports whether flags contain the long flag as
+// "--flag=value".
+func hasFlagAssignment(flags []string, flag string) bool {
+	return slices.ContainsFunc(flags, func(f string) bool {
+		return strings.HasPrefix(f, flag+"=")
+	})
 }
 
 // ValidatorTypeMatcher matches against validator type.
@@ -409,7 +1102,9 @@ func NewValidatorTypeMatcher(validatorType ValidatorType) *ValidatorTypeMatcher
 }
 
 // Match returns true if the validator type matches.
-// Supports wildcards: "git.*" matches all git validators, "*" matches all.
+// Supports category wildcards ("git.*", "file.*", "shell.*", "github.*",
+// "notification.*", ...) that match every validator in the category, and "*"
+// that matches all validators.
 func (m *ValidatorTypeMatcher) Match(ctx *MatchContext) bool {
 	if m.validatorType == ValidatorAll {
 		return true
@@ -425,6 +1120,7 @@ func (m *ValidatorTypeMatcher) Match(ctx *MatchContext) bool {
 	}
 
 	// Check for category wildcard (e.g., "git.*" matches "git.push").
+	// The trailing "." keeps "git.*" from matching "github.issue".
 	pattern := string(m.validatorType)
 	target := string(ctx.ValidatorType)
 
@@ -465,6 +1161,40 @@ func (m *ProviderMatcher) Name() string {
 	return "provider:" + m.provider
 }
 
+// PlatformMatcher matches against the operating system klaudiush runs on.
+type PlatformMatcher struct {
+	systems []string
+	goos    string
+}
+
+// NewPlatformMatcher creates a matcher for the given GOOS values, compared
+// with runtime.GOOS.
+func NewPlatformMatcher(systems []string) *PlatformMatcher {
+	return NewPlatformMatcherFor(systems, runtime.GOOS)
+}
+
+// NewPlatformMatcherFor creates a matcher for the given GOOS values, compared
+// with goos instead of runtime.GOOS.
+func NewPlatformMatcherFor(systems []string, goos string) *PlatformMatcher {
+	return &PlatformMatcher{systems: systems, goos: goos}
+}
+
+// Match returns true if the OS is one of the systems, or if there are none.
+func (m *PlatformMatcher) Match(_ *MatchContext) bool {
+	if len(m.systems) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(m.systems, func(system string) bool {
+		return strings.EqualFold(strings.TrimSpace(system), m.goos)
+	})
+}
+
+// Name returns the matcher name.
+func (m *PlatformMatcher) Name() string {
+	return "os:" + strings.Join(m.systems, ",")
+}
+
 // ToolTypeMatcher matches against the hook tool type.
 type ToolTypeMatcher struct {
 	toolType string
@@ -513,6 +1243,97 @@ func (m *EventTypeMatcher) Name() string {
 	return "event_type:" + m.eventType
 }
 
+// validatorScopes classifies git and GitHub validators by operation scope.
+var validatorScopes = map[ValidatorType]Scope{
+	ValidatorGitPush:       ScopeRemote,
+	ValidatorGitFetch:      ScopeRemote,
+	ValidatorGitPR:         ScopeRemote,
+	ValidatorGitHubIssue:   ScopeRemote,
+	ValidatorGitHubRelease: ScopeRemote,
+	ValidatorGitHubPRMerge: ScopeRemote,
+	ValidatorGitCommit:     ScopeLocal,
+	ValidatorGitAdd:        ScopeLocal,
+	ValidatorGitBranch:     ScopeLocal,
+	ValidatorGitMerge:      ScopeLocal,
+	ValidatorGitNoVerify:   ScopeLocal,
+}
+
+// remoteGitSubcommands are git subcommands that talk to a remote. Any other
+// git subcommand is treated as local.
+var remoteGitSubcommands = map[string]bool{
+	"clone":     true,
+	"fetch":     true,
+	"ls-remote": true,
+	"pull":      true,
+	"push":      true,
+}
+
+// ResolveScope returns the scope of the operation in ctx. The validator type
+// is used when it is classified; otherwise the command is parsed and any git
+// command talking to a remote, or any gh command, makes it remote while other
+// git commands make it local. Returns "" when the scope is unknown.
+func ResolveScope(ctx *MatchContext, bashParser *parser.BashParser) Scope {
+	if scope, ok := validatorScopes[ctx.ValidatorType]; ok {
+		return scope
+	}
+
+	command := ctx.Command
+	if command == "" && ctx.HookContext != nil {
+		command = ctx.HookContext.GetCommand()
+	}
+
+	if strings.TrimSpace(command) == "" {
+		return ""
+	}
+
+	result, err := bashParser.Parse(command)
+	if err != nil {
+		return ""
+	}
+
+	var scope Scope
+
+	for _, cmd := range result.Commands {
+		if cmd.Name == "gh" {
+			return ScopeRemote
+		}
+
+		gitCmd, err := parser.ParseGitCommand(cmd)
+		if err != nil {
+			continue
+		}
+
+		if remoteGitSubcommands[gitCmd.Subcommand] {
+			return ScopeRemote
+		}
+
+		scope = ScopeLocal
+	}
+
+	return scope
+}
+
+// ScopeMatcher matches against the operation scope (local or remote).
+type ScopeMatcher struct {
+	scope  Scope
+	parser *parser.BashParser
+}
+
+// NewScopeMatcher creates a matcher for operation scopes.
+func NewScopeMatcher(scope Scope) *ScopeMatcher {
+	return &ScopeMatcher{scope: scope, parser: parser.NewBashParser()}
+}
+
+// Match returns true if the operation has the configured scope.
+func (m *ScopeMatcher) Match(ctx *MatchContext) bool {
+	return ResolveScope(ctx, m.parser) == m.scope
+}
+
+// Name returns the matcher name.
+func (m *ScopeMatcher) Name() string {
+	return "scope:" + string(m.scope)
+}
+
 // CompositeOp represents the operation for composite matchers.
 type CompositeOp int
 
@@ -527,6 +1348,158 @@ const (
 	CompositeOpNOT
 )
 
+// TrackingMatcher matches against the branch's upstream tracking status.
+type TrackingMatcher struct {
+	requireUpstream bool
+	minAhead        int
+	minBehind       int
+}
+
+// NewTrackingMatcher creates a matcher for upstream tracking status.
+// Positive minAhead or minBehind imply that an upstream is required.
+func NewTrackingMatcher(requireUpstream bool, minAhead, minBehind int) *TrackingMatcher {
+	return &TrackingMatcher{
+		requireUpstream: requireUpstream || minAhead > 0 || minBehind > 0,
+		minAhead:        minAhead,
+		minBehind:       minBehind,
+	}
+}
+
+// Match returns true if the tracking status satisfies the conditions.
+func (m *TrackingMatcher) Match(ctx *MatchContext) bool {
+	if ctx.GitContext == nil {
+		return false
+	}
+
+	if m.requireUpstream && !ctx.GitContext.HasUpstream {
+		return false
+	}
+
+	return ctx.GitContext.AheadBehind.Ahead >= m.minAhead &&
+		ctx.GitContext.AheadBehind.Behind >= m.minBehind
+}
+
+// Name returns the matcher name.
+func (m *TrackingMatcher) Name() string {
+	var parts []string
+
+	if m.requireUpstream {
+		parts = append(parts, "upstream")
+	}
+
+	if m.minAhead > 0 {
+		parts = append(parts, "ahead>="+strconv.Itoa(m.minAhead))
+	}
+
+	if m.minBehind > 0 {
+		parts = append(parts, "behind>="+strconv.Itoa(m.minBehind))
+	}
+
+	return "tracking:" + strings.Join(parts, ",")
+}
+
+// StaleBranchMatcher matches when the last commit on HEAD is at least a
+// number of days old.
+type StaleBranchMatcher struct {
+	minDays int
+}
+
+// NewStaleBranchMatcher creates a matcher for branches idle for at least
+// minDays days.
+func NewStaleBranchMatcher(minDays int) *StaleBranchMatcher {
+	return &StaleBranchMatcher{minDays: minDays}
+}
+
+// Match returns true if the last commit is at least minDays days old.
+// Without git context (or commits) DaysSinceLastCommit is zero, so a
+// positive minDays does not match.
+func (m *StaleBranchMatcher) Match(ctx *MatchContext) bool {
+	if ctx.GitContext == nil {
+		return false
+	}
+
+	return ctx.GitContext.DaysSinceLastCommit >= m.minDays
+}
+
+// Name returns the matcher name.
+func (m *StaleBranchMatcher) Name() string {
+	return "stale_branch:days>=" + strconv.Itoa(m.minDays)
+}
+
+// DirtyTreeMatcher matches on whether the working tree has uncommitted
+// changes.
+type DirtyTreeMatcher struct {
+	dirty bool
+}
+
+// NewDirtyTreeMatcher creates a matcher for a dirty (dirty is true) or clean
+// working tree.
+func NewDirtyTreeMatcher(dirty bool) *DirtyTreeMatcher {
+	return &DirtyTreeMatcher{dirty: dirty}
+}
+
+// Match returns true if the working tree state equals the expected one.
+// Outside a git repository it never matches.
+func (m *DirtyTreeMatcher) Match(ctx *MatchContext) bool {
+	if ctx.GitContext == nil || !ctx.GitContext.IsInRepo {
+		return false
+	}
+
+	return ctx.GitContext.IsDirty == m.dirty
+}
+
+// Name returns the matcher name.
+func (m *DirtyTreeMatcher) Name() string {
+	if m.dirty {
+		return "work_tree:dirty"
+	}
+
+	return "work_tree:clean"
+}
+
+// StagedPathMatcher matches when any staged path matches a pattern.
+type StagedPathMatcher struct {
+	pattern Pattern
+	negated bool
+}
+
+// NewStagedPathMatcher creates a matcher for staged path patterns. A "!"
+// prefix negates it: "!go.sum" matches when no staged path matches go.sum.
+func NewStagedPathMatcher(patternStr string, opts PatternOptions) (*StagedPathMatcher, error) {
+	pattern, err := CompilePatternWithOptions(StripNegation(patternStr), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StagedPathMatcher{
+		pattern: pattern,
+		negated: IsNegated(patternStr),
+	}, nil
+}
+
+// Match returns true if any staged path matches the pattern, or for a
+// negated pattern, if none does. Outside a git repository it never matches.
+func (m *StagedPathMatcher) Match(ctx *MatchContext) bool {
+	if ctx.GitContext == nil || !ctx.GitContext.IsInRepo {
+		return false
+	}
+
+	staged := slices.ContainsFunc(ctx.GitContext.StagedFiles, func(file string) bool {
+		return m.pattern.Match(normalizePath(file))
+	})
+
+	return staged != m.negated
+}
+
+// Name returns the matcher name.
+func (m *StagedPathMatcher) Name() string {
+	if m.negated {
+		return "staged_path:!" + m.pattern.String()
+	}
+
+	return "staged_path:" + m.pattern.String()
+}
+
 // CompositeMatcher combines multiple matchers with AND/OR/NOT logic.
 type CompositeMatcher struct {
 	matchers []Matcher
@@ -639,6 +1612,59 @@ func (b *matcherBuilder) addPatternMatcher(
 	b.matchers = append(b.matchers, m)
 }
 
+// addStagedPathMatcher adds a staged path matcher if pattern is non-empty.
+func (b *matcherBuilder) addStagedPathMatcher(pattern string) {
+	if b.err != nil || pattern == "" {
+		return
+	}
+
+	m, err := NewStagedPathMatcher(pattern, b.opts)
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	b.matchers = append(b.matchers, m)
+}
+
+// addPromptMatcher adds a prompt matcher if pattern is non-empty.
+func (b *matcherBuilder) addPromptMatcher(pattern string) {
+	if b.err != nil || pattern == "" {
+		return
+	}
+
+	m, err := NewPromptPatternMatcher(pattern, b.opts)
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	b.matchers = append(b.matchers, m)
+}
+
+// addCommitMessageMatcher adds a commit message matcher if any of the commit
+// message conditions is set.
+func (b *matcherBuilder) addCommitMessageMatcher(match *RuleMatch) {
+	if b.err != nil ||
+		(match.CommitMessagePattern == "" && match.MinSubjectLength <= 0 &&
+			match.MaxSubjectLength <= 0) {
+		return
+	}
+
+	m, err := NewCommitMessageMatcher(
+		match.CommitMessagePattern,
+		match.MinSubjectLength,
+		match.MaxSubjectLength,
+		b.opts,
+	)
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	b.matchers = append(b.matchers, m)
+}
+
 // advancedPatternFactory is a function that creates a matcher with pattern options.
 type advancedPatternFactory func(string, PatternOptions) (Matcher, error)
 
@@ -704,6 +1730,64 @@ func (b *matcherBuilder) result() (Matcher, error) {
 	}
 }
 
+// addContentInFiles adds a matcher for content patterns scoped to files:
+// AND within each pair, OR across pairs.
+func (b *matcherBuilder) addContentInFiles(pairs []ContentInFile, pathMode string) {
+	if b.err != nil || len(pairs) == 0 {
+		return
+	}
+
+	m, err := NewContentInFilesMatcher(pairs, pathMode, b.opts)
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	b.matchers = append(b.matchers, m)
+}
+
+// NewContentInFilesMatcher creates a matcher that applies each content
+// pattern only to files matching its paired file pattern. A pair matches
+// when both patterns match; the matcher matches when any pair does. An empty
+// pattern in a pair is not checked.
+func NewContentInFilesMatcher(
+	pairs []ContentInFile,
+	pathMode string,
+	opts PatternOptions,
+) (Matcher, error) {
+	pairMatchers := make([]Matcher, 0, len(pairs))
+
+	for _, pair := range pairs {
+		var conds []Matcher
+
+		if pair.FilePattern != "" {
+			fileMatcher, err := NewFilePatternMatcherWithOpts(pair.FilePattern, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			conds = append(conds, fileMatcher.WithPathMode(pathMode))
+		}
+
+		if pair.ContentPattern != "" {
+			contentMatcher, err := NewContentPatternMatcherWithOpts(pair.ContentPattern, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			conds = append(conds, contentMatcher)
+		}
+
+		pairMatchers = append(pairMatchers, NewAndMatcher(conds...))
+	}
+
+	if len(pairMatchers) == 1 {
+		return pairMatchers[0], nil
+	}
+
+	return NewOrMatcher(pairMatchers...), nil
+}
+
 // Pattern matcher factory wrappers.
 //
 
@@ -711,7 +1795,17 @@ func wrapRepoMatcher(p string) (Matcher, error) { return NewRepoPatternMatcher(p
 
 func wrapBranchMatcher(p string) (Matcher, error) { return NewBranchPatternMatcher(p) }
 
-func wrapFileMatcher(p string) (Matcher, error) { return NewFilePatternMatcher(p) }
+// wrapFileMatcher returns a file matcher factory for the path mode.
+func wrapFileMatcher(pathMode string) func(string) (Matcher, error) {
+	return func(p string) (Matcher, error) {
+		m, err := NewFilePatternMatcher(p)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.WithPathMode(pathMode), nil
+	}
+}
 
 func wrapContentMatcher(p string) (Matcher, error) { return NewContentPatternMatcher(p) }
 
@@ -748,18 +1842,33 @@ func wrapBranchMultiMatcher(
 	return NewBranchMultiPatternMatcher(patterns, mode, opts)
 }
 
-func wrapFileMatcherWithOpts(p string, opts PatternOptions) (Matcher, error) {
-	return NewFilePatternMatcherWithOpts(p, opts)
+// wrapFileMatcherWithOpts returns a file matcher factory for the path mode.
+func wrapFileMatcherWithOpts(pathMode string) advancedPatternFactory {
+	return func(p string, opts PatternOptions) (Matcher, error) {
+		m, err := NewFilePatternMatcherWithOpts(p, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.WithPathMode(pathMode), nil
+	}
 }
 
-//
+// wrapFileMultiMatcher returns a file multi-pattern matcher factory for the
+// path mode.
+func wrapFileMultiMatcher(pathMode string) multiPatternFactory {
+	return func(
+		patterns []string,
+		mode MultiPatternMode,
+		opts PatternOptions,
+	) (Matcher, error) {
+		m, err := NewFileMultiPatternMatcher(patterns, mode, opts)
+		if err != nil || m == nil {
+			return nil, err
+		}
 
-func wrapFileMultiMatcher(
-	patterns []string,
-	mode MultiPatternMode,
-	opts PatternOptions,
-) (Matcher, error) {
-	return NewFileMultiPatternMatcher(patterns, mode, opts)
+		return m.WithPathMode(pathMode), nil
+	}
 }
 
 func wrapContentMatcherWithOpts(p string, opts PatternOptions) (Matcher, error) {
@@ -815,7 +1924,8 @@ func BuildMatcher(match *RuleMatch) (Matcher, error) {
 		len(match.BranchPatterns) > 0 ||
 		len(match.FilePatterns) > 0 ||
 		len(match.ContentPatterns) > 0 ||
-		len(match.CommandPatterns) > 0
+		len(match.CommandPatterns) > 0 ||
+		len(match.CommandContains) > 0
 
 	// Use legacy builder for simple cases (backward compatibility).
 	if !useAdvanced {
@@ -853,12 +1963,62 @@ func buildMatcherLegacy(match *RuleMatch) (Matcher, error) {
 		b.addSimple(NewEventTypeMatcher(match.EventType))
 	}
 
+	if match.Scope != "" {
+		b.addSimple(NewScopeMatcher(match.Scope))
+	}
+
+	if len(match.OS) > 0 {
+		b.addSimple(NewPlatformMatcher(match.OS))
+	}
+
+	if m := newFileExtensionsMatcher(match.FileExtensions); m != nil {
+		b.addSimple(m)
+	}
+
+	if match.RequireUpstream || match.MinAhead > 0 || match.MinBehind > 0 {
+		b.addSimple(NewTrackingMatcher(match.RequireUpstream, match.MinAhead, match.MinBehind))
+	}
+
+	if match.MinDaysSinceCommit > 0 {
+		b.addSimple(NewStaleBranchMatcher(match.MinDaysSinceCommit))
+	}
+
+	if match.RequireDirty {
+		b.addSimple(NewDirtyTreeMatcher(true))
+	}
+
+	if match.RequireClean {
+		b.addSimple(NewDirtyTreeMatcher(false))
+	}
+
+	b.addStagedPathMatcher(match.StagedPathPattern)
+	b.addStagedPathMatcher(match.RequireStagedPath)
+	b.addPromptMatcher(match.PromptPattern)
+	b.addCommitMessageMatcher(match)
+
+	if match.IsBinary {
+		b.addSimple(NewBinaryContentMatcher())
+	}
+
+	if match.UsesSudo {
+		b.addSimple(NewSudoMatcher())
+	}
+
+	if match.MinCommandPaths > 0 {
+		b.addSimple(NewCommandArgCountMatcher(match.MinCommandPaths))
+	}
+
+	if match.MinConsecutiveBlocks > 0 {
+		b.addSimple(NewConsecutiveBlocksMatcher(match.MinConsecutiveBlocks))
+	}
+
 	// Add pattern matchers.
 	b.addPatternMatcher(match.RepoPattern, wrapRepoMatcher)
 	b.addPatternMatcher(match.BranchPattern, wrapBranchMatcher)
-	b.addPatternMatcher(match.FilePattern, wrapFileMatcher)
+	b.addPatternMatcher(match.FilePattern, wrapFileMatcher(match.PathMode))
 	b.addPatternMatcher(match.ContentPattern, wrapContentMatcher)
 	b.addPatternMatcher(match.CommandPattern, wrapCommandMatcher)
+	b.addContentInFiles(match.ContentInFiles, match.PathMode)
 
 	return b.result()
 }
@@ -895,17 +2055,71 @@ func buildMatcherAdvanced(match *RuleMatch) (Matcher, error) {
 		b.addSimple(NewEventTypeMatcher(match.EventType))
 	}
 
+	if match.Scope != "" {
+		b.addSimple(NewScopeMatcher(match.Scope))
+	}
+
+	if len(match.OS) > 0 {
+		b.addSimple(NewPlatformMatcher(match.OS))
+	}
+
+	if m := newFileExtensionsMatcher(match.FileExtensions); m != nil {
+		b.addSimple(m)
+	}
+
+	if match.RequireUpstream || match.MinAhead > 0 || match.MinBehind > 0 {
+		b.addSimple(NewTrackingMatcher(match.RequireUpstream, match.MinAhead, match.MinBehind))
+	}
+
+	if match.MinDaysSinceCommit > 0 {
+		b.addSimple(NewStaleBranchMatcher(match.MinDaysSinceCommit))
+	}
+
+	if match.RequireDirty {
+		b.addSimple(NewDirtyTreeMatcher(true))
+	}
+
+	if match.RequireClean {
+		b.addSimple(NewDirtyTreeMatcher(false))
+	}
+
+	b.addStagedPathMatcher(match.StagedPathPattern)
+	b.addStagedPathMatcher(match.RequireStagedPath)
+	b.addPromptMatcher(match.PromptPattern)
+	b.addCommitMessageMatcher(match)
+
+	if match.IsBinary {
+		b.addSimple(NewBinaryContentMatcher())
+	}
+
+	if match.UsesSudo {
+		b.addSimple(NewSudoMatcher())
+	}
+
+	if match.MinCommandPaths > 0 {
+		b.addSimple(NewCommandArgCountMatcher(match.MinCommandPaths))
+	}
+
+	if match.MinConsecutiveBlocks > 0 {
+		b.addSimple(NewConsecutiveBlocksMatcher(match.MinConsecutiveBlocks))
+	}
+
 	// Add pattern matchers with advanced options.
 	b.addAdvancedPatternMatcher(match.RepoPattern, match.RepoPatterns,
 		wrapRepoMatcherWithOpts, wrapRepoMultiMatcher)
 	b.addAdvancedPatternMatcher(match.BranchPattern, match.BranchPatterns,
 		wrapBranchMatcherWithOpts, wrapBranchMultiMatcher)
 	b.addAdvancedPatternMatcher(match.FilePattern, match.FilePatterns,
-		wrapFileMatcherWithOpts, wrapFileMultiMatcher)
+		wrapFileMatcherWithOpts(match.PathMode), wrapFileMultiMatcher(match.PathMode))
 	b.addAdvancedPatternMatcher(match.ContentPattern, match.ContentPatterns,
 		wrapContentMatcherWithOpts, wrapContentMultiMatcher)
 	b.addAdvancedPatternMatcher(match.CommandPattern, match.CommandPatterns,
 		wrapCommandMatcherWithOpts, wrapCommandMultiMatcher)
+	b.addContentInFiles(match.ContentInFiles, match.PathMode)
+
+	if m := NewCommandContainsMatcher(match.CommandContains, mode, opts); m != nil {
+		b.addSimple(m)
+	}
 
 	return b.result()
 }
@@ -940,14 +2154,23 @@ func (*NeverMatcher) Name() string {
 var (
 	_ Matcher = (*RepoPatternMatcher)(nil)
 	_ Matcher = (*RemoteMatcher)(nil)
+	_ Matcher = (*StagedPathMatcher)(nil)
+	_ Matcher = (*PromptPatternMatcher)(nil)
+	_ Matcher = (*CommitMessageMatcher)(nil)
 	_ Matcher = (*BranchPatternMatcher)(nil)
 	_ Matcher = (*FilePatternMatcher)(nil)
+	_ Matcher = (*FileExtensionMatcher)(nil)
 	_ Matcher = (*ContentPatternMatcher)(nil)
 	_ Matcher = (*CommandPatternMatcher)(nil)
+	_ Matcher = (*CommandContainsMatcher)(nil)
 	_ Matcher = (*ValidatorTypeMatcher)(nil)
 	_ Matcher = (*ProviderMatcher)(nil)
+	_ Matcher = (*PlatformMatcher)(nil)
 	_ Matcher = (*ToolTypeMatcher)(nil)
 	_ Matcher = (*EventTypeMatcher)(nil)
+	_ Matcher = (*ScopeMatcher)(nil)
+	_ Matcher = (*TrackingMatcher)(nil)
+	_ Matcher = (*ConsecutiveBlocksMatcher)(nil)
 	_ Matcher = (*CompositeMatcher)(nil)
 	_ Matcher = (*AlwaysMatcher)(nil)
 	_ Matcher = (*NeverMatcher)(nil)
@@ -1,11 +1,16 @@
 package rules_test
 
 import (
+	"os"
+	"path/filepath"
+	"runtime"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/smykla-skalski/klaudiush/internal/rules"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
 )
 
 var _ = Describe("Matcher", func() {
@@ -190,6 +195,27 @@ var _ = Describe("Matcher", func() {
 			ctx := &rules.MatchContext{}
 			Expect(matcher.Match(ctx)).To(BeFalse())
 		})
+
+		Context("negated", func() {
+			remoteCtx := func(remote string) *rules.MatchContext {
+				return &rules.MatchContext{GitContext: &rules.GitContext{Remote: remote}}
+			}
+
+			It("should match any other remote", func() {
+				matcher := rules.NewRemoteMatcher("!origin")
+
+				Expect(matcher.Match(remoteCtx("upstream"))).To(BeTrue())
+				Expect(matcher.Match(remoteCtx("origin"))).To(BeFalse())
+				Expect(matcher.Name()).To(Equal("remote:!origin"))
+			})
+
+			It("should not match when the remote is unknown", func() {
+				matcher := rules.NewRemoteMatcher("!origin")
+
+				Expect(matcher.Match(&rules.MatchContext{})).To(BeFalse())
+				Expect(matcher.Match(remoteCtx(""))).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("BranchPatternMatcher", func() {
@@ -337,6 +363,31 @@ var _ = Describe("Matcher", func() {
 			Expect(matcher.Match(ctx)).To(BeFalse())
 		})
 
+		DescribeTable("should match normalized paths",
+			func(pattern, path string) {
+				matcher, err := rules.NewFilePatternMatcher(pattern)
+				Expect(err).NotTo(HaveOccurred())
+
+				ctx := &rules.MatchContext{FileContext: &rules.FileContext{Path: path}}
+				Expect(matcher.Match(ctx)).To(BeTrue())
+			},
+			Entry("backslash separators", "src/test/*.go", `src\test\file.go`),
+			Entry("mixed separators", "src/test/*.go", `src/test\file.go`),
+			Entry("dot slash prefix", "*.go", "./main.go"),
+			Entry("redundant separators", "src/*.go", "src//./main.go"),
+		)
+
+		It("should match the relative form of a backslash path in a repo", func() {
+			matcher, err := rules.NewFilePatternMatcher("docs/*.md")
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := &rules.MatchContext{
+				FileContext: &rules.FileContext{Path: `.\docs\guide.md`},
+				GitContext:  &rules.GitContext{RepoRoot: "/repo"},
+			}
+			Expect(matcher.WithPathMode(rules.PathModeRelative).Match(ctx)).To(BeTrue())
+		})
+
 		Describe("NewFilePatternMatcherWithOpts", func() {
 			It("should create matcher with case-insensitive option", func() {
 				opts := rules.PatternOptions{CaseInsensitive: true}
@@ -398,6 +449,176 @@ var _ = Describe("Matcher", func() {
 		})
 	})
 
+	Describe("FilePatternMatcher path modes", func() {
+		var ctx *rules.MatchContext
+
+		BeforeEach(func() {
+			ctx = &rules.MatchContext{
+				FileContext: &rules.FileContext{Path: "/home/user/project/src/api/main.go"},
+				GitContext:  &rules.GitContext{RepoRoot: "/home/user/project"},
+			}
+		})
+
+		newMatcher := func(pattern, pathMode string) *rules.FilePatternMatcher {
+			matcher, err := rules.NewFilePatternMatcher(pattern)
+			Expect(err).NotTo(HaveOccurred())
+
+			return matcher.WithPathMode(pathMode)
+		}
+
+		It("should match an absolute path against a relative pattern", func() {
+			Expect(newMatcher("src/**", "").Match(ctx)).To(BeTrue())
+			Expect(newMatcher("src/**", rules.PathModeBoth).Match(ctx)).To(BeTrue())
+			Expect(newMatcher("src/**", rules.PathModeRelative).Match(ctx)).To(BeTrue())
+		})
+
+		It("should keep matching absolute patterns", func() {
+			Expect(newMatcher("**/src/**", "").Match(ctx)).To(BeTrue())
+			Expect(newMatcher("**/src/**", rules.PathModeAbsolute).Match(ctx)).To(BeTrue())
+		})
+
+		It("should not match a relative pattern in absolute mode", func() {
+			Expect(newMatcher("src/**", rules.PathModeAbsolute).Match(ctx)).To(BeFalse())
+		})
+
+		It("should not match an absolute pattern in relative mode", func() {
+			Expect(newMatcher("/home/user/**", rules.PathModeRelative).Match(ctx)).To(BeFalse())
+		})
+
+		It("should resolve a relative path against the repository root", func() {
+			ctx.FileContext.Path = "src/api/main.go"
+
+			Expect(newMatcher("/home/user/project/src/**", "").Match(ctx)).To(BeTrue())
+			Expect(newMatcher("src/**", rules.PathModeRelative).Match(ctx)).To(BeTrue())
+		})
+
+		It("should resolve a relative path against the working directory", func() {
+			ctx.FileContext.Path = "api/main.go"
+			ctx.HookContext = &hook.Context{WorkingDir: "/home/user/project/src"}
+
+			Expect(newMatcher("src/api/**", rules.PathModeRelative).Match(ctx)).To(BeTrue())
+			Expect(newMatcher("/home/user/project/src/api/**", rules.PathModeAbsolute).Match(ctx)).
+				To(BeTrue())
+			Expect(newMatcher("api/**", rules.PathModeRelative).Match(ctx)).To(BeFalse())
+		})
+
+		It("should resolve symlinks before taking the relative form", func() {
+			tmpDir := GinkgoT().TempDir()
+			repo := filepath.Join(tmpDir, "repo")
+			Expect(os.MkdirAll(filepath.Join(repo, "src"), 0o755)).To(Succeed())
+
+			link := filepath.Join(tmpDir, "link")
+			Expect(os.Symlink(repo, link)).To(Succeed())
+
+			ctx.GitContext.RepoRoot = repo
+			ctx.FileContext.Path = filepath.Join(link, "src", "new.go")
+			Expect(newMatcher("src/**", rules.PathModeRelative).Match(ctx)).To(BeTrue())
+
+			ctx.GitContext.RepoRoot = link
+			ctx.FileContext.Path = filepath.Join(repo, "src", "new.go")
+			Expect(newMatcher("src/**", rules.PathModeRelative).Match(ctx)).To(BeTrue())
+		})
+
+		It("should keep the relative form of a file under a symlink leaving the repository", func() {
+			tmpDir := GinkgoT().TempDir()
+			repo := filepath.Join(tmpDir, "repo")
+			shared := filepath.Join(tmpDir, "shared")
+			Expect(os.MkdirAll(repo, 0o755)).To(Succeed())
+			Expect(os.MkdirAll(shared, 0o755)).To(Succeed())
+			Expect(os.Symlink(shared, filepath.Join(repo, "vendor"))).To(Succeed())
+
+			ctx.GitContext.RepoRoot = repo
+			ctx.FileContext.Path = filepath.Join(repo, "vendor", "lib.go")
+			Expect(newMatcher("vendor/**", rules.PathModeRelative).Match(ctx)).To(BeTrue())
+
+			ctx.FileContext.Path = "vendor/lib.go"
+			Expect(newMatcher("vendor/**", rules.PathModeRelative).Match(ctx)).To(BeTrue())
+		})
+
+		It("should not give files outside the repository a relative form", func() {
+			ctx.FileContext.Path = "/tmp/src/main.go"
+
+			Expect(newMatcher("**/src/**", "").Match(ctx)).To(BeTrue())
+			Expect(newMatcher("**/src/**", rules.PathModeRelative).Match(ctx)).To(BeFalse())
+		})
+
+		It("should match the path as given without a repository root", func() {
+			ctx.GitContext = nil
+
+			Expect(newMatcher("src/**", "").Match(ctx)).To(BeFalse())
+			Expect(newMatcher("**/src/**", "").Match(ctx)).To(BeTrue())
+		})
+
+		It("should apply negation across both forms", func() {
+			Expect(newMatcher("!src/**", "").Match(ctx)).To(BeFalse())
+
+			ctx.FileContext.Path = "/home/user/project/docs/index.md"
+			Expect(newMatcher("!src/**", "").Match(ctx)).To(BeTrue())
+		})
+
+		It("should apply all mode across both forms", func() {
+			matcher, err := rules.NewFileMultiPatternMatcher(
+				[]string{"src/**", "**/api/**"},
+				rules.MultiPatternAll,
+				rules.PatternOptions{},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matcher.Match(ctx)).To(BeTrue())
+		})
+
+		It("should use the path mode from RuleMatch", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				FilePattern: "src/**",
+				PathMode:    rules.PathModeAbsolute,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matcher.Match(ctx)).To(BeFalse())
+
+			matcher, err = rules.BuildMatcher(&rules.RuleMatch{
+				FilePatterns: []string{"src/**", "docs/**"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matcher.Match(ctx)).To(BeTrue())
+		})
+	})
+
+	Describe("FileExtensionMatcher", func() {
+		It("should match extension case-insensitively", func() {
+			matcher := rules.NewFileExtensionMatcher("go")
+
+			ctx := &rules.MatchContext{
+				FileContext: &rules.FileContext{Path: "cmd/MAIN.GO"},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+			Expect(matcher.Name()).To(Equal("file_extension:go"))
+		})
+
+		It("should accept a leading dot", func() {
+			matcher := rules.NewFileExtensionMatcher(".go")
+
+			ctx := &rules.MatchContext{
+				FileContext: &rules.FileContext{Path: "main.go"},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+			Expect(matcher.Name()).To(Equal("file_extension:go"))
+		})
+
+		It("should not match a name ending in the extension without a dot", func() {
+			matcher := rules.NewFileExtensionMatcher("go")
+
+			ctx := &rules.MatchContext{
+				FileContext: &rules.FileContext{Path: "cargo"},
+			}
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+
+		It("should not match when no file path is available", func() {
+			matcher := rules.NewFileExtensionMatcher("go")
+
+			Expect(matcher.Match(&rules.MatchContext{})).To(BeFalse())
+		})
+	})
+
 	Describe("ContentPatternMatcher", func() {
 		It("should match content with regex", func() {
 			matcher, err := rules.NewContentPatternMatcher("(?i)password")
@@ -587,6 +808,397 @@ var _ = Describe("Matcher", func() {
 		})
 	})
 
+	Describe("BinaryContentMatcher", func() {
+		matcher := rules.NewBinaryContentMatcher()
+
+		It("should match content with null bytes", func() {
+			ctx := &rules.MatchContext{
+				FileContext: &rules.FileContext{
+					Content: "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR",
+				},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+			Expect(matcher.Name()).To(Equal("binary_content"))
+		})
+
+		It("should not match text content", func() {
+			ctx := &rules.MatchContext{
+				FileContext: &rules.FileContext{
+					Content: "package main\n\nfunc main() {}\n",
+				},
+			}
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+
+		It("should fall back to HookContext content", func() {
+			ctx := &rules.MatchContext{
+				HookContext: &hook.Context{
+					ToolInput: hook.ToolInput{
+						Content: "ELF\x00\x01\x02",
+					},
+				},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+		})
+
+		It("should return false when no content available", func() {
+			Expect(matcher.Match(&rules.MatchContext{})).To(BeFalse())
+		})
+
+		It("should be added by BuildMatcher when IsBinary is set", func() {
+			built, err := rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType: rules.ValidatorSecrets,
+				IsBinary:      true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := &rules.MatchContext{
+				ValidatorType: rules.ValidatorSecrets,
+				FileContext:   &rules.FileContext{Content: "a\x00b"},
+			}
+			Expect(built.Match(ctx)).To(BeTrue())
+
+			ctx.FileContext.Content = "plain text"
+			Expect(built.Match(ctx)).To(BeFalse())
+		})
+	})
+
+	Describe("CommandContainsMatcher", func() {
+		commandCtx := func(command string) *rules.MatchContext {
+			return &rules.MatchContext{Command: command}
+		}
+
+		It("should match a single literal substring", func() {
+			matcher := rules.NewCommandContainsMatcher(
+				[]string{"rm -rf"}, rules.MultiPatternAny, rules.PatternOptions{},
+			)
+
+			Expect(matcher.Match(commandCtx("rm -rf ./build"))).To(BeTrue())
+			Expect(matcher.Match(commandCtx("rm -r ./build"))).To(BeFalse())
+			Expect(matcher.Match(commandCtx(""))).To(BeFalse())
+			Expect(matcher.Name()).To(Equal("command_contains:any:rm -rf"))
+		})
+
+		It("should not treat substrings as patterns", func() {
+			matcher := rules.NewCommandContainsMatcher(
+				[]string{"*.log", "a.b"}, rules.MultiPatternAny, rules.PatternOptions{},
+			)
+
+			Expect(matcher.Match(commandCtx("rm *.log"))).To(BeTrue())
+			Expect(matcher.Match(commandCtx("rm debug.log"))).To(BeFalse())
+			Expect(matcher.Match(commandCtx("cat axb"))).To(BeFalse())
+		})
+
+		It("should match any substring by default", func() {
+			matcher := rules.NewCommandContainsMatcher(
+				[]string{"--force", "--no-verify"}, rules.MultiPatternAny, rules.PatternOptions{},
+			)
+
+			Expect(matcher.Match(commandCtx("git push --force"))).To(BeTrue())
+			Expect(matcher.Match(commandCtx("git commit --no-verify"))).To(BeTrue())
+			Expect(matcher.Match(commandCtx("git push"))).To(BeFalse())
+		})
+
+		It("should require every substring in all mode", func() {
+			matcher := rules.NewCommandContainsMatcher(
+				[]string{"git push", "--force"}, rules.MultiPatternAll, rules.PatternOptions{},
+			)
+
+			Expect(matcher.Match(commandCtx("git push --force origin main"))).To(BeTrue())
+			Expect(matcher.Match(commandCtx("git push origin main"))).To(BeFalse())
+			Expect(matcher.Match(commandCtx("git commit --force"))).To(BeFalse())
+			Expect(matcher.Name()).To(Equal("command_contains:all:git push,--force"))
+		})
+
+		It("should honor case-insensitive matching", func() {
+			opts := rules.PatternOptions{CaseInsensitive: true}
+			matcher := rules.NewCommandContainsMatcher(
+				[]string{"DROP TABLE"}, rules.MultiPatternAny, opts,
+			)
+
+			Expect(matcher.Match(commandCtx(`psql -c "drop table users"`))).To(BeTrue())
+
+			matcher = rules.NewCommandContainsMatcher(
+				[]string{"DROP TABLE"}, rules.MultiPatternAny, rules.PatternOptions{},
+			)
+			Expect(matcher.Match(commandCtx(`psql -c "drop table users"`))).To(BeFalse())
+		})
+
+		It("should fall back to HookContext command", func() {
+			matcher := rules.NewCommandContainsMatcher(
+				[]string{"rm -rf"}, rules.MultiPatternAny, rules.PatternOptions{},
+			)
+
+			Expect(matcher.Match(&rules.MatchContext{
+				HookContext: &hook.Context{ToolInput: hook.ToolInput{Command: "rm -rf /tmp/x"}},
+			})).To(BeTrue())
+		})
+
+		It("should return nil without substrings", func() {
+			Expect(rules.NewCommandContainsMatcher(
+				nil, rules.MultiPatternAny, rules.PatternOptions{},
+			)).To(BeNil())
+		})
+	})
+
+	Describe("SudoMatcher", func() {
+		matcher := rules.NewSudoMatcher()
+
+		DescribeTable("should detect sudo invocations",
+			func(command string, expected bool) {
+				ctx := &rules.MatchContext{Command: command}
+				Expect(matcher.Match(ctx)).To(Equal(expected))
+			},
+			Entry("plain sudo", "sudo rm -rf /tmp/x", true),
+			Entry("sudo with flags", "sudo -E apt install jq", true),
+			Entry("absolute path", "/usr/bin/sudo ls", true),
+			Entry("env assignment prefix", "FOO=1 sudo x", true),
+			Entry("env wrapper", "env FOO=1 BAR=2 sudo -E make install", true),
+			Entry("chained with &&", "make build && sudo make install", true),
+			Entry("after a semicolon", "cd /tmp; sudo ls", true),
+			Entry("in a pipeline", "echo y | sudo tee /etc/x", true),
+			Entry("in a subshell", "(cd /tmp && sudo ls)", true),
+			Entry("unparseable command with sudo", "sudo ls 'unterminated", true),
+			Entry("no sudo", "rm -rf /tmp/x", false),
+			Entry("sudo as an argument", "echo sudo", false),
+			Entry("sudo in a string", `git commit -m "use sudo less"`, false),
+			Entry("sudo as part of a word", "pseudo-cmd --flag", false),
+			Entry("empty command", "", false),
+		)
+
+		It("should fall back to HookContext command", func() {
+			ctx := &rules.MatchContext{
+				HookContext: &hook.Context{
+					ToolInput: hook.ToolInput{Command: "sudo ls"},
+				},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+			Expect(matcher.Name()).To(Equal("uses_sudo"))
+		})
+
+		It("should be added by BuildMatcher when UsesSudo is set", func() {
+			built, err := rules.BuildMatcher(&rules.RuleMatch{
+				CommandPattern: "*apt*",
+				UsesSudo:       true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(built.Match(&rules.MatchContext{Command: "sudo apt update"})).To(BeTrue())
+			Expect(built.Match(&rules.MatchContext{Command: "apt list"})).To(BeFalse())
+			Expect(built.Match(&rules.MatchContext{Command: "sudo ls"})).To(BeFalse())
+		})
+	})
+
+	Describe("CommandArgCountMatcher", func() {
+		matcher := rules.NewCommandArgCountMatcher(3)
+
+		DescribeTable("should count path arguments",
+			func(command string, expected bool) {
+				ctx := &rules.MatchContext{Command: command}
+				Expect(matcher.Match(ctx)).To(Equal(expected))
+			},
+			Entry("single path", "rm file1", false),
+			Entry("many paths", "rm file1 file2 file3 file4", true),
+			Entry("exactly the threshold", "rm a b c", true),
+			Entry("flags are not paths", "rm -r -f -v a b", false),
+			Entry("flags interleaved with paths", "rm -f a -v b --verbose c", true),
+			Entry("quoted path with spaces counts once", `rm "my file" 'other file'`, false),
+			Entry("quoted paths", `rm "a b" 'c d' e`, true),
+			Entry("globs are not expanded", "rm *.log", false),
+			Entry("everything after -- is a path", "rm -- -a -b -c", true),
+			Entry("git subcommand is not a path", "git rm a b", false),
+			Entry("git paths after --", "git checkout -- a b c", true),
+			Entry("git checkout of the whole tree", "git checkout -- .", false),
+			Entry("behind sudo", "sudo rm -rf a b c", true),
+			Entry("behind env", "env FOO=1 rm a b", false),
+			Entry("chained commands count separately", "rm a b && rm c d", false),
+			Entry("largest command in a chain", "cd /tmp && rm a b c", true),
+			Entry("unparseable command", "rm a b 'c d", true),
+			Entry("unparseable command with few paths", "rm 'a b c", false),
+			Entry("empty command", "", false),
+		)
+
+		It("should fall back to HookContext command", func() {
+			ctx := &rules.MatchContext{
+				HookContext: &hook.Context{
+					ToolInput: hook.ToolInput{Command: "rm a b c"},
+				},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+			Expect(matcher.Name()).To(Equal("min_command_paths:3"))
+		})
+
+		It("should be added by BuildMatcher when MinCommandPaths is set", func() {
+			built, err := rules.BuildMatcher(&rules.RuleMatch{
+				CommandPattern:  "rm *",
+				MinCommandPaths: 2,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(built.Match(&rules.MatchContext{Command: "rm a b"})).To(BeTrue())
+			Expect(built.Match(&rules.MatchContext{Command: "rm a"})).To(BeFalse())
+			Expect(built.Match(&rules.MatchContext{Command: "mv a b"})).To(BeFalse())
+		})
+	})
+
+	Describe("CommitMessageMatcher", func() {
+		DescribeTable("should match the inline commit message",
+			func(pattern string, minSubject, maxSubject int, command string, expected bool) {
+				matcher, err := rules.NewCommitMessageMatcher(
+					pattern, minSubject, maxSubject, rules.PatternOptions{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(matcher.Match(&rules.MatchContext{Command: command})).To(Equal(expected))
+			},
+			Entry("pattern on a single -m", "^feat", 0, 0,
+				`git commit -sS -m "feat: add x"`, true),
+			Entry("pattern mismatch", "^feat", 0, 0,
+				`git commit -m "fix: y"`, false),
+			Entry("negated pattern", "!^(feat|fix):", 0, 0,
+				`git commit -m "update stuff"`, true),
+			Entry("pattern in a later paragraph", "(?m)^Refs: #[0-9]+$", 0, 0,
+				`git commit -m "feat: x" -m "body" -m "Refs: #12"`, true),
+			Entry("--message=value", "^fix", 0, 0,
+				`git commit --message="fix: z"`, true),
+			Entry("long subject", "", 51, 0,
+				`git commit -m "feat: this subject line is definitely longer than fifty chars"`,
+				true),
+			Entry("short subject below the minimum", "", 51, 0,
+				`git commit -m "feat: short" -m "a body that is long enough to not count here"`,
+				false),
+			Entry("subject at most the maximum", "", 0, 10,
+				`git commit -m "wip"`, true),
+			Entry("subject above the maximum", "", 0, 10,
+				`git commit -m "feat: add x"`, false),
+			Entry("subject length counts characters", "", 0, 5,
+				`git commit -m "żółw!"`, true),
+			Entry("commit chained after other commands", "^feat", 0, 0,
+				`git add . && git commit -m "feat: x"`, true),
+			Entry("message from a file is unknown", "", 0, 100,
+				`git commit -F msg.txt -m "x"`, false),
+			Entry("message from --file= is unknown", "", 0, 100,
+				`git commit --file=msg.txt -m "x"`, false),
+			Entry("reused message is unknown", "", 0, 100,
+				`git commit -C HEAD -m "x"`, false),
+			Entry("commit without a message", "", 0, 100,
+				`git commit --amend`, false),
+			Entry("other git commands", "", 0, 100,
+				`git tag -m "x" v1`, false),
+			Entry("empty command", "", 0, 100, "", false),
+		)
+
+		It("should fall back to HookContext command", func() {
+			matcher, err := rules.NewCommitMessageMatcher("^feat", 0, 72, rules.PatternOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := &rules.MatchContext{
+				HookContext: &hook.Context{
+					ToolInput: hook.ToolInput{Command: `git commit -m "feat: x"`},
+				},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+			Expect(matcher.Name()).To(Equal("commit_message:^feat:max_subject:72"))
+		})
+
+		It("should be added by BuildMatcher when a commit message condition is set", func() {
+			built, err := rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType:        rules.ValidatorGitCommit,
+				CommitMessagePattern: "^wip",
+				CaseInsensitive:      true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := &rules.MatchContext{
+				ValidatorType: rules.ValidatorGitCommit,
+				Command:       `git commit -m "WIP: stuff"`,
+			}
+			Expect(built.Match(ctx)).To(BeTrue())
+
+			ctx.Command = `git commit -m "feat: stuff"`
+			Expect(built.Match(ctx)).To(BeFalse())
+		})
+
+		It("should reject an invalid pattern", func() {
+			_, err := rules.BuildMatcher(&rules.RuleMatch{CommitMessagePattern: "("})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ContentInFilesMatcher", func() {
+		fileCtx := func(path, content string) *rules.MatchContext {
+			return &rules.MatchContext{
+				FileContext: &rules.FileContext{Path: path, Content: content},
+			}
+		}
+
+		It("should apply the content pattern only to matching files", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				ContentInFiles: []rules.ContentInFile{
+					{FilePattern: "**/*.go", ContentPattern: `TODO(?:[^(]|$)`},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matcher.Match(fileCtx("pkg/main.go", "// TODO fix"))).To(BeTrue())
+			Expect(matcher.Match(fileCtx("pkg/main.go", "// TODO(PROJ-1) fix"))).To(BeFalse())
+			Expect(matcher.Match(fileCtx("docs/notes.md", "// TODO fix"))).To(BeFalse())
+			Expect(matcher.Match(fileCtx("pkg/main.go", "package main"))).To(BeFalse())
+		})
+
+		It("should match when any pair matches", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				ContentInFiles: []rules.ContentInFile{
+					{FilePattern: "**/*.go", ContentPattern: `fmt\.Println`},
+					{FilePattern: "**/*.py", ContentPattern: `print\(`},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matcher.Match(fileCtx("main.go", `fmt.Println("x")`))).To(BeTrue())
+			Expect(matcher.Match(fileCtx("main.py", `print("x")`))).To(BeTrue())
+			Expect(matcher.Match(fileCtx("main.go", `print("x")`))).To(BeFalse())
+			Expect(matcher.Match(fileCtx("main.py", `fmt.Println("x")`))).To(BeFalse())
+		})
+
+		It("should combine with other conditions using AND", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType: rules.ValidatorFileAll,
+				ContentInFiles: []rules.ContentInFile{
+					{FilePattern: "*.go", ContentPattern: "panic"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := fileCtx("main.go", "panic(err)")
+			ctx.ValidatorType = rules.ValidatorFileGofumpt
+			Expect(matcher.Match(ctx)).To(BeTrue())
+
+			ctx.ValidatorType = rules.ValidatorGitPush
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+
+		It("should honor case-insensitive matching", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				CaseInsensitive: true,
+				ContentInFiles: []rules.ContentInFile{
+					{FilePattern: "*.GO", ContentPattern: "todo"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matcher.Match(fileCtx("main.go", "TODO"))).To(BeTrue())
+		})
+
+		It("should return an error for an invalid pattern", func() {
+			_, err := rules.BuildMatcher(&rules.RuleMatch{
+				ContentInFiles: []rules.ContentInFile{
+					{FilePattern: "*.go", ContentPattern: "(unclosed"},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("CommandPatternMatcher", func() {
 		It("should match command with glob pattern", func() {
 			matcher, err := rules.NewCommandPatternMatcher("git push*")
@@ -679,6 +1291,49 @@ var _ = Describe("Matcher", func() {
 		})
 	})
 
+	Describe("PromptPatternMatcher", func() {
+		It("should match the prompt", func() {
+			matcher, err := rules.NewPromptPatternMatcher(`(?i)\brelease\b`, rules.PatternOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := &rules.MatchContext{
+				HookContext: &hook.Context{Prompt: "Cut the Release now"},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+			Expect(matcher.Name()).To(Equal(`prompt_pattern:(?i)\brelease\b`))
+
+			ctx.HookContext.Prompt = "fix the tests"
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+
+		It("should return false when no prompt available", func() {
+			matcher, err := rules.NewPromptPatternMatcher(".*", rules.PatternOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matcher.Match(&rules.MatchContext{})).To(BeFalse())
+			Expect(matcher.Match(&rules.MatchContext{
+				HookContext: &hook.Context{},
+			})).To(BeFalse())
+		})
+
+		It("should not match a negated pattern without a prompt", func() {
+			matcher, err := rules.NewPromptPatternMatcher("!release", rules.PatternOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matcher.Match(&rules.MatchContext{
+				HookContext: &hook.Context{},
+			})).To(BeFalse())
+			Expect(matcher.Match(&rules.MatchContext{
+				HookContext: &hook.Context{Prompt: "fix the tests"},
+			})).To(BeTrue())
+		})
+
+		It("should return error for invalid pattern", func() {
+			_, err := rules.NewPromptPatternMatcher("(unclosed", rules.PatternOptions{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("ValidatorTypeMatcher", func() {
 		It("should match exact validator type", func() {
 			matcher := rules.NewValidatorTypeMatcher(rules.ValidatorGitPush)
@@ -713,6 +1368,43 @@ var _ = Describe("Matcher", func() {
 			Expect(matcher.Match(ctx)).To(BeFalse())
 		})
 
+		It("should match file category wildcard", func() {
+			matcher := rules.NewValidatorTypeMatcher(rules.ValidatorFileAll)
+
+			ctx := &rules.MatchContext{
+				ValidatorType: rules.ValidatorFileMarkdown,
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+
+			ctx.ValidatorType = rules.ValidatorFileTerraform
+			Expect(matcher.Match(ctx)).To(BeTrue())
+
+			ctx.ValidatorType = rules.ValidatorGitPush
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+
+		DescribeTable("category wildcards",
+			func(pattern, target rules.ValidatorType, expected bool) {
+				matcher := rules.NewValidatorTypeMatcher(pattern)
+				ctx := &rules.MatchContext{ValidatorType: target}
+				Expect(matcher.Match(ctx)).To(Equal(expected))
+			},
+			Entry("shell.* matches backtick",
+				rules.ValidatorShellAll, rules.ValidatorShellBacktick, true),
+			Entry("shell.* does not match file.shell",
+				rules.ValidatorShellAll, rules.ValidatorFileShell, false),
+			Entry("github.* matches issue",
+				rules.ValidatorGitHubAll, rules.ValidatorGitHubIssue, true),
+			Entry("git.* does not match github.issue",
+				rules.ValidatorGitAll, rules.ValidatorGitHubIssue, false),
+			Entry("notification.* matches bell",
+				rules.ValidatorNotificationAll, rules.ValidatorNotification, true),
+			Entry("secrets.* matches secrets",
+				rules.ValidatorSecretsAll, rules.ValidatorSecrets, true),
+			Entry("mcp.* does not match file.markdown",
+				rules.ValidatorMCPAll, rules.ValidatorFileMarkdown, false),
+		)
+
 		It("should not match when ValidatorType is empty", func() {
 			matcher := rules.NewValidatorTypeMatcher(rules.ValidatorGitPush)
 
@@ -747,6 +1439,323 @@ var _ = Describe("Matcher", func() {
 		})
 	})
 
+	Describe("ScopeMatcher", func() {
+		DescribeTable("should classify validator types",
+			func(validatorType rules.ValidatorType, expected rules.Scope) {
+				ctx := &rules.MatchContext{ValidatorType: validatorType}
+				Expect(rules.ResolveScope(ctx, parser.NewBashParser())).To(Equal(expected))
+			},
+			Entry("push is remote", rules.ValidatorGitPush, rules.ScopeRemote),
+			Entry("fetch is remote", rules.ValidatorGitFetch, rules.ScopeRemote),
+			Entry("pr is remote", rules.ValidatorGitPR, rules.ScopeRemote),
+			Entry("issue is remote", rules.ValidatorGitHubIssue, rules.ScopeRemote),
+			Entry("release is remote", rules.ValidatorGitHubRelease, rules.ScopeRemote),
+			Entry("pr merge is remote", rules.ValidatorGitHubPRMerge, rules.ScopeRemote),
+			Entry("commit is local", rules.ValidatorGitCommit, rules.ScopeLocal),
+			Entry("add is local", rules.ValidatorGitAdd, rules.ScopeLocal),
+			Entry("branch is local", rules.ValidatorGitBranch, rules.ScopeLocal),
+			Entry("merge is local", rules.ValidatorGitMerge, rules.ScopeLocal),
+			Entry("file validators have no scope", rules.ValidatorFileMarkdown, rules.Scope("")),
+		)
+
+		DescribeTable("should fall back to the command",
+			func(command string, expected rules.Scope) {
+				ctx := &rules.MatchContext{Command: command}
+				Expect(rules.ResolveScope(ctx, parser.NewBashParser())).To(Equal(expected))
+			},
+			Entry("git push", "git push origin main", rules.ScopeRemote),
+			Entry("git pull with global option", "git -C /repo pull", rules.ScopeRemote),
+			Entry("gh command", "gh pr create --fill", rules.ScopeRemote),
+			Entry("remote after local", "git add . && git push", rules.ScopeRemote),
+			Entry("git commit", `git commit -m "msg"`, rules.ScopeLocal),
+			Entry("git status", "git status", rules.ScopeLocal),
+			Entry("non-git command", "ls -la", rules.Scope("")),
+			Entry("empty command", "", rules.Scope("")),
+		)
+
+		It("should prefer the validator type over the command", func() {
+			ctx := &rules.MatchContext{
+				ValidatorType: rules.ValidatorGitCommit,
+				Command:       "git commit -m x && git push",
+			}
+			Expect(rules.NewScopeMatcher(rules.ScopeLocal).Match(ctx)).To(BeTrue())
+			Expect(rules.NewScopeMatcher(rules.ScopeRemote).Match(ctx)).To(BeFalse())
+		})
+
+		It("should be added by BuildMatcher when Scope is set", func() {
+			built, err := rules.BuildMatcher(&rules.RuleMatch{Scope: rules.ScopeRemote})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(built.Match(&rules.MatchContext{ValidatorType: rules.ValidatorGitPush})).
+				To(BeTrue())
+			Expect(built.Match(&rules.MatchContext{ValidatorType: rules.ValidatorGitCommit})).
+				To(BeFalse())
+			Expect(rules.NewScopeMatcher(rules.ScopeRemote).Name()).To(Equal("scope:remote"))
+		})
+	})
+
+	Describe("ConsecutiveBlocksMatcher", func() {
+		matcher := rules.NewConsecutiveBlocksMatcher(3)
+
+		DescribeTable("should compare the streak of blocks",
+			func(blocks int, expected bool) {
+				ctx := &rules.MatchContext{
+					HookContext: &hook.Context{ConsecutiveBlocks: blocks},
+				}
+				Expect(matcher.Match(ctx)).To(Equal(expected))
+			},
+			Entry("no blocks", 0, false),
+			Entry("below the minimum", 2, false),
+			Entry("at the minimum", 3, true),
+			Entry("above the minimum", 5, true),
+		)
+
+		It("should not match without a hook context", func() {
+			Expect(matcher.Match(&rules.MatchContext{})).To(BeFalse())
+			Expect(matcher.Name()).To(Equal("min_consecutive_blocks:3"))
+		})
+	})
+
+	Describe("PlatformMatcher", func() {
+		It("should match one of the listed systems case-insensitively", func() {
+			matcher := rules.NewPlatformMatcherFor([]string{"darwin", "Linux"}, "linux")
+			Expect(matcher.Match(&rules.MatchContext{})).To(BeTrue())
+			Expect(matcher.Name()).To(Equal("os:darwin,Linux"))
+		})
+
+		It("should not match other systems", func() {
+			matcher := rules.NewPlatformMatcherFor([]string{"darwin", "linux"}, "windows")
+			Expect(matcher.Match(&rules.MatchContext{})).To(BeFalse())
+		})
+
+		It("should match any system when the list is empty", func() {
+			matcher := rules.NewPlatformMatcherFor(nil, "windows")
+			Expect(matcher.Match(&rules.MatchContext{})).To(BeTrue())
+		})
+
+		It("should be ANDed with other conditions by BuildMatcher", func() {
+			built, err := rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+				OS:            []string{runtime.GOOS},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(built.Match(&rules.MatchContext{ValidatorType: rules.ValidatorGitPush})).
+				To(BeTrue())
+			Expect(built.Match(&rules.MatchContext{ValidatorType: rules.ValidatorGitCommit})).
+				To(BeFalse())
+
+			built, err = rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+				OS:            []string{"plan9-" + runtime.GOOS},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(built.Match(&rules.MatchContext{ValidatorType: rules.ValidatorGitPush})).
+				To(BeFalse())
+		})
+	})
+
+	Describe("TrackingMatcher", func() {
+		gitCtx := func(hasUpstream bool, ahead, behind int) *rules.MatchContext {
+			return &rules.MatchContext{
+				GitContext: &rules.GitContext{
+					Branch:      "feat/x",
+					HasUpstream: hasUpstream,
+					AheadBehind: rules.AheadBehind{Ahead: ahead, Behind: behind},
+				},
+			}
+		}
+
+		DescribeTable("should match tracking status",
+			func(matcher *rules.TrackingMatcher, ctx *rules.MatchContext, expected bool) {
+				Expect(matcher.Match(ctx)).To(Equal(expected))
+			},
+			Entry("upstream required and present",
+				rules.NewTrackingMatcher(true, 0, 0), gitCtx(true, 0, 0), true),
+			Entry("upstream required and missing",
+				rules.NewTrackingMatcher(true, 0, 0), gitCtx(false, 0, 0), false),
+			Entry("behind its upstream",
+				rules.NewTrackingMatcher(false, 0, 1), gitCtx(true, 0, 2), true),
+			Entry("up to date with its upstream",
+				rules.NewTrackingMatcher(false, 0, 1), gitCtx(true, 0, 0), false),
+			Entry("ahead enough",
+				rules.NewTrackingMatcher(false, 2, 0), gitCtx(true, 2, 0), true),
+			Entry("not ahead enough",
+				rules.NewTrackingMatcher(false, 2, 0), gitCtx(true, 1, 5), false),
+			Entry("min counts imply upstream",
+				rules.NewTrackingMatcher(false, 1, 0), gitCtx(false, 3, 0), false),
+			Entry("detached HEAD",
+				rules.NewTrackingMatcher(true, 0, 0),
+				&rules.MatchContext{GitContext: &rules.GitContext{IsInRepo: true}}, false),
+			Entry("no git context",
+				rules.NewTrackingMatcher(true, 0, 0), &rules.MatchContext{}, false),
+		)
+
+		It("should describe its conditions in the name", func() {
+			Expect(rules.NewTrackingMatcher(false, 1, 2).Name()).
+				To(Equal("tracking:upstream,ahead>=1,behind>=2"))
+		})
+
+		It("should be built from RuleMatch", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+				MinBehind:     1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := gitCtx(true, 0, 3)
+			ctx.ValidatorType = rules.ValidatorGitPush
+			Expect(matcher.Match(ctx)).To(BeTrue())
+
+			ctx.GitContext.AheadBehind.Behind = 0
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+	})
+
+	Describe("StaleBranchMatcher", func() {
+		idleFor := func(days int) *rules.MatchContext {
+			return &rules.MatchContext{
+				GitContext: &rules.GitContext{IsInRepo: true, DaysSinceLastCommit: days},
+			}
+		}
+
+		DescribeTable("should match days since the last commit",
+			func(minDays int, ctx *rules.MatchContext, expected bool) {
+				Expect(rules.NewStaleBranchMatcher(minDays).Match(ctx)).To(Equal(expected))
+			},
+			Entry("idle longer than the minimum", 30, idleFor(45), true),
+			Entry("idle exactly the minimum", 30, idleFor(30), true),
+			Entry("recently committed", 30, idleFor(2), false),
+			Entry("no git context", 30, &rules.MatchContext{}, false),
+		)
+
+		It("should describe its threshold in the name", func() {
+			Expect(rules.NewStaleBranchMatcher(30).Name()).To(Equal("stale_branch:days>=30"))
+		})
+
+		It("should be built from RuleMatch", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType:      rules.ValidatorGitPush,
+				MinDaysSinceCommit: 30,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := idleFor(31)
+			ctx.ValidatorType = rules.ValidatorGitPush
+			Expect(matcher.Match(ctx)).To(BeTrue())
+
+			ctx.GitContext.DaysSinceLastCommit = 1
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+	})
+
+	Describe("StagedPathMatcher", func() {
+		staged := func(files ...string) *rules.MatchContext {
+			return &rules.MatchContext{
+				GitContext: &rules.GitContext{IsInRepo: true, StagedFiles: files},
+			}
+		}
+
+		DescribeTable("should match the staged paths",
+			func(pattern string, ctx *rules.MatchContext, expected bool) {
+				matcher, err := rules.NewStagedPathMatcher(pattern, rules.PatternOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(matcher.Match(ctx)).To(Equal(expected))
+			},
+			Entry("root file", "**/.env", staged("go.mod", ".env"), true),
+			Entry("nested file", "**/.env", staged("deploy/app/.env"), true),
+			Entry("no matching file", "**/.env", staged("main.go", ".env.example"), false),
+			Entry("nothing staged", "**/.env", staged(), false),
+			Entry("regex pattern", `regex:^vendor/`, staged("vendor/x/y.go"), true),
+			Entry("backslash separators", "vendor/**", staged(`vendor\x\y.go`), true),
+			Entry("negated without the file", "!go.sum", staged("go.mod"), true),
+			Entry("negated with the file", "!go.sum", staged("go.mod", "go.sum"), false),
+			Entry("negated with nothing staged", "!go.sum", staged(), true),
+			Entry("no git context", "**/.env", &rules.MatchContext{}, false),
+			Entry("negated outside a repository", "!go.sum",
+				&rules.MatchContext{GitContext: &rules.GitContext{}}, false),
+		)
+
+		It("should honor case-insensitive matching", func() {
+			matcher, err := rules.NewStagedPathMatcher(
+				"**/.ENV",
+				rules.PatternOptions{CaseInsensitive: true},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matcher.Match(staged("app/.env"))).To(BeTrue())
+		})
+
+		It("should reject invalid patterns", func() {
+			_, err := rules.NewStagedPathMatcher("[", rules.PatternOptions{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should name the pattern", func() {
+			matcher, err := rules.NewStagedPathMatcher("!go.sum", rules.PatternOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matcher.Name()).To(Equal("staged_path:!go.sum"))
+		})
+
+		DescribeTable("should be built from RuleMatch",
+			func(files []string, expected bool) {
+				matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+					ValidatorType:     rules.ValidatorGitCommit,
+					RequireStagedPath: "go.mod",
+					StagedPathPattern: "!go.sum",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				ctx := staged(files...)
+				ctx.ValidatorType = rules.ValidatorGitCommit
+				Expect(matcher.Match(ctx)).To(Equal(expected))
+			},
+			Entry("go.mod without go.sum", []string{"go.mod", "main.go"}, true),
+			Entry("go.mod with go.sum", []string{"go.mod", "go.sum"}, false),
+			Entry("go.sum only", []string{"go.sum"}, false),
+			Entry("neither", []string{"main.go"}, false),
+		)
+	})
+
+	Describe("DirtyTreeMatcher", func() {
+		tree := func(dirty bool) *rules.MatchContext {
+			return &rules.MatchContext{
+				GitContext: &rules.GitContext{IsInRepo: true, IsDirty: dirty},
+			}
+		}
+
+		DescribeTable("should match the working tree state",
+			func(dirty bool, ctx *rules.MatchContext, expected bool) {
+				Expect(rules.NewDirtyTreeMatcher(dirty).Match(ctx)).To(Equal(expected))
+			},
+			Entry("dirty tree when dirty is required", true, tree(true), true),
+			Entry("clean tree when dirty is required", true, tree(false), false),
+			Entry("clean tree when clean is required", false, tree(false), true),
+			Entry("dirty tree when clean is required", false, tree(true), false),
+			Entry("no git context", true, &rules.MatchContext{}, false),
+			Entry("outside a repository when clean is required", false,
+				&rules.MatchContext{GitContext: &rules.GitContext{}}, false),
+		)
+
+		It("should name the required state", func() {
+			Expect(rules.NewDirtyTreeMatcher(true).Name()).To(Equal("work_tree:dirty"))
+			Expect(rules.NewDirtyTreeMatcher(false).Name()).To(Equal("work_tree:clean"))
+		})
+
+		It("should be built from RuleMatch", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitBranch,
+				RequireDirty:  true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := tree(true)
+			ctx.ValidatorType = rules.ValidatorGitBranch
+			Expect(matcher.Match(ctx)).To(BeTrue())
+
+			ctx.GitContext.IsDirty = false
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+	})
+
 	Describe("CompositeMatcher", func() {
 		Describe("AND", func() {
 			It("should match when all conditions match", func() {
@@ -864,6 +1873,38 @@ var _ = Describe("Matcher", func() {
 			Expect(matcher.Match(ctx)).To(BeFalse())
 		})
 
+		It("should build a command_contains matcher honoring pattern_mode", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				CommandContains: []string{"git push", "--force"},
+				PatternMode:     rules.PatternModeAll,
+				CaseInsensitive: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matcher.Match(&rules.MatchContext{Command: "GIT PUSH --force"})).To(BeTrue())
+			Expect(matcher.Match(&rules.MatchContext{Command: "git push"})).To(BeFalse())
+		})
+
+		It("should negate a remote with a ! prefix", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType: rules.ValidatorGitPush,
+				Remote:        "!origin",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := &rules.MatchContext{
+				ValidatorType: rules.ValidatorGitPush,
+				GitContext:    &rules.GitContext{Remote: "upstream"},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+
+			ctx.GitContext.Remote = "origin"
+			Expect(matcher.Match(ctx)).To(BeFalse())
+
+			ctx.GitContext = nil
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+
 		It("should return nil for nil RuleMatch", func() {
 			matcher, err := rules.BuildMatcher(nil)
 			Expect(err).NotTo(HaveOccurred())
@@ -889,6 +1930,44 @@ var _ = Describe("Matcher", func() {
 			Expect(matcher.Name()).To(Equal("remote:origin"))
 		})
 
+		DescribeTable("should match any of the file extensions",
+			func(extensions []string, path string, expected bool) {
+				matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+					FileExtensions: extensions,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(matcher).NotTo(BeNil())
+
+				ctx := &rules.MatchContext{
+					FileContext: &rules.FileContext{Path: path},
+				}
+				Expect(matcher.Match(ctx)).To(Equal(expected))
+			},
+			Entry("go file", []string{"go", "ts"}, "main.go", true),
+			Entry("ts file", []string{"go", "ts"}, "src/app.ts", true),
+			Entry("css file", []string{"go", "ts"}, "style.css", false),
+			Entry("leading dot", []string{".go", ".ts"}, "src/app.ts", true),
+			Entry("upper-case extension", []string{"GO"}, "main.go", true),
+			Entry("multi-part extension", []string{"d.ts"}, "types/index.d.ts", true),
+		)
+
+		It("should combine file extensions with other conditions", func() {
+			matcher, err := rules.BuildMatcher(&rules.RuleMatch{
+				ValidatorType:  rules.ValidatorFileAll,
+				FileExtensions: []string{"go"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := &rules.MatchContext{
+				ValidatorType: rules.ValidatorFileMarkdown,
+				FileContext:   &rules.FileContext{Path: "main.go"},
+			}
+			Expect(matcher.Match(ctx)).To(BeTrue())
+
+			ctx.ValidatorType = rules.ValidatorGitPush
+			Expect(matcher.Match(ctx)).To(BeFalse())
+		})
+
 		It("should return error for invalid pattern", func() {
 			match := &rules.RuleMatch{
 				RepoPattern: "[invalid",
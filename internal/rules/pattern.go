@@ -53,6 +53,27 @@ func DetectPatternType(pattern string) PatternType {
 	return PatternTypeGlob
 }
 
+// Pattern type prefixes force a pattern to be compiled as a specific type,
+// bypassing auto-detection (e.g., "glob:feature/*" or "regex:^release-\d+$").
+const (
+	RegexPrefix = "regex:"
+	GlobPrefix  = "glob:"
+)
+
+// ResolvePatternType returns the type of a pattern and the pattern without its
+// type prefix. Patterns without a regex: or glob: prefix are auto-detected.
+func ResolvePatternType(pattern string) (PatternType, string) {
+	if rest, ok := strings.CutPrefix(pattern, RegexPrefix); ok {
+		return PatternTypeRegex, rest
+	}
+
+	if rest, ok := strings.CutPrefix(pattern, GlobPrefix); ok {
+		return PatternTypeGlob, rest
+	}
+
+	return DetectPatternType(pattern), pattern
+}
+
 // GlobPattern wraps a validated glob pattern.
 type GlobPattern struct {
 	pattern string
@@ -85,7 +106,13 @@ type RegexPattern struct {
 
 // NewRegexPattern creates a new RegexPattern from the given pattern string.
 func NewRegexPattern(pattern string) (*RegexPattern, error) {
-	compiled, err := regexp.Compile(pattern)
+	return newRegexPatternFrom(pattern, pattern)
+}
+
+// newRegexPatternFrom compiles expr, a transformed form of pattern such as
+// pattern with a (?i) flag, and keeps pattern for String().
+func newRegexPatternFrom(pattern, expr string) (*RegexPattern, error) {
+	compiled, err := regexp.Compile(expr)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +123,16 @@ func NewRegexPattern(pattern string) (*RegexPattern, error) {
 	}, nil
 }
 
+// newCaseInsensitiveRegexPattern compiles a regex pattern that matches
+// case-insensitively. String() still returns pattern without the (?i) flag.
+func newCaseInsensitiveRegexPattern(pattern string) (*RegexPattern, error) {
+	if strings.HasPrefix(pattern, "(?i)") {
+		return NewRegexPattern(pattern)
+	}
+
+	return newRegexPatternFrom(pattern, "(?i)"+pattern)
+}
+
 // Match returns true if the string matches the regex pattern.
 func (p *RegexPattern) Match(s string) bool {
 	return p.compiled.MatchString(s)
@@ -106,39 +143,12 @@ func (p *RegexPattern) String() string {
 	return p.pattern
 }
 
-// CompilePattern compiles a pattern string, auto-detecting the pattern type.
+// CompilePattern compiles a pattern string, auto-detecting the pattern type
+// unless it has a regex: or glob: prefix.
 // Supports negation via ! prefix (e.g., "!*.tmp" matches anything except *.tmp).
 // Returns the compiled Pattern or an error if compilation fails.
 func CompilePattern(pattern string) (Pattern, error) {
-	// Handle negated patterns.
-	negated := IsNegated(pattern)
-	if negated {
-		pattern = StripNegation(pattern)
-	}
-
-	patternType := DetectPatternType(pattern)
-
-	var compiled Pattern
-
-	var err error
-
-	switch patternType {
-	case PatternTypeRegex:
-		compiled, err = NewRegexPattern(pattern)
-	default:
-		compiled, err = NewGlobPattern(pattern)
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	// Wrap in NegatedPattern if needed.
-	if negated {
-		return NewNegatedPattern(compiled), nil
-	}
-
-	return compiled, nil
+	return CompilePatternWithOptions(pattern, PatternOptions{})
 }
 
 // PatternCache provides thread-safe caching of compiled patterns.
@@ -226,12 +236,20 @@ type PatternOptions struct {
 
 // NegatedPattern wraps a pattern and inverts its match result.
 type NegatedPattern struct {
-	inner Pattern
+	inner   Pattern
+	pattern string
 }
 
 // NewNegatedPattern creates a pattern that matches when the inner pattern does not.
 func NewNegatedPattern(inner Pattern) *NegatedPattern {
-	return &NegatedPattern{inner: inner}
+	return &NegatedPattern{inner: inner, pattern: "!" + inner.String()}
+}
+
+// newNegatedPatternFrom negates inner and keeps pattern, the string it was
+// compiled from, for String(). pattern has no ! prefix when the negation came
+// from PatternOptions.
+func newNegatedPatternFrom(inner Pattern, pattern string) *NegatedPattern {
+	return &NegatedPattern{inner: inner, pattern: pattern}
 }
 
 // Match returns true if the inner pattern does NOT match.
@@ -239,9 +257,31 @@ func (p *NegatedPattern) Match(s string) bool {
 	return !p.inner.Match(s)
 }
 
-// String returns the original pattern string with ! prefix.
+// String returns the original pattern string.
 func (p *NegatedPattern) String() string {
-	return "!" + p.inner.String()
+	return p.pattern
+}
+
+// PrefixedPattern wraps a pattern compiled from a string with an explicit
+// regex: or glob: type prefix.
+type PrefixedPattern struct {
+	inner  Pattern
+	prefix string
+}
+
+// NewPrefixedPattern creates a pattern that reports the type prefix in String().
+func NewPrefixedPattern(inner Pattern, prefix string) *PrefixedPattern {
+	return &PrefixedPattern{inner: inner, prefix: prefix}
+}
+
+// Match returns true if the inner pattern matches.
+func (p *PrefixedPattern) Match(s string) bool {
+	return p.inner.Match(s)
+}
+
+// String returns the inner pattern string with its type prefix.
+func (p *PrefixedPattern) String() string {
+	return p.prefix + p.inner.String()
 }
 
 // IsNegated returns true if the pattern string starts with !.
@@ -393,15 +433,22 @@ func CompileMultiPattern(
 }
 
 // CompilePatternWithOptions compiles a pattern with additional options.
-// Supports negation via ! prefix and case-insensitive matching via options.
+// Supports negation via ! prefix, an explicit regex: or glob: type prefix
+// after it (e.g., "!glob:feature/*"), and case-insensitive matching via options.
+// String() of the result returns pattern as given; options are not reflected
+// in it.
 func CompilePatternWithOptions(pattern string, opts PatternOptions) (Pattern, error) {
+	source := pattern
+
 	// Handle negated patterns (both from prefix and options).
 	negated := opts.Negate || IsNegated(pattern)
 	if IsNegated(pattern) {
 		pattern = StripNegation(pattern)
 	}
 
-	patternType := DetectPatternType(pattern)
+	patternType, body := ResolvePatternType(pattern)
+	typePrefix := strings.TrimSuffix(pattern, body)
+	pattern = body
 
 	var compiled Pattern
 
@@ -410,12 +457,12 @@ func CompilePatternWithOptions(pattern string, opts PatternOptions) (Pattern, er
 	switch patternType {
 	case PatternTypeRegex:
 		// For regex, add (?i) flag if case-insensitive.
-		if opts.CaseInsensitive && !strings.HasPrefix(pattern, "(?i)") {
-			pattern = "(?i)" + pattern
+		if opts.CaseInsensitive {
+			compiled, err = newCaseInsensitiveRegexPattern(pattern)
+		} else {
+			compiled, err = NewRegexPattern(pattern)
 		}
 
-		compiled, err = NewRegexPattern(pattern)
-
 	default:
 		// For glob, use case-insensitive wrapper.
 		if opts.CaseInsensitive {
@@ -429,9 +476,14 @@ func CompilePatternWithOptions(pattern string, opts PatternOptions) (Pattern, er
 		return nil, err
 	}
 
+	// Keep the type prefix in String() so the pattern reads as written.
+	if typePrefix != "" {
+		compiled = NewPrefixedPattern(compiled, typePrefix)
+	}
+
 	// Wrap in NegatedPattern if needed.
 	if negated {
-		return NewNegatedPattern(compiled), nil
+		return newNegatedPatternFrom(compiled, source), nil
 	}
 
 	return compiled, nil
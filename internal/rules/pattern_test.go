@@ -301,6 +301,150 @@ var _ = Describe("Pattern", func() {
 		})
 	})
 
+	Describe("ResolvePatternType", func() {
+		DescribeTable("should honor type prefixes",
+			func(pattern string, expectedType rules.PatternType, expectedBody string) {
+				patternType, body := rules.ResolvePatternType(pattern)
+				Expect(patternType).To(Equal(expectedType))
+				Expect(body).To(Equal(expectedBody))
+			},
+			Entry("regex prefix", `regex:^release-\d+$`, rules.PatternTypeRegex, `^release-\d+$`),
+			Entry("regex prefix on glob-like pattern", "regex:feature/*",
+				rules.PatternTypeRegex, "feature/*"),
+			Entry("glob prefix", "glob:feature/*", rules.PatternTypeGlob, "feature/*"),
+			Entry("glob prefix on regex-like pattern", "glob:[ab]*.go",
+				rules.PatternTypeGlob, "[ab]*.go"),
+			Entry("no prefix glob", "feature/*", rules.PatternTypeGlob, "feature/*"),
+			Entry("no prefix regex", "^main$", rules.PatternTypeRegex, "^main$"),
+		)
+	})
+
+	Describe("type prefixes", func() {
+		It("should compile regex: patterns as regex", func() {
+			pattern, err := rules.CompilePattern("regex:feature/*")
+			Expect(err).NotTo(HaveOccurred())
+
+			// Regex "feature/*" matches "feature" followed by zero or more slashes.
+			Expect(pattern.Match("feature")).To(BeTrue())
+			Expect(pattern.Match("xfeature//")).To(BeTrue())
+			Expect(pattern.String()).To(Equal("regex:feature/*"))
+		})
+
+		It("should compile glob: patterns as glob", func() {
+			pattern, err := rules.CompilePattern("glob:feature/*")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pattern.Match("feature/login")).To(BeTrue())
+			Expect(pattern.Match("feature")).To(BeFalse())
+			Expect(pattern.String()).To(Equal("glob:feature/*"))
+		})
+
+		It("should force glob for patterns that would be detected as regex", func() {
+			pattern, err := rules.CompilePattern("glob:{feat,fix}/[a-z]*")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pattern.Match("feat/login")).To(BeTrue())
+			Expect(pattern.Match("docs/login")).To(BeFalse())
+		})
+
+		It("should match anchored regex: patterns", func() {
+			pattern, err := rules.CompilePattern(`regex:^release-\d+$`)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pattern.Match("release-42")).To(BeTrue())
+			Expect(pattern.Match("release-x")).To(BeFalse())
+		})
+
+		It("should support negation before the type prefix", func() {
+			pattern, err := rules.CompilePattern("!glob:feature/*")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pattern.Match("feature/login")).To(BeFalse())
+			Expect(pattern.Match("main")).To(BeTrue())
+			Expect(pattern.String()).To(Equal("!glob:feature/*"))
+		})
+
+		It("should apply case-insensitivity with a type prefix", func() {
+			opts := rules.PatternOptions{CaseInsensitive: true}
+
+			glob, err := rules.CompilePatternWithOptions("glob:Feature/*", opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(glob.Match("FEATURE/login")).To(BeTrue())
+			Expect(glob.String()).To(Equal("glob:Feature/*"))
+
+			regex, err := rules.CompilePatternWithOptions("regex:^main$", opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(regex.Match("MAIN")).To(BeTrue())
+			Expect(regex.String()).To(Equal("regex:^main$"))
+		})
+
+		It("should report invalid patterns after the prefix", func() {
+			_, err := rules.CompilePattern("regex:[invalid")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should honor prefixes in the pattern cache", func() {
+			rules.ClearPatternCache()
+
+			pattern, err := rules.GetCachedPattern("glob:feature/*")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pattern.Match("feature/login")).To(BeTrue())
+			Expect(pattern.String()).To(Equal("glob:feature/*"))
+
+			cached, err := rules.GetCachedPattern("glob:feature/*")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cached).To(BeIdenticalTo(pattern))
+		})
+	})
+
+	Describe("String round trip", func() {
+		caseInsensitive := rules.PatternOptions{CaseInsensitive: true}
+		negate := rules.PatternOptions{Negate: true}
+
+		DescribeTable("should return the pattern as written",
+			func(input string, opts rules.PatternOptions) {
+				pattern, err := rules.CompilePatternWithOptions(input, opts)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pattern.String()).To(Equal(input))
+			},
+			Entry("glob", "src/**/*.go", rules.PatternOptions{}),
+			Entry("glob with braces", "*.{yml,yaml}", rules.PatternOptions{}),
+			Entry("case-insensitive glob", "**/MyOrg/**", caseInsensitive),
+			Entry("regex", `^release-\d+$`, rules.PatternOptions{}),
+			Entry("case-insensitive regex", `^release-\d+$`, caseInsensitive),
+			Entry("regex with its own flag", "(?i)^main$", caseInsensitive),
+			Entry("negated glob", "!*.tmp", rules.PatternOptions{}),
+			Entry("negated case-insensitive regex", "!^WIP", caseInsensitive),
+			Entry("negated via options", "*.tmp", negate),
+			Entry("prefixed regex", "regex:^main$", caseInsensitive),
+			Entry("negated prefixed glob", "!glob:feature/*", rules.PatternOptions{}),
+		)
+
+		It("should keep the original sub-patterns in multi-patterns", func() {
+			pattern, err := rules.CompileMultiPattern(
+				[]string{"^Feat", "!*.tmp", "regex:^main$"},
+				rules.MultiPatternAll,
+				caseInsensitive,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pattern.String()).To(Equal("all(^Feat, !*.tmp, regex:^main$)"))
+		})
+
+		It("should keep the original pattern in content matcher names", func() {
+			matcher, err := rules.NewContentMultiPatternMatcher(
+				[]string{"password", "!^#"},
+				rules.MultiPatternAny,
+				caseInsensitive,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matcher.Name()).To(Equal("content_pattern:any(password, !^#)"))
+
+			single, err := rules.NewContentPatternMatcherWithOpts("!secret", caseInsensitive)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(single.Name()).To(Equal("content_pattern:!secret"))
+		})
+	})
+
 	Describe("MultiPattern", func() {
 		It("should match any pattern (OR logic)", func() {
 			patterns := []string{"*.go", "*.ts"}
@@ -0,0 +1,225 @@
+package rules
+
+import (
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+	"github.com/smykla-skalski/klaudiush/internal/xdg"
+)
+
+const (
+	rateLimitFileMode = 0o600
+	rateLimitLockExt  = ".lock"
+)
+
+// RateLimiter decides whether rate-limited rules may surface.
+type RateLimiter interface {
+	// Suppressed reports whether rule fired in repo within its rate limit
+	// window.
+	Suppressed(rule *Rule, repo string) bool
+
+	// Record records that rule fired in repo.
+	Record(rule *Rule, repo string)
+}
+
+// RateLimitStore is a RateLimiter that keeps, per rule name and repository,
+// the time until which a rule stays quiet. It is stored as one JSON file so
+// that separate hook processes share it, and loaded on first use.
+type RateLimitStore struct {
+	mu        sync.Mutex
+	stateFile string
+	now       func() time.Time
+
+	loaded bool
+	until  map[string]time.Time
+}
+
+// RateLimitStoreOption configures a RateLimitStore.
+type RateLimitStoreOption func(*RateLimitStore)
+
+// WithRateLimitStateFile overrides the persisted state path.
+func WithRateLimitStateFile(path string) RateLimitStoreOption {
+	return func(s *RateLimitStore) {
+		s.stateFile = path
+	}
+}
+
+// WithRateLimitTimeFunc overrides the clock used by the store.
+func WithRateLimitTimeFunc(fn func() time.Time) RateLimitStoreOption {
+	return func(s *RateLimitStore) {
+		if fn != nil {
+			s.now = fn
+		}
+	}
+}
+
+// NewRateLimitStore creates a rate limit store persisted at
+// xdg.RuleRateLimitStateFile by default.
+func NewRateLimitStore(opts ...RateLimitStoreOption) *RateLimitStore {
+	s := &RateLimitStore{
+		stateFile: xdg.RuleRateLimitStateFile(),
+		now:       time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Suppressed reports whether rule fired in repo less than its window ago.
+// Rules without a rate limit are never suppressed.
+func (s *RateLimitStore) Suppressed(rule *Rule, repo string) bool {
+	if rule.RateLimit == nil || rule.RateLimit.Window <= 0 {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.loadLocked()
+
+	return s.now().Before(s.until[rateLimitKey(rule.Name, repo)])
+}
+
+// Record starts the window of rule in repo and stores it, dropping expired
+// entries. The state file is locked and re-read first, so windows other
+// processes recorded since it was loaded are kept. Failures to store it are
+// ignored; rate limiting is best-effort.
+func (s *RateLimitStore) Record(rule *Rule, repo string) {
+	if rule.RateLimit == nil || rule.RateLimit.Window <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := xdg.EnsureDir(filepath.Dir(s.stateFile)); err != nil {
+		return
+	}
+
+	lockPath := strings.TrimSuffix(s.stateFile, filepath.Ext(s.stateFile)) + rateLimitLockExt
+
+	fileLock, err := fileutil.LockFile(lockPath)
+	if err != nil {
+		return
+	}
+
+	defer func() { _ = fileLock.Unlock() }()
+
+	s.loadLocked()
+	s.mergeLocked(readRateLimitState(s.stateFile))
+
+	now := s.now()
+
+	maps.DeleteFunc(s.until, func(_ string, until time.Time) bool {
+		return !now.Before(until)
+	})
+
+	s.until[rateLimitKey(rule.Name, repo)] = now.Add(rule.RateLimit.Window)
+
+	s.saveLocked()
+}
+
+// Fingerprint returns an encoding of the windows in the state file that are
+// still open, so that a cached decision can tell when a rule became
+// suppressed or was released. It reads the file every time and returns ""
+// when no window is open.
+func (s *RateLimitStore) Fingerprint() string {
+	now := s.now()
+
+	open := readRateLimitState(s.stateFile)
+	maps.DeleteFunc(open, func(_ string, until time.Time) bool {
+		return !now.Before(until)
+	})
+
+	if len(open) == 0 {
+		return ""
+	}
+
+	// Map keys are sorted when encoded, so equal windows encode the same
+	data, err := json.Marshal(open)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// loadLocked reads the stored windows once. A missing or unreadable file
+// has none. Must be called with mu held.
+func (s *RateLimitStore) loadLocked() {
+	if s.loaded {
+		return
+	}
+
+	s.loaded = true
+	s.until = readRateLimitState(s.stateFile)
+}
+
+// mergeLocked adds the windows in until, keeping the later end of windows
+// known to both. Must be called with mu held.
+func (s *RateLimitStore) mergeLocked(until map[string]time.Time) {
+	for key, end := range until {
+		if end.After(s.until[key]) {
+			s.until[key] = end
+		}
+	}
+}
+
+// saveLocked writes the windows to the state file. Must be called with mu
+// and the state file lock held.
+func (s *RateLimitStore) saveLocked() {
+	data, err := json.MarshalIndent(s.until, "", "  ")
+	if err != nil {
+		return
+	}
+
+	_ = fileutil.WriteFileAtomic(s.stateFile, data, rateLimitFileMode)
+}
+
+// readRateLimitState reads the windows stored at path. A missing or
+// unreadable file has none.
+func readRateLimitState(path string) map[string]time.Time {
+	until := make(map[string]time.Time)
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is from xdg or tests
+	if err != nil {
+		return until
+	}
+
+	var stored map[string]time.Time
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return until
+	}
+
+	maps.Copy(until, stored)
+
+	return until
+}
+
+// rateLimitKey identifies the window of a rule in a repository.
+func rateLimitKey(ruleName, repo string) string {
+	return ruleName + "\x00" + repo
+}
+
+// ruleRepo returns the repository a rule fires in: the repository root when
+// known, otherwise the hook's working directory.
+func ruleRepo(ctx *MatchContext) string {
+	if ctx.GitContext != nil && ctx.GitContext.RepoRoot != "" {
+		return ctx.GitContext.RepoRoot
+	}
+
+	if ctx.HookContext != nil {
+		return ctx.HookContext.GetWorkingDir()
+	}
+
+	return ""
+}
@@ -0,0 +1,115 @@
+package rules_test
+
+import (
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/rules"
+)
+
+var _ = Describe("RateLimitStore", func() {
+	var (
+		stateFile string
+		now       time.Time
+		store     *rules.RateLimitStore
+		rule      *rules.Rule
+	)
+
+	clock := func() time.Time { return now }
+
+	BeforeEach(func() {
+		stateFile = filepath.Join(GinkgoT().TempDir(), "rate_limits.json")
+		now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		store = rules.NewRateLimitStore(
+			rules.WithRateLimitStateFile(stateFile),
+			rules.WithRateLimitTimeFunc(clock),
+		)
+		rule = &rules.Rule{
+			Name:      "noisy",
+			RateLimit: &rules.RateLimit{Window: 10 * time.Minute},
+		}
+	})
+
+	It("should not suppress a rule that never fired", func() {
+		Expect(store.Suppressed(rule, "/repo")).To(BeFalse())
+	})
+
+	It("should suppress a rule within its window after it fired", func() {
+		store.Record(rule, "/repo")
+
+		now = now.Add(9 * time.Minute)
+		Expect(store.Suppressed(rule, "/repo")).To(BeTrue())
+
+		now = now.Add(time.Minute)
+		Expect(store.Suppressed(rule, "/repo")).To(BeFalse())
+	})
+
+	It("should key windows by rule name and repository", func() {
+		store.Record(rule, "/repo")
+
+		Expect(store.Suppressed(rule, "/other")).To(BeFalse())
+		Expect(store.Suppressed(&rules.Rule{
+			Name:      "other",
+			RateLimit: rule.RateLimit,
+		}, "/repo")).To(BeFalse())
+	})
+
+	It("should fingerprint the open windows", func() {
+		Expect(store.Fingerprint()).To(BeEmpty())
+
+		store.Record(rule, "/repo")
+
+		fired := store.Fingerprint()
+		Expect(fired).NotTo(BeEmpty())
+
+		now = now.Add(5 * time.Minute)
+		Expect(store.Fingerprint()).To(Equal(fired))
+
+		now = now.Add(5 * time.Minute)
+		Expect(store.Fingerprint()).To(BeEmpty())
+	})
+
+	It("should ignore rules without a rate limit", func() {
+		unlimited := &rules.Rule{Name: "unlimited"}
+
+		store.Record(unlimited, "/repo")
+
+		Expect(store.Suppressed(unlimited, "/repo")).To(BeFalse())
+		Expect(stateFile).NotTo(BeAnExistingFile())
+	})
+
+	It("should share windows across stores through the state file", func() {
+		store.Record(rule, "/repo")
+
+		other := rules.NewRateLimitStore(
+			rules.WithRateLimitStateFile(stateFile),
+			rules.WithRateLimitTimeFunc(clock),
+		)
+
+		Expect(other.Suppressed(rule, "/repo")).To(BeTrue())
+	})
+
+	It("should keep windows other stores recorded after it loaded", func() {
+		other := rules.NewRateLimitStore(
+			rules.WithRateLimitStateFile(stateFile),
+			rules.WithRateLimitTimeFunc(clock),
+		)
+
+		Expect(store.Suppressed(rule, "/a")).To(BeFalse())
+		Expect(other.Suppressed(rule, "/b")).To(BeFalse())
+
+		store.Record(rule, "/a")
+		other.Record(rule, "/b")
+
+		fresh := rules.NewRateLimitStore(
+			rules.WithRateLimitStateFile(stateFile),
+			rules.WithRateLimitTimeFunc(clock),
+		)
+
+		Expect(fresh.Suppressed(rule, "/a")).To(BeTrue())
+		Expect(fresh.Suppressed(rule, "/b")).To(BeTrue())
+	})
+})
@@ -13,14 +13,23 @@ type CompiledRule struct {
 	// Rule is the original rule configuration.
 	Rule *Rule
 
-	// Matcher is the compiled matcher for this rule.
+	// Matcher is the compiled matcher for this rule. Add builds it once with
+	// BuildMatcher; evaluations only call Match on it.
 	Matcher Matcher
+
+	// Transformer rewrites the command or content for transform actions.
+	// Nil for other actions.
+	Transformer *Transformer
 }
 
 // Registry stores compiled rules sorted by priority.
 type Registry struct {
 	mu    sync.RWMutex
 	rules []*CompiledRule
+
+	// index buckets rules by validator type. Nil unless EnableIndex was
+	// called; rebuilt whenever the rules change.
+	index *ruleIndex
 }
 
 // NewRegistry creates a new empty rule registry.
@@ -56,6 +65,17 @@ func (r *Registry) Add(rule *Rule) error {
 		matcher = &AlwaysMatcher{}
 	}
 
+	var transformer *Transformer
+
+	if rule.Action.Type == ActionTransform {
+		transformer, err = NewTransformer(rule.Action.Transform)
+		if err != nil {
+			return errors.Wrap(err, "failed to compile rule transform")
+		}
+
+		matcher = NewAndMatcher(matcher, transformer)
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -63,11 +83,13 @@ func (r *Registry) Add(rule *Rule) error {
 	for i, existing := range r.rules {
 		if existing.Rule.Name == rule.Name {
 			r.rules[i] = &CompiledRule{
-				Rule:    rule,
-				Matcher: matcher,
+				Rule:        rule,
+				Matcher:     matcher,
+				Transformer: transformer,
 			}
 
 			r.sortRulesLocked()
+			r.reindexLocked()
 
 			return nil
 		}
@@ -75,11 +97,13 @@ func (r *Registry) Add(rule *Rule) error {
 
 	// Add new rule.
 	r.rules = append(r.rules, &CompiledRule{
-		Rule:    rule,
-		Matcher: matcher,
+		Rule:        rule,
+		Matcher:     matcher,
+		Transformer: transformer,
 	})
 
 	r.sortRulesLocked()
+	r.reindexLocked()
 
 	return nil
 }
@@ -105,6 +129,8 @@ func (r *Registry) Remove(name string) bool {
 	for i, rule := range r.rules {
 		if rule.Rule.Name == name {
 			r.rules = slices.Delete(r.rules, i, i+1)
+			r.reindexLocked()
+
 			return true
 		}
 	}
@@ -154,6 +180,30 @@ func (r *Registry) GetEnabled() []*CompiledRule {
 	return result
 }
 
+// EnableIndex builds an index of the rules by validator type, kept up to
+// date as rules change. GetEnabledFor uses it to skip rules whose
+// validator_type cannot match.
+func (r *Registry) EnableIndex() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.index = newRuleIndex(r.rules)
+}
+
+// GetEnabledFor returns the enabled rules that can match validatorType,
+// sorted by priority. Without an index it returns all enabled rules.
+func (r *Registry) GetEnabledFor(validatorType ValidatorType) []*CompiledRule {
+	r.mu.RLock()
+	index := r.index
+	r.mu.RUnlock()
+
+	if index == nil {
+		return r.GetEnabled()
+	}
+
+	return index.candidates(validatorType)
+}
+
 // Size returns the number of rules in the registry.
 func (r *Registry) Size() int {
 	r.mu.RLock()
@@ -168,6 +218,7 @@ func (r *Registry) Clear() {
 	defer r.mu.Unlock()
 
 	r.rules = make([]*CompiledRule, 0)
+	r.reindexLocked()
 }
 
 // sortRulesLocked sorts rules by priority (descending) then by name (ascending).
@@ -184,6 +235,14 @@ func (r *Registry) sortRulesLocked() {
 	})
 }
 
+// reindexLocked rebuilds the validator type index, if enabled.
+// Must be called with write lock held.
+func (r *Registry) reindexLocked() {
+	if r.index != nil {
+		r.index = newRuleIndex(r.rules)
+	}
+}
+
 // Merge combines rules from another registry into this one.
 // Rules with the same name will be overwritten (source takes precedence).
 func (r *Registry) Merge(source *Registry) {
@@ -218,6 +277,7 @@ func (r *Registry) Merge(source *Registry) {
 	}
 
 	r.sortRulesLocked()
+	r.reindexLocked()
 }
 
 // MergeRules combines two rule slices with override semantics.
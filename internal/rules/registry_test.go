@@ -83,6 +83,33 @@ var _ = Describe("Registry", func() {
 			Expect(registry.Get("test-rule").Rule.Action.Type).To(Equal(rules.ActionWarn))
 		})
 
+		It("should compile the matcher once and reuse it for every evaluation", func() {
+			Expect(registry.Add(&rules.Rule{
+				Name:    "cached-rule",
+				Enabled: true,
+				Match: &rules.RuleMatch{
+					ValidatorType: rules.ValidatorGitPush,
+					BranchPattern: "main",
+				},
+				Action: &rules.RuleAction{Type: rules.ActionBlock},
+			})).To(Succeed())
+
+			matcher := registry.Get("cached-rule").Matcher
+			Expect(matcher).NotTo(BeNil())
+
+			evaluator := rules.NewEvaluator(registry)
+			matchCtx := &rules.MatchContext{
+				ValidatorType: rules.ValidatorGitPush,
+				GitContext:    &rules.GitContext{Branch: "main"},
+			}
+
+			for range 3 {
+				Expect(evaluator.Evaluate(matchCtx).Matched).To(BeTrue())
+			}
+
+			Expect(registry.Get("cached-rule").Matcher).To(BeIdenticalTo(matcher))
+		})
+
 		It("should return error for invalid match pattern", func() {
 			rule := &rules.Rule{
 				Name:    "test-rule",
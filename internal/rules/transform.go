@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"regexp"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Transformer applies a compiled transform action. It is also a Matcher that
+// matches when the Find pattern matches the field, so a transform rule whose
+// pattern is absent from the command does not match at all.
+type Transformer struct {
+	field   TransformField
+	find    *regexp.Regexp
+	replace string
+}
+
+// NewTransformer compiles a transform. Field defaults to TransformCommand.
+func NewTransformer(transform *Transform) (*Transformer, error) {
+	if transform == nil || transform.Find == "" {
+		return nil, errors.New("transform requires a find pattern")
+	}
+
+	field := transform.Field
+	if field == "" {
+		field = TransformCommand
+	}
+
+	if field != TransformCommand && field != TransformContent {
+		return nil, errors.Newf("invalid transform field %q", field)
+	}
+
+	find, err := regexp.Compile(transform.Find)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid transform find pattern %q", transform.Find)
+	}
+
+	return &Transformer{
+		field:   field,
+		find:    find,
+		replace: transform.Replace,
+	}, nil
+}
+
+// Match returns true if the Find pattern matches the field.
+func (t *Transformer) Match(ctx *MatchContext) bool {
+	value, ok := t.value(ctx)
+
+	return ok && t.find.MatchString(value)
+}
+
+// Name returns the matcher name.
+func (t *Transformer) Name() string {
+	return "transform:" + string(t.field) + ":" + t.find.String()
+}
+
+// Apply returns the field rewritten with every match of Find replaced, or
+// nil when the rewrite would not change it.
+func (t *Transformer) Apply(ctx *MatchContext) *Rewrite {
+	value, ok := t.value(ctx)
+	if !ok {
+		return nil
+	}
+
+	rewritten := t.find.ReplaceAllString(value, t.replace)
+	if rewritten == value {
+		return nil
+	}
+
+	return &Rewrite{Field: t.field, Value: rewritten}
+}
+
+// value returns the field to rewrite. Content is only taken from the Write
+// content, never from edits, so the rewrite maps back to a single field.
+func (t *Transformer) value(ctx *MatchContext) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	switch t.field {
+	case TransformCommand:
+		return ctx.Command, ctx.Command != ""
+	case TransformContent:
+		if ctx.HookContext == nil || ctx.HookContext.ToolInput.Content == "" {
+			return "", false
+		}
+
+		return ctx.HookContext.ToolInput.Content, true
+	default:
+		return "", false
+	}
+}
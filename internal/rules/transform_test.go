@@ -0,0 +1,100 @@
+package rules_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/rules"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+var _ = Describe("Transformer", func() {
+	forceToLease := &rules.Transform{
+		Find:    `--force(\s|$)`,
+		Replace: "--force-with-lease$1",
+	}
+
+	commandCtx := func(command string) *rules.MatchContext {
+		return &rules.MatchContext{Command: command}
+	}
+
+	It("should rewrite the command", func() {
+		transformer, err := rules.NewTransformer(forceToLease)
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := commandCtx("git push --force origin main")
+		Expect(transformer.Match(ctx)).To(BeTrue())
+		Expect(transformer.Apply(ctx)).To(Equal(&rules.Rewrite{
+			Field: rules.TransformCommand,
+			Value: "git push --force-with-lease origin main",
+		}))
+	})
+
+	It("should not match or rewrite when find doesn't match", func() {
+		transformer, err := rules.NewTransformer(forceToLease)
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := commandCtx("git push --force-with-lease origin main")
+		Expect(transformer.Match(ctx)).To(BeFalse())
+		Expect(transformer.Apply(ctx)).To(BeNil())
+	})
+
+	It("should rewrite Write content", func() {
+		transformer, err := rules.NewTransformer(&rules.Transform{
+			Field:   rules.TransformContent,
+			Find:    `\r\n`,
+			Replace: "\n",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := &rules.MatchContext{
+			HookContext: &hook.Context{ToolInput: hook.ToolInput{Content: "a\r\nb\r\n"}},
+			Command:     "a\r\nb",
+		}
+		Expect(transformer.Apply(ctx)).To(Equal(&rules.Rewrite{
+			Field: rules.TransformContent,
+			Value: "a\nb\n",
+		}))
+	})
+
+	DescribeTable("should reject invalid transforms",
+		func(transform *rules.Transform) {
+			_, err := rules.NewTransformer(transform)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("nil transform", nil),
+		Entry("empty find", &rules.Transform{Replace: "x"}),
+		Entry("invalid regex", &rules.Transform{Find: "(--force"}),
+		Entry("unsupported field", &rules.Transform{Field: "file_path", Find: "x"}),
+	)
+
+	It("should let lower-priority rules decide when find doesn't match", func() {
+		engine, err := rules.NewRuleEngine([]*rules.Rule{
+			{
+				Name:     "force-with-lease",
+				Enabled:  true,
+				Priority: 100,
+				Action: &rules.RuleAction{
+					Type:      rules.ActionTransform,
+					Transform: forceToLease,
+				},
+			},
+			{
+				Name:     "block-push",
+				Enabled:  true,
+				Priority: 10,
+				Action:   &rules.RuleAction{Type: rules.ActionBlock},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		result := engine.Evaluate(context.Background(), commandCtx("git push origin main"))
+		Expect(result.Rule.Name).To(Equal("block-push"))
+
+		result = engine.Evaluate(context.Background(), commandCtx("git push --force"))
+		Expect(result.Action).To(Equal(rules.ActionTransform))
+		Expect(result.Rewrite.Value).To(Equal("git push --force-with-lease"))
+	})
+})
@@ -4,8 +4,10 @@ package rules
 
 import (
 	"context"
+	"time"
 
 	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 )
 
@@ -21,11 +23,48 @@ const (
 
 	// ActionAllow explicitly allows the operation.
 	ActionAllow ActionType = "allow"
+
+	// ActionLog records the match without affecting the result. Evaluation
+	// continues as if the rule did not match.
+	ActionLog ActionType = "log"
+
+	// ActionTransform rewrites the command or file content with a regex
+	// replacement and lets the rewritten operation through. The rule only
+	// matches when its Find pattern matches.
+	ActionTransform ActionType = "transform"
+)
+
+// TransformField is the tool input field a transform action rewrites.
+type TransformField string
+
+// TransformField constants.
+const (
+	// TransformCommand rewrites the Bash command.
+	TransformCommand TransformField = "command"
+
+	// TransformContent rewrites the content of a Write.
+	TransformContent TransformField = "content"
+)
+
+// Scope classifies a git or GitHub operation by whether it affects only the
+// local repository or also a remote.
+type Scope string
+
+// Scope constants.
+const (
+	// ScopeLocal is an operation that only changes the local repository
+	// (commit, add, branch, merge).
+	ScopeLocal Scope = "local"
+
+	// ScopeRemote is an operation that talks to or changes a remote
+	// (push, fetch, pull, pull requests, issues).
+	ScopeRemote Scope = "remote"
 )
 
 // ValidatorType identifies a specific validator or group of validators.
 // Format: "category.name" (e.g., "git.push", "file.markdown")
-// Wildcards: "git.*" (all git validators), "*" (all validators)
+// Wildcards: "<category>.*" (all validators in a category, e.g. "git.*",
+// "file.*", "shell.*"), "*" (all validators)
 type ValidatorType string
 
 // Common validator type constants.
@@ -40,6 +79,8 @@ const (
 	ValidatorGitNoVerify      ValidatorType = "git.no_verify"
 	ValidatorGitAll           ValidatorType = "git.*"
 	ValidatorGitHubIssue      ValidatorType = "github.issue"
+	ValidatorGitHubRelease    ValidatorType = "github.release"
+	ValidatorGitHubPRMerge    ValidatorType = "github.pr_merge"
 	ValidatorGitHubAll        ValidatorType = "github.*"
 	ValidatorFileMarkdown     ValidatorType = "file.markdown"
 	ValidatorFileShell        ValidatorType = "file.shell"
@@ -52,8 +93,11 @@ const (
 	ValidatorFileLinterIgnore ValidatorType = "file.linter_ignore"
 	ValidatorFileAll          ValidatorType = "file.*"
 	ValidatorSecrets          ValidatorType = "secrets.secrets"
+	ValidatorSecretsAll       ValidatorType = "secrets.*"
 	ValidatorShellBacktick    ValidatorType = "shell.backtick"
+	ValidatorShellAll         ValidatorType = "shell.*"
 	ValidatorNotification     ValidatorType = "notification.bell"
+	ValidatorNotificationAll  ValidatorType = "notification.*"
 	ValidatorMCPServer        ValidatorType = "mcp.server"
 	ValidatorMCPAll           ValidatorType = "mcp.*"
 	ValidatorAll              ValidatorType = "*"
@@ -78,6 +122,17 @@ type Rule struct {
 
 	// Action specifies what happens when the rule matches.
 	Action *RuleAction
+
+	// RateLimit caps how often the rule surfaces, if set.
+	RateLimit *RateLimit
+}
+
+// RateLimit caps how often a rule surfaces in a repository. Matches within
+// Window of the rule last firing there are treated as if the rule's action
+// were ActionLog.
+type RateLimit struct {
+	// Window is how long the rule stays quiet after it fires.
+	Window time.Duration
 }
 
 // RuleMatch contains all conditions for a rule to match.
@@ -95,7 +150,8 @@ type RuleMatch struct {
 	// RepoPatterns allows multiple repository patterns.
 	RepoPatterns []string
 
-	// Remote matches against git remote name (exact match).
+	// Remote matches against git remote name (exact match). A "!" prefix
+	// matches any remote except the named one.
 	Remote string
 
 	// BranchPattern matches against branch name.
@@ -110,34 +166,139 @@ type RuleMatch struct {
 	// FilePatterns allows multiple file patterns.
 	FilePatterns []string
 
+	// FileExtensions matches file extensions without the leading dot
+	// (e.g., "go", "ts"). Case-insensitive; any extension matches.
+	FileExtensions []string
+
 	// ContentPattern matches against file content (regex).
 	ContentPattern string
 
 	// ContentPatterns allows multiple content patterns.
 	ContentPatterns []string
 
+	// ContentInFiles pairs content patterns with file patterns. A pair
+	// matches when both its file pattern and content pattern match; the
+	// condition is satisfied when any pair matches.
+	ContentInFiles []ContentInFile
+
 	// CommandPattern matches against bash command.
 	CommandPattern string
 
 	// CommandPatterns allows multiple command patterns.
 	CommandPatterns []string
 
+	// CommandContains matches when the command contains the literal
+	// substrings, any or all of them per PatternMode. Honors CaseInsensitive.
+	CommandContains []string
+
+	// PromptPattern matches the user prompt of the hook payload with a regex.
+	// Payloads without a prompt never match.
+	PromptPattern string
+
+	// CommitMessagePattern matches the message of a git commit given with
+	// -m/--message using regex.
+	CommitMessagePattern string
+
+	// MinSubjectLength matches when the subject of such a commit message
+	// has at least this many characters.
+	MinSubjectLength int
+
+	// MaxSubjectLength matches when the subject of such a commit message
+	// has at most this many characters.
+	MaxSubjectLength int
+
 	// ToolType matches against the hook tool type.
 	ToolType string
 
 	// EventType matches against the hook event type.
 	EventType string
 
+	// Scope matches the operation scope ("local" or "remote"), derived
+	// from the validator type or the command. See ResolveScope.
+	Scope Scope
+
+	// OS matches the operating system klaudiush runs on, as runtime.GOOS
+	// values (e.g., "darwin", "linux"). Empty matches any OS.
+	OS []string
+
+	// RequireUpstream matches only when the branch tracks an upstream.
+	RequireUpstream bool
+
+	// MinAhead matches when the branch is at least this many commits
+	// ahead of its upstream. Implies RequireUpstream when positive.
+	MinAhead int
+
+	// MinBehind matches when the branch is at least this many commits
+	// behind its upstream. Implies RequireUpstream when positive.
+	MinBehind int
+
+	// MinDaysSinceCommit matches when the last commit on HEAD is at least
+	// this many days old (a stale branch). Repositories without commits never
+	// match.
+	MinDaysSinceCommit int
+
+	// RequireDirty matches only when the working tree has uncommitted changes
+	// (staged, modified or untracked files).
+	RequireDirty bool
+
+	// RequireClean matches only when the working tree has no uncommitted
+	// changes. Outside a repository neither RequireDirty nor RequireClean
+	// matches.
+	RequireClean bool
+
+	// StagedPathPattern matches when any staged path matches the pattern
+	// (glob by default). A "!" prefix negates it: "!go.sum" matches when no
+	// staged path matches go.sum.
+	StagedPathPattern string
+
+	// RequireStagedPath matches only when a staged path matches the pattern,
+	// e.g. to pair a required go.mod with a negated go.sum pattern.
+	RequireStagedPath string
+
+	// IsBinary matches only when the file content looks like binary data.
+	IsBinary bool
+
+	// UsesSudo matches only when the command invokes sudo.
+	UsesSudo bool
+
+	// MinCommandPaths matches when a single command passes at least this
+	// many path-like arguments (e.g. "rm a b c" passes 3).
+	MinCommandPaths int
+
+	// MinConsecutiveBlocks matches when the previous invocations of the
+	// same tool blocked at least this many times in a row on the same
+	// reference. Requires loop detection to be enabled.
+	MinConsecutiveBlocks int
+
+	// LoadFileContent reads the target file from disk for content matching
+	// when the hook payload carries no content (e.g. Read and Edit).
+	LoadFileContent bool
+
 	// CaseInsensitive enables case-insensitive pattern matching.
 	CaseInsensitive bool
 
 	// PatternMode specifies how multiple patterns are combined ("any" or "all").
 	PatternMode string
+
+	// PathMode selects which form of the file path file patterns match
+	// ("absolute", "relative" to the repository root, or "both").
+	// Empty means "both".
+	PathMode string
+}
+
+// ContentInFile is a content pattern that only applies to files matching
+// FilePattern.
+type ContentInFile struct {
+	// FilePattern matches against file path (glob or regex).
+	FilePattern string
+
+	// ContentPattern matches against file content (regex).
+	ContentPattern string
 }
 
 // RuleAction specifies what happens when a rule matches.
 type RuleAction struct {
-	// Type is the action to take (block, warn, allow).
+	// Type is the action to take (block, warn, allow, log, transform).
 	Type ActionType
 
 	// Message is the human-readable message to display.
@@ -145,6 +306,36 @@ type RuleAction struct {
 
 	// Reference is an optional error reference code (e.g., "GIT019").
 	Reference string
+
+	// Severity overrides how hard a block or warn action is enforced:
+	// SeverityError blocks, SeverityWarning warns and SeverityInfo only
+	// records the finding. SeverityUnknown keeps the severity implied by
+	// Type.
+	Severity config.Severity
+
+	// Transform is the rewrite applied by a transform action.
+	Transform *Transform
+}
+
+// Transform is a regex replacement applied to a tool input field.
+type Transform struct {
+	// Field is the field to rewrite. Empty means TransformCommand.
+	Field TransformField
+
+	// Find is the regular expression to replace.
+	Find string
+
+	// Replace is the replacement, which may reference groups ($1, ${name}).
+	Replace string
+}
+
+// Rewrite is the result of applying a transform to a match context.
+type Rewrite struct {
+	// Field is the rewritten field.
+	Field TransformField
+
+	// Value is the new value of the field.
+	Value string
 }
 
 // RuleResult represents the outcome of rule evaluation.
@@ -163,13 +354,28 @@ type RuleResult struct {
 
 	// Reference is the error reference code (if any).
 	Reference string
+
+	// Severity is the severity override of the matched rule's action, or
+	// SeverityUnknown for the severity implied by Action.
+	Severity config.Severity
+
+	// Observed lists the matching log rules, which do not affect Action.
+	Observed []*Rule
+
+	// Rewrite is the rewritten field when Action is ActionTransform.
+	Rewrite *Rewrite
 }
 
 // GitContext contains git-specific data for rule matching.
 type GitContext struct {
-	// RepoRoot is the absolute path to the repository root.
+	// RepoRoot is the absolute path to the repository root. In a linked
+	// worktree it is the worktree's checkout directory.
 	RepoRoot string
 
+	// IsWorktree indicates the repository root is a linked worktree created
+	// by git worktree add.
+	IsWorktree bool
+
 	// Remote is the target remote name for push/pull operations.
 	Remote string
 
@@ -178,6 +384,34 @@ type GitContext struct {
 
 	// IsInRepo indicates whether we're inside a git repository.
 	IsInRepo bool
+
+	// HasUpstream indicates whether the branch tracks an upstream branch.
+	// False for detached HEAD and branches without tracking configuration.
+	HasUpstream bool
+
+	// AheadBehind holds commit counts relative to the upstream branch.
+	AheadBehind AheadBehind
+
+	// DaysSinceLastCommit is the number of whole days since the last commit
+	// on HEAD. Zero when the repository has no commits.
+	DaysSinceLastCommit int
+
+	// IsDirty indicates the working tree has staged, modified or untracked
+	// files.
+	IsDirty bool
+
+	// StagedFiles lists the staged paths relative to the repository root,
+	// as reported by git diff --cached --name-only.
+	StagedFiles []string
+}
+
+// AheadBehind holds how far a branch has diverged from its upstream.
+type AheadBehind struct {
+	// Ahead is the number of local commits not on the upstream.
+	Ahead int
+
+	// Behind is the number of upstream commits not on the local branch.
+	Behind int
 }
 
 // FileContext contains file-specific data for rule matching.
@@ -205,6 +439,13 @@ type MatchContext struct {
 
 	// Command is the bash command being executed (if applicable).
 	Command string
+
+	// SkipTransforms makes evaluation ignore transform rules. It is set when
+	// rewritten input is validated again.
+	SkipTransforms bool
+
+	// resolved caches paths with symlinks resolved during one evaluation.
+	resolved *resolvedPaths
 }
 
 // Engine is the main interface for the rule engine.
@@ -47,6 +47,7 @@ type CommitRulesData struct {
 // PushRulesData holds git push validation rules.
 type PushRulesData struct {
 	BlockedRemotes        []string
+	AllowedRemotes        []string
 	AllowedRemotePriority []string
 	RequireTracking       bool
 }
@@ -224,6 +225,10 @@ func collectPushRules(git *config.GitConfig) *PushRulesData {
 		data.BlockedRemotes = push.BlockedRemotes
 	}
 
+	if len(push.AllowedRemotes) > 0 {
+		data.AllowedRemotes = push.AllowedRemotes
+	}
+
 	if len(push.AllowedRemotePriority) > 0 {
 		data.AllowedRemotePriority = push.AllowedRemotePriority
 	}
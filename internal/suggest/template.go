@@ -44,6 +44,9 @@ Forbidden: {{join .Commit.ForbiddenPatterns ", "}}
 
 Never push to: {{join .Push.BlockedRemotes ", "}}
 {{- end}}
+{{- if .Push.AllowedRemotes}}
+Only push to: {{join .Push.AllowedRemotes ", "}}
+{{- end}}
 {{- if .Push.RequireTracking}}
 Always push to tracked branch.
 {{- end}}
@@ -0,0 +1,210 @@
+// Package sweep lists the files of a working tree to validate them all at
+// once, outside of any hook invocation.
+package sweep
+
+import (
+	"bufio"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/go-git/go-git/v6/plumbing/format/gitignore"
+)
+
+const (
+	// IgnoreFile lists paths excluded from sweeps, in .gitignore syntax. Like
+	// .gitignore, it can be placed in any directory and applies below it.
+	IgnoreFile = ".klaudiushignore"
+
+	gitDir         = ".git"
+	gitIgnoreFile  = ".gitignore"
+	gitExcludeFile = ".git/info/exclude"
+	commentPrefix  = "#"
+)
+
+// Files returns the files under root that aren't excluded by .gitignore,
+// .git/info/exclude or IgnoreFile, as sorted slash-separated paths relative
+// to root. The .git directory, symlinks and other non-regular files are
+// skipped.
+func Files(root string) ([]string, error) {
+	ignores := newIgnoreSet(root, gitIgnoreFile, IgnoreFile)
+	ignores.base = readPatterns(filepath.Join(root, gitExcludeFile), nil)
+
+	var files []string
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() && d.Name() == gitDir {
+			return filepath.SkipDir
+		}
+
+		if ignores.match(rel, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.Type().IsRegular() {
+			files = append(files, rel)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to walk %s", root)
+	}
+
+	slices.Sort(files)
+
+	return files, nil
+}
+
+// Filter returns the paths, relative to root, that still exist as regular
+// files and aren't excluded by an IgnoreFile, sorted. It's meant for lists
+// git already filtered, such as staged files, so .gitignore isn't applied.
+func Filter(root string, paths []string) []string {
+	ignores := newIgnoreSet(root, IgnoreFile)
+
+	var files []string
+
+	for _, p := range paths {
+		rel := path.Clean(filepath.ToSlash(p))
+
+		info, err := os.Lstat(filepath.Join(root, filepath.FromSlash(rel)))
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+
+		if !ignores.matchTree(rel) {
+			files = append(files, rel)
+		}
+	}
+
+	slices.Sort(files)
+
+	return slices.Compact(files)
+}
+
+// ignoreSet holds the ignore patterns of the directories under root, read
+// from the named ignore files on first use, after the base patterns.
+type ignoreSet struct {
+	root     string
+	names    []string
+	base     []gitignore.Pattern
+	patterns map[string][]gitignore.Pattern
+}
+
+func newIgnoreSet(root string, names ...string) *ignoreSet {
+	return &ignoreSet{
+		root:     root,
+		names:    names,
+		patterns: make(map[string][]gitignore.Pattern),
+	}
+}
+
+// match reports whether the slash-separated path rel is ignored by the
+// patterns of its parent directories.
+func (s *ignoreSet) match(rel string, isDir bool) bool {
+	dir := path.Dir(rel)
+	if dir == "." {
+		dir = ""
+	}
+
+	ps := s.load(dir)
+	if len(ps) == 0 {
+		return false
+	}
+
+	return gitignore.NewMatcher(ps).Match(strings.Split(rel, "/"), isDir)
+}
+
+// matchTree reports whether the file rel or one of its parent directories
+// is ignored.
+func (s *ignoreSet) matchTree(rel string) bool {
+	parts := strings.Split(rel, "/")
+
+	for i := 1; i < len(parts); i++ {
+		if s.match(strings.Join(parts[:i], "/"), true) {
+			return true
+		}
+	}
+
+	return s.match(rel, false)
+}
+
+// load returns the patterns that apply in dir: those of its parents first,
+// then its own, in increasing priority.
+func (s *ignoreSet) load(dir string) []gitignore.Pattern {
+	if ps, ok := s.patterns[dir]; ok {
+		return ps
+	}
+
+	ps := s.base
+
+	var domain []string
+
+	if dir != "" {
+		parent := path.Dir(dir)
+		if parent == "." {
+			parent = ""
+		}
+
+		ps = s.load(parent)
+		domain = strings.Split(dir, "/")
+	}
+
+	ps = slices.Clone(ps)
+
+	for _, name := range s.names {
+		file := filepath.Join(s.root, filepath.FromSlash(dir), name)
+		ps = append(ps, readPatterns(file, domain)...)
+	}
+
+	s.patterns[dir] = ps
+
+	return ps
+}
+
+// readPatterns parses the ignore file at file, scoped to the directory
+// domain. A missing or unreadable file has no patterns.
+func readPatterns(file string, domain []string) []gitignore.Pattern {
+	f, err := os.Open(file) //nolint:gosec // G304: ignore files of the swept tree
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var ps []gitignore.Pattern
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, commentPrefix) || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		ps = append(ps, gitignore.ParsePattern(line, domain))
+	}
+
+	return ps
+}
@@ -0,0 +1,85 @@
+package sweep_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/sweep"
+)
+
+var _ = Describe("Sweep", func() {
+	var root string
+
+	write := func(rel, content string) {
+		p := filepath.Join(root, filepath.FromSlash(rel))
+		Expect(os.MkdirAll(filepath.Dir(p), 0o755)).To(Succeed())
+		Expect(os.WriteFile(p, []byte(content), 0o644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+
+		write("README.md", "# Readme\n")
+		write("main.go", "package main\n")
+		write("build/out.bin", "binary")
+		write("docs/guide.md", "# Guide\n")
+		write("docs/draft.md", "# Draft\n")
+		write("vendor/lib/lib.go", "package lib\n")
+		write(".git/config", "[core]\n")
+		write(".git/info/exclude", "*.local\n")
+		write("settings.local", "local\n")
+		write(".gitignore", "build/\n")
+		write("docs/.klaudiushignore", "draft.md\n")
+		write(".klaudiushignore", "# generated code\nvendor/\n")
+	})
+
+	Describe("Files", func() {
+		It("should skip ignored files and the .git directory", func() {
+			files, err := sweep.Files(root)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(Equal([]string{
+				".gitignore",
+				".klaudiushignore",
+				"README.md",
+				"docs/.klaudiushignore",
+				"docs/guide.md",
+				"main.go",
+			}))
+		})
+
+		It("should honor negated patterns", func() {
+			write(".gitignore", "*.md\n!README.md\n")
+
+			files, err := sweep.Files(root)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ContainElement("README.md"))
+			Expect(files).To(ContainElement("build/out.bin"))
+			Expect(files).NotTo(ContainElement("docs/guide.md"))
+		})
+
+		It("should skip symlinks", func() {
+			Expect(os.Symlink("README.md", filepath.Join(root, "link.md"))).To(Succeed())
+
+			files, err := sweep.Files(root)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).NotTo(ContainElement("link.md"))
+		})
+	})
+
+	Describe("Filter", func() {
+		It("should drop ignored and missing files but keep gitignored ones", func() {
+			files := sweep.Filter(root, []string{
+				"main.go",
+				"build/out.bin",
+				"docs/draft.md",
+				"vendor/lib/lib.go",
+				"deleted.go",
+				"./README.md",
+			})
+			Expect(files).To(Equal([]string{"README.md", "build/out.bin", "main.go"}))
+		})
+	})
+})
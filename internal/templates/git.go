@@ -78,6 +78,13 @@ Branch type '{{.BranchType}}' is not valid
 
 Valid types: {{.ValidTypesStr}}`)
 
+	// BranchTicketTemplate formats error for a branch name without a ticket reference
+	BranchTicketTemplate = Parse("branch_ticket", `Branch name must contain a ticket reference
+
+Branch name '{{.BranchName}}' doesn't match ticket pattern: {{.TicketPattern}}
+
+Example: {{.Example}}`)
+
 	// PushRemoteNotFoundTemplate formats error for missing remote
 	PushRemoteNotFoundTemplate = Parse(
 		"push_remote_not_found",
@@ -125,6 +132,15 @@ Suggested alternatives: [{{.SuggestedRemotesStr}}]
 Available remotes: [{{.AvailableRemotesStr}}]
 {{- end}}`,
 	)
+
+	// PushRemoteNotAllowedTemplate formats error for push to a remote outside
+	// the allowlist
+	PushRemoteNotAllowedTemplate = Parse(
+		"push_remote_not_allowed",
+		`❌ Remote '{{.Remote}}' is not allowed for push operations
+
+Allowed remotes: [{{.AllowedRemotesStr}}]`,
+	)
 )
 
 // GitAddTmpFilesData holds data for GitAddTmpFilesTemplate
@@ -165,6 +181,13 @@ type BranchInvalidTypeData struct {
 	ValidTypesStr string
 }
 
+// BranchTicketData holds data for BranchTicketTemplate
+type BranchTicketData struct {
+	BranchName    string
+	TicketPattern string
+	Example       string
+}
+
 // PushRemoteNotFoundData holds data for PushRemoteNotFoundTemplate
 type PushRemoteNotFoundData struct {
 	Remote  string
@@ -177,6 +200,12 @@ type RemoteInfo struct {
 	URL  string
 }
 
+// PushRemoteNotAllowedData holds data for PushRemoteNotAllowedTemplate
+type PushRemoteNotAllowedData struct {
+	Remote            string
+	AllowedRemotesStr string
+}
+
 // PushBlockedRemoteData holds data for PushBlockedRemoteTemplate
 type PushBlockedRemoteData struct {
 	Remote              string
@@ -9,7 +9,7 @@ type Reference string
 // ReferenceBaseURL is the base URL for error references.
 const ReferenceBaseURL = "https://klaudiu.sh/e"
 
-// Git-related references (GIT001-GIT025).
+// Git-related references (GIT001-GIT033).
 const (
 	// RefGitNoSignoff indicates missing -s/--signoff flag.
 	RefGitNoSignoff Reference = ReferenceBaseURL + "/GIT001"
@@ -85,9 +85,33 @@ const (
 
 	// RefGitBlockedRemote indicates push to a blocked remote.
 	RefGitBlockedRemote Reference = ReferenceBaseURL + "/GIT025"
+
+	// RefGitMissingTrailer indicates a required commit message trailer is missing or malformed.
+	RefGitMissingTrailer Reference = ReferenceBaseURL + "/GIT026"
+
+	// RefGitLargeCommit indicates the staged diff exceeds the configured size limit.
+	RefGitLargeCommit Reference = ReferenceBaseURL + "/GIT027"
+
+	// RefGitAmendPushed indicates an amend would rewrite a commit already pushed upstream.
+	RefGitAmendPushed Reference = ReferenceBaseURL + "/GIT028"
+
+	// RefGitEmojiPolicy indicates the commit title violates the emoji/gitmoji policy.
+	RefGitEmojiPolicy Reference = ReferenceBaseURL + "/GIT029"
+
+	// RefGitBlockedCommitMarker indicates a pushed commit has a WIP/"do not merge" marker.
+	RefGitBlockedCommitMarker Reference = ReferenceBaseURL + "/GIT030"
+
+	// RefGitLargeFile indicates git add would stage a new large or binary file.
+	RefGitLargeFile Reference = ReferenceBaseURL + "/GIT031"
+
+	// RefGitMissingChangelog indicates a commit doesn't stage a required changelog fragment.
+	RefGitMissingChangelog Reference = ReferenceBaseURL + "/GIT032"
+
+	// RefGitTagPush indicates a push includes tags.
+	RefGitTagPush Reference = ReferenceBaseURL + "/GIT033"
 )
 
-// File-related references (FILE001-FILE009).
+// File-related references (FILE001-FILE013).
 const (
 	// RefShellcheck indicates shellcheck validation failure.
 	RefShellcheck Reference = ReferenceBaseURL + "/FILE001"
@@ -118,6 +142,15 @@ const (
 
 	// RefLinterIgnore indicates linter ignore directives detected in code.
 	RefLinterIgnore Reference = ReferenceBaseURL + "/FILE010"
+
+	// RefTerraformVersions indicates missing Terraform or provider version constraints.
+	RefTerraformVersions Reference = ReferenceBaseURL + "/FILE011"
+
+	// RefMarkdownFrontMatter indicates missing or invalid markdown front matter.
+	RefMarkdownFrontMatter Reference = ReferenceBaseURL + "/FILE012"
+
+	// RefWorkflowRunner indicates a workflow job uses a disallowed runner label.
+	RefWorkflowRunner Reference = ReferenceBaseURL + "/FILE013"
 )
 
 // Security-related references (SEC001-SEC005).
@@ -148,6 +181,12 @@ const (
 const (
 	// RefGHIssueValidation indicates gh issue create validation failure (body markdown).
 	RefGHIssueValidation Reference = ReferenceBaseURL + "/GH001"
+
+	// RefGHReleaseValidation indicates gh release create validation failure (notes, tag or title).
+	RefGHReleaseValidation Reference = ReferenceBaseURL + "/GH002"
+
+	// RefGHPRMergeValidation indicates gh pr merge validation failure (merge method, branch deletion).
+	RefGHPRMergeValidation Reference = ReferenceBaseURL + "/GH003"
 )
 
 // MCP Elicitation references (MCP001-MCP005).
@@ -180,6 +219,17 @@ const (
 	RefPluginDangerousChars Reference = ReferenceBaseURL + "/PLUG005"
 )
 
+// Reference namespaces, the code prefixes returned by Reference.Category.
+const (
+	NamespaceGit     = "GIT"
+	NamespaceGitHub  = "GH"
+	NamespaceFile    = "FILE"
+	NamespaceSecrets = "SEC"
+	NamespaceShell   = "SHELL"
+	NamespaceMCP     = "MCP"
+	NamespacePlugin  = "PLUG"
+)
+
 // minCodeLength is the minimum length for a valid reference code.
 const minCodeLength = 3
 
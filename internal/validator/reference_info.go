@@ -0,0 +1,80 @@
+package validator
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ReferenceInfo describes a reference code for humans.
+type ReferenceInfo struct {
+	// Code is the reference code (e.g., "GIT019").
+	Code string
+
+	// Title is a short summary of the problem.
+	Title string
+
+	// Description explains what triggers the reference.
+	Description string
+
+	// URL is the documentation URL. Defaults to ReferenceBaseURL/{Code}.
+	URL string
+}
+
+// referenceInfos holds every registered reference, keyed by code.
+var referenceInfos = struct {
+	mu    sync.RWMutex
+	infos map[string]ReferenceInfo
+}{infos: make(map[string]ReferenceInfo)}
+
+// RegisterReferences adds reference descriptions to the registry. Validator
+// packages call it from init for the codes they emit. A later registration of
+// the same code replaces the earlier one.
+func RegisterReferences(infos ...ReferenceInfo) {
+	referenceInfos.mu.Lock()
+	defer referenceInfos.mu.Unlock()
+
+	for _, info := range infos {
+		info.Code = strings.ToUpper(info.Code)
+		if info.URL == "" {
+			info.URL = ReferenceBaseURL + "/" + info.Code
+		}
+
+		referenceInfos.infos[info.Code] = info
+	}
+}
+
+// LookupReference returns the registered description of a code. The code is
+// matched case-insensitively and may also be given as a reference URL.
+func LookupReference(code string) (ReferenceInfo, bool) {
+	code = strings.ToUpper(Reference(code).Code())
+
+	referenceInfos.mu.RLock()
+	defer referenceInfos.mu.RUnlock()
+
+	info, ok := referenceInfos.infos[code]
+
+	return info, ok
+}
+
+// RegisteredReferences returns all registered references sorted by code.
+func RegisteredReferences() []ReferenceInfo {
+	referenceInfos.mu.RLock()
+	defer referenceInfos.mu.RUnlock()
+
+	infos := make([]ReferenceInfo, 0, len(referenceInfos.infos))
+	for _, info := range referenceInfos.infos {
+		infos = append(infos, info)
+	}
+
+	slices.SortFunc(infos, func(a, b ReferenceInfo) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+
+	return infos
+}
+
+// Info returns the registered description of the reference.
+func (r Reference) Info() (ReferenceInfo, bool) {
+	return LookupReference(r.Code())
+}
@@ -0,0 +1,86 @@
+package validator_test
+
+import (
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+)
+
+var _ = Describe("ReferenceInfo registry", func() {
+	BeforeEach(func() {
+		validator.RegisterReferences(
+			validator.ReferenceInfo{
+				Code:        "TEST001",
+				Title:       "Test title",
+				Description: "Test description.",
+			},
+			validator.ReferenceInfo{
+				Code:  "TEST002",
+				Title: "Custom URL",
+				URL:   "https://example.com/TEST002",
+			},
+		)
+	})
+
+	Describe("LookupReference", func() {
+		It("returns a registered code", func() {
+			info, ok := validator.LookupReference("TEST001")
+			Expect(ok).To(BeTrue())
+			Expect(info.Title).To(Equal("Test title"))
+			Expect(info.Description).To(Equal("Test description."))
+		})
+
+		It("defaults the URL to the reference base URL", func() {
+			info, ok := validator.LookupReference("TEST001")
+			Expect(ok).To(BeTrue())
+			Expect(info.URL).To(Equal(validator.ReferenceBaseURL + "/TEST001"))
+		})
+
+		It("keeps an explicit URL", func() {
+			info, ok := validator.LookupReference("TEST002")
+			Expect(ok).To(BeTrue())
+			Expect(info.URL).To(Equal("https://example.com/TEST002"))
+		})
+
+		It("matches codes case-insensitively", func() {
+			info, ok := validator.LookupReference("test001")
+			Expect(ok).To(BeTrue())
+			Expect(info.Code).To(Equal("TEST001"))
+		})
+
+		It("accepts a reference URL", func() {
+			_, ok := validator.LookupReference(validator.ReferenceBaseURL + "/TEST001")
+			Expect(ok).To(BeTrue())
+		})
+
+		It("reports unknown codes", func() {
+			_, ok := validator.LookupReference("NOPE999")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("Info", func() {
+		It("looks up the reference code", func() {
+			info, ok := validator.Reference(validator.ReferenceBaseURL + "/TEST001").Info()
+			Expect(ok).To(BeTrue())
+			Expect(info.Title).To(Equal("Test title"))
+		})
+	})
+
+	Describe("RegisteredReferences", func() {
+		It("returns references sorted by code", func() {
+			infos := validator.RegisteredReferences()
+
+			var codes []string
+			for _, info := range infos {
+				codes = append(codes, info.Code)
+			}
+
+			Expect(codes).To(ContainElements("TEST001", "TEST002"))
+			Expect(slices.IsSorted(codes)).To(BeTrue())
+		})
+	})
+})
@@ -6,7 +6,9 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"sync"
 
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/parser"
 )
@@ -18,10 +20,40 @@ type Predicate func(*hook.Context) bool
 type Registration struct {
 	Validator Validator
 	Predicate Predicate
+
+	// Namespace is the prefix of the reference codes the validator emits
+	// (e.g., NamespaceGit). Optional, only used for introspection.
+	Namespace string
 }
 
-// Registry manages validator registrations and selection.
+// ValidatorInfo describes a registered validator without running it.
+type ValidatorInfo struct {
+	// Name is the validator name.
+	Name string
+
+	// Category is the workload category of the validator.
+	Category ValidatorCategory
+
+	// Severity is the severity of the validator's findings: error unless the
+	// validator reports a lower one through SeverityReporter.
+	Severity config.Severity
+
+	// ReferenceNamespace is the prefix of the reference codes the validator
+	// emits (e.g., "GIT"), or empty when unknown.
+	ReferenceNamespace string
+}
+
+// SeverityReporter is implemented by validators whose findings are reported
+// with a configured severity.
+type SeverityReporter interface {
+	Severity() config.Severity
+}
+
+// Registry manages validator registrations and selection. It is safe for
+// concurrent use: Replace swaps the whole registration set at once, so
+// FindValidators sees either the old set or the new one, never a mix.
 type Registry struct {
+	mu            sync.RWMutex
 	registrations []Registration
 }
 
@@ -34,14 +66,54 @@ func NewRegistry() *Registry {
 
 // Register adds a validator with a predicate to the registry.
 func (r *Registry) Register(validator Validator, predicate Predicate) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	r.registrations = append(r.registrations, Registration{
 		Validator: validator,
 		Predicate: predicate,
 	})
 }
 
+// Unregister removes all validators with the given name and reports whether
+// any were removed.
+func (r *Registry) Unregister(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := make([]Registration, 0, len(r.registrations))
+
+	for _, reg := range r.registrations {
+		if reg.Validator.Name() != name {
+			kept = append(kept, reg)
+		}
+	}
+
+	removed := len(kept) != len(r.registrations)
+	r.registrations = kept
+
+	return removed
+}
+
+// Replace atomically replaces all registrations. Validators already returned
+// by FindValidators keep running; later lookups only see the new set.
+func (r *Registry) Replace(registrations []Registration) {
+	next := slices.Clone(registrations)
+	if next == nil {
+		next = make([]Registration, 0)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.registrations = next
+}
+
 // FindValidators returns all validators whose predicates match the context.
 func (r *Registry) FindValidators(ctx *hook.Context) []Validator {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	validators := make([]Validator, 0)
 
 	for _, reg := range r.registrations {
@@ -53,8 +125,35 @@ func (r *Registry) FindValidators(ctx *hook.Context) []Validator {
 	return validators
 }
 
+// Validators describes the registered validators in registration order.
+func (r *Registry) Validators() []ValidatorInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	infos := make([]ValidatorInfo, 0, len(r.registrations))
+
+	for _, reg := range r.registrations {
+		severity := config.SeverityError
+		if reporter, ok := reg.Validator.(SeverityReporter); ok {
+			severity = reporter.Severity()
+		}
+
+		infos = append(infos, ValidatorInfo{
+			Name:               reg.Validator.Name(),
+			Category:           reg.Validator.Category(),
+			Severity:           severity,
+			ReferenceNamespace: reg.Namespace,
+		})
+	}
+
+	return infos
+}
+
 // Count returns the number of registered validators.
 func (r *Registry) Count() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	return len(r.registrations)
 }
 
@@ -1,13 +1,143 @@
 package validator_test
 
 import (
+	"fmt"
+	"sync"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 )
 
+var _ = Describe("Registry", func() {
+	var registry *validator.Registry
+
+	registrations := func(prefix string, count int) []validator.Registration {
+		regs := make([]validator.Registration, 0, count)
+		for i := range count {
+			regs = append(regs, validator.Registration{
+				Validator: newBenchValidator(fmt.Sprintf("%s-%d", prefix, i), validator.Pass()),
+				Predicate: validator.Always(),
+			})
+		}
+
+		return regs
+	}
+
+	names := func(validators []validator.Validator) []string {
+		result := make([]string, 0, len(validators))
+		for _, v := range validators {
+			result = append(result, v.Name())
+		}
+
+		return result
+	}
+
+	BeforeEach(func() {
+		registry = validator.NewRegistry()
+	})
+
+	Describe("Unregister", func() {
+		It("removes validators with the given name", func() {
+			registry.Replace(registrations("a", 2))
+
+			Expect(registry.Unregister("a-0")).To(BeTrue())
+			Expect(registry.Count()).To(Equal(1))
+			Expect(names(registry.FindValidators(&hook.Context{}))).To(Equal([]string{"a-1"}))
+		})
+
+		It("reports false when nothing matches", func() {
+			registry.Replace(registrations("a", 2))
+
+			Expect(registry.Unregister("missing")).To(BeFalse())
+			Expect(registry.Count()).To(Equal(2))
+		})
+	})
+
+	Describe("Validators", func() {
+		It("describes the registrations without running them", func() {
+			registry.Replace([]validator.Registration{{
+				Validator: newBenchValidator("v", validator.Fail("must not run")),
+				Predicate: validator.Never(),
+				Namespace: validator.NamespaceShell,
+			}})
+
+			Expect(registry.Validators()).To(Equal([]validator.ValidatorInfo{{
+				Name:               "v",
+				Category:           validator.CategoryCPU,
+				Severity:           config.SeverityError,
+				ReferenceNamespace: validator.NamespaceShell,
+			}}))
+		})
+	})
+
+	Describe("Replace", func() {
+		It("replaces all registrations", func() {
+			registry.Replace(registrations("old", 2))
+			registry.Replace(registrations("new", 1))
+
+			Expect(names(registry.FindValidators(&hook.Context{}))).To(Equal([]string{"new-0"}))
+		})
+
+		It("does not alias the given slice", func() {
+			regs := registrations("a", 1)
+			registry.Replace(regs)
+			regs[0].Predicate = validator.Never()
+
+			Expect(registry.FindValidators(&hook.Context{})).To(HaveLen(1))
+		})
+
+		It("accepts nil", func() {
+			registry.Replace(registrations("a", 1))
+			registry.Replace(nil)
+
+			Expect(registry.Count()).To(BeZero())
+			Expect(registry.FindValidators(&hook.Context{})).To(BeEmpty())
+		})
+
+		It("never exposes a partially replaced set to concurrent lookups", func() {
+			oldSet := registrations("old", 3)
+			newSet := registrations("new", 7)
+			registry.Replace(oldSet)
+
+			var (
+				wg      sync.WaitGroup
+				mu      sync.Mutex
+				lengths = map[int]int{}
+			)
+
+			for range 4 {
+				wg.Go(func() {
+					for range 500 {
+						found := registry.FindValidators(&hook.Context{})
+
+						mu.Lock()
+						lengths[len(found)]++
+						mu.Unlock()
+					}
+				})
+			}
+
+			for i := range 500 {
+				if i%2 == 0 {
+					registry.Replace(newSet)
+				} else {
+					registry.Replace(oldSet)
+				}
+			}
+
+			wg.Wait()
+
+			for length := range lengths {
+				Expect(length).To(BeElementOf(3, 7))
+			}
+		})
+	})
+})
+
 var _ = Describe("Git Predicates", func() {
 	Describe("GitSubcommandIs", func() {
 		It("matches git checkout command", func() {
@@ -6,43 +6,54 @@ package validator
 //nolint:gosec // G101: strings contain command examples with flag names, not hardcoded credentials
 var DefaultSuggestions = map[Reference]string{
 	// Git suggestions
-	RefGitNoSignoff:          "Add -s flag: git commit -sS -m \"message\"",
-	RefGitNoGPGSign:          "Add -S flag: git commit -sS -m \"message\"",
-	RefGitMissingFlags:       "Add -sS flags to your command, keeping ALL existing arguments. Example: git commit -sS -m \"your message\"",
-	RefGitNoStaged:           "Stage specific files with git add <files> (check git status first), then retry the commit",
-	RefGitBadTitle:           "Shorten title to max 50 chars total including type(scope): prefix",
-	RefGitBadBody:            "Wrap body lines at 72 characters",
-	RefGitFeatCI:             "Use ci(...) instead of feat(ci) or fix(ci)",
-	RefGitNoRemote:           "Specify remote: git push <remote> <branch>",
-	RefGitNoBranch:           "Specify branch: git push <remote> <branch>",
-	RefGitFileNotExist:       "Verify the file exists before adding",
-	RefGitPRRef:              "Remove PR reference from commit message (use in PR body instead)",
-	RefGitClaudeAttr:         "Remove Claude attribution from commit message",
-	RefGitConventionalCommit: "Use format: type(scope): description (total title must be under 50 chars)",
-	RefGitForbiddenPattern:   "Remove forbidden pattern from commit message",
-	RefGitSignoffMismatch:    "Use correct signoff identity: git config user.name and user.email",
-	RefGitListFormat:         "Add empty line before list items in commit body",
-	RefGitMergeMessage:       "Fix PR title/body to follow commit message conventions before merge",
-	RefGitMergeSignoff:       "Add --body flag with Signed-off-by trailer to gh pr merge command",
-	RefGitBlockedFiles:       "Remove blocked files from your git add command. Do not stage these files.",
-	RefGitBranchName:         "Use lowercase kebab-case for branch names (e.g., feat/my-feature)",
-	RefGitNoVerify:           "Remove --no-verify flag and fix any pre-commit hook issues",
-	RefGitKongOrgPush:        "Push to 'upstream' remote instead: git push upstream <branch>",
-	RefGitPRValidation:       "Fix the issue and retry gh pr create",
-	RefGitFetchNoRemote:      "Specify valid remote: git fetch <remote> (use 'git remote -v' to list remotes)",
-	RefGitBlockedRemote:      "Use an allowed remote for push",
+	RefGitNoSignoff:           "Add -s flag: git commit -sS -m \"message\"",
+	RefGitNoGPGSign:           "Add -S flag: git commit -sS -m \"message\"",
+	RefGitMissingFlags:        "Add -sS flags to your command, keeping ALL existing arguments. Example: git commit -sS -m \"your message\"",
+	RefGitNoStaged:            "Stage specific files with git add <files> (check git status first), then retry the commit",
+	RefGitBadTitle:            "Shorten title to max 50 chars total including type(scope): prefix",
+	RefGitBadBody:             "Wrap body lines at 72 characters",
+	RefGitFeatCI:              "Use ci(...) instead of feat(ci) or fix(ci)",
+	RefGitNoRemote:            "Specify remote: git push <remote> <branch>",
+	RefGitNoBranch:            "Specify branch: git push <remote> <branch>",
+	RefGitFileNotExist:        "Verify the file exists before adding",
+	RefGitPRRef:               "Remove PR reference from commit message (use in PR body instead)",
+	RefGitClaudeAttr:          "Remove Claude attribution from commit message",
+	RefGitConventionalCommit:  "Use format: type(scope): description (total title must be under 50 chars)",
+	RefGitForbiddenPattern:    "Remove forbidden pattern from commit message",
+	RefGitSignoffMismatch:     "Use correct signoff identity: git config user.name and user.email",
+	RefGitListFormat:          "Add empty line before list items in commit body",
+	RefGitMergeMessage:        "Fix PR title/body to follow commit message conventions before merge",
+	RefGitMergeSignoff:        "Add --body flag with Signed-off-by trailer to gh pr merge command",
+	RefGitBlockedFiles:        "Remove blocked files from your git add command. Do not stage these files.",
+	RefGitBranchName:          "Use lowercase kebab-case for branch names (e.g., feat/my-feature)",
+	RefGitNoVerify:            "Remove --no-verify flag and fix any pre-commit hook issues",
+	RefGitKongOrgPush:         "Push to 'upstream' remote instead: git push upstream <branch>",
+	RefGitPRValidation:        "Fix the issue and retry gh pr create",
+	RefGitFetchNoRemote:       "Specify valid remote: git fetch <remote> (use 'git remote -v' to list remotes)",
+	RefGitBlockedRemote:       "Use an allowed remote for push",
+	RefGitMissingTrailer:      "Add the required trailers (e.g., Signed-off-by: Name <email>) as the last paragraph of the commit message",
+	RefGitLargeCommit:         "Split the staged changes into smaller commits",
+	RefGitAmendPushed:         "Create a new commit instead of amending the pushed one",
+	RefGitEmojiPolicy:         "Start the title with one gitmoji, or remove emoji from it, as configured",
+	RefGitBlockedCommitMarker: "Reword or squash the marked commits before pushing them to this branch",
+	RefGitLargeFile:           "Add the files to .gitignore, or track them with Git LFS",
+	RefGitMissingChangelog:    "Add a changelog fragment under the changelog directory and stage it",
+	RefGitTagPush:             "Push without --tags/--follow-tags, or push a single tag explicitly",
 
 	// File suggestions
-	RefShellcheck:   "Run 'shellcheck <file>' to see detailed errors",
-	RefTerraformFmt: "Run 'terraform fmt' or 'tofu fmt' to fix formatting",
-	RefTflint:       "Run 'tflint' to see detailed linting issues",
-	RefActionlint:   "Run 'actionlint' to see workflow issues",
-	RefMarkdownLint: "Fix the formatting issue and retry",
-	RefGofumpt:      "Run 'gofumpt -w <file>' to auto-fix formatting",
-	RefRuffCheck:    "Run 'ruff check <file>' to see Python code quality issues",
-	RefOxlintCheck:  "Run 'oxlint <file>' to see JavaScript/TypeScript code quality issues",
-	RefRustfmtCheck: "Run 'rustfmt <file>' to auto-fix formatting",
-	RefLinterIgnore: "Fix linter errors properly instead of suppressing them with ignore directives",
+	RefShellcheck:          "Run 'shellcheck <file>' to see detailed errors",
+	RefTerraformFmt:        "Run 'terraform fmt' or 'tofu fmt' to fix formatting",
+	RefTflint:              "Run 'tflint' to see detailed linting issues",
+	RefActionlint:          "Run 'actionlint' to see workflow issues",
+	RefMarkdownLint:        "Fix the formatting issue and retry",
+	RefGofumpt:             "Run 'gofumpt -w <file>' to auto-fix formatting",
+	RefRuffCheck:           "Run 'ruff check <file>' to see Python code quality issues",
+	RefOxlintCheck:         "Run 'oxlint <file>' to see JavaScript/TypeScript code quality issues",
+	RefRustfmtCheck:        "Run 'rustfmt <file>' to auto-fix formatting",
+	RefLinterIgnore:        "Fix linter errors properly instead of suppressing them with ignore directives",
+	RefTerraformVersions:   "Pin required_version and provider versions in the terraform block",
+	RefMarkdownFrontMatter: "Start the file with a '---' fenced YAML block that defines the required keys",
+	RefWorkflowRunner:      "Pin runs-on to a runner label from allowed_runners instead of a *-latest label",
 
 	// Security suggestions
 	RefSecretsAPIKey:     "Remove API key and use environment variables or secret management",
@@ -55,7 +66,9 @@ var DefaultSuggestions = map[Reference]string{
 	RefShellBackticks: "Use HEREDOC syntax or file-based input (git commit -F file.txt)",
 
 	// GitHub CLI suggestions
-	RefGHIssueValidation: "Fix markdown formatting in issue body (empty lines around headings, proper list spacing)",
+	RefGHIssueValidation:   "Fix markdown formatting in issue body (empty lines around headings, proper list spacing)",
+	RefGHReleaseValidation: "Add the missing release notes sections and use a tag and title matching the configured patterns",
+	RefGHPRMergeValidation: "Merge with an allowed method (--squash, --merge or --rebase) and add --delete-branch if required",
 
 	// MCP Elicitation suggestions
 	RefMCPServerBlocked:    "Remove MCP server from deny list or use a different server",
@@ -0,0 +1,20 @@
+package validator
+
+import "context"
+
+// skipTransformsKey marks a context whose rule checks ignore transform rules.
+type skipTransformsKey struct{}
+
+// WithoutTransforms returns ctx marked so that rule checks ignore transform
+// rules. Rewritten tool input is validated again under such a context, so
+// the built-in checks run on it instead of the rewrite deciding the result.
+func WithoutTransforms(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipTransformsKey{}, true)
+}
+
+// TransformsSkipped reports whether ctx was marked by WithoutTransforms.
+func TransformsSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipTransformsKey{}).(bool)
+
+	return skip
+}
@@ -69,12 +69,24 @@ type Result struct {
 	// Some validators may only warn without blocking.
 	ShouldBlock bool
 
+	// Informational marks a non-blocking finding that is only recorded,
+	// not reported as a warning. Set for validators with severity "info".
+	Informational bool
+
 	// Reference is the URL that uniquely identifies this error type.
 	// Format: https://klaudiu.sh/e/{CODE} (e.g., https://klaudiu.sh/e/GIT001).
 	Reference Reference
 
 	// FixHint provides a short suggestion for fixing the issue.
 	FixHint string
+
+	// PluginDetails contains structured key/value context reported by an
+	// external plugin. Only rendered in verbose output.
+	PluginDetails map[string]string
+
+	// UpdatedInput holds tool input fields (e.g. "command") to replace
+	// before the tool runs. Set by rule transform actions.
+	UpdatedInput map[string]string
 }
 
 // Pass creates a passing validation result.
@@ -175,6 +187,10 @@ func (r *Result) String() string {
 		return "BLOCK"
 	}
 
+	if r.Informational {
+		return "INFO"
+	}
+
 	return "WARN"
 }
 
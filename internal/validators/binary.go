@@ -0,0 +1,59 @@
+package validators
+
+import "unicode/utf8"
+
+const (
+	// binarySniffLength is how much content IsBinaryContent inspects.
+	// git uses the same limit when it looks for null bytes.
+	binarySniffLength = 8000
+
+	// binaryNonTextRatio is the share of non-text characters above which
+	// content without null bytes is still treated as binary.
+	binaryNonTextRatio = 0.3
+)
+
+// IsBinaryContent reports whether content looks like binary data rather than
+// text. Only the first 8000 bytes are inspected: content is binary when they
+// contain a null byte, or when more than 30% of the characters are invalid
+// UTF-8 or control characters other than common whitespace.
+func IsBinaryContent(content string) bool {
+	if len(content) > binarySniffLength {
+		content = content[:binarySniffLength]
+	}
+
+	var total, nonText int
+
+	for i := 0; i < len(content); {
+		// A multi-byte character cut off by the sniff limit is not an error.
+		if !utf8.FullRuneInString(content[i:]) {
+			break
+		}
+
+		r, size := utf8.DecodeRuneInString(content[i:])
+		i += size
+		total++
+
+		switch {
+		case r == 0:
+			return true
+		case r == utf8.RuneError && size == 1:
+			nonText++
+		case isNonTextControl(r):
+			nonText++
+		}
+	}
+
+	return total > 0 && float64(nonText)/float64(total) > binaryNonTextRatio
+}
+
+// isNonTextControl reports whether r is a control character that does not
+// appear in ordinary text. Tab, newline, carriage return, form feed and
+// escape (used by ANSI color codes) count as text.
+func isNonTextControl(r rune) bool {
+	switch r {
+	case '\t', '\n', '\r', '\f', '\x1b':
+		return false
+	}
+
+	return r < 0x20 || r == 0x7f
+}
@@ -0,0 +1,41 @@
+package validators_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/validators"
+)
+
+var _ = Describe("IsBinaryContent", func() {
+	DescribeTable("detects text",
+		func(content string) {
+			Expect(validators.IsBinaryContent(content)).To(BeFalse())
+		},
+		Entry("empty content", ""),
+		Entry("source code", "package main\n\nfunc main() {\n\tprintln(\"hi\")\n}\n"),
+		Entry("CRLF line endings", "line one\r\nline two\r\n"),
+		Entry("unicode text", "zażółć gęślą jaźń ✨ 日本語"),
+		Entry("ANSI color codes", "\x1b[31merror\x1b[0m: failed\n"),
+		Entry("a few control characters", "text with a bell\a and a backspace\b"),
+		Entry("multi-byte character cut at the sniff limit",
+			strings.Repeat("a", 7999)+"ż"),
+	)
+
+	DescribeTable("detects binary",
+		func(content string) {
+			Expect(validators.IsBinaryContent(content)).To(BeTrue())
+		},
+		Entry("single null byte", "text\x00text"),
+		Entry("PNG header", "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"),
+		Entry("invalid UTF-8", "\xff\xfe\xfd\xfc\xfb\xfa"),
+		Entry("mostly control characters", "\x01\x02\x03\x04ab"),
+	)
+
+	It("inspects only the beginning of the content", func() {
+		content := strings.Repeat("a", 9000) + "\x00"
+		Expect(validators.IsBinaryContent(content)).To(BeFalse())
+	})
+})
@@ -0,0 +1,23 @@
+package elicitation
+
+import "github.com/smykla-skalski/klaudiush/internal/validator"
+
+func init() {
+	validator.RegisterReferences(
+		validator.ReferenceInfo{
+			Code:        validator.RefMCPServerBlocked.Code(),
+			Title:       "MCP server blocked",
+			Description: "MCP server is on the deny list.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefMCPServerNotAllowed.Code(),
+			Title:       "MCP server not allowed",
+			Description: "MCP server is not on the allow list.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefMCPURLModeBlocked.Code(),
+			Title:       "MCP URL mode blocked",
+			Description: "URL mode is blocked for MCP elicitation.",
+		},
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/smykla-skalski/klaudiush/internal/validators"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
 )
@@ -46,10 +47,25 @@ func (e *ContentExtractor) Extract(ctx *hook.Context, filePath string) (*Content
 
 	// Get content from context (Write operation)
 	content := ctx.ToolInput.Content
-	if content != "" {
-		return &ContentInfo{Content: content, IsFragment: false}, nil
+	if content == "" {
+		data, err := e.readFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		content = string(data)
 	}
 
+	if validators.IsBinaryContent(content) {
+		e.logger.Debug("binary content, skipping", "file", filePath)
+		return nil, errBinaryContent
+	}
+
+	return &ContentInfo{Content: content, IsFragment: false}, nil
+}
+
+// readFile reads the file being validated from disk.
+func (e *ContentExtractor) readFile(filePath string) ([]byte, error) {
 	// Check if file exists
 	if _, err := os.Stat(filePath); err != nil {
 		e.logger.Debug("file does not exist, skipping", "file", filePath)
@@ -63,7 +79,7 @@ func (e *ContentExtractor) Extract(ctx *hook.Context, filePath string) (*Content
 		return nil, err
 	}
 
-	return &ContentInfo{Content: string(data), IsFragment: false}, nil
+	return data, nil
 }
 
 // extractEditContent extracts content for Edit operations with surrounding context.
@@ -0,0 +1,52 @@
+package file
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/smykla-skalski/klaudiush/internal/linters"
+	"github.com/smykla-skalski/klaudiush/internal/validators"
+)
+
+// snippetIndent indents snippets under the finding they belong to.
+const snippetIndent = "  "
+
+// formatLintFindings formats linter findings as "file:line:col: message
+// (rule)", each followed by a snippet of content around its line with
+// contextLines lines before and after. Without parsed findings, the non-empty
+// lines of the raw output are returned.
+func formatLintFindings(result *linters.LintResult, content string, contextLines int) string {
+	if len(result.Findings) == 0 {
+		var cleanLines []string
+
+		for line := range strings.SplitSeq(result.RawOut, "\n") {
+			if strings.TrimSpace(line) != "" {
+				cleanLines = append(cleanLines, line)
+			}
+		}
+
+		return strings.Join(cleanLines, "\n")
+	}
+
+	lines := make([]string, 0, len(result.Findings))
+
+	for _, f := range result.Findings {
+		line := fmt.Sprintf("%s:%d:%d: %s", f.File, f.Line, f.Column, f.Message)
+		if f.Rule != "" {
+			line += " (" + f.Rule + ")"
+		}
+
+		lines = append(lines, line)
+
+		if snippet := validators.RenderSnippet(content, f.Line, contextLines); snippet != "" {
+			lines = append(lines, indentLines(snippet, snippetIndent))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// indentLines prefixes every line of text with indent.
+func indentLines(text, indent string) string {
+	return indent + strings.ReplaceAll(text, "\n", "\n"+indent)
+}
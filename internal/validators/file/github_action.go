@@ -98,7 +98,8 @@ func NewWorkflowValidator(
 	}
 }
 
-// Validate checks GitHub Actions workflow and composable action files for digest pinning and runs actionlint
+// Validate checks GitHub Actions workflow and composable action files for digest pinning
+// and runner labels, and runs actionlint
 func (v *WorkflowValidator) Validate(ctx context.Context, hookCtx *hook.Context) *validator.Result {
 	log := v.Logger()
 
@@ -138,6 +139,9 @@ func (v *WorkflowValidator) Validate(ctx context.Context, hookCtx *hook.Context)
 		}
 	}
 
+	// Check runs-on labels against the runner policy
+	runnerErrors := v.validateRunners(content)
+
 	// Run actionlint if enabled and available
 	if v.isUseActionlint() {
 		actionlintWarnings := v.runActionlint(ctx, content, filePath)
@@ -161,7 +165,7 @@ func (v *WorkflowValidator) Validate(ctx context.Context, hookCtx *hook.Context)
 			validator.RefActionlint,
 			allErrors[0],
 		).AddDetail("file", filepath.Base(filePath)).
-			AddDetail("errors", strings.Join(allErrors, "\n")).
+			AddDetail("errors", strings.Join(append(allErrors, runnerErrors...), "\n")).
 			AddDetail("help", `Requirements:
   - Use digest-pinned actions with version or branch comments:
     uses: actions/checkout@abc123... # v4.1.7
@@ -172,9 +176,31 @@ func (v *WorkflowValidator) Validate(ctx context.Context, hookCtx *hook.Context)
     uses: vendor/custom-action@v1`)
 	}
 
+	if len(runnerErrors) > 0 {
+		return v.buildRunnerResult(filePath, runnerErrors)
+	}
+
 	return validator.Pass()
 }
 
+// buildRunnerResult creates a blocking result for disallowed runs-on labels.
+func (v *WorkflowValidator) buildRunnerResult(
+	filePath string,
+	runnerErrors []string,
+) *validator.Result {
+	help := `Pin runs-on to specific runner labels:
+    runs-on: [self-hosted, linux, x64]`
+
+	if allowed := v.getAllowedRunners(); len(allowed) > 0 {
+		help += "\n\n  Allowed runners: " + strings.Join(allowed, ", ")
+	}
+
+	return validator.FailWithRef(validator.RefWorkflowRunner, runnerErrors[0]).
+		AddDetail("file", filepath.Base(filePath)).
+		AddDetail("errors", strings.Join(runnerErrors, "\n")).
+		AddDetail("help", help)
+}
+
 // isWorkflowFile checks if the file path is a GitHub Actions workflow or composable action
 func (*WorkflowValidator) isWorkflowFile(path string) bool {
 	ext := filepath.Ext(path)
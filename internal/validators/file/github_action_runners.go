@@ -0,0 +1,221 @@
+package file
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// matrixExprRegex matches a runs-on expression that selects a matrix value,
+// e.g. "${{ matrix.os }}".
+var matrixExprRegex = regexp.MustCompile(`^\$\{\{\s*matrix\.([A-Za-z0-9_-]+)\s*\}\}$`)
+
+// runnerLabel is a single runs-on label of a job
+type runnerLabel struct {
+	Job   string
+	Value string
+	Line  int
+}
+
+// validateRunners checks the runs-on labels of all jobs against the runner policy.
+// It returns nil when no runner policy is configured or the workflow cannot be parsed.
+func (v *WorkflowValidator) validateRunners(content string) []string {
+	allowed := v.getAllowedRunners()
+	blockLatest := v.isBlockLatestRunners()
+
+	if len(allowed) == 0 && !blockLatest {
+		return nil
+	}
+
+	labels, err := parseRunnerLabels(content)
+	if err != nil {
+		v.Logger().Debug("failed to parse workflow for runner validation", "error", err)
+		return nil
+	}
+
+	var errs []string
+
+	for _, label := range labels {
+		switch {
+		case blockLatest && strings.HasSuffix(label.Value, "-latest"):
+			errs = append(errs, fmt.Sprintf(
+				"Line %d: Job '%s' uses floating runner label '%s'",
+				label.Line, label.Job, label.Value,
+			))
+		case len(allowed) > 0 && !slices.Contains(allowed, label.Value):
+			errs = append(errs, fmt.Sprintf(
+				"Line %d: Job '%s' uses runner label '%s' not in allowed_runners",
+				label.Line, label.Job, label.Value,
+			))
+		}
+	}
+
+	return errs
+}
+
+// parseRunnerLabels extracts the runs-on labels of all jobs in a workflow.
+// Labels are read from string, array and {group, labels} forms. Matrix
+// expressions are resolved to the values in the job's strategy.matrix; other
+// expressions cannot be resolved before the run and are skipped.
+func parseRunnerLabels(content string) ([]runnerLabel, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, err
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	jobs := mappingValue(doc.Content[0], "jobs")
+	if jobs == nil || jobs.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	var labels []runnerLabel
+
+	for i := 0; i+1 < len(jobs.Content); i += 2 {
+		name, job := jobs.Content[i].Value, jobs.Content[i+1]
+
+		runsOn := mappingValue(job, "runs-on")
+		if runsOn == nil {
+			continue
+		}
+
+		for _, node := range runsOnLabelNodes(runsOn) {
+			for _, resolved := range resolveMatrixLabel(job, node) {
+				labels = append(labels, runnerLabel{
+					Job:   name,
+					Value: resolved.Value,
+					Line:  resolved.Line,
+				})
+			}
+		}
+	}
+
+	return labels, nil
+}
+
+// runsOnLabelNodes returns the scalar label nodes of a runs-on value.
+func runsOnLabelNodes(runsOn *yaml.Node) []*yaml.Node {
+	switch runsOn.Kind {
+	case yaml.ScalarNode:
+		return []*yaml.Node{runsOn}
+	case yaml.SequenceNode:
+		return scalarNodes(runsOn)
+	case yaml.MappingNode:
+		if labels := mappingValue(runsOn, "labels"); labels != nil {
+			return runsOnLabelNodes(labels)
+		}
+
+		return nil
+	default:
+		return nil
+	}
+}
+
+// resolveMatrixLabel resolves a "${{ matrix.key }}" label to the values of
+// key in the job's strategy.matrix, including matrix.include entries.
+// Plain labels are returned as is; other expressions resolve to nothing.
+func resolveMatrixLabel(job, label *yaml.Node) []*yaml.Node {
+	if !strings.Contains(label.Value, "${{") {
+		return []*yaml.Node{label}
+	}
+
+	matches := matrixExprRegex.FindStringSubmatch(strings.TrimSpace(label.Value))
+	if len(matches) < 2 {
+		return nil
+	}
+
+	matrix := mappingValue(mappingValue(job, "strategy"), "matrix")
+	if matrix == nil || matrix.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	key := matches[1]
+
+	var values []*yaml.Node
+
+	if value := mappingValue(matrix, key); value != nil {
+		values = append(values, matrixValueLabels(value)...)
+	}
+
+	if include := mappingValue(matrix, "include"); include != nil &&
+		include.Kind == yaml.SequenceNode {
+		for _, entry := range include.Content {
+			if value := mappingValue(entry, key); value != nil {
+				values = append(values, runsOnLabelNodes(value)...)
+			}
+		}
+	}
+
+	// Matrix values may themselves be expressions (e.g. fromJSON); skip those.
+	return slices.DeleteFunc(values, func(n *yaml.Node) bool {
+		return strings.Contains(n.Value, "${{")
+	})
+}
+
+// matrixValueLabels returns the labels of a matrix dimension. Each entry is
+// either a single label or an array of labels.
+func matrixValueLabels(value *yaml.Node) []*yaml.Node {
+	if value.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var labels []*yaml.Node
+
+	for _, entry := range value.Content {
+		labels = append(labels, runsOnLabelNodes(entry)...)
+	}
+
+	return labels
+}
+
+// scalarNodes returns the scalar items of a sequence node.
+func scalarNodes(seq *yaml.Node) []*yaml.Node {
+	var nodes []*yaml.Node
+
+	for _, item := range seq.Content {
+		if item.Kind == yaml.ScalarNode {
+			nodes = append(nodes, item)
+		}
+	}
+
+	return nodes
+}
+
+// mappingValue returns the value of key in a mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// getAllowedRunners returns the allowed runs-on labels, or nil if unrestricted
+func (v *WorkflowValidator) getAllowedRunners() []string {
+	if v.config != nil {
+		return v.config.AllowedRunners
+	}
+
+	return nil
+}
+
+// isBlockLatestRunners returns whether floating *-latest runner labels are blocked
+func (v *WorkflowValidator) isBlockLatestRunners() bool {
+	if v.config != nil && v.config.BlockLatestRunners != nil {
+		return *v.config.BlockLatestRunners
+	}
+
+	return false
+}
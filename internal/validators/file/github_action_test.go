@@ -10,7 +10,9 @@ import (
 	execpkg "github.com/smykla-skalski/klaudiush/internal/exec"
 	"github.com/smykla-skalski/klaudiush/internal/github"
 	"github.com/smykla-skalski/klaudiush/internal/linters"
+	validatorpkg "github.com/smykla-skalski/klaudiush/internal/validator"
 	"github.com/smykla-skalski/klaudiush/internal/validators/file"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
 )
@@ -805,5 +807,160 @@ jobs:
 				Expect(result.Details["errors"]).To(ContainSubstring("uses tag without digest"))
 			})
 		})
+
+		Context("runner label policy", func() {
+			var cfg *config.WorkflowValidatorConfig
+
+			BeforeEach(func() {
+				cfg = &config.WorkflowValidatorConfig{
+					UseActionlint:  new(false),
+					AllowedRunners: []string{"self-hosted", "linux", "x64", "arc-small"},
+				}
+			})
+
+			validate := func(content string) *validatorpkg.Result {
+				runner := execpkg.NewCommandRunner(10 * time.Second)
+				v := file.NewWorkflowValidator(
+					linters.NewActionLinter(runner),
+					&mockGitHubClient{},
+					log,
+					cfg,
+					nil,
+				)
+
+				return v.Validate(context.Background(), &hook.Context{
+					EventType: hook.EventTypePreToolUse,
+					ToolName:  hook.ToolTypeWrite,
+					ToolInput: hook.ToolInput{
+						FilePath: "/project/.github/workflows/test.yml",
+						Content:  content,
+					},
+				})
+			}
+
+			It("should pass when all labels are allowed", func() {
+				result := validate(`on: push
+jobs:
+  build:
+    runs-on: [self-hosted, linux, x64]
+  lint:
+    runs-on: arc-small
+`)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("should block a string label that is not allowed", func() {
+				result := validate(`on: push
+jobs:
+  build:
+    runs-on: windows-2022
+`)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(result.Reference).To(Equal(validatorpkg.RefWorkflowRunner))
+				Expect(result.Message).To(Equal(
+					"Line 4: Job 'build' uses runner label 'windows-2022' not in allowed_runners",
+				))
+				Expect(result.Details["help"]).To(ContainSubstring("self-hosted, linux, x64, arc-small"))
+			})
+
+			It("should block array labels that are not allowed", func() {
+				result := validate(`on: push
+jobs:
+  build:
+    runs-on:
+      - self-hosted
+      - gpu
+`)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("Line 6: Job 'build'"))
+				Expect(result.Message).To(ContainSubstring("'gpu'"))
+			})
+
+			It("should read labels from the group form", func() {
+				result := validate(`on: push
+jobs:
+  build:
+    runs-on:
+      group: private
+      labels: [self-hosted, arm64]
+`)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("'arm64'"))
+			})
+
+			It("should resolve matrix expressions", func() {
+				result := validate(`on: push
+jobs:
+  test:
+    strategy:
+      matrix:
+        os: [arc-small, [self-hosted, linux]]
+        include:
+          - os: macos-14
+    runs-on: ${{ matrix.os }}
+`)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Details["errors"]).To(Equal(
+					"Line 8: Job 'test' uses runner label 'macos-14' not in allowed_runners",
+				))
+			})
+
+			It("should skip expressions that cannot be resolved", func() {
+				result := validate(`on: workflow_call
+jobs:
+  build:
+    runs-on: ${{ inputs.runner }}
+  test:
+    strategy:
+      matrix: ${{ fromJSON(needs.setup.outputs.matrix) }}
+    runs-on: ${{ matrix.os }}
+`)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("should block floating latest labels", func() {
+				cfg.AllowedRunners = nil
+				cfg.BlockLatestRunners = new(true)
+
+				result := validate(`on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+  test:
+    runs-on: ubuntu-24.04
+`)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(Equal(
+					"Line 4: Job 'build' uses floating runner label 'ubuntu-latest'",
+				))
+				Expect(result.Details["help"]).NotTo(ContainSubstring("Allowed runners"))
+			})
+
+			It("should ignore runner labels without a policy", func() {
+				cfg.AllowedRunners = nil
+
+				result := validate(`on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+`)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("should include runner errors in digest pinning failures", func() {
+				result := validate(`on: push
+jobs:
+  build:
+    runs-on: windows-2022
+    steps:
+      - uses: actions/checkout@v4
+`)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Reference).To(Equal(validatorpkg.RefActionlint))
+				Expect(result.Details["errors"]).To(ContainSubstring("uses tag without digest"))
+				Expect(result.Details["errors"]).To(ContainSubstring("'windows-2022'"))
+			})
+		})
 	})
 })
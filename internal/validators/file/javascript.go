@@ -2,8 +2,6 @@ package file
 
 import (
 	"context"
-	"fmt"
-	"strings"
 	"time"
 
 	"github.com/smykla-skalski/klaudiush/internal/linters"
@@ -96,7 +94,10 @@ func (v *JavaScriptValidator) Validate(
 
 	log.Debug("oxlint failed", "output", result.RawOut)
 
-	return validator.FailWithRef(validator.RefOxlintCheck, v.formatOxlintOutput(result))
+	return validator.FailWithRef(
+		validator.RefOxlintCheck,
+		formatLintFindings(result, ci.Content, v.getContextLines()),
+	)
 }
 
 // extractContent creates a ContentExtractor and extracts content from the hook context.
@@ -107,40 +108,6 @@ func (v *JavaScriptValidator) extractContent(
 	return NewContentExtractor(v.Logger(), v.getContextLines()).Extract(ctx, filePath)
 }
 
-// formatOxlintOutput formats oxlint findings into human-readable text.
-//
-//nolint:dupl // Same display logic as formatRuffOutput, not worth abstracting
-func (*JavaScriptValidator) formatOxlintOutput(result *linters.LintResult) string {
-	if len(result.Findings) == 0 {
-		// Fallback to raw output if no findings parsed
-		lines := strings.Split(result.RawOut, "\n")
-
-		var cleanLines []string
-
-		for _, line := range lines {
-			if strings.TrimSpace(line) != "" {
-				cleanLines = append(cleanLines, line)
-			}
-		}
-
-		return strings.Join(cleanLines, "\n")
-	}
-
-	lines := make([]string, 0, len(result.Findings))
-
-	for _, f := range result.Findings {
-		// Format: file:line:col: message (rule)
-		line := fmt.Sprintf("%s:%d:%d: %s", f.File, f.Line, f.Column, f.Message)
-		if f.Rule != "" {
-			line += " (" + f.Rule + ")"
-		}
-
-		lines = append(lines, line)
-	}
-
-	return strings.Join(lines, "\n")
-}
-
 // buildOxlintOptions creates OxlintCheckOptions with excludes from config and fragment-specific rules.
 func (v *JavaScriptValidator) buildOxlintOptions(isFragment bool) *linters.OxlintCheckOptions {
 	var (
@@ -152,6 +152,11 @@ func (*LinterIgnoreValidator) getContent(hookCtx *hook.Context) string {
 		return ""
 	}
 
+	// For MultiEdit operations, check the new strings of all edits
+	if hookCtx.ToolName == hook.ToolTypeMultiEdit {
+		return hookCtx.GetContent()
+	}
+
 	return ""
 }
 
@@ -345,6 +345,30 @@ fn unused() {}
 			})
 		})
 
+		Context("MultiEdit operations", func() {
+			BeforeEach(func() {
+				ctx.ToolName = hook.ToolTypeMultiEdit
+			})
+
+			It("checks new_string of every edit", func() {
+				ctx.ToolInput.Edits = []hook.Edit{
+					{OldString: `x = 1`, NewString: `x = 2`},
+					{OldString: `y = 1`, NewString: `y = 1  # type: ignore`},
+				}
+				result := v.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("# type: ignore"))
+			})
+
+			It("passes when all edits are clean", func() {
+				ctx.ToolInput.Edits = []hook.Edit{
+					{OldString: `x = 1  # noqa`, NewString: `x = 1`},
+				}
+				result := v.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+		})
+
 		Context("multiple violations", func() {
 			It("reports all violations", func() {
 				ctx.ToolInput.Content = `
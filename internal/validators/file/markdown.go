@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,9 +29,14 @@ const (
 	defaultContextLines = 2
 )
 
+// markdownWarningLineRegex matches the line number of a built-in markdown
+// warning.
+var markdownWarningLineRegex = regexp.MustCompile(`^Line (\d+):`)
+
 var (
 	errFileValidationNotImpl = errors.New("file-based validation not implemented")
 	errNoContent             = errors.New("no content found")
+	errBinaryContent         = errors.New("binary content")
 )
 
 // MarkdownValidator validates Markdown formatting rules
@@ -141,10 +148,27 @@ func (v *MarkdownValidator) Validate(ctx context.Context, hookCtx *hook.Context)
 		return validator.Pass()
 	}
 
+	// Front matter can only be judged on whole files, not edit fragments
+	var frontMatterWarning *validator.Result
+
+	if hookCtx.ToolInput.Content != "" {
+		if r := v.checkFrontMatter(hookCtx.ToolInput.Content); r != nil {
+			if r.ShouldBlock {
+				return r
+			}
+
+			frontMatterWarning = r
+		}
+	}
+
 	// Skip if markdownlint is disabled
 	if !v.isUseMarkdownlint() {
 		log.Debug("markdownlint is disabled, skipping validation")
-		return validator.Pass()
+		return passOrWarning(frontMatterWarning)
+	}
+
+	if hookCtx.EventType == hook.EventTypePreToolUse && hookCtx.ToolName == hook.ToolTypeMultiEdit {
+		return v.validateMultiEdit(ctx, hookCtx)
 	}
 
 	content, initialState, err := v.getContentWithState(hookCtx)
@@ -154,27 +178,105 @@ func (v *MarkdownValidator) Validate(ctx context.Context, hookCtx *hook.Context)
 	}
 
 	if content == "" {
-		return validator.Pass()
+		return passOrWarning(frontMatterWarning)
 	}
 
-	timeout := v.getTimeout()
+	result := v.lint(ctx, hookCtx, content, initialState)
 
-	lintCtx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
+	if !result.Success {
+		return v.buildBlockingResult(result, content)
+	}
+
+	// No blocking errors - check for cosmetic table warnings
+	if len(result.CosmeticTableWarnings) > 0 {
+		r := v.buildCosmeticResult(result, content)
+		if frontMatterWarning != nil {
+			r.AddDetail("front_matter", frontMatterWarning.Message)
+		}
+
+		return r
+	}
+
+	return passOrWarning(frontMatterWarning)
+}
+
+// validateMultiEdit lints the fragment around each edit of a MultiEdit operation.
+// Edits are applied in order, so each fragment is extracted from the content
+// the edit actually replaces.
+func (v *MarkdownValidator) validateMultiEdit(
+	ctx context.Context,
+	hookCtx *hook.Context,
+) *validator.Result {
+	log := v.Logger()
 
-	// Get the file path for error reporting
 	filePath := hookCtx.GetFilePath()
-	displayPath := getDisplayPath(filePath)
+	if filePath == "" || len(hookCtx.ToolInput.Edits) == 0 {
+		return validator.Pass()
+	}
+
+	//nolint:gosec // filePath is from Claude Code tool context, not user input
+	originalContent, err := os.ReadFile(filePath)
+	if err != nil {
+		log.Debug("failed to read file for multi-edit validation", "file", filePath, "error", err)
+		return validator.Pass()
+	}
 
-	result := v.linter.LintWithPath(lintCtx, content, initialState, displayPath)
+	current := string(originalContent)
 
-	if !result.Success {
-		return v.buildBlockingResult(result)
+	var cosmetic *validator.Result
+
+	for _, edit := range hookCtx.ToolInput.Edits {
+		if edit.OldString == "" {
+			continue
+		}
+
+		if edit.NewString != "" {
+			fragment, state := v.editFragment(current, edit.OldString, edit.NewString)
+			if fragment != "" {
+				result := v.lint(ctx, hookCtx, fragment, state)
+				if !result.Success {
+					return v.buildBlockingResult(result, fragment)
+				}
+
+				if cosmetic == nil && len(result.CosmeticTableWarnings) > 0 {
+					cosmetic = v.buildCosmeticResult(result, fragment)
+					if cosmetic.ShouldBlock {
+						return cosmetic
+					}
+				}
+			}
+		}
+
+		if edit.ReplaceAll {
+			current = strings.ReplaceAll(current, edit.OldString, edit.NewString)
+		} else {
+			current = strings.Replace(current, edit.OldString, edit.NewString, 1)
+		}
 	}
 
-	// No blocking errors - check for cosmetic table warnings
-	if len(result.CosmeticTableWarnings) > 0 {
-		return v.buildCosmeticResult(result)
+	return passOrWarning(cosmetic)
+}
+
+// lint runs the markdown linter on content with the configured timeout.
+func (v *MarkdownValidator) lint(
+	ctx context.Context,
+	hookCtx *hook.Context,
+	content string,
+	initialState *validators.MarkdownState,
+) *linters.LintResult {
+	lintCtx, cancel := context.WithTimeout(ctx, v.getTimeout())
+	defer cancel()
+
+	// Get the file path for error reporting
+	displayPath := getDisplayPath(hookCtx.GetFilePath())
+
+	return v.linter.LintWithPath(lintCtx, content, initialState, displayPath)
+}
+
+// passOrWarning returns warning if set, otherwise a passing result.
+func passOrWarning(warning *validator.Result) *validator.Result {
+	if warning != nil {
+		return warning
 	}
 
 	return validator.Pass()
@@ -188,6 +290,10 @@ func (v *MarkdownValidator) getContentWithState(
 
 	// Try to get content from tool input (Write operation)
 	if ctx.ToolInput.Content != "" {
+		if validators.IsBinaryContent(ctx.ToolInput.Content) {
+			return "", nil, errBinaryContent
+		}
+
 		return ctx.ToolInput.Content, nil, nil
 	}
 
@@ -215,47 +321,14 @@ func (v *MarkdownValidator) getContentWithState(
 			return "", nil, err
 		}
 
-		// Extract fragment with context lines around the edit
-		contextLines := v.getContextLines()
-
-		result := ExtractEditFragmentWithRange(
-			string(originalContent),
-			oldStr,
-			newStr,
-			contextLines,
-			log,
-		)
-		if result.Content == "" {
-			log.Debug("could not extract edit fragment, skipping validation")
+		fragment, state := v.editFragment(string(originalContent), oldStr, newStr)
+		if fragment == "" {
 			return "", nil, errNoContent
 		}
 
-		fragment := result.Content
-
-		// Detect markdown state at fragment start
-		fragmentStartLine := getFragmentStartLine(string(originalContent), oldStr, contextLines)
-		state := validators.DetectMarkdownState(string(originalContent), fragmentStartLine)
-		state.StartLine = fragmentStartLine
-		state.EditRange = result.EditRange
-
-		// Determine if the NEW content (new_string) reaches end of file.
-		// We check if old_string is at or near EOF in the original content.
-		// If old_string ends at EOF, then new_string (which replaces it) also ends at EOF.
-		state.EndsAtEOF = EditReachesEOF(string(originalContent), oldStr)
-
-		log.Debug("fragment initial state",
-			"start_line", fragmentStartLine,
-			"in_code_block", state.InCodeBlock,
-			"ends_at_eof", state.EndsAtEOF,
-			"in_list", state.InList,
-			"list_depth", state.ListItemDepth,
-			"list_stack_len", len(state.ListStack),
-			"last_heading_level", state.LastHeadingLevel,
-		)
-
 		log.Debug("validating edit fragment with context")
 
-		return fragment, &state, nil
+		return fragment, state, nil
 	}
 
 	// Try to get from file path (Edit or PostToolUse)
@@ -269,13 +342,60 @@ func (v *MarkdownValidator) getContentWithState(
 	return "", nil, errNoContent
 }
 
-// buildBlockingResult creates a blocking (FailWithRef) result from lint output.
-func (*MarkdownValidator) buildBlockingResult(result *linters.LintResult) *validator.Result {
+// editFragment extracts the edited fragment with context lines from original
+// and detects the markdown state at its start. It returns an empty fragment
+// when old_string cannot be located.
+func (v *MarkdownValidator) editFragment(
+	original, oldStr, newStr string,
+) (string, *validators.MarkdownState) {
+	log := v.Logger()
+
+	// Extract fragment with context lines around the edit
+	contextLines := v.getContextLines()
+
+	result := ExtractEditFragmentWithRange(original, oldStr, newStr, contextLines, log)
+	if result.Content == "" {
+		log.Debug("could not extract edit fragment, skipping validation")
+		return "", nil
+	}
+
+	// Detect markdown state at fragment start
+	fragmentStartLine := getFragmentStartLine(original, oldStr, contextLines)
+	state := validators.DetectMarkdownState(original, fragmentStartLine)
+	state.StartLine = fragmentStartLine
+	state.EditRange = result.EditRange
+
+	// Determine if the NEW content (new_string) reaches end of file.
+	// We check if old_string is at or near EOF in the original content.
+	// If old_string ends at EOF, then new_string (which replaces it) also ends at EOF.
+	state.EndsAtEOF = EditReachesEOF(original, oldStr)
+
+	log.Debug("fragment initial state",
+		"start_line", fragmentStartLine,
+		"in_code_block", state.InCodeBlock,
+		"ends_at_eof", state.EndsAtEOF,
+		"in_list", state.InList,
+		"list_depth", state.ListItemDepth,
+		"list_stack_len", len(state.ListStack),
+		"last_heading_level", state.LastHeadingLevel,
+	)
+
+	return result.Content, &state
+}
+
+// buildBlockingResult creates a blocking (FailWithRef) result from the lint
+// output for content.
+func (v *MarkdownValidator) buildBlockingResult(
+	result *linters.LintResult,
+	content string,
+) *validator.Result {
 	message := buildSpecificMessage(result.RawOut)
 
 	r := validator.FailWithRef(validator.RefMarkdownLint, message).
 		AddDetail("errors", strings.TrimSpace(result.RawOut))
 
+	v.attachSnippets(r, result.RawOut, content)
+
 	// Include table suggestions from structural issues
 	attachFirstSuggestion(r, result.TableSuggested)
 
@@ -285,9 +405,12 @@ func (*MarkdownValidator) buildBlockingResult(result *linters.LintResult) *valid
 	return r
 }
 
-// buildCosmeticResult creates a result for cosmetic-only table warnings.
-// Returns a blocking or warning result depending on config.
-func (v *MarkdownValidator) buildCosmeticResult(result *linters.LintResult) *validator.Result {
+// buildCosmeticResult creates a result for cosmetic-only table warnings in
+// content. Returns a blocking or warning result depending on config.
+func (v *MarkdownValidator) buildCosmeticResult(
+	result *linters.LintResult,
+	content string,
+) *validator.Result {
 	errText := strings.Join(result.CosmeticTableWarnings, "\n")
 	message := buildSpecificMessage(errText)
 
@@ -300,11 +423,42 @@ func (v *MarkdownValidator) buildCosmeticResult(result *linters.LintResult) *val
 	}
 
 	r = r.AddDetail("errors", errText)
+	v.attachSnippets(r, errText, content)
 	attachFirstSuggestion(r, result.CosmeticTableSuggested)
 
 	return r
 }
 
+// attachSnippets adds a snippet of content around the line of each built-in
+// warning in output, with the configured context lines, as the "snippet"
+// detail. Warnings of markdownlint carry their own location and get none.
+func (v *MarkdownValidator) attachSnippets(r *validator.Result, output, content string) {
+	var snippets []string
+
+	for line := range strings.SplitSeq(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		matches := markdownWarningLineRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		lineNum, err := strconv.Atoi(matches[1])
+		if err != nil {
+			continue
+		}
+
+		snippet := validators.RenderSnippet(content, lineNum, v.getContextLines())
+		if snippet != "" {
+			snippets = append(snippets, line+"\n"+indentLines(snippet, snippetIndent))
+		}
+	}
+
+	if len(snippets) > 0 {
+		r.AddDetail("snippet", strings.Join(snippets, "\n\n"))
+	}
+}
+
 // attachFirstSuggestion adds the first table suggestion from the map to the result.
 func attachFirstSuggestion(r *validator.Result, suggestions map[int]string) {
 	for lineNum, suggestion := range suggestions {
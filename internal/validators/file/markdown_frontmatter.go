@@ -0,0 +1,145 @@
+package file
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+)
+
+var errFrontMatterNotMapping = errors.New("front matter must be a mapping of keys to values")
+
+// frontMatterFence is the line that opens and closes a YAML front matter block.
+const frontMatterFence = "---"
+
+// extractFrontMatter returns the YAML between the leading "---" fence and the
+// closing "---" (or "...") line. found is false when the content does not
+// start with a front matter block.
+func extractFrontMatter(content string) (frontMatter string, found, closed bool) {
+	content = strings.TrimPrefix(content, "\ufeff")
+
+	firstLine, rest, _ := strings.Cut(content, "\n")
+	if strings.TrimRight(firstLine, " \t\r") != frontMatterFence {
+		return "", false, false
+	}
+
+	var lines []string
+
+	for line := range strings.SplitSeq(rest, "\n") {
+		trimmed := strings.TrimRight(line, " \t\r")
+		if trimmed == frontMatterFence || trimmed == "..." {
+			return strings.Join(lines, "\n"), true, true
+		}
+
+		lines = append(lines, line)
+	}
+
+	return "", true, false
+}
+
+// parseFrontMatter decodes front matter YAML, which must be a mapping.
+// Empty front matter decodes to an empty map.
+func parseFrontMatter(frontMatter string) (map[string]any, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(frontMatter), &doc); err != nil {
+		return nil, errors.New(strings.TrimPrefix(err.Error(), "yaml: "))
+	}
+
+	fields := map[string]any{}
+
+	if len(doc.Content) == 0 {
+		return fields, nil
+	}
+
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errFrontMatterNotMapping
+	}
+
+	if err := doc.Content[0].Decode(&fields); err != nil {
+		return nil, errors.New(strings.TrimPrefix(err.Error(), "yaml: "))
+	}
+
+	return fields, nil
+}
+
+// checkFrontMatter validates the front matter of a whole markdown file.
+// It returns nil when the content passes or no front matter checks are configured.
+func (v *MarkdownValidator) checkFrontMatter(content string) *validator.Result {
+	required := v.isRequireFrontMatter()
+	keys := v.getRequiredFrontMatterKeys()
+
+	if !required && len(keys) == 0 {
+		return nil
+	}
+
+	frontMatter, found, closed := extractFrontMatter(content)
+	if !found {
+		if required {
+			return validator.FailWithRef(
+				validator.RefMarkdownFrontMatter,
+				"Missing YAML front matter: the file must start with a '---' fenced block",
+			)
+		}
+
+		return nil
+	}
+
+	if !closed {
+		return v.malformedFrontMatter("front matter is not closed by a '---' line")
+	}
+
+	fields, err := parseFrontMatter(frontMatter)
+	if err != nil {
+		return v.malformedFrontMatter(err.Error())
+	}
+
+	var missing []string
+
+	for _, key := range keys {
+		if value, ok := fields[key]; !ok || value == nil {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return validator.WarnWithRef(
+		validator.RefMarkdownFrontMatter,
+		"Front matter is missing required keys: "+strings.Join(missing, ", "),
+	)
+}
+
+// malformedFrontMatter reports front matter that is not a valid YAML mapping.
+// It blocks only when front matter is required.
+func (v *MarkdownValidator) malformedFrontMatter(reason string) *validator.Result {
+	message := fmt.Sprintf("Malformed YAML front matter: %s", reason)
+
+	if v.isRequireFrontMatter() {
+		return validator.FailWithRef(validator.RefMarkdownFrontMatter, message)
+	}
+
+	return validator.WarnWithRef(validator.RefMarkdownFrontMatter, message)
+}
+
+// isRequireFrontMatter returns whether markdown files must have front matter
+func (v *MarkdownValidator) isRequireFrontMatter() bool {
+	if v.config != nil && v.config.RequireFrontMatter != nil {
+		return *v.config.RequireFrontMatter
+	}
+
+	return false
+}
+
+// getRequiredFrontMatterKeys returns the keys the front matter must define
+func (v *MarkdownValidator) getRequiredFrontMatterKeys() []string {
+	if v.config != nil {
+		return v.config.RequiredFrontMatterKeys
+	}
+
+	return nil
+}
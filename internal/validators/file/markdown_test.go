@@ -2,6 +2,8 @@ package file_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 
 	execpkg "github.com/smykla-skalski/klaudiush/internal/exec"
 	"github.com/smykla-skalski/klaudiush/internal/linters"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
 	"github.com/smykla-skalski/klaudiush/internal/validators/file"
 	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
@@ -136,6 +139,34 @@ code
 				).To(ContainSubstring("Line 2: Code block should have empty line before it"))
 			})
 
+			It("includes a snippet around the reported line", func() {
+				ctx.ToolInput.Content = "# Title\n\nSome text\n```bash\ncode\n```\n"
+				result := v.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Details["snippet"]).To(Equal(
+					"Line 4: Code block should have empty line before it\n" +
+						"    2 |\n" +
+						"    3 | Some text\n" +
+						"  > 4 | ```bash\n" +
+						"    5 | code\n" +
+						"    6 | ```",
+				))
+			})
+
+			It("uses the configured number of context lines in snippets", func() {
+				contextLines := 0
+				v = file.NewMarkdownValidator(
+					&config.MarkdownValidatorConfig{ContextLines: &contextLines},
+					linters.NewMarkdownLinter(execpkg.NewCommandRunner(10*time.Second)),
+					logger.NewNoOpLogger(),
+					nil,
+				)
+
+				ctx.ToolInput.Content = "Some text\n```bash\ncode\n```\n"
+				result := v.Validate(context.Background(), ctx)
+				Expect(result.Details["snippet"]).To(HaveSuffix("\n  > 2 | ```bash"))
+			})
+
 			It("passes when code block has empty line before", func() {
 				content := `Some text
 
@@ -347,6 +378,63 @@ code
 			})
 		})
 
+		Context("MultiEdit operations", func() {
+			var filePath string
+
+			BeforeEach(func() {
+				filePath = filepath.Join(GinkgoT().TempDir(), "doc.md")
+				Expect(os.WriteFile(
+					filePath,
+					[]byte("# Title\n\nFirst paragraph.\n\n## Section\n\nSecond paragraph.\n"),
+					0o600,
+				)).To(Succeed())
+
+				ctx.ToolName = hook.ToolTypeMultiEdit
+				ctx.ToolInput.FilePath = filePath
+			})
+
+			It("passes when all edits are valid", func() {
+				ctx.ToolInput.Edits = []hook.Edit{
+					{OldString: "First paragraph.", NewString: "Updated first paragraph."},
+					{OldString: "Second paragraph.", NewString: "Updated second paragraph."},
+				}
+				result := v.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("fails when a later edit breaks formatting", func() {
+				ctx.ToolInput.Edits = []hook.Edit{
+					{OldString: "First paragraph.", NewString: "Updated first paragraph."},
+					{OldString: "## Section\n\n", NewString: "## Section\n"},
+				}
+				result := v.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(
+					result.Details["errors"],
+				).To(ContainSubstring("Header should have empty line after it"))
+			})
+
+			It("locates edits that depend on earlier edits", func() {
+				ctx.ToolInput.Edits = []hook.Edit{
+					{OldString: "First paragraph.", NewString: "Intro paragraph."},
+					{OldString: "# Title\n\nIntro", NewString: "# Title\nIntro"},
+				}
+				result := v.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeTrue())
+			})
+
+			It("passes when the file cannot be read", func() {
+				ctx.ToolInput.FilePath = filepath.Join(filepath.Dir(filePath), "missing.md")
+				ctx.ToolInput.Edits = []hook.Edit{
+					{OldString: "## Section\n\n", NewString: "## Section\n"},
+				}
+				result := v.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+		})
+
 		Context("complex scenarios", func() {
 			It("handles mixed formatting issues", func() {
 				content := `# Title
@@ -694,6 +782,141 @@ code
 			})
 		})
 
+		Context("front matter validation", func() {
+			newFrontMatterValidator := func(required bool, keys ...string) *file.MarkdownValidator {
+				runner := execpkg.NewCommandRunner(10 * time.Second)
+				linter := linters.NewMarkdownLinter(runner)
+				cfg := &config.MarkdownValidatorConfig{
+					RequireFrontMatter:      &required,
+					RequiredFrontMatterKeys: keys,
+				}
+
+				return file.NewMarkdownValidator(cfg, linter, logger.NewNoOpLogger(), nil)
+			}
+
+			It("passes with all required keys", func() {
+				ctx.ToolInput.Content = `---
+title: Getting started
+description: Install and configure
+---
+
+# Getting started
+`
+				result := newFrontMatterValidator(true, "title", "description").
+					Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("passes without front matter when not required", func() {
+				ctx.ToolInput.Content = "# Title\n\nText.\n"
+				result := newFrontMatterValidator(false, "title").
+					Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("blocks without front matter when required", func() {
+				ctx.ToolInput.Content = "# Title\n\nText.\n"
+				result := newFrontMatterValidator(true).Validate(context.Background(), ctx)
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(result.Reference).To(Equal(validator.RefMarkdownFrontMatter))
+				Expect(result.Message).To(ContainSubstring("Missing YAML front matter"))
+			})
+
+			It("warns on missing and null keys", func() {
+				ctx.ToolInput.Content = `---
+title: Getting started
+owner:
+---
+
+# Getting started
+`
+				result := newFrontMatterValidator(true, "title", "description", "owner").
+					Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeFalse())
+				Expect(result.Reference).To(Equal(validator.RefMarkdownFrontMatter))
+				Expect(result.Message).To(Equal(
+					"Front matter is missing required keys: description, owner",
+				))
+			})
+
+			It("accepts a closing '...' fence", func() {
+				ctx.ToolInput.Content = "---\ntitle: Doc\n...\n\n# Doc\n"
+				result := newFrontMatterValidator(true, "title").
+					Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("blocks malformed YAML when front matter is required", func() {
+				ctx.ToolInput.Content = "---\ntitle: [unclosed\n---\n\n# Doc\n"
+				result := newFrontMatterValidator(true, "title").
+					Validate(context.Background(), ctx)
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(result.Message).To(HavePrefix("Malformed YAML front matter:"))
+			})
+
+			It("warns on malformed YAML when front matter is not required", func() {
+				ctx.ToolInput.Content = "---\ntitle: a: b\n---\n\n# Doc\n"
+				result := newFrontMatterValidator(false, "title").
+					Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeFalse())
+				Expect(result.Message).To(HavePrefix("Malformed YAML front matter:"))
+			})
+
+			It("reports front matter that is not a mapping", func() {
+				ctx.ToolInput.Content = "---\n- title\n---\n\n# Doc\n"
+				result := newFrontMatterValidator(true).Validate(context.Background(), ctx)
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(result.Message).To(ContainSubstring("must be a mapping"))
+			})
+
+			It("reports an unclosed front matter block", func() {
+				ctx.ToolInput.Content = "---\ntitle: Doc\n\n# Doc\n"
+				result := newFrontMatterValidator(true).Validate(context.Background(), ctx)
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(result.Message).To(ContainSubstring("not closed"))
+			})
+
+			It("checks front matter when markdownlint is disabled", func() {
+				useMarkdownlint := false
+				required := true
+				cfg := &config.MarkdownValidatorConfig{
+					UseMarkdownlint:         &useMarkdownlint,
+					RequireFrontMatter:      &required,
+					RequiredFrontMatterKeys: []string{"title"},
+				}
+				runner := execpkg.NewCommandRunner(10 * time.Second)
+				vNoLint := file.NewMarkdownValidator(
+					cfg, linters.NewMarkdownLinter(runner), logger.NewNoOpLogger(), nil,
+				)
+
+				ctx.ToolInput.Content = "---\ndescription: Doc\n---\n\n# Doc\n"
+				result := vNoLint.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("title"))
+			})
+
+			It("keeps the front matter warning alongside table warnings", func() {
+				ctx.ToolInput.Content = `---
+description: Doc
+---
+
+# Test
+
+| Name | Age |
+| ---- | --- |
+| John | 30  |
+`
+				result := newFrontMatterValidator(false, "title").
+					Validate(context.Background(), ctx)
+				Expect(result.ShouldBlock).To(BeFalse())
+				Expect(result.Reference).To(Equal(validator.RefMarkdownLint))
+				Expect(result.Details["front_matter"]).To(ContainSubstring("title"))
+			})
+		})
+
 		Context("specific error messages", func() {
 			It("returns specific message instead of generic text", func() {
 				content := `# Header
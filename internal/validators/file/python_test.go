@@ -129,6 +129,11 @@ print("Hello, World!")
 			result := v.Validate(context.Background(), ctx)
 			Expect(result.Passed).To(BeFalse())
 			Expect(result.Message).To(Not(BeEmpty()))
+			Expect(result.Message).To(Equal(
+				"test.py:2:11: undefined name 'undefined_var' (F821)\n" +
+					"    1 | def hello():\n" +
+					"  > 2 |     print(undefined_var)",
+			))
 		})
 	})
 
@@ -0,0 +1,82 @@
+package file
+
+import "github.com/smykla-skalski/klaudiush/internal/validator"
+
+func init() {
+	validator.RegisterReferences(
+		validator.ReferenceInfo{
+			Code:        validator.RefShellcheck.Code(),
+			Title:       "Shellcheck validation failed",
+			Description: "Shell script failed shellcheck static analysis.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefTerraformFmt.Code(),
+			Title: "Terraform format validation failed",
+			Description: "Terraform/OpenTofu file has formatting issues detected by `terraform " +
+				"fmt` or `tofu fmt`.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefTflint.Code(),
+			Title:       "TFLint validation failed",
+			Description: "tflint found issues in a Terraform/OpenTofu file.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefActionlint.Code(),
+			Title: "Actionlint validation failed",
+			Description: "actionlint or digest pinning validation found problems in a GitHub " +
+				"Actions workflow file.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefMarkdownLint.Code(),
+			Title:       "Markdown lint validation failed",
+			Description: "Markdown file has formatting issues that may affect rendering.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGofumpt.Code(),
+			Title:       "Gofumpt formatting failure",
+			Description: "Go code has formatting issues detected by gofumpt.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefRuffCheck.Code(),
+			Title:       "Ruff Python validation failure",
+			Description: "Python code has linting issues detected by ruff.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefOxlintCheck.Code(),
+			Title:       "Oxlint JavaScript/TypeScript validation failure",
+			Description: "JavaScript or TypeScript code has linting issues detected by oxlint.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefRustfmtCheck.Code(),
+			Title:       "Rustfmt formatting failure",
+			Description: "Rust code has formatting issues detected by rustfmt.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefLinterIgnore.Code(),
+			Title: "Linter ignore directives detected",
+			Description: "Code being written or edited contains linter ignore/suppress " +
+				"directives (e.g., `# noqa`, `// eslint-disable`, `//nolint`, `#[allow(...)]`).",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefTerraformVersions.Code(),
+			Title: "Missing Terraform version constraints",
+			Description: "A Terraform/OpenTofu file that defines a `terraform` or `provider` " +
+				"block is missing a `required_version` constraint, or a provider it uses has no " +
+				"version constraint in `required_providers`.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefMarkdownFrontMatter.Code(),
+			Title: "Missing or invalid markdown front matter",
+			Description: "A Markdown file written with front matter checks enabled does not " +
+				"start with a YAML front matter block, the block is not valid YAML, or it does " +
+				"not define all required keys.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefWorkflowRunner.Code(),
+			Title: "Workflow job uses a disallowed runner label",
+			Description: "A job in a GitHub Actions workflow sets `runs-on` to a label that is " +
+				"not in `allowed_runners`, or to a floating `*-latest` label while " +
+				"`block_latest_runners` is enabled.",
+		},
+	)
+}
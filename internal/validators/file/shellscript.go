@@ -101,7 +101,10 @@ func (v *ShellScriptValidator) Validate(
 
 	log.Debug("shellcheck failed", "output", result.RawOut)
 
-	return validator.FailWithRef(validator.RefShellcheck, v.formatShellCheckOutput(result.RawOut))
+	return validator.FailWithRef(
+		validator.RefShellcheck,
+		v.formatShellCheckOutput(result, ci.Content),
+	)
 }
 
 // extractContent extracts shell script content from the hook context, with
@@ -206,23 +209,18 @@ func (*ShellScriptValidator) isFishScript(filePath, content string) bool {
 	return false
 }
 
-// formatShellCheckOutput formats shellcheck output for display.
-func (*ShellScriptValidator) formatShellCheckOutput(output string) string {
-	lines := strings.Split(output, "\n")
-
-	var cleanLines []string
-
-	for _, line := range lines {
-		if strings.TrimSpace(line) != "" {
-			cleanLines = append(cleanLines, line)
-		}
-	}
-
-	if len(cleanLines) == 0 {
+// formatShellCheckOutput formats shellcheck findings for display, with a
+// snippet of content around each reported line.
+func (v *ShellScriptValidator) formatShellCheckOutput(
+	result *linters.LintResult,
+	content string,
+) string {
+	output := formatLintFindings(result, content, v.getContextLines())
+	if output == "" {
 		return "Shellcheck validation failed"
 	}
 
-	return strings.Join(cleanLines, "\n")
+	return output
 }
 
 // buildShellCheckOptions creates ShellCheckOptions with excludes from config and fragment-specific rules.
@@ -8,6 +8,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
 
 	execpkg "github.com/smykla-skalski/klaudiush/internal/exec"
 	"github.com/smykla-skalski/klaudiush/internal/linters"
@@ -441,4 +442,47 @@ echo $VAR
 			Expect(result.Passed).To(BeTrue())
 		})
 	})
+
+	Describe("findings", func() {
+		It("should list each finding with a snippet of the script", func() {
+			checker := linters.NewMockShellChecker(gomock.NewController(GinkgoT()))
+			contextLines := 1
+			validator := file.NewShellScriptValidator(
+				logger.NewNoOpLogger(),
+				checker,
+				&config.ShellScriptValidatorConfig{ContextLines: &contextLines},
+				nil,
+			)
+
+			checker.EXPECT().
+				CheckWithOptions(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&linters.LintResult{
+					Success: false,
+					RawOut:  `[{"file":"script.sh","line":3}]`,
+					Findings: []linters.LintFinding{{
+						File:    "script.sh",
+						Line:    3,
+						Column:  1,
+						Message: "Use 'cd ... || exit' in case cd fails.",
+						Rule:    "SC2164",
+					}},
+				})
+
+			result := validator.Validate(context.Background(), &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeWrite,
+				ToolInput: hook.ToolInput{
+					FilePath: "test.sh",
+					Content:  "#!/bin/bash\nset -e\ncd /tmp\necho done\n",
+				},
+			})
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(Equal(
+				"script.sh:3:1: Use 'cd ... || exit' in case cd fails. (SC2164)\n" +
+					"    2 | set -e\n" +
+					"  > 3 | cd /tmp\n" +
+					"    4 | echo done",
+			))
+		})
+	})
 })
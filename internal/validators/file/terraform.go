@@ -71,6 +71,21 @@ func (v *TerraformValidator) Validate(
 		return validator.Pass()
 	}
 
+	var warnings []string
+
+	// Version constraints can only be judged on whole files, not edit fragments
+	if hookCtx.ToolInput.Content != "" {
+		if issues := v.checkVersions(hookCtx.GetFilePath(), content); len(issues) > 0 {
+			message := "Missing Terraform version constraints:\n- " + strings.Join(issues, "\n- ")
+
+			if v.isBlockOnMissingVersionConstraints() {
+				return validator.FailWithRef(validator.RefTerraformVersions, message)
+			}
+
+			warnings = append(warnings, message)
+		}
+	}
+
 	// Detect which tool to use
 	tool := v.formatter.DetectTool()
 	log.Debug("detected terraform tool", "tool", tool)
@@ -83,8 +98,6 @@ func (v *TerraformValidator) Validate(
 	}
 	defer cleanup()
 
-	var warnings []string
-
 	// Run format check if enabled
 	if v.isCheckFormat() {
 		if fmtWarning := v.checkFormat(ctx, content, tool); fmtWarning != "" {
@@ -174,6 +187,19 @@ func (v *TerraformValidator) getContent(ctx *hook.Context) (string, error) {
 	return "", errNoContent
 }
 
+// checkVersions returns the missing version constraints required by config
+// of the module containing path, written with content.
+func (v *TerraformValidator) checkVersions(path, content string) []string {
+	requireVersion := v.isRequireTerraformVersionConstraint()
+	requireProviders := v.isRequireProviderVersionConstraints()
+
+	if !requireVersion && !requireProviders {
+		return nil
+	}
+
+	return checkVersionConstraints(path, content, requireVersion, requireProviders)
+}
+
 // checkFormat runs terraform/tofu fmt -check using TerraformFormatter
 func (v *TerraformValidator) checkFormat(ctx context.Context, content, tool string) string {
 	if tool == "" {
@@ -266,6 +292,33 @@ func (v *TerraformValidator) isUseTflint() bool {
 	return true
 }
 
+// isRequireTerraformVersionConstraint returns whether required_version is required.
+func (v *TerraformValidator) isRequireTerraformVersionConstraint() bool {
+	if v.config != nil && v.config.RequireTerraformVersionConstraint != nil {
+		return *v.config.RequireTerraformVersionConstraint
+	}
+
+	return false
+}
+
+// isRequireProviderVersionConstraints returns whether provider versions must be pinned.
+func (v *TerraformValidator) isRequireProviderVersionConstraints() bool {
+	if v.config != nil && v.config.RequireProviderVersionConstraints != nil {
+		return *v.config.RequireProviderVersionConstraints
+	}
+
+	return false
+}
+
+// isBlockOnMissingVersionConstraints returns whether missing constraints block.
+func (v *TerraformValidator) isBlockOnMissingVersionConstraints() bool {
+	if v.config != nil && v.config.BlockOnMissingVersionConstraints != nil {
+		return *v.config.BlockOnMissingVersionConstraints
+	}
+
+	return false
+}
+
 // Category returns the validator category for parallel execution.
 // TerraformValidator uses CategoryIO because it invokes terraform/tofu and tflint.
 func (*TerraformValidator) Category() validator.ValidatorCategory {
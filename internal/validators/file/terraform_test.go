@@ -2,6 +2,8 @@ package file_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -9,7 +11,9 @@ import (
 
 	execpkg "github.com/smykla-skalski/klaudiush/internal/exec"
 	"github.com/smykla-skalski/klaudiush/internal/linters"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
 	"github.com/smykla-skalski/klaudiush/internal/validators/file"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
 )
@@ -333,5 +337,192 @@ resource "aws_instance" "multiple" {
 				Expect(result).NotTo(BeNil())
 			})
 		})
+
+		Context("version constraints", func() {
+			var cfg *config.TerraformValidatorConfig
+
+			newValidator := func() *file.TerraformValidator {
+				runner := execpkg.NewCommandRunner(10 * time.Second)
+
+				return file.NewTerraformValidator(
+					linters.NewTerraformFormatter(runner),
+					linters.NewTfLinter(runner),
+					logger.NewNoOpLogger(),
+					cfg,
+					nil,
+				)
+			}
+
+			BeforeEach(func() {
+				disabled := false
+				enabled := true
+				cfg = &config.TerraformValidatorConfig{
+					CheckFormat:                       &disabled,
+					UseTflint:                         &disabled,
+					RequireTerraformVersionConstraint: &enabled,
+					RequireProviderVersionConstraints: &enabled,
+				}
+			})
+
+			It("passes when everything is pinned", func() {
+				ctx.ToolInput.Content = `terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    random = { source = "hashicorp/random", version = "3.6.0" }
+  }
+}
+
+provider "aws" {
+  region = "us-east-1"
+}
+`
+				result := newValidator().Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("ignores files without terraform or provider blocks", func() {
+				ctx.ToolInput.Content = `resource "aws_s3_bucket" "b" {
+  bucket = "example"
+}
+`
+				result := newValidator().Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("warns about missing constraints", func() {
+				ctx.ToolInput.Content = `# required_version = ">= 1.5"
+terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws" # version = "~> 5.0"
+    }
+  }
+}
+
+provider "aws" {}
+provider "google" {}
+`
+				result := newValidator().Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeFalse())
+
+				warnings := result.Details["warnings"]
+				Expect(warnings).To(ContainSubstring("no required_version constraint"))
+				Expect(warnings).To(ContainSubstring(`required_providers entry "aws" has no version constraint`))
+				Expect(warnings).To(ContainSubstring(`provider "google" has no version constraint`))
+				Expect(warnings).NotTo(ContainSubstring(`provider "aws" has no`))
+			})
+
+			It("accepts the legacy version shorthand", func() {
+				cfg.RequireTerraformVersionConstraint = nil
+				ctx.ToolInput.Content = `terraform {
+  required_providers {
+    aws = "~> 5.0"
+  }
+}
+`
+				result := newValidator().Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("flags provider files without a terraform block", func() {
+				ctx.ToolInput.Content = `provider "aws" {
+  region = "us-east-1"
+}
+`
+				result := newValidator().Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Details["warnings"]).To(ContainSubstring("no required_version"))
+			})
+
+			It("reads constraints from the other files of the module", func() {
+				dir := GinkgoT().TempDir()
+				Expect(os.WriteFile(filepath.Join(dir, "versions.tf"), []byte(`terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+  }
+}
+`), 0o600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(dir, "broken.tf"), []byte("terraform {\n"), 0o600)).
+					To(Succeed())
+
+				ctx.ToolInput.FilePath = filepath.Join(dir, "main.tf")
+				ctx.ToolInput.Content = `provider "aws" {
+  region = "us-east-1"
+}
+`
+				Expect(newValidator().Validate(context.Background(), ctx).Passed).To(BeTrue())
+
+				ctx.ToolInput.Content = `provider "aws" {}
+provider "google" {}
+`
+				result := newValidator().Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+
+				warnings := result.Details["warnings"]
+				Expect(warnings).To(ContainSubstring(`provider "google" has no version constraint`))
+				Expect(warnings).NotTo(ContainSubstring("required_version"))
+				Expect(warnings).NotTo(ContainSubstring(`provider "aws"`))
+			})
+
+			It("ignores a terraform block holding only a backend", func() {
+				ctx.ToolInput.FilePath = filepath.Join(GinkgoT().TempDir(), "backend.tf")
+				ctx.ToolInput.Content = `terraform {
+  backend "s3" {
+    bucket = "state"
+    key    = "app.tfstate"
+  }
+}
+`
+				Expect(newValidator().Validate(context.Background(), ctx).Passed).To(BeTrue())
+			})
+
+			It("does not mistake strings and heredocs for blocks", func() {
+				ctx.ToolInput.Content = `terraform {
+  required_version = ">= 1.5"
+}
+
+resource "null_resource" "x" {
+  triggers = {
+    script = <<-EOT
+      provider "fake" {}
+    EOT
+    note = "terraform { required_providers { x = {} } }"
+  }
+}
+`
+				Expect(newValidator().Validate(context.Background(), ctx).Passed).To(BeTrue())
+			})
+
+			It("blocks when configured", func() {
+				block := true
+				cfg.BlockOnMissingVersionConstraints = &block
+				ctx.ToolInput.Content = "terraform {}\n"
+
+				result := newValidator().Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(result.Reference).To(Equal(validator.RefTerraformVersions))
+			})
+
+			It("does nothing when not required", func() {
+				cfg.RequireTerraformVersionConstraint = nil
+				cfg.RequireProviderVersionConstraints = nil
+				ctx.ToolInput.Content = "terraform {}\n"
+
+				result := newValidator().Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+		})
 	})
 })
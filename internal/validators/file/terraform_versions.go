@@ -0,0 +1,225 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// tfVersionInfo summarizes the version constraints declared in a Terraform
+// module.
+type tfVersionInfo struct {
+	// hasRequiredVersion is true when a terraform block sets required_version.
+	hasRequiredVersion bool
+
+	// providers lists the names of top-level provider blocks, without duplicates.
+	providers []string
+
+	// required lists the entries of required_providers blocks.
+	required []string
+
+	// pinned holds the required_providers entries with a version constraint.
+	pinned map[string]bool
+}
+
+// tfBackendBlocks are the terraform block contents that configure state
+// storage rather than the module's requirements.
+var tfBackendBlocks = []string{"backend", "cloud"}
+
+// checkVersionConstraints returns a description of each missing version
+// constraint of the module containing path, with content as the content of
+// path. The other .tf files in the directory of path are part of the module,
+// so constraints may live in a separate versions.tf. Only files that define
+// provider blocks or a terraform block with more than a backend are checked.
+func checkVersionConstraints(
+	path, content string,
+	requireVersion, requireProviders bool,
+) []string {
+	body, ok := parseTerraformFile(path, []byte(content))
+	if !ok || !needsVersionCheck(body) {
+		return nil
+	}
+
+	info := tfVersionInfo{pinned: map[string]bool{}}
+	info.add(body)
+
+	for _, sibling := range moduleFiles(path) {
+		data, err := os.ReadFile(sibling) //nolint:gosec // G304: files next to the written file
+		if err != nil {
+			continue
+		}
+
+		if siblingBody, ok := parseTerraformFile(sibling, data); ok {
+			info.add(siblingBody)
+		}
+	}
+
+	var issues []string
+
+	if requireVersion && !info.hasRequiredVersion {
+		issues = append(issues, "terraform block has no required_version constraint")
+	}
+
+	if !requireProviders {
+		return issues
+	}
+
+	for _, name := range info.required {
+		if !info.pinned[name] {
+			issues = append(issues, fmt.Sprintf(
+				"required_providers entry %q has no version constraint", name,
+			))
+		}
+	}
+
+	for _, name := range info.providers {
+		if !slices.Contains(info.required, name) {
+			issues = append(issues, fmt.Sprintf(
+				"provider %q has no version constraint in required_providers", name,
+			))
+		}
+	}
+
+	return issues
+}
+
+// parseTerraformFile parses the native syntax HCL file src. ok is false when
+// it has syntax errors, which the formatter and linter report.
+func parseTerraformFile(path string, src []byte) (*hclsyntax.Body, bool) {
+	file, diags := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, false
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+
+	return body, ok
+}
+
+// moduleFiles returns the other .tf files in the directory of path, sorted.
+func moduleFiles(path string) []string {
+	if path == "" {
+		return nil
+	}
+
+	matches, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*.tf"))
+	if err != nil {
+		return nil
+	}
+
+	clean := filepath.Clean(path)
+
+	return slices.DeleteFunc(matches, func(match string) bool {
+		return match == clean
+	})
+}
+
+// needsVersionCheck reports whether body defines a provider block or a
+// terraform block with more than a backend or cloud block. A backend.tf
+// holding only the state configuration is not expected to pin versions.
+func needsVersionCheck(body *hclsyntax.Body) bool {
+	for _, block := range body.Blocks {
+		switch block.Type {
+		case "provider":
+			return true
+		case "terraform":
+			if !isBackendOnly(block.Body) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isBackendOnly reports whether a terraform block body holds only backend or
+// cloud blocks.
+func isBackendOnly(body *hclsyntax.Body) bool {
+	if len(body.Attributes) > 0 || len(body.Blocks) == 0 {
+		return false
+	}
+
+	for _, block := range body.Blocks {
+		if !slices.Contains(tfBackendBlocks, block.Type) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// add records the terraform and provider blocks of body.
+func (info *tfVersionInfo) add(body *hclsyntax.Body) {
+	for _, block := range body.Blocks {
+		switch block.Type {
+		case "terraform":
+			if _, ok := block.Body.Attributes["required_version"]; ok {
+				info.hasRequiredVersion = true
+			}
+
+			for _, nested := range block.Body.Blocks {
+				if nested.Type == "required_providers" {
+					info.addRequired(nested.Body)
+				}
+			}
+
+		case "provider":
+			if len(block.Labels) > 0 && !slices.Contains(info.providers, block.Labels[0]) {
+				info.providers = append(info.providers, block.Labels[0])
+			}
+		}
+	}
+}
+
+// addRequired records the entries of a required_providers block body, in
+// source order.
+func (info *tfVersionInfo) addRequired(body *hclsyntax.Body) {
+	attrs := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		attrs = append(attrs, attr)
+	}
+
+	slices.SortFunc(attrs, func(a, b *hclsyntax.Attribute) int {
+		return a.SrcRange.Start.Byte - b.SrcRange.Start.Byte
+	})
+
+	for _, attr := range attrs {
+		if !slices.Contains(info.required, attr.Name) {
+			info.required = append(info.required, attr.Name)
+		}
+
+		if hasVersionConstraint(attr.Expr) {
+			info.pinned[attr.Name] = true
+		}
+	}
+}
+
+// hasVersionConstraint reports whether a required_providers entry sets a
+// version: either an object with a version attribute, or the legacy
+// shorthand of a version string (aws = "~> 5.0").
+func hasVersionConstraint(expr hclsyntax.Expression) bool {
+	object, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		value, diags := expr.Value(nil)
+
+		return !diags.HasErrors() && value.Type() == cty.String
+	}
+
+	for _, item := range object.Items {
+		key, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || key.Type() != cty.String || key.IsNull() {
+			continue
+		}
+
+		if key.AsString() == "version" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,6 +4,7 @@ package git
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"path/filepath"
 	"strings"
 	"time"
@@ -27,6 +28,7 @@ type AddValidator struct {
 	validator.BaseValidator
 	gitRunner GitRunner
 	config    *config.AddValidatorConfig
+	statFile  func(root, name string) (fs.FileInfo, error)
 }
 
 // NewAddValidator creates a new GitAddValidator instance
@@ -42,6 +44,7 @@ func NewAddValidator(
 		),
 		gitRunner: defaultGitRunner(gitRunner),
 		config:    cfg,
+		statFile:  statRepoFile,
 	}
 }
 
@@ -102,6 +105,11 @@ func (v *AddValidator) Validate(ctx context.Context, hookCtx *hook.Context) *val
 		).AddDetail("help", message)
 	}
 
+	// Warn about (or block) large and binary new files
+	if result := v.checkLargeFiles(result.Commands, gitRoot); result != nil {
+		return result
+	}
+
 	log.Debug("Git add validation passed")
 
 	return validator.Pass()
@@ -0,0 +1,12 @@
+package git
+
+import "io/fs"
+
+// Export functions for testing.
+
+// ExportUseFS makes the validator stat files in fsys instead of the disk.
+func (v *AddValidator) ExportUseFS(fsys fs.FS) {
+	v.statFile = func(_, name string) (fs.FileInfo, error) {
+		return fs.Stat(fsys, name)
+	}
+}
@@ -0,0 +1,218 @@
+package git
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+const bytesPerKB = 1024
+
+// statRepoFile stats a file given its path relative to the repository root.
+func statRepoFile(root, name string) (fs.FileInfo, error) {
+	return os.Stat(filepath.Join(root, name))
+}
+
+// checkLargeFiles checks the new files staged by git add against
+// MaxAddFileSizeKB and BlockedBinaryExtensions. Files already tracked are
+// skipped. It returns nil when the check is disabled, cannot run, or passes.
+func (v *AddValidator) checkLargeFiles(
+	commands []parser.Command,
+	gitRoot string,
+) *validator.Result {
+	maxKB, extensions := v.getMaxAddFileSizeKB(), v.getBlockedBinaryExtensions()
+	if maxKB <= 0 && len(extensions) == 0 {
+		return nil
+	}
+
+	var pathspecs []string
+
+	for _, cmd := range commands {
+		if v.isGitAddCommand(cmd) {
+			pathspecs = append(pathspecs, addPathspecs(cmd.Args[1:])...)
+		}
+	}
+
+	if len(pathspecs) == 0 {
+		return nil
+	}
+
+	untracked, err := v.gitRunner.GetUntrackedFiles()
+	if err != nil {
+		v.Logger().Debug("Failed to get untracked files", "error", err)
+		return nil
+	}
+
+	var issues []string
+
+	for _, file := range untracked {
+		if !slices.ContainsFunc(pathspecs, func(spec string) bool {
+			return pathspecMatches(spec, file)
+		}) {
+			continue
+		}
+
+		if issue := v.largeFileIssue(gitRoot, file, maxKB, extensions); issue != "" {
+			issues = append(issues, issue)
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	message := "Large or binary files in git add: " + strings.Join(issues, ", ")
+	help := "Add these files to .gitignore, or track them with Git LFS " +
+		"(git lfs track \"*.<ext>\") before adding them"
+
+	if v.isBlockOnLargeFiles() {
+		return validator.FailWithRef(validator.RefGitLargeFile, message).
+			AddDetail("help", help)
+	}
+
+	return validator.WarnWithRef(validator.RefGitLargeFile, message).
+		AddDetail("help", help)
+}
+
+// largeFileIssue describes why file should not be added, or returns "".
+func (v *AddValidator) largeFileIssue(
+	gitRoot, file string,
+	maxKB int,
+	extensions []string,
+) string {
+	name := strings.ToLower(path.Base(file))
+	for _, ext := range extensions {
+		if strings.HasSuffix(name, "."+ext) {
+			return fmt.Sprintf("%s (binary .%s)", file, ext)
+		}
+	}
+
+	if maxKB <= 0 {
+		return ""
+	}
+
+	info, err := v.statFile(gitRoot, file)
+	if err != nil || info.IsDir() {
+		return ""
+	}
+
+	if info.Size() > int64(maxKB)*bytesPerKB {
+		return fmt.Sprintf("%s (%d KB, max %d KB)", file, info.Size()/bytesPerKB, maxKB)
+	}
+
+	return ""
+}
+
+// addPathspecs returns the pathspecs of a git add command's arguments. "."
+// stands for every file; -A/--all without pathspecs adds "." too. Commands
+// that only update tracked files (-u/--update) return nil.
+func addPathspecs(args []string) []string {
+	var (
+		specs    []string
+		all      bool
+		update   bool
+		skipNext bool
+		literal  bool
+	)
+
+	for _, arg := range args {
+		switch {
+		case skipNext:
+			skipNext = false
+		case literal:
+			specs = append(specs, cleanPathspec(arg))
+		case arg == "--":
+			literal = true
+		case arg == "-A" || arg == "--all":
+			all = true
+		case arg == "-u" || arg == "--update":
+			update = true
+		case arg == "--chmod" || arg == "--pathspec-from-file":
+			skipNext = true
+		case strings.HasPrefix(arg, "-"):
+		case strings.TrimSpace(arg) != "":
+			specs = append(specs, cleanPathspec(arg))
+		}
+	}
+
+	if update && !all {
+		return nil
+	}
+
+	if all && len(specs) == 0 {
+		return []string{"."}
+	}
+
+	return specs
+}
+
+// cleanPathspec normalizes a pathspec to a slash-separated path relative to
+// the repository root.
+func cleanPathspec(spec string) string {
+	return strings.TrimPrefix(path.Clean(filepath.ToSlash(spec)), "./")
+}
+
+// pathspecMatches reports whether file (relative to the repository root) is
+// covered by spec: the whole tree, the file itself, a directory containing
+// it, or a glob. Globs without a "/" also match the file name.
+func pathspecMatches(spec, file string) bool {
+	if spec == "." || spec == file || strings.HasPrefix(file, spec+"/") {
+		return true
+	}
+
+	if matched, _ := path.Match(spec, file); matched {
+		return true
+	}
+
+	if !strings.Contains(spec, "/") {
+		matched, _ := path.Match(spec, path.Base(file))
+
+		return matched
+	}
+
+	return false
+}
+
+// getMaxAddFileSizeKB returns the maximum new file size in KB, or 0 if disabled
+func (v *AddValidator) getMaxAddFileSizeKB() int {
+	if v.config != nil && v.config.MaxAddFileSizeKB != nil {
+		return *v.config.MaxAddFileSizeKB
+	}
+
+	return 0
+}
+
+// getBlockedBinaryExtensions returns the lowercased blocked extensions
+// without the leading dot
+func (v *AddValidator) getBlockedBinaryExtensions() []string {
+	if v.config == nil {
+		return nil
+	}
+
+	extensions := make([]string, 0, len(v.config.BlockedBinaryExtensions))
+
+	for _, ext := range v.config.BlockedBinaryExtensions {
+		ext = strings.TrimPrefix(strings.TrimPrefix(ext, "*"), ".")
+		if ext != "" {
+			extensions = append(extensions, strings.ToLower(ext))
+		}
+	}
+
+	return extensions
+}
+
+// isBlockOnLargeFiles returns whether large or binary files block the add
+func (v *AddValidator) isBlockOnLargeFiles() bool {
+	if v.config != nil && v.config.BlockOnLargeFiles != nil {
+		return *v.config.BlockOnLargeFiles
+	}
+
+	return false
+}
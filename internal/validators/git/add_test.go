@@ -2,6 +2,8 @@ package git_test
 
 import (
 	"context"
+	"strings"
+	"testing/fstest"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -9,6 +11,7 @@ import (
 	gitpkg "github.com/smykla-skalski/klaudiush/internal/git"
 	"github.com/smykla-skalski/klaudiush/internal/validator"
 	"github.com/smykla-skalski/klaudiush/internal/validators/git"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
 )
@@ -307,5 +310,118 @@ var _ = Describe("GitAddValidator", func() {
 				Expect(result.ShouldBlock).To(BeTrue())
 			})
 		})
+
+		Context("when checking large and binary files", func() {
+			var (
+				cfg  *config.AddValidatorConfig
+				fsys fstest.MapFS
+			)
+
+			addCtx := func(command string) *hook.Context {
+				return &hook.Context{
+					EventType: hook.EventTypePreToolUse,
+					ToolName:  hook.ToolTypeBash,
+					ToolInput: hook.ToolInput{Command: command},
+				}
+			}
+
+			BeforeEach(func() {
+				maxKB := 100
+				cfg = &config.AddValidatorConfig{
+					MaxAddFileSizeKB:        &maxKB,
+					BlockedBinaryExtensions: []string{".zip", "*.PSD", "tar.gz"},
+				}
+				fsys = fstest.MapFS{
+					"dump.sql":        {Data: []byte(strings.Repeat("x", 200*1024))},
+					"src/main.go":     {Data: []byte("package main")},
+					"assets/logo.psd": {Data: []byte("psd")},
+					"tracked.bin":     {Data: []byte(strings.Repeat("x", 200*1024))},
+				}
+				fakeGit.UntrackedFiles = []string{"dump.sql", "src/main.go", "assets/logo.psd"}
+				val = git.NewAddValidator(log, fakeGit, cfg, nil)
+				val.ExportUseFS(fsys)
+			})
+
+			It("should warn about an oversized new file", func() {
+				result := val.Validate(context.Background(), addCtx("git add dump.sql"))
+
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeFalse())
+				Expect(result.Reference).To(Equal(validator.RefGitLargeFile))
+				Expect(result.Message).To(ContainSubstring("dump.sql (200 KB, max 100 KB)"))
+				Expect(result.Details["help"]).To(ContainSubstring("git lfs track"))
+			})
+
+			It("should warn about blocked binary extensions case-insensitively", func() {
+				result := val.Validate(context.Background(), addCtx("git add assets/"))
+
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("assets/logo.psd (binary .psd)"))
+			})
+
+			It("should match multi-part extensions", func() {
+				fsys["build/out.tar.gz"] = &fstest.MapFile{Data: []byte("gz")}
+				fakeGit.UntrackedFiles = append(fakeGit.UntrackedFiles, "build/out.tar.gz")
+
+				result := val.Validate(context.Background(), addCtx("git add build"))
+
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("build/out.tar.gz (binary .tar.gz)"))
+			})
+
+			It("should expand git add -A to every untracked file", func() {
+				result := val.Validate(context.Background(), addCtx("git add -A"))
+
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("dump.sql"))
+				Expect(result.Message).To(ContainSubstring("assets/logo.psd"))
+				Expect(result.Message).NotTo(ContainSubstring("src/main.go"))
+			})
+
+			It("should match glob pathspecs against file names", func() {
+				result := val.Validate(context.Background(), addCtx("git add '*.sql'"))
+
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("dump.sql"))
+			})
+
+			It("should skip files that are already tracked", func() {
+				result := val.Validate(context.Background(), addCtx("git add tracked.bin"))
+
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("should skip git add -u", func() {
+				result := val.Validate(context.Background(), addCtx("git add -u ."))
+
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("should pass small files", func() {
+				result := val.Validate(context.Background(), addCtx("git add src/main.go"))
+
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("should block when block_on_large_files is set", func() {
+				block := true
+				cfg.BlockOnLargeFiles = &block
+
+				result := val.Validate(context.Background(), addCtx("git add ."))
+
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(result.Reference).To(Equal(validator.RefGitLargeFile))
+			})
+
+			It("should be disabled by default", func() {
+				val = git.NewAddValidator(log, fakeGit, &config.AddValidatorConfig{}, nil)
+				val.ExportUseFS(fsys)
+
+				result := val.Validate(context.Background(), addCtx("git add ."))
+
+				Expect(result.Passed).To(BeTrue())
+			})
+		})
 	})
 })
@@ -114,6 +114,20 @@ func (v *BranchValidator) isAllowUppercase() bool {
 	return false // default: not allowed
 }
 
+// getTicketPattern returns the compiled ticket pattern, or nil if not set.
+func (v *BranchValidator) getTicketPattern() *regexp.Regexp {
+	if v.config == nil || v.config.RequireTicketPattern == "" {
+		return nil
+	}
+
+	pattern, err := regexp.Compile(v.config.RequireTicketPattern)
+	if err != nil {
+		return nil // already validated by config validation
+	}
+
+	return pattern
+}
+
 // Validate validates git branch names.
 func (v *BranchValidator) Validate(ctx context.Context, hookCtx *hook.Context) *validator.Result {
 	log := v.Logger()
@@ -377,5 +391,31 @@ func (v *BranchValidator) validateBranchName(branchName string) *validator.Resul
 		}
 	}
 
-	return validator.Pass()
+	return v.validateTicket(branchName)
+}
+
+// validateTicket checks that the branch name contains a ticket reference when
+// a ticket pattern is configured.
+func (v *BranchValidator) validateTicket(branchName string) *validator.Result {
+	pattern := v.getTicketPattern()
+	if pattern == nil || pattern.MatchString(branchName) {
+		return validator.Pass()
+	}
+
+	example := "feat/PROJ-123-add-login"
+	if !v.isAllowUppercase() {
+		example = strings.ToLower(example)
+	}
+
+	message := templates.MustExecute(
+		templates.BranchTicketTemplate,
+		templates.BranchTicketData{
+			BranchName:    branchName,
+			TicketPattern: pattern.String(),
+			Example:       example,
+		},
+	)
+
+	return validator.FailWithRef(validator.RefGitBranchName, message).
+		WithFixHint("Include a ticket ID in the branch name, e.g. " + example)
 }
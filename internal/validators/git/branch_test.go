@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/smykla-skalski/klaudiush/internal/validators/git"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
 )
@@ -441,6 +442,65 @@ var _ = Describe("BranchValidator", func() {
 		})
 	})
 
+	Describe("with RequireTicketPattern", func() {
+		BeforeEach(func() {
+			v = git.NewBranchValidator(&config.BranchValidatorConfig{
+				RequireTicketPattern: `[A-Z]+-\d+`,
+				AllowUppercase:       new(true),
+			}, logger.NewNoOpLogger(), nil)
+		})
+
+		It("should pass for branch with ticket after type", func() {
+			ctx.ToolInput.Command = "git checkout -b feat/PROJ-123-add-login"
+			result := v.Validate(context.Background(), ctx)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should pass for git switch -c with ticket", func() {
+			ctx.ToolInput.Command = "git switch -c fix/ABC-9-null-pointer"
+			result := v.Validate(context.Background(), ctx)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should fail for branch without ticket", func() {
+			ctx.ToolInput.Command = "git checkout -b feat/add-login"
+			result := v.Validate(context.Background(), ctx)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Message).To(ContainSubstring("must contain a ticket reference"))
+			Expect(result.Message).To(ContainSubstring(`[A-Z]+-\d+`))
+			Expect(result.FixHint).To(ContainSubstring("feat/PROJ-123-add-login"))
+		})
+
+		It("should still require a valid type", func() {
+			ctx.ToolInput.Command = "git checkout -b PROJ-123-add-login"
+			result := v.Validate(context.Background(), ctx)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).NotTo(ContainSubstring("ticket reference"))
+		})
+
+		It("should skip protected branches", func() {
+			ctx.ToolInput.Command = "git checkout -b main"
+			result := v.Validate(context.Background(), ctx)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should use lowercase example when uppercase is not allowed", func() {
+			v = git.NewBranchValidator(&config.BranchValidatorConfig{
+				RequireTicketPattern: `(?i)[a-z]+-\d+`,
+			}, logger.NewNoOpLogger(), nil)
+
+			ctx.ToolInput.Command = "git checkout -b feat/add-login"
+			result := v.Validate(context.Background(), ctx)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.FixHint).To(ContainSubstring("feat/proj-123-add-login"))
+
+			ctx.ToolInput.Command = "git checkout -b feat/proj-123-add-login"
+			result = v.Validate(context.Background(), ctx)
+			Expect(result.Passed).To(BeTrue())
+		})
+	})
+
 	Describe("non-branch commands", func() {
 		It("should pass for git checkout without -b", func() {
 			ctx.ToolInput.Command = "git checkout main"
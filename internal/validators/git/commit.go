@@ -108,21 +108,58 @@ func (v *CommitValidator) validateGitCommit(
 	gitCmd *parser.GitCommand,
 	hasGitAdd bool,
 ) *validator.Result {
-	log := v.Logger()
-
 	// Check -sS flags
 	if res := v.checkFlags(gitCmd); !res.Passed {
 		return res
 	}
 
-	// Check staging area (skip for --amend, --allow-empty, or if git add is in the chain)
+	var warning *validator.Result
+
+	// Check whether --amend rewrites a pushed commit
+	if res := v.checkAmendPushed(gitCmd); res != nil {
+		if res.ShouldBlock {
+			return res
+		}
+
+		warning = res
+	}
+
+	// Check staging area, diff size and changelog fragment (skip for --amend,
+	// --allow-empty, or if git add is in the chain)
 	if v.shouldCheckStaging(gitCmd, hasGitAdd) {
 		if res := v.checkStagingArea(gitCmd); !res.Passed {
 			return res
 		}
+
+		if res := v.checkDiffSize(gitCmd); res != nil {
+			if res.ShouldBlock {
+				return res
+			}
+
+			warning = res
+		}
+
+		if res := v.checkChangelogFragment(gitCmd); res != nil {
+			return res
+		}
+	}
+
+	// A message failure takes precedence over warnings
+	res := v.validateCommitMessage(ctx, gitCmd)
+	if res.Passed && warning != nil {
+		return warning
 	}
 
-	// Extract and validate commit message (if enabled)
+	return res
+}
+
+// validateCommitMessage extracts and validates the commit message (if enabled)
+func (v *CommitValidator) validateCommitMessage(
+	ctx context.Context,
+	gitCmd *parser.GitCommand,
+) *validator.Result {
+	log := v.Logger()
+
 	if !v.isMessageValidationEnabled() {
 		log.Debug("Commit message validation is disabled")
 		return validator.Pass()
@@ -0,0 +1,60 @@
+package git
+
+import (
+	"fmt"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+// checkAmendPushed checks whether --amend would rewrite a commit that is
+// already on the upstream branch. It returns nil for other commits, for a
+// local-only HEAD, and when the branch has no upstream or HEAD is detached.
+func (v *CommitValidator) checkAmendPushed(gitCmd *parser.GitCommand) *validator.Result {
+	if !gitCmd.HasFlag("--amend") || !v.gitRunner.IsInRepo() {
+		return nil
+	}
+
+	log := v.Logger()
+
+	branch, err := v.gitRunner.GetCurrentBranch()
+	if err != nil || branch == "" {
+		log.Debug("Skipping amend check without a current branch", "error", err)
+		return nil
+	}
+
+	status, err := v.gitRunner.GetUpstreamStatus(branch)
+	if err != nil {
+		log.Debug("Failed to get upstream status", "branch", branch, "error", err)
+		return nil
+	}
+
+	// HEAD is the branch tip, so it is local-only when the branch is ahead
+	if !status.HasUpstream || status.Ahead > 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf(
+		"HEAD of %q is already pushed, amending it rewrites published history",
+		branch,
+	)
+	help := "Collaborators who pulled the commit must reset their branch after a force push. " +
+		"Commit the fix as a new commit instead"
+
+	if v.isBlockAmendPushed() {
+		return validator.FailWithRef(validator.RefGitAmendPushed, message).
+			AddDetail("help", help)
+	}
+
+	return validator.WarnWithRef(validator.RefGitAmendPushed, message).
+		AddDetail("help", help)
+}
+
+// isBlockAmendPushed returns whether amending a pushed commit blocks the commit
+func (v *CommitValidator) isBlockAmendPushed() bool {
+	if v.config != nil && v.config.BlockAmendPushed != nil {
+		return *v.config.BlockAmendPushed
+	}
+
+	return true
+}
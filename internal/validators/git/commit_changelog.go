@@ -0,0 +1,112 @@
+package git
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+const defaultChangelogDir = "changelog.d"
+
+// checkChangelogFragment checks that the commit stages a changelog fragment
+// when RequireChangelogFragment is enabled. Commits whose type is listed in
+// ExemptTypes are skipped. It returns nil when the check is disabled, cannot
+// run, or passes.
+func (v *CommitValidator) checkChangelogFragment(gitCmd *parser.GitCommand) *validator.Result {
+	if !v.isRequireChangelogFragment() {
+		return nil
+	}
+
+	// Without a message (editor), the type is unknown and the fragment is
+	// still required
+	if msg, err := v.extractCommitMessage(gitCmd); err == nil && msg != "" {
+		commitType := NewCommitParser().Parse(msg).Type
+		if commitType != "" && slices.Contains(v.getExemptTypes(), commitType) {
+			return nil
+		}
+	}
+
+	if !v.gitRunner.IsInRepo() {
+		return nil
+	}
+
+	staged, err := v.gitRunner.GetStagedFiles()
+	if err != nil {
+		v.Logger().Debug("Failed to get staged files", "error", err)
+		return nil
+	}
+
+	if slices.ContainsFunc(staged, v.isChangelogFragment) {
+		return nil
+	}
+
+	dir := v.getChangelogDir()
+	message := "Commit doesn't include a changelog fragment in " + dir + "/"
+
+	if pattern := v.getChangelogPattern(); pattern != "" {
+		message += " matching " + pattern
+	}
+
+	return validator.FailWithRef(validator.RefGitMissingChangelog, message).
+		AddDetail("help", "Add a fragment describing the change under "+dir+
+			"/ and stage it, or use an exempt commit type ("+
+			strings.Join(v.getExemptTypes(), ", ")+")")
+}
+
+// isChangelogFragment reports whether file is under ChangelogDir and its name
+// matches ChangelogPattern.
+func (v *CommitValidator) isChangelogFragment(file string) bool {
+	rest, ok := strings.CutPrefix(file, v.getChangelogDir()+"/")
+	if !ok || rest == "" {
+		return false
+	}
+
+	pattern := v.getChangelogPattern()
+
+	return pattern == "" || doublestar.MatchUnvalidated(pattern, path.Base(rest))
+}
+
+// isRequireChangelogFragment returns whether commits must stage a changelog fragment
+func (v *CommitValidator) isRequireChangelogFragment() bool {
+	if v.config != nil && v.config.RequireChangelogFragment != nil {
+		return *v.config.RequireChangelogFragment
+	}
+
+	return false
+}
+
+// getChangelogDir returns the changelog fragment directory without slashes
+// around it
+func (v *CommitValidator) getChangelogDir() string {
+	if v.config != nil && v.config.ChangelogDir != "" {
+		dir := strings.Trim(cleanPathspec(v.config.ChangelogDir), "/")
+		if dir != "" && dir != "." {
+			return dir
+		}
+	}
+
+	return defaultChangelogDir
+}
+
+// getChangelogPattern returns the glob fragment file names must match
+func (v *CommitValidator) getChangelogPattern() string {
+	if v.config != nil {
+		return v.config.ChangelogPattern
+	}
+
+	return ""
+}
+
+// getExemptTypes returns the commit types that don't need a changelog fragment
+func (v *CommitValidator) getExemptTypes() []string {
+	if v.config != nil && v.config.ExemptTypes != nil {
+		return v.config.ExemptTypes
+	}
+
+	return []string{"chore", "docs"}
+}
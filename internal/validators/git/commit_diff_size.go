@@ -0,0 +1,126 @@
+package git
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+// checkDiffSize checks the staged diff against MaxDiffLines and MaxDiffBytes.
+// It returns nil when the check is disabled, cannot run, or passes.
+func (v *CommitValidator) checkDiffSize(gitCmd *parser.GitCommand) *validator.Result {
+	maxLines, maxBytes := v.getMaxDiffLines(), v.getMaxDiffBytes()
+	if maxLines <= 0 && maxBytes <= 0 {
+		return nil
+	}
+
+	// With -a/--all the commit includes unstaged changes, so the staged
+	// diff does not reflect the commit size.
+	if gitCmd.HasFlag("-a") || gitCmd.HasFlag("-A") || gitCmd.HasFlag("--all") {
+		return nil
+	}
+
+	if !v.gitRunner.IsInRepo() {
+		return nil
+	}
+
+	stats, err := v.gitRunner.GetStagedDiffStats()
+	if err != nil {
+		v.Logger().Debug("Failed to get staged diff stats", "error", err)
+		return nil
+	}
+
+	var lines, size int
+
+	for _, stat := range stats {
+		if v.isExcludedFromDiffSize(stat.Path) {
+			continue
+		}
+
+		lines += stat.Lines()
+		size += stat.Bytes
+	}
+
+	var issues []string
+
+	if maxLines > 0 && lines > maxLines {
+		issues = append(issues, fmt.Sprintf("%d changed lines (max %d)", lines, maxLines))
+	}
+
+	if maxBytes > 0 && size > maxBytes {
+		issues = append(issues, fmt.Sprintf("%d changed bytes (max %d)", size, maxBytes))
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	message := "Staged diff is too large: " + strings.Join(issues, ", ")
+	help := "Split the change into smaller commits, or add generated files and lockfiles " +
+		"to diff_size_exclude"
+
+	if v.isBlockOnLargeDiff() {
+		return validator.FailWithRef(validator.RefGitLargeCommit, message).
+			AddDetail("help", help)
+	}
+
+	return validator.WarnWithRef(validator.RefGitLargeCommit, message).
+		AddDetail("help", help)
+}
+
+// isExcludedFromDiffSize reports whether a file matches a DiffSizeExclude
+// pattern. Patterns without a "/" also match the file name.
+func (v *CommitValidator) isExcludedFromDiffSize(file string) bool {
+	for _, pattern := range v.getDiffSizeExclude() {
+		if doublestar.MatchUnvalidated(pattern, file) {
+			return true
+		}
+
+		if !strings.Contains(pattern, "/") && doublestar.MatchUnvalidated(pattern, path.Base(file)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getMaxDiffLines returns the maximum staged diff lines, or 0 if disabled
+func (v *CommitValidator) getMaxDiffLines() int {
+	if v.config != nil && v.config.MaxDiffLines != nil {
+		return *v.config.MaxDiffLines
+	}
+
+	return 0
+}
+
+// getMaxDiffBytes returns the maximum staged diff bytes, or 0 if disabled
+func (v *CommitValidator) getMaxDiffBytes() int {
+	if v.config != nil && v.config.MaxDiffBytes != nil {
+		return *v.config.MaxDiffBytes
+	}
+
+	return 0
+}
+
+// getDiffSizeExclude returns the patterns of files not counted toward the diff size
+func (v *CommitValidator) getDiffSizeExclude() []string {
+	if v.config != nil {
+		return v.config.DiffSizeExclude
+	}
+
+	return nil
+}
+
+// isBlockOnLargeDiff returns whether an oversized diff blocks the commit
+func (v *CommitValidator) isBlockOnLargeDiff() bool {
+	if v.config != nil && v.config.BlockOnLargeDiff != nil {
+		return *v.config.BlockOnLargeDiff
+	}
+
+	return false
+}
@@ -0,0 +1,212 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+)
+
+// gitmojis maps each known gitmoji (see https://gitmoji.dev) to its shortcode.
+// Emoji are listed without the U+FE0F variation selector, which is stripped
+// before lookup.
+var gitmojis = map[string]string{
+	"🎨":   ":art:",
+	"⚡":   ":zap:",
+	"🔥":   ":fire:",
+	"🐛":   ":bug:",
+	"🚑":   ":ambulance:",
+	"✨":   ":sparkles:",
+	"📝":   ":memo:",
+	"🚀":   ":rocket:",
+	"💄":   ":lipstick:",
+	"🎉":   ":tada:",
+	"✅":   ":white_check_mark:",
+	"🔒":   ":lock:",
+	"🔐":   ":closed_lock_with_key:",
+	"🔖":   ":bookmark:",
+	"🚨":   ":rotating_light:",
+	"🚧":   ":construction:",
+	"💚":   ":green_heart:",
+	"⬇":   ":arrow_down:",
+	"⬆":   ":arrow_up:",
+	"📌":   ":pushpin:",
+	"👷":   ":construction_worker:",
+	"📈":   ":chart_with_upwards_trend:",
+	"♻":   ":recycle:",
+	"➕":   ":heavy_plus_sign:",
+	"➖":   ":heavy_minus_sign:",
+	"🔧":   ":wrench:",
+	"🔨":   ":hammer:",
+	"🌐":   ":globe_with_meridians:",
+	"✏":   ":pencil2:",
+	"💩":   ":poop:",
+	"⏪":   ":rewind:",
+	"🔀":   ":twisted_rightwards_arrows:",
+	"📦":   ":package:",
+	"👽":   ":alien:",
+	"🚚":   ":truck:",
+	"📄":   ":page_facing_up:",
+	"💥":   ":boom:",
+	"🍱":   ":bento:",
+	"♿":   ":wheelchair:",
+	"💡":   ":bulb:",
+	"🍻":   ":beers:",
+	"💬":   ":speech_balloon:",
+	"🗃":   ":card_file_box:",
+	"🔊":   ":loud_sound:",
+	"🔇":   ":mute:",
+	"👥":   ":busts_in_silhouette:",
+	"🚸":   ":children_crossing:",
+	"🏗":   ":building_construction:",
+	"📱":   ":iphone:",
+	"🤡":   ":clown_face:",
+	"🥚":   ":egg:",
+	"🙈":   ":see_no_evil:",
+	"📸":   ":camera_flash:",
+	"⚗":   ":alembic:",
+	"🔍":   ":mag:",
+	"🏷":   ":label:",
+	"🌱":   ":seedling:",
+	"🚩":   ":triangular_flag_on_post:",
+	"🥅":   ":goal_net:",
+	"💫":   ":dizzy:",
+	"🗑":   ":wastebasket:",
+	"🛂":   ":passport_control:",
+	"🩹":   ":adhesive_bandage:",
+	"🧐":   ":monocle_face:",
+	"⚰":   ":coffin:",
+	"🧪":   ":test_tube:",
+	"👔":   ":necktie:",
+	"🩺":   ":stethoscope:",
+	"🧱":   ":bricks:",
+	"🧑‍💻": ":technologist:",
+	"💸":   ":money_with_wings:",
+	"🧵":   ":thread:",
+	"🦺":   ":safety_vest:",
+	"✈":   ":airplane:",
+}
+
+// gitmojiShortcodes is the set of known gitmoji shortcodes.
+var gitmojiShortcodes = func() map[string]bool {
+	codes := make(map[string]bool, len(gitmojis))
+	for _, code := range gitmojis {
+		codes[code] = true
+	}
+
+	return codes
+}()
+
+// EmojiPolicyRule enforces the emoji policy for the commit title: either the
+// title must start with a known gitmoji, or it must contain no emoji at all.
+type EmojiPolicyRule struct {
+	RequireGitmoji bool
+	BlockEmoji     bool
+}
+
+func (*EmojiPolicyRule) Name() string {
+	return "emoji-policy"
+}
+
+func (r *EmojiPolicyRule) Validate(_ *ParsedCommit, message string) *RuleResult {
+	title := extractTitle(message)
+
+	if isRevertCommit(title) {
+		return nil
+	}
+
+	if r.BlockEmoji {
+		if emoji := findEmoji(title); emoji != "" {
+			return &RuleResult{
+				Reference: validator.RefGitEmojiPolicy,
+				Message:   fmt.Sprintf("Title contains emoji '%s'", emoji),
+				Context: []string{
+					"Emoji are not allowed in commit titles",
+					fmt.Sprintf("Current title: '%s'", title),
+				},
+			}
+		}
+	}
+
+	if r.RequireGitmoji {
+		if _, _, ok := splitGitmoji(title); !ok {
+			return &RuleResult{
+				Reference: validator.RefGitEmojiPolicy,
+				Message:   "Title doesn't start with a gitmoji",
+				Context: []string{
+					"Start the title with a gitmoji or its shortcode, followed by a space",
+					"Example: :sparkles: feat(api): add endpoint",
+					fmt.Sprintf("Current title: '%s'", title),
+				},
+			}
+		}
+	}
+
+	return nil
+}
+
+// splitGitmoji splits a leading gitmoji (emoji or :shortcode:) and the
+// following space off title. ok is false when title doesn't start with one.
+func splitGitmoji(title string) (gitmoji, rest string, ok bool) {
+	head, rest, found := strings.Cut(title, " ")
+	if !found || rest == "" {
+		return "", "", false
+	}
+
+	if gitmojiShortcodes[head] {
+		return head, rest, true
+	}
+
+	if _, known := gitmojis[strings.ReplaceAll(head, "\ufe0f", "")]; known {
+		return head, rest, true
+	}
+
+	return "", "", false
+}
+
+// stripGitmoji removes a leading gitmoji from the title (first line) of
+// message so the title format rules see the plain title.
+func stripGitmoji(message string) string {
+	title, body, hasBody := strings.Cut(message, "\n")
+
+	_, rest, ok := splitGitmoji(title)
+	if !ok {
+		return message
+	}
+
+	if hasBody {
+		return rest + "\n" + body
+	}
+
+	return rest
+}
+
+// findEmoji returns the first emoji in s, or "" when s contains none.
+func findEmoji(s string) string {
+	for _, r := range s {
+		if isEmoji(r) {
+			return string(r)
+		}
+	}
+
+	return ""
+}
+
+// isEmoji reports whether r falls in a Unicode block used for emoji.
+// Text symbols such as ©, ® and ™ are not treated as emoji.
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // Mahjong through Symbols and Pictographs Extended-A
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // Miscellaneous Symbols and Dingbats
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // Miscellaneous Symbols and Arrows (⬆, ⭐)
+		return r == 0x2B05 || r == 0x2B06 || r == 0x2B07 || r == 0x2B1B ||
+			r == 0x2B1C || r == 0x2B50 || r == 0x2B55
+	case r >= 0x2300 && r <= 0x23FF: // Miscellaneous Technical (⌚, ⏪, ⏰)
+		return r == 0x231A || r == 0x231B || r == 0x2328 || r == 0x23CF ||
+			(r >= 0x23E9 && r <= 0x23F3) || (r >= 0x23F8 && r <= 0x23FA)
+	default:
+		return false
+	}
+}
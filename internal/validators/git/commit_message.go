@@ -41,9 +41,16 @@ func (v *CommitValidator) validateMessage(ctx context.Context, message string) *
 	}
 	parser := NewCommitParser(parserOpts...)
 
-	// Parse the commit message
+	// Parse the commit message. A required gitmoji prefix is stripped first so
+	// the title format rules see "type(scope): description".
 	parsed := parser.Parse(message)
 
+	if v.shouldRequireGitmoji() {
+		parsed = parser.Parse(stripGitmoji(message))
+		parsed.Raw = message
+		parsed.Title = extractTitle(message)
+	}
+
 	// Build and execute validation rules
 	rules := v.buildRules(ctx)
 	ruleResults := make([]*RuleResult, 0)
@@ -115,6 +122,17 @@ func (v *CommitValidator) buildRules(ctx context.Context) []CommitRule {
 		}
 	}
 
+	// Emoji policy rule
+	requireGitmoji := v.shouldRequireGitmoji()
+	blockEmoji := v.shouldBlockEmoji()
+
+	if requireGitmoji || blockEmoji {
+		rules = append(rules, &EmojiPolicyRule{
+			RequireGitmoji: requireGitmoji,
+			BlockEmoji:     blockEmoji,
+		})
+	}
+
 	// Infrastructure scope misuse rule
 	if v.shouldBlockInfraScopeMisuse() {
 		rules = append(rules, NewInfraScopeMisuseRule())
@@ -151,6 +169,13 @@ func (v *CommitValidator) buildRules(ctx context.Context) []CommitRule {
 		})
 	}
 
+	// Required trailers rule
+	if trailers := v.getRequiredTrailers(); len(trailers) > 0 {
+		rules = append(rules, &RequiredTrailersRule{
+			Trailers: trailers,
+		})
+	}
+
 	return rules
 }
 
@@ -261,6 +286,7 @@ func (*CommitValidator) buildErrorResult(results []*RuleResult, message string)
 // This reuses the same order as selectPrimaryReference.
 var referenceFixOrder = []validator.Reference{
 	validator.RefGitConventionalCommit, // GIT013
+	validator.RefGitEmojiPolicy,        // GIT029
 	validator.RefGitFeatCI,             // GIT006
 	validator.RefGitBadTitle,           // GIT004
 	validator.RefGitBadBody,            // GIT005
@@ -269,6 +295,7 @@ var referenceFixOrder = []validator.Reference{
 	validator.RefGitClaudeAttr,         // GIT012
 	validator.RefGitForbiddenPattern,   // GIT014
 	validator.RefGitSignoffMismatch,    // GIT015
+	validator.RefGitMissingTrailer,     // GIT026
 }
 
 // sortResultsByFixOrder sorts rule results by fix priority.
@@ -299,14 +326,16 @@ func sortResultsByFixOrder(results []*RuleResult) {
 // selectPrimaryReference selects the most appropriate reference from rule results.
 // Priority order (highest to lowest):
 // 1. Conventional commit format errors (GIT013)
-// 2. Infrastructure scope misuse (GIT006)
-// 3. Title length errors (GIT004)
-// 4. Body errors (GIT005)
-// 5. List formatting (GIT016)
-// 6. PR references (GIT011)
-// 7. AI attribution (GIT012)
-// 8. Forbidden patterns (GIT014)
-// 9. Signoff mismatch (GIT015)
+// 2. Emoji policy (GIT029)
+// 3. Infrastructure scope misuse (GIT006)
+// 4. Title length errors (GIT004)
+// 5. Body errors (GIT005)
+// 6. List formatting (GIT016)
+// 7. PR references (GIT011)
+// 8. AI attribution (GIT012)
+// 9. Forbidden patterns (GIT014)
+// 10. Signoff mismatch (GIT015)
+// 11. Missing or malformed trailers (GIT026)
 func selectPrimaryReference(results []*RuleResult) validator.Reference {
 	if len(results) == 0 {
 		return validator.RefGitConventionalCommit // fallback
@@ -327,6 +356,7 @@ func selectPrimaryReference(results []*RuleResult) validator.Reference {
 	// Check in priority order
 	priorityOrder := []validator.Reference{
 		validator.RefGitConventionalCommit, // Format issues are fundamental
+		validator.RefGitEmojiPolicy,        // Title prefix issues
 		validator.RefGitFeatCI,             // Semantic type misuse
 		validator.RefGitBadTitle,           // Title issues
 		validator.RefGitBadBody,            // Body issues
@@ -335,6 +365,7 @@ func selectPrimaryReference(results []*RuleResult) validator.Reference {
 		validator.RefGitClaudeAttr,         // Content issues
 		validator.RefGitForbiddenPattern,   // Content issues
 		validator.RefGitSignoffMismatch,    // Signoff issues
+		validator.RefGitMissingTrailer,     // Trailer issues
 	}
 
 	for _, ref := range priorityOrder {
@@ -485,6 +516,33 @@ func (v *CommitValidator) getExpectedSignoff() string {
 	return ""
 }
 
+// getRequiredTrailers returns the required trailer tokens from config.
+func (v *CommitValidator) getRequiredTrailers() []string {
+	if v.config != nil && v.config.Message != nil {
+		return v.config.Message.RequiredTrailers
+	}
+
+	return nil
+}
+
+// shouldRequireGitmoji returns whether the title must start with a gitmoji.
+func (v *CommitValidator) shouldRequireGitmoji() bool {
+	if v.config != nil && v.config.Message != nil && v.config.Message.RequireGitmoji != nil {
+		return *v.config.Message.RequireGitmoji
+	}
+
+	return false
+}
+
+// shouldBlockEmoji returns whether emoji are blocked in the title.
+func (v *CommitValidator) shouldBlockEmoji() bool {
+	if v.config != nil && v.config.Message != nil && v.config.Message.BlockEmoji != nil {
+		return *v.config.Message.BlockEmoji
+	}
+
+	return false
+}
+
 // getForbiddenPatterns returns the list of forbidden patterns from config, or defaults.
 func (v *CommitValidator) getForbiddenPatterns() []string {
 	if v.config != nil && v.config.Message != nil && len(v.config.Message.ForbiddenPatterns) > 0 {
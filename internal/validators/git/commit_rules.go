@@ -552,6 +552,140 @@ func (r *SignoffRule) Validate(_ *ParsedCommit, message string) *RuleResult {
 	return nil
 }
 
+// identityTrailerValueRegex matches "Name <email>" trailer values.
+var identityTrailerValueRegex = regexp.MustCompile(`^[^<>]+ <[^<>\s@]+@[^<>\s]+>$`)
+
+// identityTrailers lists trailer tokens (lowercase) whose values must be "Name <email>".
+var identityTrailers = map[string]bool{
+	"signed-off-by":  true,
+	"co-authored-by": true,
+	"reviewed-by":    true,
+	"acked-by":       true,
+	"tested-by":      true,
+	"reported-by":    true,
+}
+
+// RequiredTrailersRule validates that required git trailers are present in the
+// commit message trailer block (the last paragraph) and are well-formed.
+type RequiredTrailersRule struct {
+	Trailers []string
+}
+
+func (*RequiredTrailersRule) Name() string {
+	return "required-trailers"
+}
+
+func (r *RequiredTrailersRule) Validate(_ *ParsedCommit, message string) *RuleResult {
+	if len(r.Trailers) == 0 {
+		return nil
+	}
+
+	block := extractTrailerBlock(message)
+
+	var primary string
+
+	ctx := make([]string, 0)
+
+	addIssue := func(msg string, lines ...string) {
+		if primary == "" {
+			primary = msg
+		} else {
+			ctx = append(ctx, msg)
+		}
+
+		ctx = append(ctx, lines...)
+	}
+
+	for _, token := range r.Trailers {
+		found, malformed := findTrailer(block, token)
+
+		switch {
+		case len(malformed) > 0:
+			lines := make([]string, 0, len(malformed))
+			for _, line := range malformed {
+				lines = append(lines, fmt.Sprintf("Line: '%s'", truncateLine(line)))
+			}
+
+			addIssue("Malformed trailer: "+token, lines...)
+		case !found:
+			addIssue("Missing required trailer: " + token)
+		}
+	}
+
+	if primary == "" {
+		return nil
+	}
+
+	ctx = append(ctx,
+		"Trailers must be in the last paragraph, separated from the body by an empty line",
+		"Format: 'Token: value' (identity trailers use 'Name <email>')",
+	)
+
+	return &RuleResult{
+		Reference: validator.RefGitMissingTrailer,
+		Message:   primary,
+		Context:   ctx,
+	}
+}
+
+// extractTrailerBlock returns the lines of the last paragraph of the commit message,
+// excluding the title paragraph. Returns nil when the message has no body.
+func extractTrailerBlock(message string) []string {
+	lines := strings.Split(strings.TrimRight(message, "\n "), "\n")
+
+	end := len(lines)
+
+	start := end
+	for start > 0 && strings.TrimSpace(lines[start-1]) != "" {
+		start--
+	}
+
+	// The first paragraph holds the title and can't be a trailer block
+	if start == 0 {
+		return nil
+	}
+
+	return lines[start:end]
+}
+
+// findTrailer looks up a trailer token (case-insensitive) in the trailer block.
+// It reports whether a well-formed trailer was found and returns any lines that
+// use the token but are malformed (missing colon, empty value, or bad identity).
+func findTrailer(block []string, token string) (bool, []string) {
+	lowerToken := strings.ToLower(token)
+	found := false
+
+	var malformed []string
+
+	for _, raw := range block {
+		line := strings.TrimSpace(raw)
+		if len(line) < len(token) || !strings.EqualFold(line[:len(token)], token) {
+			continue
+		}
+
+		rest := line[len(token):]
+
+		// Skip longer tokens sharing the prefix (e.g. "Signed-off-by-bot")
+		if rest != "" && rest[0] != ':' && rest[0] != ' ' && rest[0] != '\t' {
+			continue
+		}
+
+		value, ok := strings.CutPrefix(rest, ":")
+		value = strings.TrimSpace(value)
+
+		if !ok || value == "" ||
+			(identityTrailers[lowerToken] && !identityTrailerValueRegex.MatchString(value)) {
+			malformed = append(malformed, line)
+
+			continue
+		}
+
+		found = true
+	}
+
+	return found, malformed
+}
+
 // containsClaudeAIAttribution checks for AI attribution patterns.
 func containsClaudeAIAttribution(message string) bool {
 	lower := strings.ToLower(message)
@@ -1,6 +1,7 @@
 package git_test
 
 import (
+	"fmt"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -484,3 +485,168 @@ var _ = Describe("ConventionalFormatRule cascading warning", func() {
 		Expect(allText).To(ContainSubstring("type(scope): prefix counts toward 50-char limit"))
 	})
 })
+
+var _ = Describe("RequiredTrailersRule", func() {
+	var rule *git.RequiredTrailersRule
+
+	BeforeEach(func() {
+		rule = &git.RequiredTrailersRule{
+			Trailers: []string{"Signed-off-by", "Co-authored-by"},
+		}
+	})
+
+	validate := func(message string) *git.RuleResult {
+		return rule.Validate(&git.ParsedCommit{Title: "test", Valid: true}, message)
+	}
+
+	Context("when required trailers are present", func() {
+		DescribeTable(
+			"passes",
+			func(message string) {
+				Expect(validate(message)).To(BeNil())
+			},
+			Entry(
+				"body and trailers",
+				"feat(api): add endpoint\n\nAdd a new endpoint.\n\n"+
+					"Signed-off-by: Jane Doe <jane@klaudiu.sh>\n"+
+					"Co-authored-by: John Doe <john@klaudiu.sh>",
+			),
+			Entry(
+				"trailers only",
+				"feat(api): add endpoint\n\n"+
+					"Co-authored-by: John Doe <john@klaudiu.sh>\n"+
+					"Signed-off-by: Jane Doe <jane@klaudiu.sh>",
+			),
+			Entry(
+				"case-insensitive token",
+				"feat(api): add endpoint\n\n"+
+					"signed-off-by: Jane Doe <jane@klaudiu.sh>\n"+
+					"co-authored-by: John Doe <john@klaudiu.sh>",
+			),
+		)
+
+		It("passes when no trailers are configured", func() {
+			rule = &git.RequiredTrailersRule{}
+			Expect(validate("feat(api): add endpoint")).To(BeNil())
+		})
+	})
+
+	Context("when required trailers are missing", func() {
+		It("blocks a title-only message", func() {
+			result := validate("feat(api): add endpoint")
+			Expect(result).NotTo(BeNil())
+			Expect(result.Reference).To(Equal(validator.RefGitMissingTrailer))
+			Expect(result.Message).To(Equal("Missing required trailer: Signed-off-by"))
+			Expect(result.Context).To(ContainElement("Missing required trailer: Co-authored-by"))
+		})
+
+		It("ignores trailers outside the last paragraph", func() {
+			result := validate(
+				"feat(api): add endpoint\n\n" +
+					"Signed-off-by: Jane Doe <jane@klaudiu.sh>\n" +
+					"Co-authored-by: John Doe <john@klaudiu.sh>\n\n" +
+					"Some trailing prose.",
+			)
+			Expect(result).NotTo(BeNil())
+			Expect(result.Message).To(Equal("Missing required trailer: Signed-off-by"))
+		})
+
+		It("does not treat a longer token as the required one", func() {
+			rule = &git.RequiredTrailersRule{Trailers: []string{"Signed-off"}}
+			result := validate("feat(api): add endpoint\n\nSigned-off-by: Jane Doe <jane@klaudiu.sh>")
+			Expect(result).NotTo(BeNil())
+			Expect(result.Message).To(Equal("Missing required trailer: Signed-off"))
+		})
+	})
+
+	Context("when required trailers are malformed", func() {
+		DescribeTable(
+			"blocks",
+			func(trailer string) {
+				rule = &git.RequiredTrailersRule{Trailers: []string{"Signed-off-by"}}
+				result := validate("feat(api): add endpoint\n\n" + trailer)
+				Expect(result).NotTo(BeNil())
+				Expect(result.Reference).To(Equal(validator.RefGitMissingTrailer))
+				Expect(result.Message).To(Equal("Malformed trailer: Signed-off-by"))
+				Expect(result.Context).To(ContainElement(fmt.Sprintf("Line: '%s'", trailer)))
+			},
+			Entry("missing colon", "Signed-off-by Jane Doe <jane@klaudiu.sh>"),
+			Entry("empty value", "Signed-off-by:"),
+			Entry("missing email", "Signed-off-by: Jane Doe"),
+			Entry("missing name", "Signed-off-by: <jane@klaudiu.sh>"),
+			Entry("invalid email", "Signed-off-by: Jane Doe <jane>"),
+		)
+
+		It("accepts any non-empty value for non-identity trailers", func() {
+			rule = &git.RequiredTrailersRule{Trailers: []string{"Change-Id"}}
+			Expect(validate("feat(api): add endpoint\n\nChange-Id: I1234abcd")).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("EmojiPolicyRule", func() {
+	validate := func(rule *git.EmojiPolicyRule, message string) *git.RuleResult {
+		return rule.Validate(&git.ParsedCommit{Title: "test", Valid: true}, message)
+	}
+
+	Context("when gitmoji is required", func() {
+		rule := &git.EmojiPolicyRule{RequireGitmoji: true}
+
+		DescribeTable(
+			"passes",
+			func(message string) {
+				Expect(validate(rule, message)).To(BeNil())
+			},
+			Entry("emoji", "✨ feat(api): add endpoint"),
+			Entry("emoji with variation selector", "♻️ refactor(api): split handler"),
+			Entry("shortcode", ":sparkles: feat(api): add endpoint"),
+			Entry("with body", "🐛 fix(api): handle nil\n\nBody text."),
+			Entry("revert commit", `Revert "✨ feat(api): add endpoint"`),
+		)
+
+		DescribeTable(
+			"blocks",
+			func(message string) {
+				result := validate(rule, message)
+				Expect(result).NotTo(BeNil())
+				Expect(result.Reference).To(Equal(validator.RefGitEmojiPolicy))
+				Expect(result.Message).To(Equal("Title doesn't start with a gitmoji"))
+			},
+			Entry("no emoji", "feat(api): add endpoint"),
+			Entry("emoji not at start", "feat(api): add endpoint ✨"),
+			Entry("unknown emoji", "🦄 feat(api): add endpoint"),
+			Entry("unknown shortcode", ":unicorn: feat(api): add endpoint"),
+			Entry("emoji without space", "✨feat(api): add endpoint"),
+		)
+	})
+
+	Context("when emoji are blocked", func() {
+		rule := &git.EmojiPolicyRule{BlockEmoji: true}
+
+		DescribeTable(
+			"passes",
+			func(message string) {
+				Expect(validate(rule, message)).To(BeNil())
+			},
+			Entry("plain title", "feat(api): add endpoint"),
+			Entry("shortcode is not an emoji", ":sparkles: feat(api): add endpoint"),
+			Entry("text symbols", "docs(legal): update © and ™ notices"),
+			Entry("emoji in body only", "feat(api): add endpoint\n\nShip it 🚀"),
+		)
+
+		DescribeTable(
+			"blocks",
+			func(message, emoji string) {
+				result := validate(rule, message)
+				Expect(result).NotTo(BeNil())
+				Expect(result.Reference).To(Equal(validator.RefGitEmojiPolicy))
+				Expect(result.Message).To(Equal(fmt.Sprintf("Title contains emoji '%s'", emoji)))
+			},
+			Entry("leading gitmoji", "✨ feat(api): add endpoint", "✨"),
+			Entry("trailing emoji", "feat(api): add endpoint 🚀", "🚀"),
+			Entry("dingbat", "fix(api): handle nil ✅", "✅"),
+			Entry("extended pictograph", "chore(deps): bump 🧪 tools", "🧪"),
+			Entry("technical symbol", "perf(api): faster ⏪ rewind", "⏪"),
+		)
+	})
+})
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	gitpkg "github.com/smykla-skalski/klaudiush/internal/git"
+	validatorpkg "github.com/smykla-skalski/klaudiush/internal/validator"
 	"github.com/smykla-skalski/klaudiush/internal/validators/git"
 	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
@@ -1027,6 +1028,123 @@ Signed-off-by: Test User <test@klaudiu.sh>`
 				Expect(result.Passed).To(BeTrue())
 			})
 		})
+
+		Context("when required trailers are configured", func() {
+			var trailerValidator *git.CommitValidator
+
+			makeCtxWithMsg := func(msg string) *hook.Context {
+				return &hook.Context{
+					EventType: hook.EventTypePreToolUse,
+					ToolName:  hook.ToolTypeBash,
+					ToolInput: hook.ToolInput{
+						Command: `git commit -sS -a -m "` + msg + `"`,
+					},
+				}
+			}
+
+			BeforeEach(func() {
+				cfg := &config.CommitValidatorConfig{
+					Message: &config.CommitMessageConfig{
+						RequiredTrailers: []string{"Signed-off-by"},
+					},
+				}
+				trailerValidator = git.NewCommitValidator(log, fakeGit, cfg, nil)
+			})
+
+			It("should pass when the trailer is present", func() {
+				result := trailerValidator.Validate(
+					context.Background(),
+					makeCtxWithMsg("feat(api): add endpoint\n\nSigned-off-by: Test User <test@klaudiu.sh>"),
+				)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("should fail when the trailer is missing", func() {
+				result := trailerValidator.Validate(
+					context.Background(),
+					makeCtxWithMsg("feat(api): add endpoint"),
+				)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Reference).To(ContainSubstring("GIT026"))
+				Expect(result.Message).To(ContainSubstring("Missing required trailer: Signed-off-by"))
+			})
+
+			It("should fail when the trailer is malformed", func() {
+				result := trailerValidator.Validate(
+					context.Background(),
+					makeCtxWithMsg("feat(api): add endpoint\n\nSigned-off-by: Test User"),
+				)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("Malformed trailer: Signed-off-by"))
+			})
+		})
+
+		Context("when an emoji policy is configured", func() {
+			makeCtxWithMsg := func(msg string) *hook.Context {
+				return &hook.Context{
+					EventType: hook.EventTypePreToolUse,
+					ToolName:  hook.ToolTypeBash,
+					ToolInput: hook.ToolInput{
+						Command: `git commit -sS -a -m "` + msg + `"`,
+					},
+				}
+			}
+
+			newEmojiValidator := func(requireGitmoji, blockEmoji bool) *git.CommitValidator {
+				cfg := &config.CommitValidatorConfig{
+					Message: &config.CommitMessageConfig{
+						RequireGitmoji: &requireGitmoji,
+						BlockEmoji:     &blockEmoji,
+					},
+				}
+
+				return git.NewCommitValidator(log, fakeGit, cfg, nil)
+			}
+
+			It("should pass a gitmoji title that follows conventional commits", func() {
+				result := newEmojiValidator(true, false).Validate(
+					context.Background(),
+					makeCtxWithMsg(":sparkles: feat(api): add endpoint"),
+				)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("should still check conventional commits after the gitmoji", func() {
+				result := newEmojiValidator(true, false).Validate(
+					context.Background(),
+					makeCtxWithMsg("✨ add endpoint"),
+				)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Reference).To(ContainSubstring("GIT013"))
+			})
+
+			It("should fail when the gitmoji is missing", func() {
+				result := newEmojiValidator(true, false).Validate(
+					context.Background(),
+					makeCtxWithMsg("feat(api): add endpoint"),
+				)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Reference).To(ContainSubstring("GIT029"))
+			})
+
+			It("should fail when emoji are blocked", func() {
+				result := newEmojiValidator(false, true).Validate(
+					context.Background(),
+					makeCtxWithMsg("feat(api): add endpoint 🚀"),
+				)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Reference).To(ContainSubstring("GIT029"))
+				Expect(result.Message).To(ContainSubstring("Title contains emoji"))
+			})
+
+			It("should not check emoji when both options are off", func() {
+				result := newEmojiValidator(false, false).Validate(
+					context.Background(),
+					makeCtxWithMsg("feat(api): add endpoint 🚀"),
+				)
+				Expect(result.Passed).To(BeTrue())
+			})
+		})
 	})
 
 	Describe("File-based commit messages", func() {
@@ -1314,6 +1432,305 @@ Signed-off-by: Test User <test@klaudiu.sh>`
 		})
 	})
 
+	Describe("Amending pushed commits", func() {
+		var cfg *config.CommitValidatorConfig
+
+		amend := &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{
+				Command: `git commit --amend -sS -m "feat(api): amend commit"`,
+			},
+		}
+
+		BeforeEach(func() {
+			cfg = &config.CommitValidatorConfig{}
+			fakeGit.CurrentBranch = "feat/api"
+			fakeGit.Upstreams = map[string]gitpkg.UpstreamStatus{
+				"feat/api": {HasUpstream: true},
+			}
+		})
+
+		validate := func(ctx *hook.Context) *validatorpkg.Result {
+			v := git.NewCommitValidator(log, fakeGit, cfg, nil)
+
+			return v.Validate(context.Background(), ctx)
+		}
+
+		It("should block amending a pushed HEAD", func() {
+			result := validate(amend)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Reference).To(Equal(validatorpkg.RefGitAmendPushed))
+			Expect(result.Message).To(ContainSubstring(`HEAD of "feat/api" is already pushed`))
+		})
+
+		It("should only warn when block_amend_pushed is false", func() {
+			blockAmend := false
+			cfg.BlockAmendPushed = &blockAmend
+
+			result := validate(amend)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeFalse())
+			Expect(result.Reference).To(Equal(validatorpkg.RefGitAmendPushed))
+		})
+
+		It("should allow amending a local-only HEAD", func() {
+			fakeGit.Upstreams["feat/api"] = gitpkg.UpstreamStatus{HasUpstream: true, Ahead: 1}
+
+			Expect(validate(amend).Passed).To(BeTrue())
+		})
+
+		It("should allow amending a local HEAD on a diverged branch", func() {
+			fakeGit.Upstreams["feat/api"] = gitpkg.UpstreamStatus{
+				HasUpstream: true,
+				Ahead:       2,
+				Behind:      3,
+			}
+
+			Expect(validate(amend).Passed).To(BeTrue())
+		})
+
+		It("should skip branches without an upstream", func() {
+			fakeGit.Upstreams = nil
+
+			Expect(validate(amend).Passed).To(BeTrue())
+		})
+
+		It("should skip a detached HEAD", func() {
+			fakeGit.CurrentBranch = ""
+
+			Expect(validate(amend).Passed).To(BeTrue())
+		})
+
+		It("should skip when the upstream status cannot be read", func() {
+			fakeGit.Err = &gitpkg.FakeRunnerError{Msg: "git failed"}
+
+			Expect(validate(amend).Passed).To(BeTrue())
+		})
+
+		It("should not check commits without --amend", func() {
+			fakeGit.StagedFiles = []string{"main.go"}
+
+			result := validate(&hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+				ToolInput: hook.ToolInput{
+					Command: `git commit -sS -m "feat(api): new commit"`,
+				},
+			})
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should report a message error before the amend warning", func() {
+			blockAmend := false
+			cfg.BlockAmendPushed = &blockAmend
+
+			result := validate(&hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+				ToolInput: hook.ToolInput{
+					Command: `git commit --amend -sS -m "not conventional"`,
+				},
+			})
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Reference).NotTo(Equal(validatorpkg.RefGitAmendPushed))
+		})
+	})
+
+	Describe("Staged diff size", func() {
+		var cfg *config.CommitValidatorConfig
+
+		commit := func(command string) *hook.Context {
+			return &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+				ToolInput: hook.ToolInput{Command: command},
+			}
+		}
+
+		intPtr := func(v int) *int { return &v }
+
+		BeforeEach(func() {
+			fakeGit.DiffStats = []gitpkg.DiffStat{
+				{Path: "main.go", Added: 300, Deleted: 100, Bytes: 12000},
+				{Path: "web/package-lock.json", Added: 5000, Deleted: 4000, Bytes: 400000},
+				{Path: "gen/api/client.go", Added: 2000, Bytes: 80000},
+				{Path: "logo.png", Binary: true},
+			}
+			cfg = &config.CommitValidatorConfig{}
+		})
+
+		validate := func(command string) *validatorpkg.Result {
+			v := git.NewCommitValidator(log, fakeGit, cfg, nil)
+
+			return v.Validate(context.Background(), commit(command))
+		}
+
+		It("should not check the diff size by default", func() {
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should warn when the staged diff exceeds max_diff_lines", func() {
+			cfg.MaxDiffLines = intPtr(1000)
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeFalse())
+			Expect(result.Reference).To(Equal(validatorpkg.RefGitLargeCommit))
+			Expect(result.Message).To(ContainSubstring("11400 changed lines (max 1000)"))
+		})
+
+		It("should block when block_on_large_diff is enabled", func() {
+			cfg.MaxDiffLines = intPtr(1000)
+			block := true
+			cfg.BlockOnLargeDiff = &block
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Reference).To(Equal(validatorpkg.RefGitLargeCommit))
+		})
+
+		It("should check max_diff_bytes", func() {
+			cfg.MaxDiffBytes = intPtr(100000)
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("492000 changed bytes (max 100000)"))
+			Expect(result.Message).NotTo(ContainSubstring("changed lines"))
+		})
+
+		It("should not count excluded files", func() {
+			cfg.MaxDiffLines = intPtr(1000)
+			cfg.MaxDiffBytes = intPtr(100000)
+			cfg.DiffSizeExclude = []string{"package-lock.json", "gen/**"}
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should not let a name pattern with a slash match the file name", func() {
+			cfg.MaxDiffLines = intPtr(1000)
+			cfg.DiffSizeExclude = []string{"web/*.lock", "api/client.go"}
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeFalse())
+		})
+
+		It("should skip the check when committing with -a", func() {
+			cfg.MaxDiffLines = intPtr(1000)
+
+			result := validate(`git commit -sS -a -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should report a commit message error before the size warning", func() {
+			cfg.MaxDiffLines = intPtr(1000)
+
+			result := validate(`git commit -sS -m "bad format no type"`)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Reference).To(Equal(validatorpkg.RefGitConventionalCommit))
+		})
+
+		It("should pass when the diff stats cannot be read", func() {
+			cfg.MaxDiffLines = intPtr(1000)
+			fakeGit.Err = &gitpkg.FakeRunnerError{Msg: "git failed"}
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeTrue())
+		})
+	})
+
+	Describe("Changelog fragment", func() {
+		var cfg *config.CommitValidatorConfig
+
+		validate := func(command string) *validatorpkg.Result {
+			v := git.NewCommitValidator(log, fakeGit, cfg, nil)
+
+			return v.Validate(context.Background(), &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+				ToolInput: hook.ToolInput{Command: command},
+			})
+		}
+
+		BeforeEach(func() {
+			require := true
+			cfg = &config.CommitValidatorConfig{RequireChangelogFragment: &require}
+			fakeGit.StagedFiles = []string{"pkg/api/handler.go"}
+		})
+
+		It("should not require a fragment by default", func() {
+			cfg = &config.CommitValidatorConfig{}
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should block a commit without a fragment", func() {
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Reference).To(Equal(validatorpkg.RefGitMissingChangelog))
+			Expect(result.Message).To(ContainSubstring("changelog.d/"))
+		})
+
+		It("should pass a commit with a fragment", func() {
+			fakeGit.StagedFiles = append(fakeGit.StagedFiles, "changelog.d/123.feature.md")
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		DescribeTable("exempt commit types",
+			func(message string, passed bool) {
+				result := validate(`git commit -sS -m "` + message + `"`)
+				Expect(result.Passed).To(Equal(passed))
+			},
+			Entry("chore is exempt", "chore(deps): bump cobra", true),
+			Entry("docs is exempt", "docs(readme): fix typo", true),
+			Entry("fix is not exempt", "fix(api): handle nil user", false),
+		)
+
+		It("should use the configured exempt types", func() {
+			cfg.ExemptTypes = []string{"ci"}
+
+			Expect(validate(`git commit -sS -m "ci(lint): pin golangci"`).Passed).To(BeTrue())
+			Expect(validate(`git commit -sS -m "chore(deps): bump cobra"`).Passed).To(BeFalse())
+		})
+
+		It("should use the configured directory and pattern", func() {
+			cfg.ChangelogDir = "./news/"
+			cfg.ChangelogPattern = "*.md"
+			fakeGit.StagedFiles = append(fakeGit.StagedFiles, "news/123.txt")
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("news/ matching *.md"))
+
+			fakeGit.StagedFiles = append(fakeGit.StagedFiles, "news/123.md")
+
+			result = validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should not count a file outside the directory", func() {
+			fakeGit.StagedFiles = append(fakeGit.StagedFiles, "docs/changelog.d/123.md")
+
+			result := validate(`git commit -sS -m "feat(api): add endpoint"`)
+			Expect(result.Passed).To(BeFalse())
+		})
+
+		It("should skip the check when git add is in the chain", func() {
+			result := validate(
+				`git add changelog.d/123.md && git commit -sS -m "feat(api): add endpoint"`,
+			)
+			Expect(result.Passed).To(BeTrue())
+		})
+	})
+
 	Describe("Global options (-C flag)", func() {
 		It("should validate commit with -C directory option", func() {
 			ctx := &hook.Context{
@@ -3,9 +3,13 @@ package git
 import (
 	"context"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/cockroachdb/errors"
+
 	"github.com/smykla-skalski/klaudiush/internal/exec"
 	gitpkg "github.com/smykla-skalski/klaudiush/internal/git"
 )
@@ -179,6 +183,70 @@ func (r *CLIGitRunnerWithPath) GetRemotes() (map[string]string, error) {
 	return remotes, nil
 }
 
+// GetUpstreamStatus returns the upstream tracking status of the given branch
+func (r *CLIGitRunnerWithPath) GetUpstreamStatus(branch string) (gitpkg.UpstreamStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliUpstreamStatus(ctx, r.runner, []string{"-C", r.path}, branch)
+}
+
+// GetStagedDiffStats returns per-file line counts of the staged changes
+func (r *CLIGitRunnerWithPath) GetStagedDiffStats() ([]gitpkg.DiffStat, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliStagedDiffStats(ctx, r.runner, []string{"-C", r.path})
+}
+
+// GetUnpushedCommitSubjects returns the subjects of the commits on the given
+// branch that are not on its upstream, newest first
+func (r *CLIGitRunnerWithPath) GetUnpushedCommitSubjects(branch string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliUnpushedCommitSubjects(ctx, r.runner, []string{"-C", r.path}, branch)
+}
+
+// GetCommitDiffs returns the lines added by each non-merge commit in
+// revRange, newest first
+func (r *CLIGitRunnerWithPath) GetCommitDiffs(
+	revRange string,
+	maxCount int,
+) ([]gitpkg.CommitDiff, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliCommitDiffs(ctx, r.runner, []string{"-C", r.path}, revRange, maxCount)
+}
+
+// GetLastCommitTime returns the committer time of HEAD, or the zero time if
+// the repository has no commits
+func (r *CLIGitRunnerWithPath) GetLastCommitTime() (time.Time, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliLastCommitTime(ctx, r.runner, []string{"-C", r.path})
+}
+
+// IsDirty reports whether the path's working tree has staged, modified or
+// untracked files
+func (r *CLIGitRunnerWithPath) IsDirty() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliIsDirty(ctx, r.runner, []string{"-C", r.path})
+}
+
+// IsWorktree reports whether the path is in a linked worktree created by
+// git worktree add
+func (r *CLIGitRunnerWithPath) IsWorktree() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliIsWorktree(ctx, r.runner, []string{"-C", r.path}, r.path)
+}
+
 // NewGitRunner creates a GitRunner instance based on environment configuration
 // By default, uses SDK-based implementation for better performance
 // Set KLAUDIUSH_USE_SDK_GIT to "false" or "0" to use CLI-based implementation
@@ -376,6 +444,469 @@ func (r *CLIGitRunner) GetRemotes() (map[string]string, error) {
 	return remotes, nil
 }
 
+// GetUpstreamStatus returns the upstream tracking status of the given branch
+func (r *CLIGitRunner) GetUpstreamStatus(branch string) (gitpkg.UpstreamStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliUpstreamStatus(ctx, r.runner, nil, branch)
+}
+
+// GetStagedDiffStats returns per-file line counts of the staged changes
+func (r *CLIGitRunner) GetStagedDiffStats() ([]gitpkg.DiffStat, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliStagedDiffStats(ctx, r.runner, nil)
+}
+
+// GetUnpushedCommitSubjects returns the subjects of the commits on the given
+// branch that are not on its upstream, newest first
+func (r *CLIGitRunner) GetUnpushedCommitSubjects(branch string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliUnpushedCommitSubjects(ctx, r.runner, nil, branch)
+}
+
+// GetCommitDiffs returns the lines added by each non-merge commit in
+// revRange, newest first
+func (r *CLIGitRunner) GetCommitDiffs(
+	revRange string,
+	maxCount int,
+) ([]gitpkg.CommitDiff, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliCommitDiffs(ctx, r.runner, nil, revRange, maxCount)
+}
+
+// GetLastCommitTime returns the committer time of HEAD, or the zero time if
+// the repository has no commits
+func (r *CLIGitRunner) GetLastCommitTime() (time.Time, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliLastCommitTime(ctx, r.runner, nil)
+}
+
+// IsDirty reports whether the working tree has staged, modified or untracked
+// files
+func (r *CLIGitRunner) IsDirty() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliIsDirty(ctx, r.runner, nil)
+}
+
+// IsWorktree reports whether we're in a linked worktree created by
+// git worktree add
+func (r *CLIGitRunner) IsWorktree() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return cliIsWorktree(ctx, r.runner, nil, "")
+}
+
+// cliIsWorktree compares the git directory with the common directory from
+// "git rev-parse --git-dir --git-common-dir". They are the same directory
+// in the main worktree and differ in a linked worktree, whose git directory
+// is <common dir>/worktrees/<name>. Relative paths are resolved against dir,
+// the directory git runs in.
+func cliIsWorktree(
+	ctx context.Context,
+	runner exec.CommandRunner,
+	prefix []string,
+	dir string,
+) (bool, error) {
+	args := append(append([]string{}, prefix...), "rev-parse", "--git-dir", "--git-common-dir")
+
+	result := runner.Run(ctx, "git", args...)
+	if result.Err != nil {
+		return false, result.Err
+	}
+
+	lines := parseLines(result.Stdout)
+	if len(lines) != 2 { //nolint:mnd // one line per requested path
+		return false, errors.Newf("unexpected git rev-parse output: %q", result.Stdout)
+	}
+
+	return resolveGitPath(dir, lines[0]) != resolveGitPath(dir, lines[1]), nil
+}
+
+// resolveGitPath returns path as an absolute path with symlinks resolved, so
+// the git and common directories compare equal however git printed them.
+func resolveGitPath(dir, path string) string {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+
+	return filepath.Clean(path)
+}
+
+// cliLastCommitTime reads the committer time of HEAD with
+// "git log -1 --format=%ct". A repository without commits yields the zero
+// time and no error.
+func cliLastCommitTime(
+	ctx context.Context,
+	runner exec.CommandRunner,
+	prefix []string,
+) (time.Time, error) {
+	args := append(append([]string{}, prefix...), "rev-parse", "--verify", "--quiet", "HEAD")
+	if result := runner.Run(ctx, "git", args...); result.Err != nil {
+		return time.Time{}, nil
+	}
+
+	args = append(append([]string{}, prefix...), "log", "-1", "--format=%ct", "HEAD")
+
+	result := runner.Run(ctx, "git", args...)
+	if result.Err != nil {
+		return time.Time{}, result.Err
+	}
+
+	seconds, err := strconv.ParseInt(strings.TrimSpace(result.Stdout), 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to parse commit time")
+	}
+
+	return time.Unix(seconds, 0), nil
+}
+
+// cliIsDirty runs "git status --porcelain", which lists one line per staged,
+// modified or untracked path and nothing for a clean working tree.
+func cliIsDirty(ctx context.Context, runner exec.CommandRunner, prefix []string) (bool, error) {
+	args := append(append([]string{}, prefix...), "status", "--porcelain")
+
+	result := runner.Run(ctx, "git", args...)
+	if result.Err != nil {
+		return false, result.Err
+	}
+
+	return strings.TrimSpace(result.Stdout) != "", nil
+}
+
+// cliUpstreamStatus resolves the branch's upstream and counts commits ahead
+// and behind it. A branch without an upstream (or an empty branch name for
+// detached HEAD) yields a zero status and no error.
+func cliUpstreamStatus(
+	ctx context.Context,
+	runner exec.CommandRunner,
+	prefix []string,
+	branch string,
+) (gitpkg.UpstreamStatus, error) {
+	if branch == "" {
+		return gitpkg.UpstreamStatus{}, nil
+	}
+
+	upstream := branch + "@{upstream}"
+
+	args := append(append([]string{}, prefix...), "rev-parse", "--verify", "--quiet", upstream)
+	if result := runner.Run(ctx, "git", args...); result.Err != nil {
+		return gitpkg.UpstreamStatus{}, nil
+	}
+
+	args = append(
+		append([]string{}, prefix...),
+		"rev-list", "--left-right", "--count", branch+"..."+upstream,
+	)
+
+	result := runner.Run(ctx, "git", args...)
+	if result.Err != nil {
+		return gitpkg.UpstreamStatus{}, result.Err
+	}
+
+	fields := strings.Fields(result.Stdout)
+
+	const countFields = 2
+
+	if len(fields) != countFields {
+		return gitpkg.UpstreamStatus{}, errors.Newf(
+			"unexpected rev-list output: %q",
+			strings.TrimSpace(result.Stdout),
+		)
+	}
+
+	ahead, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return gitpkg.UpstreamStatus{}, errors.Wrap(err, "failed to parse ahead count")
+	}
+
+	behind, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return gitpkg.UpstreamStatus{}, errors.Wrap(err, "failed to parse behind count")
+	}
+
+	return gitpkg.UpstreamStatus{HasUpstream: true, Ahead: ahead, Behind: behind}, nil
+}
+
+// cliUnpushedCommitSubjects lists the subjects of "git log upstream..branch".
+// A branch without an upstream (or an empty branch name for detached HEAD)
+// yields no subjects and no error.
+func cliUnpushedCommitSubjects(
+	ctx context.Context,
+	runner exec.CommandRunner,
+	prefix []string,
+	branch string,
+) ([]string, error) {
+	if branch == "" {
+		return nil, nil
+	}
+
+	upstream := branch + "@{upstream}"
+
+	args := append(append([]string{}, prefix...), "rev-parse", "--verify", "--quiet", upstream)
+	if result := runner.Run(ctx, "git", args...); result.Err != nil {
+		return nil, nil
+	}
+
+	args = append(
+		append([]string{}, prefix...),
+		"log", "--format=%s", upstream+".."+branch,
+	)
+
+	result := runner.Run(ctx, "git", args...)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+
+	var subjects []string
+
+	for line := range strings.SplitSeq(strings.TrimSpace(result.Stdout), "\n") {
+		if line != "" {
+			subjects = append(subjects, line)
+		}
+	}
+
+	return subjects, nil
+}
+
+// commitRecordSeparator starts each commit in cliCommitDiffs output.
+const commitRecordSeparator = "\x1e"
+
+// cliCommitDiffs runs a zero-context "git log --patch" over revRange and
+// collects the added lines of each commit. An upstream in revRange that does
+// not exist yields ErrNoTracking, like the SDK implementation.
+func cliCommitDiffs(
+	ctx context.Context,
+	runner exec.CommandRunner,
+	prefix []string,
+	revRange string,
+	maxCount int,
+) ([]gitpkg.CommitDiff, error) {
+	for _, rev := range strings.Split(revRange, "..") {
+		if !strings.Contains(rev, "@{u") {
+			continue
+		}
+
+		args := append(append([]string{}, prefix...), "rev-parse", "--verify", "--quiet", rev)
+		if result := runner.Run(ctx, "git", args...); result.Err != nil {
+			return nil, errors.Wrapf(gitpkg.ErrNoTracking, "revision %q", rev)
+		}
+	}
+
+	args := append(
+		append([]string{}, prefix...),
+		"-c", "core.quotePath=false",
+		"log", "--no-merges", "--no-renames", "--no-color", "--no-ext-diff",
+		"--patch", "--unified=0", "--src-prefix=a/", "--dst-prefix=b/",
+		"--format="+commitRecordSeparator+"%H%x00%s",
+	)
+
+	if maxCount > 0 {
+		args = append(args, "--max-count="+strconv.Itoa(maxCount))
+	}
+
+	args = append(args, revRange, "--")
+
+	result := runner.Run(ctx, "git", args...)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+
+	return parseLogPatch(result.Stdout)
+}
+
+// parseLogPatch parses the output of cliCommitDiffs: a record separator,
+// hash, NUL and subject line for each commit, followed by its patch.
+func parseLogPatch(output string) ([]gitpkg.CommitDiff, error) {
+	var (
+		diffs  []gitpkg.CommitDiff
+		path   string
+		line   int
+		inHunk bool
+	)
+
+	for text := range strings.SplitSeq(output, "\n") {
+		switch {
+		case strings.HasPrefix(text, commitRecordSeparator):
+			hash, subject, _ := strings.Cut(strings.TrimPrefix(text, commitRecordSeparator), "\x00")
+			diffs = append(diffs, gitpkg.CommitDiff{Hash: hash, Subject: subject})
+			path, inHunk = "", false
+
+		case len(diffs) == 0:
+			continue
+
+		case strings.HasPrefix(text, "diff --git "):
+			path, inHunk = "", false
+
+		case strings.HasPrefix(text, "@@"):
+			start, err := parseHunkStart(text)
+			if err != nil {
+				return nil, err
+			}
+
+			line, inHunk = start, true
+
+		case !inHunk:
+			if name, ok := strings.CutPrefix(text, "+++ b/"); ok {
+				path = strings.TrimSuffix(name, "\t")
+			}
+
+		case strings.HasPrefix(text, "+") && path != "":
+			commit := &diffs[len(diffs)-1]
+			commit.Added = append(commit.Added, gitpkg.AddedLine{
+				Path: path,
+				Line: line,
+				Text: text[1:],
+			})
+			line++
+		}
+	}
+
+	return diffs, nil
+}
+
+// parseHunkStart returns the first new line number of a hunk header like
+// "@@ -10,2 +12,3 @@".
+func parseHunkStart(header string) (int, error) {
+	_, rest, _ := strings.Cut(header, " +")
+	start, _, _ := strings.Cut(rest, " ")
+	start, _, _ = strings.Cut(start, ",")
+
+	line, err := strconv.Atoi(start)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse hunk header %q", header)
+	}
+
+	return line, nil
+}
+
+// cliStagedDiffStats runs "git diff --cached --numstat" together with a
+// zero-context patch, which provides the byte counts numstat lacks. Renames
+// are disabled so paths match the SDK implementation.
+func cliStagedDiffStats(
+	ctx context.Context,
+	runner exec.CommandRunner,
+	prefix []string,
+) ([]gitpkg.DiffStat, error) {
+	args := append(
+		append([]string{}, prefix...),
+		"-c", "core.quotePath=false",
+		"diff", "--cached", "--no-renames", "--no-color", "--no-ext-diff",
+		"--numstat", "--patch", "--unified=0",
+	)
+
+	result := runner.Run(ctx, "git", args...)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+
+	return parseNumstatPatch(result.Stdout)
+}
+
+// parseNumstatPatch parses "git diff --numstat --patch" output: numstat lines
+// ("added<TAB>deleted<TAB>path", "-" for binary files) followed by one patch
+// per file in the same order.
+func parseNumstatPatch(output string) ([]gitpkg.DiffStat, error) {
+	var (
+		stats   []gitpkg.DiffStat
+		file    = -1
+		inHunk  bool
+		counted bool
+	)
+
+	for line := range strings.SplitSeq(output, "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			file++
+			inHunk = false
+
+		case file < 0:
+			if line == "" {
+				continue
+			}
+
+			stat, err := parseNumstatLine(line)
+			if err != nil {
+				return nil, err
+			}
+
+			stats = append(stats, stat)
+
+		case strings.HasPrefix(line, "@@"):
+			inHunk = true
+
+		case !inHunk || file >= len(stats):
+			continue
+
+		case strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-"):
+			// The +/- marker stands in for the line's newline.
+			stats[file].Bytes += len(line)
+			counted = true
+
+			continue
+
+		case strings.HasPrefix(line, "\\") && counted:
+			// "\ No newline at end of file" follows a line without a newline.
+			stats[file].Bytes--
+		}
+
+		counted = false
+	}
+
+	return stats, nil
+}
+
+// parseNumstatLine parses a single "added<TAB>deleted<TAB>path" line.
+func parseNumstatLine(line string) (gitpkg.DiffStat, error) {
+	const numstatFields = 3
+
+	fields := strings.SplitN(line, "\t", numstatFields)
+	if len(fields) != numstatFields {
+		return gitpkg.DiffStat{}, errors.Newf("unexpected numstat line: %q", line)
+	}
+
+	stat := gitpkg.DiffStat{Path: fields[2]}
+
+	if fields[0] == "-" && fields[1] == "-" {
+		stat.Binary = true
+
+		return stat, nil
+	}
+
+	added, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return gitpkg.DiffStat{}, errors.Wrapf(err, "failed to parse added count in %q", line)
+	}
+
+	deleted, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return gitpkg.DiffStat{}, errors.Wrapf(err, "failed to parse deleted count in %q", line)
+	}
+
+	stat.Added, stat.Deleted = added, deleted
+
+	return stat, nil
+}
+
 // parseLines splits output by newlines and filters empty lines
 func parseLines(output string) []string {
 	output = strings.TrimSpace(output)
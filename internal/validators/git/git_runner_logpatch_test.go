@@ -0,0 +1,68 @@
+package git
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gitpkg "github.com/smykla-skalski/klaudiush/internal/git"
+)
+
+var _ = Describe("parseLogPatch", func() {
+	It("collects the added lines of each commit", func() {
+		output := "\x1eaaa111\x00Add key\n" +
+			"\n" +
+			"diff --git a/.env b/.env\n" +
+			"--- a/.env\n" +
+			"+++ b/.env\n" +
+			"@@ -2 +2,2 @@\n" +
+			"-OLD=1\n" +
+			"+++plus\n" +
+			"+KEY=value\n" +
+			"diff --git a/logo.png b/logo.png\n" +
+			"Binary files a/logo.png and b/logo.png differ\n" +
+			"diff --git a/gone.txt b/gone.txt\n" +
+			"deleted file mode 100644\n" +
+			"--- a/gone.txt\n" +
+			"+++ /dev/null\n" +
+			"@@ -1 +0,0 @@\n" +
+			"-bye\n" +
+			"\x1ebbb222\x00Add docs\n" +
+			"\n" +
+			"diff --git a/docs/read me.md b/docs/read me.md\n" +
+			"new file mode 100644\n" +
+			"--- /dev/null\n" +
+			"+++ b/docs/read me.md\t\n" +
+			"@@ -0,0 +1 @@\n" +
+			"+hello\n" +
+			"\\ No newline at end of file\n"
+
+		diffs, err := parseLogPatch(output)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diffs).To(Equal([]gitpkg.CommitDiff{
+			{
+				Hash:    "aaa111",
+				Subject: "Add key",
+				Added: []gitpkg.AddedLine{
+					{Path: ".env", Line: 2, Text: "++plus"},
+					{Path: ".env", Line: 3, Text: "KEY=value"},
+				},
+			},
+			{
+				Hash:    "bbb222",
+				Subject: "Add docs",
+				Added:   []gitpkg.AddedLine{{Path: "docs/read me.md", Line: 1, Text: "hello"}},
+			},
+		}))
+	})
+
+	It("returns no commits for empty output", func() {
+		diffs, err := parseLogPatch("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diffs).To(BeEmpty())
+	})
+
+	It("rejects malformed hunk headers", func() {
+		_, err := parseLogPatch("\x1eaaa111\x00Subject\n@@ -1 +x @@\n")
+		Expect(err).To(MatchError(ContainSubstring("failed to parse hunk header")))
+	})
+})
@@ -0,0 +1,57 @@
+package git
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gitpkg "github.com/smykla-skalski/klaudiush/internal/git"
+)
+
+var _ = Describe("parseNumstatPatch", func() {
+	It("parses numstat lines and counts patch bytes per file", func() {
+		output := "-\t-\tlogo.png\n" +
+			"1\t0\tdocs/read me.md\n" +
+			"2\t1\tmain.go\n" +
+			"\n" +
+			"diff --git a/logo.png b/logo.png\n" +
+			"index 88768ef..8bd6648 100644\n" +
+			"Binary files a/logo.png and b/logo.png differ\n" +
+			"diff --git a/docs/read me.md b/docs/read me.md\n" +
+			"new file mode 100644\n" +
+			"--- /dev/null\n" +
+			"+++ b/docs/read me.md\t\n" +
+			"@@ -0,0 +1 @@\n" +
+			"+-- dash\n" +
+			"diff --git a/main.go b/main.go\n" +
+			"--- a/main.go\n" +
+			"+++ b/main.go\n" +
+			"@@ -2 +2 @@ package main\n" +
+			"-old\n" +
+			"+new\n" +
+			"@@ -3,0 +4 @@\n" +
+			"+end\n" +
+			"\\ No newline at end of file\n"
+
+		stats, err := parseNumstatPatch(output)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats).To(Equal([]gitpkg.DiffStat{
+			{Path: "logo.png", Binary: true},
+			{Path: "docs/read me.md", Added: 1, Bytes: 8},
+			{Path: "main.go", Added: 2, Deleted: 1, Bytes: 11},
+		}))
+	})
+
+	It("returns no stats for an empty diff", func() {
+		stats, err := parseNumstatPatch("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats).To(BeEmpty())
+	})
+
+	It("rejects malformed numstat lines", func() {
+		_, err := parseNumstatPatch("x\ty\tmain.go\n")
+		Expect(err).To(MatchError(ContainSubstring("failed to parse added count")))
+
+		_, err = parseNumstatPatch("garbage\n")
+		Expect(err).To(MatchError(ContainSubstring("unexpected numstat line")))
+	})
+})
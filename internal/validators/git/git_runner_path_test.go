@@ -2,14 +2,18 @@ package git_test
 
 import (
 	"os"
+	"os/exec"
 	"path/filepath"
+	"time"
 
 	gogit "github.com/go-git/go-git/v6"
 	"github.com/go-git/go-git/v6/config"
+	"github.com/go-git/go-git/v6/plumbing"
 	"github.com/go-git/go-git/v6/plumbing/object"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	gitpkg "github.com/smykla-skalski/klaudiush/internal/git"
 	"github.com/smykla-skalski/klaudiush/internal/validators/git"
 )
 
@@ -135,6 +139,76 @@ var _ = Describe("CLIGitRunnerWithPath", func() {
 		})
 	})
 
+	Describe("IsWorktree", func() {
+		It("should return false for the main working tree", func() {
+			isWorktree, err := runner.IsWorktree()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isWorktree).To(BeFalse())
+		})
+
+		Context("when the path is a linked worktree", func() {
+			var worktreeDir string
+
+			BeforeEach(func() {
+				// GIT_DIR and GIT_WORK_TREE pin git to the main working tree,
+				// so clear them to let git discover the linked worktree.
+				os.Unsetenv("GIT_DIR")
+				os.Unsetenv("GIT_WORK_TREE")
+
+				testFile := filepath.Join(tempDir, "initial.txt")
+				Expect(os.WriteFile(testFile, []byte("initial"), 0o644)).To(Succeed())
+
+				worktree, err := repo.Worktree()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Add("initial.txt")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Commit("Initial commit", &gogit.CommitOptions{Author: testAuthor})
+				Expect(err).NotTo(HaveOccurred())
+
+				worktreeDir = filepath.Join(tempDir, "worktrees", "feature")
+
+				output, err := exec.Command(
+					"git", "-C", tempDir, "worktree", "add", "-b", "feature", worktreeDir,
+				).CombinedOutput()
+				if err != nil {
+					Skip("git worktree command not available: " + string(output))
+				}
+			})
+
+			It("should return true", func() {
+				isWorktree, err := git.NewCLIGitRunnerForPath(worktreeDir).IsWorktree()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isWorktree).To(BeTrue())
+			})
+
+			It("should return the worktree checkout as root from a subdirectory", func() {
+				subDir := filepath.Join(worktreeDir, "sub")
+				Expect(os.Mkdir(subDir, 0o755)).To(Succeed())
+
+				subRunner := git.NewCLIGitRunnerForPath(subDir)
+
+				root, err := subRunner.GetRepoRoot()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(root).To(Equal(worktreeDir))
+
+				isWorktree, err := subRunner.IsWorktree()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isWorktree).To(BeTrue())
+			})
+
+			It("should return false from a subdirectory of the main working tree", func() {
+				subDir := filepath.Join(tempDir, "subdir")
+				Expect(os.Mkdir(subDir, 0o755)).To(Succeed())
+
+				isWorktree, err := git.NewCLIGitRunnerForPath(subDir).IsWorktree()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isWorktree).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("GetStagedFiles", func() {
 		Context("when no files are staged", func() {
 			It("should return empty list", func() {
@@ -165,6 +239,45 @@ var _ = Describe("CLIGitRunnerWithPath", func() {
 		})
 	})
 
+	Describe("GetStagedDiffStats", func() {
+		BeforeEach(func() {
+			worktree, err := repo.Worktree()
+			Expect(err).NotTo(HaveOccurred())
+
+			write := func(name, content string) {
+				err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0o644)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Add(name)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			write("a.txt", "a\nb\nc\n")
+			write("old.txt", "gone\n")
+
+			_, err = worktree.Commit("Initial commit", &gogit.CommitOptions{Author: testAuthor})
+			Expect(err).NotTo(HaveOccurred())
+
+			write("a.txt", "a\nB\nc\nd")
+			write("new file.txt", "x\ny\n")
+			write("bin.dat", "\x00\x01\x02")
+
+			_, err = worktree.Remove("old.txt")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should count staged lines and bytes per file", func() {
+			stats, err := runner.GetStagedDiffStats()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats).To(Equal([]gitpkg.DiffStat{
+				{Path: "a.txt", Added: 2, Deleted: 1, Bytes: 5},
+				{Path: "bin.dat", Binary: true},
+				{Path: "new file.txt", Added: 2, Bytes: 4},
+				{Path: "old.txt", Deleted: 1, Bytes: 5},
+			}))
+		})
+	})
+
 	Describe("GetModifiedFiles", func() {
 		BeforeEach(func() {
 			// Create initial commit
@@ -257,6 +370,58 @@ var _ = Describe("CLIGitRunnerWithPath", func() {
 		})
 	})
 
+	Describe("GetLastCommitTime", func() {
+		Context("when the repository has no commits", func() {
+			It("should return the zero time without error", func() {
+				lastCommit, err := runner.GetLastCommitTime()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lastCommit.IsZero()).To(BeTrue())
+			})
+		})
+
+		Context("when HEAD has a commit", func() {
+			It("should return its committer time", func() {
+				when := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+				testFile := filepath.Join(tempDir, "initial.txt")
+				Expect(os.WriteFile(testFile, []byte("initial"), 0o644)).To(Succeed())
+
+				worktree, err := repo.Worktree()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Add("initial.txt")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Commit("Initial commit", &gogit.CommitOptions{
+					Author:    testAuthor,
+					Committer: &object.Signature{Name: "Test User", When: when},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				lastCommit, err := runner.GetLastCommitTime()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lastCommit.Equal(when)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("IsDirty", func() {
+		It("should report a clean working tree", func() {
+			dirty, err := runner.IsDirty()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dirty).To(BeFalse())
+		})
+
+		It("should report untracked files as dirty", func() {
+			testFile := filepath.Join(tempDir, "untracked.txt")
+			Expect(os.WriteFile(testFile, []byte("content"), 0o644)).To(Succeed())
+
+			dirty, err := runner.IsDirty()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dirty).To(BeTrue())
+		})
+	})
+
 	Describe("GetRemoteURL", func() {
 		Context("when remote exists", func() {
 			BeforeEach(func() {
@@ -339,6 +504,119 @@ var _ = Describe("CLIGitRunnerWithPath", func() {
 		})
 	})
 
+	Describe("GetUpstreamStatus", func() {
+		var head plumbing.Hash
+
+		BeforeEach(func() {
+			testFile := filepath.Join(tempDir, "initial.txt")
+			err := os.WriteFile(testFile, []byte("initial"), 0o644)
+			Expect(err).NotTo(HaveOccurred())
+
+			worktree, err := repo.Worktree()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worktree.Add("initial.txt")
+			Expect(err).NotTo(HaveOccurred())
+
+			head, err = worktree.Commit("Initial commit", &gogit.CommitOptions{
+				Author: testAuthor,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when branch has no upstream", func() {
+			It("should report no upstream without error", func() {
+				status, err := runner.GetUpstreamStatus("master")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.HasUpstream).To(BeFalse())
+			})
+		})
+
+		Context("when HEAD is detached", func() {
+			It("should report no upstream for an empty branch", func() {
+				status, err := runner.GetUpstreamStatus("")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.HasUpstream).To(BeFalse())
+			})
+		})
+
+		Context("when branch is ahead of its upstream", func() {
+			BeforeEach(func() {
+				_, err := repo.CreateRemote(&config.RemoteConfig{
+					Name: "origin",
+					URLs: []string{"https://github.com/test/repo.git"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				cfg, err := repo.Config()
+				Expect(err).NotTo(HaveOccurred())
+
+				cfg.Branches["master"] = &config.Branch{
+					Name:   "master",
+					Remote: "origin",
+					Merge:  "refs/heads/master",
+				}
+				Expect(repo.SetConfig(cfg)).To(Succeed())
+
+				Expect(repo.Storer.SetReference(plumbing.NewHashReference(
+					plumbing.NewRemoteReferenceName("origin", "master"),
+					head,
+				))).To(Succeed())
+
+				testFile := filepath.Join(tempDir, "local.txt")
+				Expect(os.WriteFile(testFile, []byte("local"), 0o644)).To(Succeed())
+
+				worktree, err := repo.Worktree()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Add("local.txt")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worktree.Commit("Local commit", &gogit.CommitOptions{
+					Author: testAuthor,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should count commits ahead and behind", func() {
+				status, err := runner.GetUpstreamStatus("master")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.HasUpstream).To(BeTrue())
+				Expect(status.Ahead).To(Equal(1))
+				Expect(status.Behind).To(Equal(0))
+			})
+
+			It("should list the subjects of unpushed commits", func() {
+				subjects, err := runner.GetUnpushedCommitSubjects("master")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subjects).To(Equal([]string{"Local commit"}))
+			})
+
+			It("should list the added lines of unpushed commits", func() {
+				diffs, err := runner.GetCommitDiffs("@{u}..HEAD", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(diffs).To(HaveLen(1))
+				Expect(diffs[0].Subject).To(Equal("Local commit"))
+				Expect(diffs[0].Added).To(Equal([]gitpkg.AddedLine{
+					{Path: "local.txt", Line: 1, Text: "local"},
+				}))
+			})
+		})
+
+		Context("when listing unpushed commits without an upstream", func() {
+			It("should return no subjects without error", func() {
+				subjects, err := runner.GetUnpushedCommitSubjects("master")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subjects).To(BeEmpty())
+			})
+
+			It("should return ErrNoTracking for a range from the upstream", func() {
+				_, err := runner.GetCommitDiffs("@{u}..HEAD", 0)
+				Expect(err).To(MatchError(gitpkg.ErrNoTracking))
+			})
+		})
+	})
+
 	Describe("GetRemotes", func() {
 		Context("when no remotes exist", func() {
 			It("should return empty map", func() {
@@ -89,15 +89,33 @@ func (v *PushValidator) validatePushCommand(
 		return result
 	}
 
+	if result := v.validateAllowedRemote(remote, runner); !result.Passed {
+		return result
+	}
+
+	// A tag push warning is only reported when nothing else fails
+	tagPush := v.validateNoTagPush(gitCmd, remote)
+	if !tagPush.Passed && tagPush.ShouldBlock {
+		return tagPush
+	}
+
 	// Skip remote existence check if a preceding command adds this remote
 	if pendingRemotes[remote] {
 		v.Logger().
 			Debug("remote being added by preceding command, skipping check", "remote", remote)
 
-		return validator.Pass()
+		return tagPush
+	}
+
+	if result := v.validateRemoteExists(remote, runner); !result.Passed {
+		return result
 	}
 
-	return v.validateRemoteExists(remote, runner)
+	if result := v.validateNoBlockedMarkers(gitCmd, runner); !result.Passed {
+		return result
+	}
+
+	return tagPush
 }
 
 // getRunnerForCommand returns the appropriate git runner for the command.
@@ -226,6 +244,45 @@ func (v *PushValidator) validateNotBlockedRemote(
 	return result
 }
 
+// validateAllowedRemote checks that the remote is in the allowlist, if one is
+// configured
+func (v *PushValidator) validateAllowedRemote(
+	remote string,
+	runner GitRunner,
+) *validator.Result {
+	if v.config == nil || len(v.config.AllowedRemotes) == 0 ||
+		slices.Contains(v.config.AllowedRemotes, remote) {
+		return validator.Pass()
+	}
+
+	result := validator.FailWithRef(
+		validator.RefGitBlockedRemote,
+		templates.MustExecute(
+			templates.PushRemoteNotAllowedTemplate,
+			templates.PushRemoteNotAllowedData{
+				Remote:            remote,
+				AllowedRemotesStr: strings.Join(v.config.AllowedRemotes, ", "),
+			},
+		),
+	)
+
+	// Suggest the first allowed remote the repository has
+	allRemotes, err := runner.GetRemotes()
+	if err != nil {
+		return result
+	}
+
+	for _, allowed := range v.config.AllowedRemotes {
+		if _, exists := allRemotes[allowed]; exists {
+			return result.WithFixHint(
+				"Push to '" + allowed + "' instead: git push " + allowed + " <branch>",
+			)
+		}
+	}
+
+	return result
+}
+
 // validateRemoteExists checks if the remote exists
 func (*PushValidator) validateRemoteExists(remote string, runner GitRunner) *validator.Result {
 	helper := NewRemoteHelper()
@@ -0,0 +1,201 @@
+package git
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+// validateNoBlockedMarkers checks the commits being pushed (the commits on the
+// pushed branch that are not on its upstream) for blocked markers such as WIP
+// or "DO NOT MERGE". Only pushes to the configured target branches are
+// checked, and the check is skipped when the branch has no known upstream.
+func (v *PushValidator) validateNoBlockedMarkers(
+	gitCmd *parser.GitCommand,
+	runner GitRunner,
+) *validator.Result {
+	log := v.Logger()
+
+	markers := v.getBlockedCommitMarkers()
+	if len(markers) == 0 {
+		return validator.Pass()
+	}
+
+	source, target := pushBranches(gitCmd, runner)
+	if source == "" || !v.isCommitMarkerBranch(target) {
+		return validator.Pass()
+	}
+
+	status, err := runner.GetUpstreamStatus(source)
+	if err != nil || !status.HasUpstream {
+		log.Debug("upstream unknown, skipping commit marker check", "branch", source)
+		return validator.Pass()
+	}
+
+	if status.Ahead == 0 {
+		return validator.Pass()
+	}
+
+	subjects, err := runner.GetUnpushedCommitSubjects(source)
+	if err != nil {
+		log.Debug("failed to list unpushed commits", "branch", source, "error", err)
+		return validator.Pass()
+	}
+
+	var flagged []string
+
+	for _, subject := range subjects {
+		if marker := findCommitMarker(subject, markers); marker != "" {
+			flagged = append(flagged, fmt.Sprintf("%s (marker: %s)", subject, marker))
+		}
+	}
+
+	if len(flagged) == 0 {
+		return validator.Pass()
+	}
+
+	message := fmt.Sprintf(
+		"Pushing %d commit(s) with a blocked marker to '%s'",
+		len(flagged),
+		target,
+	)
+	details := strings.Join(flagged, "\n")
+
+	if v.getCommitMarkerSeverity() == config.SeverityWarning {
+		return validator.WarnWithRef(validator.RefGitBlockedCommitMarker, message).
+			AddDetail("commits", details)
+	}
+
+	return validator.FailWithRef(validator.RefGitBlockedCommitMarker, message).
+		AddDetail("commits", details)
+}
+
+// pushBranches returns the local branch being pushed and the remote branch it
+// is pushed to. The refspec is the first argument after the remote; without
+// one the current branch is pushed to the branch of the same name. source is
+// empty when it cannot be determined (e.g. a ref deletion or detached HEAD).
+func pushBranches(gitCmd *parser.GitCommand, runner GitRunner) (source, target string) {
+	if gitCmd.HasFlag("--delete") || gitCmd.HasFlag("-d") {
+		return "", ""
+	}
+
+	var positional []string
+
+	for _, arg := range gitCmd.Args {
+		if !strings.HasPrefix(arg, "-") {
+			positional = append(positional, arg)
+		}
+	}
+
+	refspec := "HEAD"
+	if len(positional) > 1 {
+		refspec = strings.TrimPrefix(positional[1], "+")
+	}
+
+	source, target, hasTarget := strings.Cut(refspec, ":")
+	if source == "HEAD" {
+		current, err := runner.GetCurrentBranch()
+		if err != nil {
+			return "", ""
+		}
+
+		source = current
+	}
+
+	if !hasTarget {
+		target = source
+	}
+
+	return strings.TrimPrefix(source, "refs/heads/"), strings.TrimPrefix(target, "refs/heads/")
+}
+
+// findCommitMarker returns the first marker found in subject, or "" if none.
+// Matching is case-insensitive. A marker starting or ending with a letter or
+// digit must not be directly preceded or followed by one.
+func findCommitMarker(subject string, markers []string) string {
+	lower := strings.ToLower(subject)
+
+	for _, marker := range markers {
+		needle := strings.ToLower(marker)
+		if needle == "" {
+			continue
+		}
+
+		for offset := 0; ; {
+			idx := strings.Index(lower[offset:], needle)
+			if idx < 0 {
+				break
+			}
+
+			start := offset + idx
+			end := start + len(needle)
+
+			if isMarkerBoundary(lower, start, end, needle) {
+				return marker
+			}
+
+			offset = start + 1
+		}
+	}
+
+	return ""
+}
+
+// isMarkerBoundary reports whether the match of needle at s[start:end] is not
+// part of a longer word.
+func isMarkerBoundary(s string, start, end int, needle string) bool {
+	first, _ := utf8.DecodeRuneInString(needle)
+	if isWordRune(first) && start > 0 {
+		if prev, _ := utf8.DecodeLastRuneInString(s[:start]); isWordRune(prev) {
+			return false
+		}
+	}
+
+	last, _ := utf8.DecodeLastRuneInString(needle)
+	if isWordRune(last) && end < len(s) {
+		if next, _ := utf8.DecodeRuneInString(s[end:]); isWordRune(next) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// getBlockedCommitMarkers returns the markers that block a push
+func (v *PushValidator) getBlockedCommitMarkers() []string {
+	if v.config != nil && v.config.BlockedCommitMarkers != nil {
+		return v.config.BlockedCommitMarkers
+	}
+
+	return config.DefaultBlockedCommitMarkers
+}
+
+// isCommitMarkerBranch reports whether pushes to branch are checked for
+// blocked commit markers. An empty branch list checks every branch.
+func (v *PushValidator) isCommitMarkerBranch(branch string) bool {
+	branches := config.DefaultCommitMarkerBranches
+	if v.config != nil && v.config.CommitMarkerBranches != nil {
+		branches = v.config.CommitMarkerBranches
+	}
+
+	return len(branches) == 0 || slices.Contains(branches, branch)
+}
+
+// getCommitMarkerSeverity returns the severity of a blocked commit marker
+func (v *PushValidator) getCommitMarkerSeverity() config.Severity {
+	if v.config != nil && v.config.CommitMarkerSeverity != config.SeverityUnknown {
+		return v.config.CommitMarkerSeverity
+	}
+
+	return config.SeverityError
+}
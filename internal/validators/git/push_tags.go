@@ -0,0 +1,55 @@
+package git
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+// tagPushFlags are the git push flags that push tags along with the branch.
+var tagPushFlags = []string{"--tags", "--follow-tags"}
+
+// validateNoTagPush flags a push that includes tags when block_tag_push is
+// enabled, unless the remote is listed in tag_push_remotes.
+func (v *PushValidator) validateNoTagPush(
+	gitCmd *parser.GitCommand,
+	remote string,
+) *validator.Result {
+	if v.config == nil || v.config.BlockTagPush == nil || !*v.config.BlockTagPush {
+		return validator.Pass()
+	}
+
+	flag := ""
+
+	for _, f := range tagPushFlags {
+		if gitCmd.HasFlag(f) {
+			flag = f
+
+			break
+		}
+	}
+
+	if flag == "" || slices.Contains(v.config.TagPushRemotes, remote) {
+		return validator.Pass()
+	}
+
+	message := fmt.Sprintf("Pushing tags to '%s' with %s", remote, flag)
+
+	if v.getTagPushSeverity() == config.SeverityWarning {
+		return validator.WarnWithRef(validator.RefGitTagPush, message)
+	}
+
+	return validator.FailWithRef(validator.RefGitTagPush, message)
+}
+
+// getTagPushSeverity returns the severity of a push including tags
+func (v *PushValidator) getTagPushSeverity() config.Severity {
+	if v.config != nil && v.config.TagPushSeverity != config.SeverityUnknown {
+		return v.config.TagPushSeverity
+	}
+
+	return config.SeverityError
+}
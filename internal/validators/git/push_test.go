@@ -345,6 +345,62 @@ var _ = Describe("PushValidator", func() {
 			})
 		})
 
+		Context("allowed remotes", func() {
+			BeforeEach(func() {
+				cfg := &config.PushValidatorConfig{}
+				cfg.AllowedRemotes = []string{"fork", "origin"}
+				validator = git.NewPushValidator(log, fakeGit, cfg, nil)
+			})
+
+			It("allows push to an allowed remote", func() {
+				ctx := createContext("git push origin main")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("blocks push to a remote outside the allowlist", func() {
+				ctx := createContext("git push upstream main")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Reference).To(Equal(validatorpkg.RefGitBlockedRemote))
+				Expect(result.Message).
+					To(ContainSubstring("Remote 'upstream' is not allowed for push operations"))
+				Expect(result.Message).To(ContainSubstring("Allowed remotes: [fork, origin]"))
+				Expect(result.FixHint).To(ContainSubstring("git push origin <branch>"))
+			})
+
+			It("blocks push to a tracking remote outside the allowlist", func() {
+				fakeGit.CurrentBranch = "feature-branch"
+				fakeGit.BranchRemotes = map[string]string{
+					"feature-branch": "upstream",
+				}
+
+				ctx := createContext("git push")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("Remote 'upstream' is not allowed"))
+			})
+
+			It("allows push to an allowed tracking remote", func() {
+				fakeGit.CurrentBranch = "feature-branch"
+				fakeGit.BranchRemotes = map[string]string{
+					"feature-branch": "origin",
+				}
+
+				ctx := createContext("git push")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("allows all remotes when the allowlist is empty", func() {
+				validator = git.NewPushValidator(log, fakeGit, &config.PushValidatorConfig{}, nil)
+
+				ctx := createContext("git push upstream main")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+		})
+
 		Context("compound commands with git remote add", func() {
 			It("passes when remote is added in a preceding command", func() {
 				ctx := createContext(
@@ -405,5 +461,202 @@ var _ = Describe("PushValidator", func() {
 				Expect(result.Passed).To(BeTrue())
 			})
 		})
+
+		Context("blocked commit markers", func() {
+			BeforeEach(func() {
+				fakeGit.CurrentBranch = "main"
+				fakeGit.Upstreams = map[string]gitpkg.UpstreamStatus{
+					"main":    {HasUpstream: true, Ahead: 2},
+					"feature": {HasUpstream: true, Ahead: 1},
+				}
+			})
+
+			It("blocks pushing a WIP commit to a protected branch", func() {
+				fakeGit.Unpushed = map[string][]string{
+					"main": {"feat(api): add endpoint", "WIP: half-done refactor"},
+				}
+
+				ctx := createContext("git push origin main")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeTrue())
+				Expect(result.Reference).To(Equal(validatorpkg.RefGitBlockedCommitMarker))
+				Expect(result.Message).To(
+					ContainSubstring("Pushing 1 commit(s) with a blocked marker to 'main'"),
+				)
+				Expect(result.Details["commits"]).To(
+					ContainSubstring("WIP: half-done refactor (marker: WIP)"),
+				)
+				Expect(result.Details["commits"]).NotTo(ContainSubstring("add endpoint"))
+			})
+
+			It("passes when all pending commits are clean", func() {
+				fakeGit.Unpushed = map[string][]string{
+					"main": {"feat(api): add endpoint", "fix(api): handle nil body"},
+				}
+
+				ctx := createContext("git push origin main")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			DescribeTable("default markers",
+				func(subject string, blocked bool) {
+					fakeGit.Unpushed = map[string][]string{"main": {subject}}
+
+					ctx := createContext("git push")
+					result := validator.Validate(context.Background(), ctx)
+					Expect(result.Passed).To(Equal(!blocked))
+				},
+				Entry("WIP prefix", "WIP: refactor", true),
+				Entry("bracketed wip", "[wip] feat: add api", true),
+				Entry("do not merge", "feat: add api (do not merge)", true),
+				Entry("DONOTMERGE", "DONOTMERGE test hack", true),
+				Entry("ci skip", "docs: fix typo [ci skip]", true),
+				Entry("wip inside a word", "chore: wipe cache", false),
+				Entry("clean subject", "feat: add api", false),
+			)
+
+			It("skips the check when the upstream is unknown", func() {
+				fakeGit.Upstreams = nil
+				fakeGit.Unpushed = map[string][]string{"main": {"WIP: refactor"}}
+
+				ctx := createContext("git push origin main")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("skips pushes to branches that are not checked", func() {
+				fakeGit.CurrentBranch = "feature"
+				fakeGit.Unpushed = map[string][]string{"feature": {"WIP: refactor"}}
+
+				ctx := createContext("git push origin feature")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+
+			It("checks the target branch of a refspec", func() {
+				fakeGit.CurrentBranch = "feature"
+				fakeGit.Unpushed = map[string][]string{"feature": {"WIP: refactor"}}
+
+				ctx := createContext("git push origin HEAD:main")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Message).To(ContainSubstring("to 'main'"))
+			})
+
+			It("warns instead of blocking when severity is warning", func() {
+				cfg := &config.PushValidatorConfig{CommitMarkerSeverity: config.SeverityWarning}
+				validator = git.NewPushValidator(log, fakeGit, cfg, nil)
+				fakeGit.Unpushed = map[string][]string{"main": {"WIP: refactor"}}
+
+				ctx := createContext("git push origin main")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeFalse())
+				Expect(result.Reference).To(Equal(validatorpkg.RefGitBlockedCommitMarker))
+			})
+
+			It("uses configured markers and branches", func() {
+				cfg := &config.PushValidatorConfig{
+					BlockedCommitMarkers: []string{"fixup!"},
+					CommitMarkerBranches: []string{},
+				}
+				validator = git.NewPushValidator(log, fakeGit, cfg, nil)
+				fakeGit.CurrentBranch = "feature"
+				fakeGit.Unpushed = map[string][]string{
+					"feature": {"fixup! feat: add api", "WIP: refactor"},
+				}
+
+				ctx := createContext("git push origin feature")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.Details["commits"]).To(ContainSubstring("fixup! feat: add api"))
+				Expect(result.Details["commits"]).NotTo(ContainSubstring("WIP"))
+			})
+
+			It("skips the check when no markers are configured", func() {
+				cfg := &config.PushValidatorConfig{BlockedCommitMarkers: []string{}}
+				validator = git.NewPushValidator(log, fakeGit, cfg, nil)
+				fakeGit.Unpushed = map[string][]string{"main": {"WIP: refactor"}}
+
+				ctx := createContext("git push origin main")
+				result := validator.Validate(context.Background(), ctx)
+				Expect(result.Passed).To(BeTrue())
+			})
+		})
+
+		Context("tag pushes", func() {
+			BeforeEach(func() {
+				fakeGit.CurrentBranch = "feature"
+				validator = git.NewPushValidator(log, fakeGit, &config.PushValidatorConfig{
+					BlockTagPush: new(true),
+				}, nil)
+			})
+
+			DescribeTable("blocks pushes that include tags",
+				func(command, flag string) {
+					result := validator.Validate(context.Background(), createContext(command))
+					Expect(result.Passed).To(BeFalse())
+					Expect(result.ShouldBlock).To(BeTrue())
+					Expect(result.Reference).To(Equal(validatorpkg.RefGitTagPush))
+					Expect(result.Message).To(Equal("Pushing tags to 'origin' with " + flag))
+				},
+				Entry("--tags", "git push --tags", "--tags"),
+				Entry("--tags with remote", "git push origin --tags", "--tags"),
+				Entry("--follow-tags", "git push origin --follow-tags", "--follow-tags"),
+				Entry("--follow-tags with branch", "git push --follow-tags origin feature",
+					"--follow-tags"),
+			)
+
+			It("allows normal branch pushes", func() {
+				for _, command := range []string{
+					"git push",
+					"git push origin feature",
+					"git push -u origin feature",
+				} {
+					result := validator.Validate(context.Background(), createContext(command))
+					Expect(result.Passed).To(BeTrue(), command)
+				}
+			})
+
+			It("allows tags to a tag-publishing remote", func() {
+				validator = git.NewPushValidator(log, fakeGit, &config.PushValidatorConfig{
+					BlockTagPush:   new(true),
+					TagPushRemotes: []string{"upstream"},
+				}, nil)
+
+				result := validator.Validate(
+					context.Background(),
+					createContext("git push upstream --tags"),
+				)
+				Expect(result.Passed).To(BeTrue())
+
+				result = validator.Validate(
+					context.Background(),
+					createContext("git push origin --tags"),
+				)
+				Expect(result.Passed).To(BeFalse())
+			})
+
+			It("warns instead of blocking when severity is warning", func() {
+				validator = git.NewPushValidator(log, fakeGit, &config.PushValidatorConfig{
+					BlockTagPush:    new(true),
+					TagPushSeverity: config.SeverityWarning,
+				}, nil)
+
+				result := validator.Validate(context.Background(), createContext("git push --tags"))
+				Expect(result.Passed).To(BeFalse())
+				Expect(result.ShouldBlock).To(BeFalse())
+				Expect(result.Reference).To(Equal(validatorpkg.RefGitTagPush))
+			})
+
+			It("allows tag pushes by default", func() {
+				validator = git.NewPushValidator(log, fakeGit, nil, nil)
+
+				result := validator.Validate(context.Background(), createContext("git push --tags"))
+				Expect(result.Passed).To(BeTrue())
+			})
+		})
 	})
 })
@@ -0,0 +1,199 @@
+package git
+
+import "github.com/smykla-skalski/klaudiush/internal/validator"
+
+func init() {
+	validator.RegisterReferences(
+		validator.ReferenceInfo{
+			Code:        validator.RefGitNoSignoff.Code(),
+			Title:       "Missing signoff flag",
+			Description: "Git commit is missing the `-s` or `--signoff` flag.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitNoGPGSign.Code(),
+			Title:       "Missing GPG signing flag",
+			Description: "Git commit is missing the `-S` or `--gpg-sign` flag.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitNoStaged.Code(),
+			Title:       "No files staged for commit",
+			Description: "No files are staged, but a commit was attempted.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitBadTitle.Code(),
+			Title:       "Commit message title issues",
+			Description: "The commit message title is too long or has formatting problems.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitBadBody.Code(),
+			Title:       "Commit message body issues",
+			Description: "One or more body lines exceed the maximum length.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitFeatCI.Code(),
+			Title: "Incorrect infrastructure scope usage",
+			Description: "Using `feat(ci)`, `fix(ci)`, `feat(test)`, or similar combinations " +
+				"where a user-facing type is paired with an infrastructure scope.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitNoRemote.Code(),
+			Title:       "Remote does not exist",
+			Description: "The git remote you specified does not exist in this repository.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitNoBranch.Code(),
+			Title: "Missing branch for push",
+			Description: "No branch specified for git push and the current branch doesn't track " +
+				"a remote branch.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitFileNotExist.Code(),
+			Title:       "File does not exist",
+			Description: "You ran `git add` on a file that doesn't exist.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitMissingFlags.Code(),
+			Title:       "Missing required flags",
+			Description: "Git commit is missing required flags (typically `-s` and `-S`).",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitPRRef.Code(),
+			Title: "PR reference in commit message",
+			Description: "Commit message contains a pull request reference (e.g., `#123` or `PR " +
+				"#456`).",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitClaudeAttr.Code(),
+			Title: "Claude attribution in commit message",
+			Description: "Commit message contains AI-generated attribution (e.g., \"Generated by " +
+				"Claude\" or \"Co-authored-by: Claude\").",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitConventionalCommit.Code(),
+			Title:       "Invalid conventional commit format",
+			Description: "Commit message doesn't follow the conventional commits specification.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitForbiddenPattern.Code(),
+			Title: "Forbidden pattern in commit message",
+			Description: "Commit message contains a forbidden pattern (by default, references to " +
+				"`tmp/` directory).",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitSignoffMismatch.Code(),
+			Title:       "Signoff identity mismatch",
+			Description: "The `Signed-off-by` trailer doesn't match the expected identity.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitListFormat.Code(),
+			Title:       "List format issues in commit body",
+			Description: "List items in the commit body aren't formatted as valid Markdown.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitMergeMessage.Code(),
+			Title: "PR merge message validation failed",
+			Description: "PR title or body doesn't follow commit message conventions for squash " +
+				"merge.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitMergeSignoff.Code(),
+			Title:       "PR merge signoff missing",
+			Description: "The merge commit body has no `Signed-off-by` trailer.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitBlockedFiles.Code(),
+			Title: "Blocked files in git add",
+			Description: "Attempting to stage files that match a blocked pattern (default: " +
+				"`tmp/*`).",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitBranchName.Code(),
+			Title: "Branch naming violation",
+			Description: "The branch name violates naming conventions: contains spaces, " +
+				"uppercase letters, invalid type prefix, or doesn't follow `type/description` " +
+				"format.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitNoVerify.Code(),
+			Title: "--no-verify flag blocked",
+			Description: "The `git commit` command includes `--no-verify` or `-n` flag, which " +
+				"skips pre-commit hooks.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitKongOrgPush.Code(),
+			Title: "Kong org push to origin blocked",
+			Description: "Pushing to the `origin` remote in a Kong organization repository. " +
+				"Organization policy requires pushing to `upstream` instead.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitPRValidation.Code(),
+			Title: "PR validation failure",
+			Description: "The `gh pr create` command failed validation. Issues can include: " +
+				"title too long, invalid conventional commit format, missing PR body, markdown " +
+				"formatting errors, missing base branch label, or forbidden patterns in " +
+				"title/body.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitFetchNoRemote.Code(),
+			Title: "Remote doesn't exist for git fetch",
+			Description: "The `git fetch` command specifies a remote that doesn't exist in the " +
+				"local repository.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefGitBlockedRemote.Code(),
+			Title:       "Push to blocked remote",
+			Description: "The `git push` command targets a remote that is on the blocked list.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitMissingTrailer.Code(),
+			Title: "Missing or malformed commit trailer",
+			Description: "The commit message is missing a required git trailer, or the trailer " +
+				"is malformed.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitLargeCommit.Code(),
+			Title: "Staged diff too large",
+			Description: "The changes staged for commit exceed the configured `max_diff_lines` " +
+				"or `max_diff_bytes` limit.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitAmendPushed.Code(),
+			Title: "Amending a pushed commit",
+			Description: "`git commit --amend` would replace a commit that is already on the " +
+				"branch's upstream.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitEmojiPolicy.Code(),
+			Title: "Commit title emoji policy",
+			Description: "The commit title violates the configured emoji policy: it doesn't " +
+				"start with a gitmoji when `require_gitmoji` is enabled, or it contains an emoji " +
+				"when `block_emoji` is enabled.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitBlockedCommitMarker.Code(),
+			Title: "Blocked commit marker in push",
+			Description: "The `git push` command would push commits to a protected branch, and " +
+				"at least one of them has a blocked marker such as `WIP`, `DO NOT MERGE`, " +
+				"`DONOTMERGE` or `[ci skip]` in its subject.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitLargeFile.Code(),
+			Title: "Large or binary file in git add",
+			Description: "The `git add` command would stage a new file that exceeds " +
+				"`max_add_file_size_kb` or has an extension listed in " +
+				"`blocked_binary_extensions`.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitMissingChangelog.Code(),
+			Title: "Missing changelog fragment",
+			Description: "`require_changelog_fragment` is enabled and the commit doesn't " +
+				"stage a changelog fragment under `changelog_dir`.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGitTagPush.Code(),
+			Title: "Tags included in push",
+			Description: "`block_tag_push` is enabled and the `git push` command includes tags " +
+				"with `--tags` or `--follow-tags` to a remote not listed in `tag_push_remotes`.",
+		},
+	)
+}
@@ -224,7 +224,7 @@ func (*IssueValidator) readBodyFile(filePath string) (string, error) {
 	return string(content), nil
 }
 
-// validateIssue performs markdown validation on the issue body.
+// validateIssue checks the issue body for required sections and markdown issues.
 func (v *IssueValidator) validateIssue(ctx context.Context, data IssueData) *validator.Result {
 	log := v.Logger()
 
@@ -233,10 +233,11 @@ func (v *IssueValidator) validateIssue(ctx context.Context, data IssueData) *val
 		return v.handleMissingBody(log)
 	}
 
-	// Validate markdown formatting.
+	// Check required template sections and markdown formatting.
+	errs := v.checkRequiredSections(data.Body)
 	warnings := v.validateMarkdown(ctx, data.Body)
 
-	return v.buildResult(nil, warnings, data.Title)
+	return v.buildResult(errs, warnings, data.Title)
 }
 
 // handleMissingBody handles the case when issue body is not provided.
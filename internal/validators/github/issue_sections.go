@@ -0,0 +1,78 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+// getRequiredSections returns the template sections the issue body must contain.
+func (v *IssueValidator) getRequiredSections() []string {
+	if v.config != nil {
+		return v.config.RequiredSections
+	}
+
+	return nil
+}
+
+// checkRequiredSections returns an error for every required section missing
+// from the issue body.
+func (v *IssueValidator) checkRequiredSections(body string) []string {
+	missing := findMissingSections(body, v.getRequiredSections())
+	if len(missing) == 0 {
+		return nil
+	}
+
+	errs := make([]string, 0, len(missing))
+	for _, section := range missing {
+		errs = append(errs, fmt.Sprintf("Issue body missing '%s' section", section))
+	}
+
+	return errs
+}
+
+// findMissingSections returns the sections that have no matching heading in
+// body. A section matches a heading of any level with the same text, ignoring
+// case; headings inside fenced code blocks are not considered.
+func findMissingSections(body string, sections []string) []string {
+	if len(sections) == 0 {
+		return nil
+	}
+
+	headings := make(map[string]bool)
+	inFence := false
+
+	for line := range strings.SplitSeq(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+
+			continue
+		}
+
+		if inFence || !strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		headings[normalizeSection(trimmed)] = true
+	}
+
+	var missing []string
+
+	for _, section := range sections {
+		if !headings[normalizeSection(section)] {
+			missing = append(missing, section)
+		}
+	}
+
+	return missing
+}
+
+// normalizeSection strips the heading markers and closing hashes from a
+// section heading and lowercases it.
+func normalizeSection(heading string) string {
+	text := strings.TrimLeft(strings.TrimSpace(heading), "#")
+	text = strings.TrimRight(strings.TrimSpace(text), "#")
+
+	return strings.ToLower(strings.TrimSpace(text))
+}
@@ -165,6 +165,99 @@ No empty line after heading"`,
 		})
 	})
 
+	Describe("with RequiredSections", func() {
+		issueCommand := func(body string) *hook.Context {
+			return &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+				ToolInput: hook.ToolInput{
+					Command: `gh issue create --title "Bug report" --body "` + body + `"`,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			cfg := &config.IssueValidatorConfig{
+				RequiredSections: []string{"## Steps to reproduce", "## Expected"},
+			}
+			validator = github.NewIssueValidator(cfg, mockLinter, logger.NewNoOpLogger(), nil)
+
+			mockLinter.EXPECT().
+				Lint(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&linters.LintResult{Success: true}).
+				AnyTimes()
+		})
+
+		It("should pass when all sections are present", func() {
+			result := validator.Validate(ctx, issueCommand(
+				"## Steps to reproduce\n\nRun it.\n\n## Expected\n\nIt works.",
+			))
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should match sections at any heading level ignoring case", func() {
+			result := validator.Validate(ctx, issueCommand(
+				"### steps to reproduce\n\nRun it.\n\n### EXPECTED\n\nIt works.",
+			))
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should block when some sections are missing", func() {
+			result := validator.Validate(ctx, issueCommand(
+				"## Steps to reproduce\n\nRun it.",
+			))
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Message).To(ContainSubstring("Issue body missing '## Expected' section"))
+			Expect(result.Message).NotTo(ContainSubstring("'## Steps to reproduce'"))
+		})
+
+		It("should block when no sections are present", func() {
+			result := validator.Validate(ctx, issueCommand("Something is broken."))
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Message).To(ContainSubstring("'## Steps to reproduce'"))
+			Expect(result.Message).To(ContainSubstring("'## Expected'"))
+		})
+
+		It("should ignore headings inside code blocks", func() {
+			fence := "```"
+			result := validator.Validate(ctx, issueCommand(
+				"## Steps to reproduce\n\n"+fence+"\n## Expected\n"+fence,
+			))
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("'## Expected'"))
+		})
+
+		It("should check bodies read from --body-file", func() {
+			bodyFile := filepath.Join(GinkgoT().TempDir(), "body.md")
+			Expect(os.WriteFile(bodyFile, []byte("## Expected\n\nIt works.\n"), 0o600)).
+				To(Succeed())
+
+			result := validator.Validate(ctx, &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+				ToolInput: hook.ToolInput{
+					Command: `gh issue create --title "Bug report" --body-file ` + bodyFile,
+				},
+			})
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("'## Steps to reproduce'"))
+			Expect(result.Message).NotTo(ContainSubstring("'## Expected'"))
+		})
+
+		It("should keep passing issues without a body", func() {
+			result := validator.Validate(ctx, &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeBash,
+				ToolInput: hook.ToolInput{
+					Command: `gh issue create --title "Bug report"`,
+				},
+			})
+			Expect(result.Passed).To(BeTrue())
+		})
+	})
+
 	Describe("extractIssueData", func() {
 		It("should extract title from double quotes", func() {
 			hookCtx := &hook.Context{
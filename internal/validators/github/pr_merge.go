@@ -0,0 +1,309 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+const (
+	prSubcommand   = "pr"
+	mergeOperation = "merge"
+	minGHPRMerge   = 2
+)
+
+// Merge methods selected by the gh pr merge method flags.
+const (
+	mergeMethodSquash = "squash"
+	mergeMethodMerge  = "merge"
+	mergeMethodRebase = "rebase"
+)
+
+// prMergeValueFlags are the gh pr merge flags that take a value, so the
+// value is not mistaken for a flag or the pull request.
+var prMergeValueFlags = map[string]bool{
+	"-b": true, "--body": true,
+	"-F": true, "--body-file": true,
+	"-t": true, "--subject": true,
+	"-A": true, "--author-email": true,
+	"-R": true, "--repo": true,
+	"--match-head-commit": true,
+}
+
+// prMergeShorthands maps the boolean gh pr merge shorthands to their long
+// flags, so grouped shorthands such as -sd can be expanded.
+var prMergeShorthands = map[rune]string{
+	's': "--squash",
+	'm': "--merge",
+	'r': "--rebase",
+	'd': "--delete-branch",
+}
+
+// PRMergeValidator validates gh pr merge commands against the allowed merge
+// methods and the branch deletion policy.
+type PRMergeValidator struct {
+	validator.BaseValidator
+	config *config.PRMergeValidatorConfig
+}
+
+// NewPRMergeValidator creates a new PRMergeValidator instance.
+func NewPRMergeValidator(
+	cfg *config.PRMergeValidatorConfig,
+	log logger.Logger,
+	ruleAdapter validator.RuleChecker,
+) *PRMergeValidator {
+	return &PRMergeValidator{
+		BaseValidator: *validator.NewBaseValidatorWithRules(
+			"validate-pr-merge", log, ruleAdapter,
+		),
+		config: cfg,
+	}
+}
+
+// getAllowedMethods returns the allowed merge methods, or nil for any.
+func (v *PRMergeValidator) getAllowedMethods() []string {
+	if v.config != nil {
+		return v.config.AllowedMethods
+	}
+
+	return nil
+}
+
+// isRequireDeleteBranch returns whether --delete-branch is required.
+func (v *PRMergeValidator) isRequireDeleteBranch() bool {
+	if v.config != nil && v.config.RequireDeleteBranch != nil {
+		return *v.config.RequireDeleteBranch
+	}
+
+	return false
+}
+
+// isSkipAutoMerge returns whether merges that only enable auto-merge are
+// skipped.
+func (v *PRMergeValidator) isSkipAutoMerge() bool {
+	if v.config != nil && v.config.SkipAutoMerge != nil {
+		return *v.config.SkipAutoMerge
+	}
+
+	return false
+}
+
+// Validate checks gh pr merge commands for an allowed merge method and the
+// --delete-branch requirement.
+func (v *PRMergeValidator) Validate(ctx context.Context, hookCtx *hook.Context) *validator.Result {
+	log := v.Logger()
+	log.Debug("Running PR merge validation")
+
+	if result := v.CheckRules(ctx, hookCtx); result != nil {
+		return result
+	}
+
+	bashParser := parser.NewBashParser()
+
+	result, err := bashParser.Parse(hookCtx.GetCommand())
+	if err != nil {
+		log.Error("Failed to parse command", "error", err)
+
+		return validator.Warn(fmt.Sprintf("Failed to parse command: %v", err))
+	}
+
+	for _, cmd := range result.Commands {
+		if !isGHPRMerge(&cmd) {
+			continue
+		}
+
+		return v.validatePRMerge(extractPRMergeData(cmd.Args[2:]))
+	}
+
+	log.Debug("No gh pr merge commands found")
+
+	return validator.Pass()
+}
+
+// isGHPRMerge checks if a command is gh pr merge.
+func isGHPRMerge(cmd *parser.Command) bool {
+	if cmd.Name != ghCommand || len(cmd.Args) < minGHPRMerge {
+		return false
+	}
+
+	return cmd.Args[0] == prSubcommand && cmd.Args[1] == mergeOperation
+}
+
+// PRMergeData holds the merge options of a gh pr merge command.
+type PRMergeData struct {
+	// PR is the pull request number, URL or branch, empty for the pull
+	// request of the current branch.
+	PR string
+
+	// Methods are the merge methods selected, in order. gh rejects more
+	// than one, but each is still checked.
+	Methods []string
+
+	DeleteBranch bool
+	Auto         bool
+	DisableAuto  bool
+}
+
+// extractPRMergeData extracts the merge options from the arguments
+// following "gh pr merge".
+func extractPRMergeData(args []string) PRMergeData {
+	var data PRMergeData
+
+	for i := 0; i < len(args); i++ {
+		flag, value, hasValue := strings.Cut(args[i], "=")
+
+		if !strings.HasPrefix(flag, "-") {
+			if data.PR == "" {
+				data.PR = args[i]
+			}
+
+			continue
+		}
+
+		if !hasValue && prMergeValueFlags[flag] {
+			i++
+
+			continue
+		}
+
+		enabled := value != "false"
+
+		for _, name := range expandPRMergeFlag(flag) {
+			switch name {
+			case "--squash":
+				data.addMethod(mergeMethodSquash, enabled)
+			case "--merge":
+				data.addMethod(mergeMethodMerge, enabled)
+			case "--rebase":
+				data.addMethod(mergeMethodRebase, enabled)
+			case "--delete-branch":
+				data.DeleteBranch = enabled
+			case "--auto":
+				data.Auto = enabled
+			case "--disable-auto":
+				data.DisableAuto = enabled
+			}
+		}
+	}
+
+	return data
+}
+
+// addMethod records method as selected, or unselects it for a flag set to
+// false.
+func (d *PRMergeData) addMethod(method string, enabled bool) {
+	d.Methods = slices.DeleteFunc(d.Methods, func(m string) bool { return m == method })
+
+	if enabled {
+		d.Methods = append(d.Methods, method)
+	}
+}
+
+// expandPRMergeFlag returns the long flags of a group of boolean shorthands
+// such as -sd, or the flag itself otherwise.
+func expandPRMergeFlag(flag string) []string {
+	if strings.HasPrefix(flag, "--") || len(flag) < 2 {
+		return []string{flag}
+	}
+
+	var names []string
+
+	for _, short := range flag[1:] {
+		name, ok := prMergeShorthands[short]
+		if !ok {
+			return []string{flag}
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// validatePRMerge checks the merge options against the configuration.
+func (v *PRMergeValidator) validatePRMerge(data PRMergeData) *validator.Result {
+	// --disable-auto only cancels a queued merge.
+	if data.DisableAuto {
+		return validator.Pass()
+	}
+
+	if data.Auto && v.isSkipAutoMerge() {
+		v.Logger().Debug("Skipping auto-merge", "pr", data.PR)
+
+		return validator.Pass()
+	}
+
+	var errs []string
+
+	errs = append(errs, v.checkMethods(data.Methods)...)
+
+	if v.isRequireDeleteBranch() && !data.DeleteBranch {
+		errs = append(errs, "Merges must delete the branch - add --delete-branch")
+	}
+
+	if len(errs) == 0 {
+		return validator.Pass()
+	}
+
+	var message strings.Builder
+
+	message.WriteString("PR merge validation failed\n\n")
+
+	for _, err := range errs {
+		message.WriteString(err)
+		message.WriteString("\n")
+	}
+
+	if data.PR != "" {
+		message.WriteString("\nPull request: ")
+		message.WriteString(data.PR)
+	}
+
+	return validator.FailWithRef(validator.RefGHPRMergeValidation, message.String()).
+		WithFixHint("Merge with an allowed method and the required flags")
+}
+
+// checkMethods checks that a merge method is selected and allowed, when
+// allowed methods are configured.
+func (v *PRMergeValidator) checkMethods(methods []string) []string {
+	allowed := v.getAllowedMethods()
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	allowedList := strings.Join(allowedMethodFlags(allowed), ", ")
+
+	if len(methods) == 0 {
+		return []string{"No merge method given - use one of " + allowedList}
+	}
+
+	var errs []string
+
+	for _, method := range methods {
+		if !slices.Contains(allowed, method) {
+			errs = append(errs, fmt.Sprintf(
+				"Merge method '%s' is not allowed - use one of %s", method, allowedList,
+			))
+		}
+	}
+
+	return errs
+}
+
+// allowedMethodFlags returns the flags selecting the allowed methods.
+func allowedMethodFlags(allowed []string) []string {
+	flags := make([]string, 0, len(allowed))
+
+	for _, method := range allowed {
+		flags = append(flags, "--"+method)
+	}
+
+	return flags
+}
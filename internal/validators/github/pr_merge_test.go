@@ -0,0 +1,144 @@
+package github_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/internal/validators/github"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+var _ = Describe("PRMergeValidator", func() {
+	var (
+		cfg *config.PRMergeValidatorConfig
+		ctx context.Context
+	)
+
+	validate := func(command string) *validator.Result {
+		v := github.NewPRMergeValidator(cfg, logger.NewNoOpLogger(), nil)
+
+		return v.Validate(ctx, &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{Command: command},
+		})
+	}
+
+	BeforeEach(func() {
+		cfg = &config.PRMergeValidatorConfig{
+			AllowedMethods: []string{"squash"},
+		}
+		ctx = context.Background()
+	})
+
+	Context("with allowed methods", func() {
+		It("should pass an allowed method", func() {
+			Expect(validate("gh pr merge 123 --squash").Passed).To(BeTrue())
+		})
+
+		It("should pass an allowed method given as a shorthand", func() {
+			Expect(validate("gh pr merge -s 123").Passed).To(BeTrue())
+		})
+
+		It("should block a disallowed method", func() {
+			result := validate("gh pr merge 123 --merge")
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Reference).To(Equal(validator.RefGHPRMergeValidation))
+			Expect(result.Message).To(ContainSubstring(
+				"Merge method 'merge' is not allowed - use one of --squash",
+			))
+			Expect(result.Message).To(ContainSubstring("Pull request: 123"))
+		})
+
+		It("should block a disallowed method in grouped shorthands", func() {
+			result := validate("gh pr merge -rd")
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("Merge method 'rebase' is not allowed"))
+		})
+
+		It("should block a merge without a method", func() {
+			result := validate(`gh pr merge 123 --body "Merging"`)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring(
+				"No merge method given - use one of --squash",
+			))
+		})
+
+		It("should not mistake flag values for methods", func() {
+			Expect(validate(`gh pr merge --subject --merge --squash`).Passed).To(BeTrue())
+		})
+
+		It("should ignore a method flag set to false", func() {
+			Expect(validate("gh pr merge --merge=false --squash").Passed).To(BeTrue())
+		})
+
+		It("should pass any method when none are configured", func() {
+			cfg.AllowedMethods = nil
+
+			Expect(validate("gh pr merge 123").Passed).To(BeTrue())
+			Expect(validate("gh pr merge 123 --rebase").Passed).To(BeTrue())
+		})
+	})
+
+	Context("with require_delete_branch", func() {
+		BeforeEach(func() {
+			cfg.RequireDeleteBranch = new(true)
+		})
+
+		It("should pass with --delete-branch", func() {
+			Expect(validate("gh pr merge 123 --squash --delete-branch").Passed).To(BeTrue())
+		})
+
+		It("should pass with grouped shorthands", func() {
+			Expect(validate("gh pr merge 123 -sd").Passed).To(BeTrue())
+		})
+
+		It("should block without --delete-branch", func() {
+			result := validate("gh pr merge 123 --squash")
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring(
+				"Merges must delete the branch - add --delete-branch",
+			))
+		})
+
+		It("should report every violation", func() {
+			result := validate("gh pr merge 123 --merge")
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("Merge method 'merge' is not allowed"))
+			Expect(result.Message).To(ContainSubstring("add --delete-branch"))
+		})
+	})
+
+	Context("with auto-merge", func() {
+		It("should check auto-merges by default", func() {
+			Expect(validate("gh pr merge 123 --auto --merge").Passed).To(BeFalse())
+		})
+
+		It("should skip auto-merges when configured", func() {
+			cfg.SkipAutoMerge = new(true)
+
+			Expect(validate("gh pr merge 123 --auto --merge").Passed).To(BeTrue())
+			Expect(validate("gh pr merge 123 --merge").Passed).To(BeFalse())
+		})
+
+		It("should pass --disable-auto", func() {
+			Expect(validate("gh pr merge 123 --disable-auto").Passed).To(BeTrue())
+		})
+	})
+
+	Context("with other commands", func() {
+		It("should pass gh pr commands other than merge", func() {
+			Expect(validate("gh pr view 123 --json mergeable").Passed).To(BeTrue())
+		})
+
+		It("should check gh pr merge in a command chain", func() {
+			Expect(validate("gh pr checks 123 && gh pr merge 123 --rebase").Passed).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package github
+
+import "github.com/smykla-skalski/klaudiush/internal/validator"
+
+func init() {
+	validator.RegisterReferences(
+		validator.ReferenceInfo{
+			Code:  validator.RefGHIssueValidation.Code(),
+			Title: "GitHub issue body validation failure",
+			Description: "The `gh issue create` command has markdown formatting issues in the " +
+				"issue body, or the body is missing a required template section.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGHReleaseValidation.Code(),
+			Title: "GitHub release validation failure",
+			Description: "The `gh release create` command has release notes missing a required " +
+				"template section, or a tag or title not matching the configured pattern.",
+		},
+		validator.ReferenceInfo{
+			Code:  validator.RefGHPRMergeValidation.Code(),
+			Title: "GitHub PR merge validation failure",
+			Description: "The `gh pr merge` command uses a merge method that isn't allowed, " +
+				"none at all, or lacks the required `--delete-branch`.",
+		},
+	)
+}
@@ -0,0 +1,275 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+const (
+	releaseSubcommand  = "release"
+	minGHReleaseCreate = 2
+
+	// stdinNotesFile is the --notes-file value that reads notes from stdin.
+	stdinNotesFile = "-"
+)
+
+// releaseValueFlags are the gh release create flags that take a value, so
+// the value is not mistaken for the tag.
+var releaseValueFlags = map[string]bool{
+	"-t": true, "--title": true,
+	"-n": true, "--notes": true,
+	"-F": true, "--notes-file": true,
+	"-R": true, "--repo": true,
+	"--target":              true,
+	"--notes-start-tag":     true,
+	"--discussion-category": true,
+}
+
+// ReleaseValidator validates gh release create commands against the release
+// notes template and the tag and title patterns.
+type ReleaseValidator struct {
+	validator.BaseValidator
+	config *config.ReleaseValidatorConfig
+}
+
+// NewReleaseValidator creates a new ReleaseValidator instance.
+func NewReleaseValidator(
+	cfg *config.ReleaseValidatorConfig,
+	log logger.Logger,
+	ruleAdapter validator.RuleChecker,
+) *ReleaseValidator {
+	return &ReleaseValidator{
+		BaseValidator: *validator.NewBaseValidatorWithRules(
+			"validate-release", log, ruleAdapter,
+		),
+		config: cfg,
+	}
+}
+
+// isRequireNotes returns whether release notes are required.
+func (v *ReleaseValidator) isRequireNotes() bool {
+	if v.config != nil && v.config.RequireNotes != nil {
+		return *v.config.RequireNotes
+	}
+
+	return false
+}
+
+// getRequiredSections returns the template sections the notes must contain.
+func (v *ReleaseValidator) getRequiredSections() []string {
+	if v.config != nil {
+		return v.config.RequiredSections
+	}
+
+	return nil
+}
+
+// getTagPattern returns the configured tag pattern, or "" for any tag.
+func (v *ReleaseValidator) getTagPattern() string {
+	if v.config != nil {
+		return v.config.TagPattern
+	}
+
+	return ""
+}
+
+// getTitlePattern returns the configured title pattern, or "" for any title.
+func (v *ReleaseValidator) getTitlePattern() string {
+	if v.config != nil {
+		return v.config.TitlePattern
+	}
+
+	return ""
+}
+
+// Validate checks gh release create commands for the configured notes
+// sections and tag and title patterns.
+func (v *ReleaseValidator) Validate(ctx context.Context, hookCtx *hook.Context) *validator.Result {
+	log := v.Logger()
+	log.Debug("Running release validation")
+
+	if result := v.CheckRules(ctx, hookCtx); result != nil {
+		return result
+	}
+
+	bashParser := parser.NewBashParser()
+
+	result, err := bashParser.Parse(hookCtx.GetCommand())
+	if err != nil {
+		log.Error("Failed to parse command", "error", err)
+
+		return validator.Warn(fmt.Sprintf("Failed to parse command: %v", err))
+	}
+
+	for _, cmd := range result.Commands {
+		if !isGHReleaseCreate(&cmd) {
+			continue
+		}
+
+		return v.validateRelease(extractReleaseData(cmd.Args[2:]))
+	}
+
+	log.Debug("No gh release create commands found")
+
+	return validator.Pass()
+}
+
+// isGHReleaseCreate checks if a command is gh release create.
+func isGHReleaseCreate(cmd *parser.Command) bool {
+	if cmd.Name != ghCommand || len(cmd.Args) < minGHReleaseCreate {
+		return false
+	}
+
+	return cmd.Args[0] == releaseSubcommand && cmd.Args[1] == createOperation
+}
+
+// ReleaseData holds extracted release metadata.
+type ReleaseData struct {
+	Tag       string
+	Title     string
+	HasTitle  bool
+	Notes     string
+	NotesFile string
+
+	// GeneratedNotes is set for --generate-notes and --notes-from-tag, whose
+	// notes are only known to GitHub.
+	GeneratedNotes bool
+}
+
+// extractReleaseData extracts the release tag, title and notes from the
+// arguments following "gh release create".
+func extractReleaseData(args []string) ReleaseData {
+	var data ReleaseData
+
+	for i := 0; i < len(args); i++ {
+		flag, value, hasValue := strings.Cut(args[i], "=")
+
+		if !strings.HasPrefix(flag, "-") {
+			if data.Tag == "" {
+				data.Tag = args[i]
+			}
+
+			continue
+		}
+
+		if !hasValue && releaseValueFlags[flag] && i+1 < len(args) {
+			i++
+			value, hasValue = args[i], true
+		}
+
+		switch flag {
+		case "-t", "--title":
+			data.Title, data.HasTitle = value, hasValue
+		case "-n", "--notes":
+			data.Notes = value
+		case "-F", "--notes-file":
+			data.NotesFile = value
+		case "--generate-notes", "--notes-from-tag":
+			data.GeneratedNotes = value != "false"
+		}
+	}
+
+	if data.Notes == "" && data.NotesFile != "" && data.NotesFile != stdinNotesFile {
+		//nolint:gosec // NotesFile is from Claude Code tool context
+		if content, err := os.ReadFile(data.NotesFile); err == nil {
+			data.Notes = string(content)
+		}
+	}
+
+	return data
+}
+
+// validateRelease checks the release data against the configuration.
+func (v *ReleaseValidator) validateRelease(data ReleaseData) *validator.Result {
+	var errs []string
+
+	errs = append(errs, checkPattern("tag", data.Tag, v.getTagPattern())...)
+
+	if data.HasTitle {
+		errs = append(errs, checkPattern("title", data.Title, v.getTitlePattern())...)
+	}
+
+	errs = append(errs, v.checkNotes(data)...)
+
+	if len(errs) == 0 {
+		return validator.Pass()
+	}
+
+	var message strings.Builder
+
+	message.WriteString("Release validation failed\n\n")
+
+	for _, err := range errs {
+		message.WriteString(err)
+		message.WriteString("\n")
+	}
+
+	if data.Tag != "" {
+		message.WriteString("\nRelease tag: ")
+		message.WriteString(data.Tag)
+	}
+
+	return validator.FailWithRef(validator.RefGHReleaseValidation, message.String()).
+		WithFixHint("Follow the release notes template and the configured tag and title patterns")
+}
+
+// checkNotes checks that notes are present when required and contain the
+// required sections. Notes read from stdin or generated by GitHub are not
+// checked for sections.
+func (v *ReleaseValidator) checkNotes(data ReleaseData) []string {
+	hasNotes := data.Notes != "" || data.NotesFile == stdinNotesFile || data.GeneratedNotes
+
+	if !hasNotes {
+		if v.isRequireNotes() {
+			return []string{"Release notes are required - use --notes or --notes-file"}
+		}
+
+		return nil
+	}
+
+	if data.Notes == "" {
+		return nil
+	}
+
+	missing := findMissingSections(data.Notes, v.getRequiredSections())
+
+	errs := make([]string, 0, len(missing))
+	for _, section := range missing {
+		errs = append(errs, fmt.Sprintf("Release notes missing '%s' section", section))
+	}
+
+	return errs
+}
+
+// checkPattern returns an error when value does not match pattern. Empty
+// values and patterns, and patterns that do not compile, are not checked;
+// invalid patterns are reported by config validation.
+func checkPattern(name, value, pattern string) []string {
+	if value == "" || pattern == "" {
+		return nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil || re.MatchString(value) {
+		return nil
+	}
+
+	return []string{
+		fmt.Sprintf("Release %s '%s' does not match pattern '%s'", name, value, pattern),
+	}
+}
+
+// Category returns the validator category for parallel execution.
+// ReleaseValidator uses CategoryIO because it may read --notes-file.
+func (*ReleaseValidator) Category() validator.ValidatorCategory {
+	return validator.CategoryIO
+}
@@ -0,0 +1,139 @@
+package github_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/internal/validators/github"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+var _ = Describe("ReleaseValidator", func() {
+	var (
+		cfg *config.ReleaseValidatorConfig
+		ctx context.Context
+	)
+
+	validate := func(command string) *validator.Result {
+		v := github.NewReleaseValidator(cfg, logger.NewNoOpLogger(), nil)
+
+		return v.Validate(ctx, &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{Command: command},
+		})
+	}
+
+	BeforeEach(func() {
+		requireNotes := true
+		cfg = &config.ReleaseValidatorConfig{
+			RequireNotes:     &requireNotes,
+			RequiredSections: []string{"## Changes", "## Upgrade notes"},
+			TagPattern:       `^v\d+\.\d+\.\d+$`,
+			TitlePattern:     `^Release v\d+\.\d+\.\d+$`,
+		}
+		ctx = context.Background()
+	})
+
+	Context("with a compliant release", func() {
+		It("should pass with notes from a heredoc", func() {
+			result := validate(`gh release create v1.2.3 -t "Release v1.2.3" -n "$(cat <<'EOF'
+## Changes
+
+- Faster startup
+
+### Upgrade Notes
+
+Nothing to do.
+EOF
+)"`)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should pass with a notes file and assets", func() {
+			notesFile := filepath.Join(GinkgoT().TempDir(), "notes.md")
+			Expect(os.WriteFile(
+				notesFile,
+				[]byte("## Changes\n\n- Fix\n\n## Upgrade notes\n\nNone.\n"),
+				0o600,
+			)).To(Succeed())
+
+			result := validate(
+				"gh release create --notes-file=" + notesFile + " v1.2.3 dist/app.tar.gz --draft",
+			)
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should accept generated notes as notes without checking sections", func() {
+			result := validate("gh release create v1.2.3 --generate-notes")
+			Expect(result.Passed).To(BeTrue())
+		})
+	})
+
+	Context("with a non-compliant release", func() {
+		It("should fail when notes miss a required section", func() {
+			result := validate(`gh release create v1.2.3 -n "## Changes
+
+- Fix"`)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.ShouldBlock).To(BeTrue())
+			Expect(result.Reference).To(Equal(validator.RefGHReleaseValidation))
+			Expect(result.Message).
+				To(ContainSubstring("Release notes missing '## Upgrade notes' section"))
+			Expect(result.Message).NotTo(ContainSubstring("'## Changes'"))
+		})
+
+		It("should fail when notes are required but missing", func() {
+			result := validate("gh release create v1.2.3 --title 'Release v1.2.3'")
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("Release notes are required"))
+		})
+
+		It("should fail when the tag does not match the pattern", func() {
+			result := validate("gh release create 1.2.3 --generate-notes")
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).
+				To(ContainSubstring("Release tag '1.2.3' does not match pattern"))
+		})
+
+		It("should not take a flag value for the tag", func() {
+			result := validate("gh release create --target main --generate-notes")
+			Expect(result.Passed).To(BeTrue())
+		})
+
+		It("should fail when the title does not match the pattern", func() {
+			result := validate("gh release create v1.2.3 -t 'New stuff' --generate-notes")
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("Release title 'New stuff' does not match"))
+		})
+	})
+
+	Context("with other gh release commands", func() {
+		DescribeTable("should skip them",
+			func(command string) {
+				Expect(validate(command).Passed).To(BeTrue())
+			},
+			Entry("list", "gh release list"),
+			Entry("view", "gh release view 1.2.3"),
+			Entry("upload", "gh release upload 1.2.3 dist/app.tar.gz"),
+		)
+	})
+
+	It("should pass everything without config", func() {
+		cfg = nil
+
+		Expect(validate("gh release create anything").Passed).To(BeTrue())
+	})
+
+	It("should use CategoryIO", func() {
+		v := github.NewReleaseValidator(cfg, logger.NewNoOpLogger(), nil)
+		Expect(v.Category()).To(Equal(github.CategoryIO))
+	})
+})
@@ -1,6 +1,8 @@
 package secrets
 
 import (
+	"bufio"
+	"iter"
 	"strings"
 )
 
@@ -12,6 +14,13 @@ type Detector interface {
 	Detect(content string) []Finding
 }
 
+// LineDetector is implemented by detectors that can stream findings line by
+// line, letting the caller stop the scan early.
+type LineDetector interface {
+	// DetectLines yields findings in line order until the caller stops.
+	DetectLines(content string) iter.Seq[Finding]
+}
+
 // PatternDetector implements Detector using compiled regex patterns.
 type PatternDetector struct {
 	patterns []Pattern
@@ -31,53 +40,52 @@ func NewDefaultPatternDetector() *PatternDetector {
 
 // Detect scans content for secrets using all configured patterns.
 func (d *PatternDetector) Detect(content string) []Finding {
-	if content == "" {
-		return nil
-	}
-
 	var findings []Finding
 
-	lines := strings.Split(content, "\n")
-
-	for i, pattern := range d.patterns {
-		matches := d.patterns[i].Regex.FindAllStringIndex(content, -1)
-
-		for _, match := range matches {
-			line, col := d.getPosition(lines, content, match[0])
-
-			findings = append(findings, Finding{
-				Pattern: &d.patterns[i],
-				Match:   content[match[0]:match[1]],
-				Line:    line,
-				Column:  col,
-			})
-		}
-
-		_ = pattern // avoid unused variable lint error
+	for finding := range d.DetectLines(content) {
+		findings = append(findings, finding)
 	}
 
 	return findings
 }
 
-// getPosition returns the 1-indexed line and column for a byte offset.
-func (*PatternDetector) getPosition(lines []string, _ string, offset int) (line, column int) {
-	pos := 0
-
-	for lineIdx, lineContent := range lines {
-		lineEnd := pos + len(lineContent)
-		if lineIdx < len(lines)-1 {
-			lineEnd++ // account for newline character
+// DetectLines scans content line by line and yields findings in line order.
+// Each line is matched on its own, so patterns cannot match across lines;
+// the built-in patterns (including private key headers) are all single-line.
+// Stopping the iteration stops the scan, so callers that only need the first
+// finding don't pay for scanning the rest of the content.
+func (d *PatternDetector) DetectLines(content string) iter.Seq[Finding] {
+	return func(yield func(Finding) bool) {
+		if content == "" {
+			return
 		}
 
-		if offset < lineEnd {
-			return lineIdx + 1, offset - pos + 1
+		scanner := bufio.NewScanner(strings.NewReader(content))
+		// A line can be as long as the content; never fail with ErrTooLong.
+		scanner.Buffer(nil, len(content)+1)
+
+		lineNum := 0
+
+		for scanner.Scan() {
+			lineNum++
+			line := scanner.Bytes()
+
+			for i := range d.patterns {
+				for _, match := range d.patterns[i].Regex.FindAllIndex(line, -1) {
+					finding := Finding{
+						Pattern: &d.patterns[i],
+						Match:   string(line[match[0]:match[1]]),
+						Line:    lineNum,
+						Column:  match[0] + 1,
+					}
+
+					if !yield(finding) {
+						return
+					}
+				}
+			}
 		}
-
-		pos = lineEnd
 	}
-
-	// Fallback: return last line
-	return len(lines), offset - pos + 1
 }
 
 // AddPatterns adds additional patterns to the detector.
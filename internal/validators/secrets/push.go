@@ -0,0 +1,263 @@
+package secrets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/smykla-skalski/klaudiush/internal/git"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/parser"
+)
+
+// shortHashLength is the length of commit hashes in messages.
+const shortHashLength = 7
+
+// pushFinding is a finding in a line added by a commit being pushed.
+type pushFinding struct {
+	commit  git.CommitDiff
+	path    string
+	line    int
+	finding Finding
+}
+
+// validatePush scans the lines added by the commits of each git push in
+// command. Git errors pass, as the push itself reports a broken repository.
+func (v *SecretsValidator) validatePush(command string) *validator.Result {
+	log := v.Logger()
+
+	if v.gitRunner == nil || !v.config.IsScanPushEnabled() {
+		return validator.Pass()
+	}
+
+	parseResult, err := parser.NewBashParser().Parse(command)
+	if err != nil {
+		log.Debug("failed to parse command", "error", err)
+
+		return validator.Pass()
+	}
+
+	for _, cmd := range parseResult.Commands {
+		if cmd.Name != "git" || len(cmd.Args) == 0 {
+			continue
+		}
+
+		gitCmd, err := parser.ParseGitCommand(cmd)
+		if err != nil || gitCmd.Subcommand != "push" {
+			continue
+		}
+
+		if result := v.scanPush(gitCmd); !result.Passed {
+			return result
+		}
+	}
+
+	return validator.Pass()
+}
+
+// scanPush scans the commits sent by a single git push, in the repository of
+// its working directory (git -C or a preceding cd). When the range can't be
+// resolved, e.g. the branch has no upstream or the remote branch does not
+// exist yet, the most recent commits of the pushed ref are scanned.
+func (v *SecretsValidator) scanPush(gitCmd *parser.GitCommand) *validator.Result {
+	log := v.Logger()
+
+	runner := v.gitRunner
+	if dir := gitCmd.GetWorkingDirectory(); dir != "" && v.gitRunnerForPath != nil {
+		runner = v.gitRunnerForPath(dir)
+	}
+
+	revRange, head, ok := v.pushRange(gitCmd, runner)
+	if !ok {
+		log.Debug("push sends no commits")
+
+		return validator.Pass()
+	}
+
+	var (
+		diffs []git.CommitDiff
+		err   error
+	)
+
+	if revRange != "" {
+		diffs, err = runner.GetCommitDiffs(revRange, 0)
+	}
+
+	if revRange == "" || err != nil {
+		fallback := v.config.GetPushFallbackCommits()
+		log.Debug("no range to compare to, scanning recent commits",
+			"range", revRange, "commits", fallback, "error", err)
+
+		diffs, err = runner.GetCommitDiffs(head, fallback)
+	}
+
+	if err != nil {
+		log.Debug("failed to read pushed commits", "range", revRange, "error", err)
+
+		return validator.Pass()
+	}
+
+	findings := v.detectPush(diffs)
+	if len(findings) == 0 {
+		log.Debug("no secrets detected in pushed commits", "commits", len(diffs))
+
+		return validator.Pass()
+	}
+
+	return v.createPushResult(findings)
+}
+
+// pushRange returns the revision range a git push sends and the ref it
+// pushes. A push naming a remote sends the source of its refspec (HEAD
+// without one), compared to the remote-tracking branch of its destination.
+// Other pushes use the configured range. revRange is empty when there is no
+// branch to compare to, such as for a detached HEAD. ok is false for a push
+// that sends no commits, such as a ref deletion.
+func (v *SecretsValidator) pushRange(
+	gitCmd *parser.GitCommand,
+	runner git.Runner,
+) (revRange, head string, ok bool) {
+	if gitCmd.HasFlag("--delete") || gitCmd.HasFlag("-d") {
+		return "", "", false
+	}
+
+	var positional []string
+
+	for _, arg := range gitCmd.Args {
+		if !strings.HasPrefix(arg, "-") {
+			positional = append(positional, arg)
+		}
+	}
+
+	if len(positional) == 0 {
+		return v.config.GetPushRange(), "HEAD", true
+	}
+
+	remote := positional[0]
+
+	refspec := "HEAD"
+	if len(positional) > 1 {
+		refspec = strings.TrimPrefix(positional[1], "+")
+	}
+
+	source, target, hasTarget := strings.Cut(refspec, ":")
+	if source == "" {
+		return "", "", false
+	}
+
+	if !hasTarget {
+		target = source
+	}
+
+	if target == "HEAD" {
+		current, err := runner.GetCurrentBranch()
+		if err != nil || current == "" {
+			return "", source, true
+		}
+
+		target = current
+	}
+
+	target = strings.TrimPrefix(target, "refs/heads/")
+
+	return remote + "/" + target + ".." + source, source, true
+}
+
+// detectPush scans the added lines of each commit, file by file, and maps the
+// findings back to their line in the file.
+func (v *SecretsValidator) detectPush(diffs []git.CommitDiff) []pushFinding {
+	stopOnFirst := v.shouldBlock() && v.config.IsStopOnFirstMatchEnabled()
+
+	var findings []pushFinding
+
+	for _, commit := range diffs {
+		for _, lines := range groupByPath(commit.Added) {
+			texts := make([]string, 0, len(lines))
+			for _, line := range lines {
+				texts = append(texts, line.Text)
+			}
+
+			for _, finding := range v.detect(strings.Join(texts, "\n")) {
+				findings = append(findings, pushFinding{
+					commit:  commit,
+					path:    lines[0].Path,
+					line:    fileLine(lines, finding.Line),
+					finding: finding,
+				})
+
+				if stopOnFirst {
+					return findings
+				}
+			}
+		}
+	}
+
+	return findings
+}
+
+// groupByPath splits added lines into runs of lines from the same file.
+func groupByPath(added []git.AddedLine) [][]git.AddedLine {
+	var groups [][]git.AddedLine
+
+	for i, line := range added {
+		if i == 0 || added[i-1].Path != line.Path {
+			groups = append(groups, nil)
+		}
+
+		groups[len(groups)-1] = append(groups[len(groups)-1], line)
+	}
+
+	return groups
+}
+
+// fileLine maps a line of the joined added lines to its line in the file, or
+// 0 when the detector reported no line.
+func fileLine(lines []git.AddedLine, line int) int {
+	if line < 1 || line > len(lines) {
+		return 0
+	}
+
+	return lines[line-1].Line
+}
+
+// createPushResult creates a validation result naming the commit, file and
+// line of each finding.
+func (v *SecretsValidator) createPushResult(findings []pushFinding) *validator.Result {
+	messages := make([]string, 0, len(findings))
+
+	for _, f := range findings {
+		messages = append(messages, fmt.Sprintf(
+			"Commit %s (%s) %s:%d: %s (%s)",
+			shortHash(f.commit.Hash),
+			f.commit.Subject,
+			f.path,
+			f.line,
+			f.finding.Pattern.Description,
+			f.finding.Pattern.Name,
+		))
+	}
+
+	ref := findings[0].finding.Pattern.Reference
+	message := fmt.Sprintf(
+		"Potential secrets in commits being pushed (%d finding(s)):\n%s",
+		len(findings),
+		strings.Join(messages, "\n"),
+	)
+
+	const fixHint = "Remove the secret from the commits (e.g. git rebase -i) " +
+		"and rotate it, since it is in the local history"
+
+	if v.shouldBlock() {
+		return validator.FailWithRef(ref, message).WithFixHint(fixHint)
+	}
+
+	return validator.WarnWithRef(ref, message).WithFixHint(fixHint)
+}
+
+// shortHash abbreviates a commit hash.
+func shortHash(hash string) string {
+	if len(hash) > shortHashLength {
+		return hash[:shortHashLength]
+	}
+
+	return hash
+}
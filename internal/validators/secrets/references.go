@@ -0,0 +1,33 @@
+package secrets
+
+import "github.com/smykla-skalski/klaudiush/internal/validator"
+
+func init() {
+	validator.RegisterReferences(
+		validator.ReferenceInfo{
+			Code:        validator.RefSecretsAPIKey.Code(),
+			Title:       "API key detected",
+			Description: "Found an API key or service credential in the code.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefSecretsPassword.Code(),
+			Title:       "Hardcoded password detected",
+			Description: "A hardcoded password was found in the code.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefSecretsPrivKey.Code(),
+			Title:       "Private key detected",
+			Description: "Detected a private key in the code.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefSecretsToken.Code(),
+			Title:       "Token detected",
+			Description: "Found an authentication token in the code.",
+		},
+		validator.ReferenceInfo{
+			Code:        validator.RefSecretsConnString.Code(),
+			Title:       "Connection string with credentials detected",
+			Description: "A database connection string contains credentials.",
+		},
+	)
+}
@@ -6,8 +6,10 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/smykla-skalski/klaudiush/internal/git"
 	"github.com/smykla-skalski/klaudiush/internal/linters"
 	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/internal/validators"
 	"github.com/smykla-skalski/klaudiush/pkg/config"
 	"github.com/smykla-skalski/klaudiush/pkg/hook"
 	"github.com/smykla-skalski/klaudiush/pkg/logger"
@@ -16,11 +18,33 @@ import (
 // SecretsValidator validates file content for secrets and sensitive data.
 type SecretsValidator struct {
 	validator.BaseValidator
-	detector        Detector
-	gitleaks        linters.GitleaksChecker
-	config          *config.SecretsValidatorConfig
-	allowListRegex  []*regexp.Regexp
-	disabledPatters map[string]bool
+	detector         Detector
+	gitleaks         linters.GitleaksChecker
+	config           *config.SecretsValidatorConfig
+	allowListRegex   []*regexp.Regexp
+	disabledPatters  map[string]bool
+	gitRunner        git.Runner
+	gitRunnerForPath func(path string) git.Runner
+}
+
+// SecretsValidatorOption configures a SecretsValidator.
+type SecretsValidatorOption func(*SecretsValidator)
+
+// WithGitRunner sets the git runner used to read the commits being pushed.
+// Without one, git push commands are not scanned.
+func WithGitRunner(runner git.Runner) SecretsValidatorOption {
+	return func(v *SecretsValidator) {
+		v.gitRunner = runner
+	}
+}
+
+// WithGitRunnerForPath sets the function creating the git runner for pushes
+// run in another directory, via git -C or a preceding cd. Without one, such
+// pushes are read with the runner set by WithGitRunner.
+func WithGitRunnerForPath(forPath func(path string) git.Runner) SecretsValidatorOption {
+	return func(v *SecretsValidator) {
+		v.gitRunnerForPath = forPath
+	}
 }
 
 // NewSecretsValidator creates a new SecretsValidator.
@@ -30,6 +54,7 @@ func NewSecretsValidator(
 	gitleaks linters.GitleaksChecker,
 	cfg *config.SecretsValidatorConfig,
 	ruleAdapter validator.RuleChecker,
+	opts ...SecretsValidatorOption,
 ) *SecretsValidator {
 	v := &SecretsValidator{
 		BaseValidator: *validator.NewBaseValidatorWithRules(
@@ -50,6 +75,10 @@ func NewSecretsValidator(
 		}
 	}
 
+	for _, opt := range opts {
+		opt(v)
+	}
+
 	return v
 }
 
@@ -80,6 +109,11 @@ func (v *SecretsValidator) Validate(ctx context.Context, hookCtx *hook.Context)
 		return result
 	}
 
+	// Bash commands only reach the validator for git push
+	if hookCtx.ToolName == hook.ToolTypeBash {
+		return v.validatePush(hookCtx.GetCommand())
+	}
+
 	// Get content to validate
 	content := v.getContent(hookCtx)
 	if content == "" {
@@ -94,11 +128,14 @@ func (v *SecretsValidator) Validate(ctx context.Context, hookCtx *hook.Context)
 		return validator.Pass()
 	}
 
-	// Run pattern detection
-	findings := v.detector.Detect(content)
+	// Binary content is not scanned
+	if validators.IsBinaryContent(content) {
+		log.Debug("binary content, skipping")
+		return validator.Pass()
+	}
 
-	// Filter findings
-	findings = v.filterFindings(findings)
+	// Run pattern detection
+	findings := v.detect(content)
 
 	if len(findings) > 0 {
 		return v.createResult(findings)
@@ -117,6 +154,36 @@ func (v *SecretsValidator) Validate(ctx context.Context, hookCtx *hook.Context)
 	return validator.Pass()
 }
 
+// detect runs the detector and drops allowed and disabled findings. Detectors
+// that support line-by-line scanning stop at the first kept finding when
+// StopOnFirstMatch is enabled and findings block.
+func (v *SecretsValidator) detect(content string) []Finding {
+	lineDetector, ok := v.detector.(LineDetector)
+	if !ok {
+		return v.filterFindings(v.detector.Detect(content))
+	}
+
+	stopOnFirst := v.shouldBlock() && v.config.IsStopOnFirstMatchEnabled()
+
+	var findings []Finding
+
+	for finding := range lineDetector.DetectLines(content) {
+		if !v.keepFinding(finding) {
+			continue
+		}
+
+		findings = append(findings, finding)
+
+		if stopOnFirst {
+			v.Logger().Debug("stopping at first finding", "line", finding.Line)
+
+			break
+		}
+	}
+
+	return findings
+}
+
 // getContent extracts content to validate from the hook context.
 func (*SecretsValidator) getContent(hookCtx *hook.Context) string {
 	// For Write operations, use the content directly
@@ -129,6 +196,11 @@ func (*SecretsValidator) getContent(hookCtx *hook.Context) string {
 		return hookCtx.ToolInput.NewString
 	}
 
+	// For MultiEdit operations, validate the new content of all edits
+	if hookCtx.ToolName == hook.ToolTypeMultiEdit {
+		return hookCtx.GetContent()
+	}
+
 	return ""
 }
 
@@ -159,24 +231,32 @@ func (v *SecretsValidator) filterFindings(findings []Finding) []Finding {
 	filtered := make([]Finding, 0, len(findings))
 
 	for _, finding := range findings {
-		// Skip disabled patterns
-		if v.disabledPatters[finding.Pattern.Name] {
-			v.Logger().Debug("skipping disabled pattern", "pattern", finding.Pattern.Name)
-
-			continue
+		if v.keepFinding(finding) {
+			filtered = append(filtered, finding)
 		}
+	}
 
-		// Skip if matches allow list
-		if v.matchesAllowList(finding.Match) {
-			v.Logger().Debug("skipping allowed match", "match", finding.Match)
+	return filtered
+}
 
-			continue
-		}
+// keepFinding reports whether a finding is neither from a disabled pattern nor
+// on the allow list.
+func (v *SecretsValidator) keepFinding(finding Finding) bool {
+	// Skip disabled patterns
+	if v.disabledPatters[finding.Pattern.Name] {
+		v.Logger().Debug("skipping disabled pattern", "pattern", finding.Pattern.Name)
 
-		filtered = append(filtered, finding)
+		return false
 	}
 
-	return filtered
+	// Skip if matches allow list
+	if v.matchesAllowList(finding.Match) {
+		v.Logger().Debug("skipping allowed match", "match", finding.Match)
+
+		return false
+	}
+
+	return true
 }
 
 // matchesAllowList checks if a match should be ignored based on allow list.
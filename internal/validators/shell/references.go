@@ -0,0 +1,14 @@
+package shell
+
+import "github.com/smykla-skalski/klaudiush/internal/validator"
+
+func init() {
+	validator.RegisterReferences(
+		validator.ReferenceInfo{
+			Code:  validator.RefShellBackticks.Code(),
+			Title: "Unescaped backticks in strings",
+			Description: "Command substitution (backticks or `$()`) detected in double-quoted " +
+				"strings, which can cause unexpected behavior when the shell interprets them.",
+		},
+	)
+}
@@ -0,0 +1,50 @@
+package validators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// snippetMarker marks the reported line in a snippet.
+const snippetMarker = ">"
+
+// RenderSnippet renders the 1-indexed line of content with up to
+// contextLines lines before and after it, each prefixed with its line number
+// and the reported line marked:
+//
+//	  2 | echo "start"
+//	> 3 | cd /tmp
+//	  4 | echo "done"
+//
+// It returns an empty string when line is outside of content.
+func RenderSnippet(content string, line, contextLines int) string {
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	if content == "" || line < 1 || line > len(lines) {
+		return ""
+	}
+
+	contextLines = max(contextLines, 0)
+	start := max(1, line-contextLines)
+	end := min(len(lines), line+contextLines)
+	width := len(strconv.Itoa(end))
+
+	var b strings.Builder
+
+	for n := start; n <= end; n++ {
+		marker := " "
+		if n == line {
+			marker = snippetMarker
+		}
+
+		fmt.Fprintf(&b, "%s %*d |", marker, width, n)
+
+		if text := strings.TrimRight(lines[n-1], "\r"); text != "" {
+			b.WriteString(" " + text)
+		}
+
+		b.WriteString("\n")
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
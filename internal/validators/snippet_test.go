@@ -0,0 +1,43 @@
+package validators_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/internal/validators"
+)
+
+var _ = Describe("RenderSnippet", func() {
+	const content = "one\ntwo\nthree\n\nfive\nsix\n"
+
+	DescribeTable("renders the line with its context",
+		func(line, contextLines int, expected string) {
+			Expect(validators.RenderSnippet(content, line, contextLines)).To(Equal(expected))
+		},
+		Entry("in the middle", 3, 1, "  2 | two\n> 3 | three\n  4 |"),
+		Entry("at the start", 1, 2, "> 1 | one\n  2 | two\n  3 | three"),
+		Entry("at the end", 6, 1, "  5 | five\n> 6 | six"),
+		Entry("without context", 5, 0, "> 5 | five"),
+		Entry("with negative context", 2, -1, "> 2 | two"),
+	)
+
+	It("should pad line numbers to the same width", func() {
+		content := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\n"
+
+		Expect(validators.RenderSnippet(content, 9, 1)).
+			To(Equal("   8 | h\n>  9 | i\n  10 | j"))
+	})
+
+	It("should drop carriage returns", func() {
+		Expect(validators.RenderSnippet("a\r\nb\r\n", 2, 1)).To(Equal("  1 | a\n> 2 | b"))
+	})
+
+	DescribeTable("returns nothing for lines outside of content",
+		func(content string, line int) {
+			Expect(validators.RenderSnippet(content, line, 2)).To(BeEmpty())
+		},
+		Entry("line zero", content, 0),
+		Entry("past the end", content, 7),
+		Entry("empty content", "", 1),
+	)
+})
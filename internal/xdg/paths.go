@@ -112,6 +112,11 @@ func StateDir() string {
 	return filepath.Join(StateHome(), appName)
 }
 
+// CacheDir returns CacheHome()/klaudiush.
+func CacheDir() string {
+	return filepath.Join(CacheHome(), appName)
+}
+
 // --- Specific file paths ---
 
 // GlobalConfigFile returns ConfigDir()/config.toml.
@@ -129,6 +134,11 @@ func LogFile() string {
 	return filepath.Join(StateDir(), "dispatcher.log")
 }
 
+// ResultsFile returns StateDir()/results.log.
+func ResultsFile() string {
+	return filepath.Join(StateDir(), "results.log")
+}
+
 // ExceptionStateFile returns DataDir()/exceptions/state.json.
 func ExceptionStateFile() string {
 	return filepath.Join(DataDir(), "exceptions", "state.json")
@@ -164,6 +174,26 @@ func PluginDir() string {
 	return filepath.Join(DataDir(), "plugins")
 }
 
+// LintCacheDir returns CacheDir()/lint.
+func LintCacheDir() string {
+	return filepath.Join(CacheDir(), "lint")
+}
+
+// DebounceCacheDir returns CacheDir()/debounce.
+func DebounceCacheDir() string {
+	return filepath.Join(CacheDir(), "debounce")
+}
+
+// LoopStateDir returns StateDir()/loops.
+func LoopStateDir() string {
+	return filepath.Join(StateDir(), "loops")
+}
+
+// RuleRateLimitStateFile returns StateDir()/rule_rate_limits.json.
+func RuleRateLimitStateFile() string {
+	return filepath.Join(StateDir(), "rule_rate_limits.json")
+}
+
 // MigrationMarker returns StateDir()/.migration_v2.
 func MigrationMarker() string {
 	return filepath.Join(StateDir(), ".migration_v2")
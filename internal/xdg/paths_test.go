@@ -224,6 +224,17 @@ func TestPluginDir(t *testing.T) {
 	}
 }
 
+func TestLintCacheDir(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "/xdg/cache")
+
+	got := xdg.LintCacheDir()
+	want := "/xdg/cache/klaudiush/lint"
+
+	if got != want {
+		t.Errorf("LintCacheDir() = %q, want %q", got, want)
+	}
+}
+
 func TestMigrationMarker(t *testing.T) {
 	t.Setenv("XDG_STATE_HOME", "/xdg/state")
 
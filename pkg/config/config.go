@@ -1,9 +1,30 @@
 // Package config provides configuration schema types for klaudiush validators.
 package config
 
+import "time"
+
 // CurrentConfigVersion is the latest config schema version.
 const CurrentConfigVersion = 1
 
+// Result sinks for GlobalConfig.ResultSink.
+const (
+	// ResultSinkStderr writes the report to stderr.
+	ResultSinkStderr = "stderr"
+
+	// ResultSinkFile appends the report to GlobalConfig.ResultFile.
+	ResultSinkFile = "file"
+
+	// ResultSinkSyslog sends each finding to the local syslog daemon.
+	ResultSinkSyslog = "syslog"
+)
+
+// DefaultLintCacheTTL is how long cached linter results are reused when
+// GlobalConfig.LintCacheTTL is not set.
+const DefaultLintCacheTTL = 24 * time.Hour
+
+// ValidResultSinks are the valid values for GlobalConfig.ResultSink.
+var ValidResultSinks = []string{ResultSinkStderr, ResultSinkFile, ResultSinkSyslog}
+
 // Config represents the root configuration for klaudiush.
 type Config struct {
 	// Version is the config schema version. Defaults to 1 when omitted.
@@ -38,10 +59,24 @@ type Config struct {
 
 	// Overrides contains persistent disable/enable overrides for error codes and validators.
 	Overrides *OverridesConfig `json:"overrides,omitempty" koanf:"overrides" toml:"overrides,omitempty"`
+
+	// Profiles maps profile names to partial configuration overrides.
+	// The selected profile (--profile or KLAUDIUSH_PROFILE) is merged over the base config.
+	Profiles map[string]*ProfileConfig `json:"profiles,omitempty" koanf:"profiles" toml:"profiles,omitempty"`
 }
 
 // ValidatorsConfig groups all validator configurations by category.
 type ValidatorsConfig struct {
+	// Disabled lists validators to disable in bulk, as short names ("markdown"),
+	// dotted names ("git.pr") or validator type globs ("file.*").
+	// A validator's own "enabled" setting wins when set explicitly.
+	Disabled []string `json:"disabled,omitempty" koanf:"disabled" toml:"disabled,omitempty"`
+
+	// Enabled lists validators to enable in bulk, using the same names as
+	// Disabled. It wins over Disabled, so it can re-enable a single validator
+	// of a disabled group.
+	Enabled []string `json:"enabled,omitempty" koanf:"enabled" toml:"enabled,omitempty"`
+
 	// Git validator configurations.
 	Git *GitConfig `json:"git,omitempty" koanf:"git" toml:"git,omitempty"`
 
@@ -75,6 +110,75 @@ type GlobalConfig struct {
 	// Default: "10s"
 	DefaultTimeout Duration `json:"default_timeout,omitempty" koanf:"default_timeout" toml:"default_timeout,omitempty"`
 
+	// HookTimeout bounds the total time spent validating a single hook
+	// invocation. When it expires, in-flight validators are cancelled and the
+	// findings of the finished ones are kept. The unfinished validators allow
+	// the operation unless FailClosedOnTimeout is set.
+	// Default: 0 (no limit)
+	HookTimeout Duration `json:"hook_timeout,omitempty" koanf:"hook_timeout" toml:"hook_timeout,omitempty"`
+
+	// FailClosedOnTimeout blocks the operation when HookTimeout expires.
+	// Default: false (allow on timeout)
+	FailClosedOnTimeout *bool `json:"fail_closed_on_timeout,omitempty" koanf:"fail_closed_on_timeout" toml:"fail_closed_on_timeout,omitempty"`
+
+	// MaxSeverity caps the severity of every validator and rule finding.
+	// With "warning", blocking findings are downgraded to warnings, which is
+	// useful when rolling out klaudiush before enforcing it. With "info",
+	// blocks and warnings are only recorded.
+	// Default: "error" (no cap)
+	MaxSeverity Severity `json:"max_severity,omitempty" koanf:"max_severity" toml:"max_severity,omitempty"`
+
+	// EmitPostToolUseSummary runs file validators on Claude PostToolUse Write
+	// events and starts the response additionalContext with a one-line summary
+	// of the findings, such as "markdown (2)". PreToolUse responses are not
+	// affected.
+	// Default: false
+	EmitPostToolUseSummary *bool `json:"emit_post_tool_use_summary,omitempty" koanf:"emit_post_tool_use_summary" toml:"emit_post_tool_use_summary,omitempty"`
+
+	// ResultSink selects where the human-readable validation report is written.
+	// The stderr report is only printed with --color on a terminal; the file
+	// and syslog sinks receive every report with findings.
+	// Values: "stderr", "file", "syslog"
+	// Default: "stderr"
+	ResultSink string `json:"result_sink,omitempty" jsonschema:"enum=stderr,enum=file,enum=syslog" koanf:"result_sink" toml:"result_sink,omitempty"`
+
+	// ResultFile is the file reports are appended to when ResultSink is "file".
+	// Default: "$XDG_STATE_HOME/klaudiush/results.log"
+	ResultFile string `json:"result_file,omitempty" koanf:"result_file" toml:"result_file,omitempty"`
+
+	// Log configures rotation of the klaudiush log file.
+	Log *LogConfig `json:"log,omitempty" koanf:"log" toml:"log,omitempty"`
+
+	// LintCache reuses the result of an external linter (markdownlint,
+	// shellcheck, ...) when it already ran with the same arguments on the same
+	// file contents. Results are stored in $XDG_CACHE_HOME/klaudiush/lint.
+	// Default: false
+	LintCache *bool `json:"lint_cache,omitempty" koanf:"lint_cache" toml:"lint_cache,omitempty"`
+
+	// LintCacheTTL is how long a cached linter result is reused. Upgrading a
+	// linter or editing its config file invalidates its results earlier.
+	// Default: "24h"
+	LintCacheTTL Duration `json:"lint_cache_ttl,omitempty" koanf:"lint_cache_ttl" toml:"lint_cache_ttl,omitempty"`
+
+	// Debounce reuses the decision of an identical PreToolUse invocation
+	// (same tool input, target file content, working directory and config)
+	// made within this window instead of running the validators again, for
+	// bursts of repeated events during bulk edits. Decisions are stored in
+	// $XDG_CACHE_HOME/klaudiush/debounce. Git state is not part of the key,
+	// so keep the window short.
+	// Default: "0" (disabled)
+	Debounce Duration `json:"debounce,omitempty" koanf:"debounce" toml:"debounce,omitempty"`
+
+	// LoopDetectionThreshold detects an agent stuck retrying the same blocked
+	// operation. Once invocations of the same tool have blocked this many
+	// times in a row on the same reference, the blocking message says so and
+	// points to the reference and exception tokens. Counts are stored in
+	// $XDG_STATE_HOME/klaudiush/loops and reset when the tool passes or after
+	// 30 minutes without a block. Rules can match the count with
+	// min_consecutive_blocks.
+	// Default: 0 (disabled)
+	LoopDetectionThreshold int `json:"loop_detection_threshold,omitempty" koanf:"loop_detection_threshold" toml:"loop_detection_threshold,omitempty"`
+
 	// ParallelExecution enables parallel validator execution.
 	// Default: false (sequential execution)
 	ParallelExecution *bool `json:"parallel_execution,omitempty" koanf:"parallel_execution" toml:"parallel_execution,omitempty"`
@@ -101,6 +205,100 @@ func (g *GlobalConfig) IsParallelExecutionEnabled() bool {
 	return *g.ParallelExecution
 }
 
+// GetHookTimeout returns the hook timeout, or 0 if there is no limit.
+func (g *GlobalConfig) GetHookTimeout() time.Duration {
+	if g == nil {
+		return 0
+	}
+
+	return g.HookTimeout.ToDuration()
+}
+
+// IsFailClosedOnTimeout returns whether a hook timeout blocks the operation.
+func (g *GlobalConfig) IsFailClosedOnTimeout() bool {
+	if g == nil || g.FailClosedOnTimeout == nil {
+		return false
+	}
+
+	return *g.FailClosedOnTimeout
+}
+
+// IsPostToolUseSummaryEnabled returns whether Claude PostToolUse responses
+// carry a findings summary.
+func (g *GlobalConfig) IsPostToolUseSummaryEnabled() bool {
+	if g == nil || g.EmitPostToolUseSummary == nil {
+		return false
+	}
+
+	return *g.EmitPostToolUseSummary
+}
+
+// IsLintCacheEnabled returns whether external linter results are cached.
+func (g *GlobalConfig) IsLintCacheEnabled() bool {
+	if g == nil || g.LintCache == nil {
+		return false
+	}
+
+	return *g.LintCache
+}
+
+// GetLintCacheTTL returns how long cached linter results are reused,
+// defaulting to DefaultLintCacheTTL.
+func (g *GlobalConfig) GetLintCacheTTL() time.Duration {
+	if g == nil || g.LintCacheTTL.ToDuration() <= 0 {
+		return DefaultLintCacheTTL
+	}
+
+	return g.LintCacheTTL.ToDuration()
+}
+
+// GetDebounce returns the debounce window, or 0 when debouncing is disabled.
+func (g *GlobalConfig) GetDebounce() time.Duration {
+	if g == nil || g.Debounce.ToDuration() < 0 {
+		return 0
+	}
+
+	return g.Debounce.ToDuration()
+}
+
+// GetLoopDetectionThreshold returns the number of consecutive blocks that
+// counts as a loop, or 0 when loop detection is disabled.
+func (g *GlobalConfig) GetLoopDetectionThreshold() int {
+	if g == nil || g.LoopDetectionThreshold < 0 {
+		return 0
+	}
+
+	return g.LoopDetectionThreshold
+}
+
+// GetMaxSeverity returns the maximum finding severity, defaulting to
+// SeverityError (no cap).
+func (g *GlobalConfig) GetMaxSeverity() Severity {
+	if g == nil || g.MaxSeverity == SeverityUnknown {
+		return SeverityError
+	}
+
+	return g.MaxSeverity
+}
+
+// GetLog returns the log file config, or nil for defaults.
+func (g *GlobalConfig) GetLog() *LogConfig {
+	if g == nil {
+		return nil
+	}
+
+	return g.Log
+}
+
+// GetResultSink returns the result sink, defaulting to "stderr".
+func (g *GlobalConfig) GetResultSink() string {
+	if g == nil || g.ResultSink == "" {
+		return ResultSinkStderr
+	}
+
+	return g.ResultSink
+}
+
 // GetProviders returns the provider config, creating it if it doesn't exist.
 func (c *Config) GetProviders() *ProvidersConfig {
 	if c.Providers == nil {
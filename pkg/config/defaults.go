@@ -23,6 +23,13 @@ var DefaultValidBranchTypes = []string{
 // DefaultProtectedBranches are branches that skip validation.
 var DefaultProtectedBranches = []string{"main", "master"}
 
+// DefaultBlockedCommitMarkers are commit subject markers that block a push.
+var DefaultBlockedCommitMarkers = []string{"WIP", "DO NOT MERGE", "DONOTMERGE", "[ci skip]"}
+
+// DefaultCommitMarkerBranches are the target branches checked for blocked
+// commit markers on push.
+var DefaultCommitMarkerBranches = []string{"main", "master"}
+
 // DefaultForbiddenPatterns block mentions of tmp directory.
 var DefaultForbiddenPatterns = []string{
 	`\btmp/`,  // tmp/ path references
@@ -33,7 +33,8 @@ type FileConfig struct {
 
 // MarkdownValidatorConfig configures the Markdown file validator.
 type MarkdownValidatorConfig struct {
-	ValidatorConfig `koanf:",squash"`
+	ValidatorConfig  `koanf:",squash"`
+	PathFilterConfig `koanf:",squash"`
 
 	// Timeout is the maximum time allowed for markdown linting operations.
 	// Default: "10s"
@@ -41,6 +42,7 @@ type MarkdownValidatorConfig struct {
 
 	// ContextLines is the number of lines before/after an edit to include for validation.
 	// This allows validating edited fragments without forcing fixes for all existing issues.
+	// It is also the number of lines shown around each reported line in error snippets.
 	// Default: 2
 	ContextLines *int `json:"context_lines,omitempty" koanf:"context_lines" toml:"context_lines,omitempty"`
 
@@ -113,17 +115,29 @@ type MarkdownValidatorConfig struct {
 	// regardless of this setting.
 	// Default: "warning"
 	TableFormattingSeverity string `json:"table_formatting_severity,omitempty" jsonschema:"enum=warning,enum=error" koanf:"table_formatting_severity" toml:"table_formatting_severity,omitempty"`
+
+	// RequireFrontMatter blocks writing markdown files that do not start with
+	// a YAML front matter block fenced by "---" lines.
+	// Default: false
+	RequireFrontMatter *bool `json:"require_front_matter,omitempty" koanf:"require_front_matter" toml:"require_front_matter,omitempty"`
+
+	// RequiredFrontMatterKeys lists keys the front matter must define
+	// (e.g., ["title", "description"]). Missing keys produce a warning.
+	// Default: []
+	RequiredFrontMatterKeys []string `json:"required_front_matter_keys,omitempty" koanf:"required_front_matter_keys" toml:"required_front_matter_keys,omitempty"`
 }
 
 // ShellScriptValidatorConfig configures the shell script validator.
 type ShellScriptValidatorConfig struct {
-	ValidatorConfig `koanf:",squash"`
+	ValidatorConfig  `koanf:",squash"`
+	PathFilterConfig `koanf:",squash"`
 
 	// Timeout is the maximum time allowed for shellcheck operations.
 	// Default: "10s"
 	Timeout Duration `json:"timeout,omitempty" koanf:"timeout" toml:"timeout,omitempty"`
 
 	// ContextLines is the number of lines before/after an edit to include for validation.
+	// It is also the number of lines shown around each reported line in error snippets.
 	// Default: 2
 	ContextLines *int `json:"context_lines,omitempty" koanf:"context_lines" toml:"context_lines,omitempty"`
 
@@ -147,7 +161,8 @@ type ShellScriptValidatorConfig struct {
 
 // TerraformValidatorConfig configures the Terraform/OpenTofu validator.
 type TerraformValidatorConfig struct {
-	ValidatorConfig `koanf:",squash"`
+	ValidatorConfig  `koanf:",squash"`
+	PathFilterConfig `koanf:",squash"`
 
 	// Timeout is the maximum time allowed for terraform/tofu operations.
 	// Default: "10s"
@@ -170,7 +185,8 @@ type TerraformValidatorConfig struct {
 	// Default: true
 	UseTflint *bool `json:"use_tflint,omitempty" koanf:"use_tflint" toml:"use_tflint,omitempty"`
 
-	// TerraformPath is the path to the terraform binary.
+	// TerraformPath is the path to the terraform binary. When set, it is used
+	// even if tofu is found on PATH.
 	// Default: "" (use PATH)
 	TerraformPath string `json:"terraform_path,omitempty" koanf:"terraform_path" toml:"terraform_path,omitempty"`
 
@@ -181,11 +197,27 @@ type TerraformValidatorConfig struct {
 	// TflintPath is the path to the tflint binary.
 	// Default: "" (use PATH)
 	TflintPath string `json:"tflint_path,omitempty" koanf:"tflint_path" toml:"tflint_path,omitempty"`
+
+	// RequireTerraformVersionConstraint requires a terraform block with
+	// required_version in files that define terraform or provider blocks.
+	// Default: false
+	RequireTerraformVersionConstraint *bool `json:"require_terraform_version_constraint,omitempty" koanf:"require_terraform_version_constraint" toml:"require_terraform_version_constraint,omitempty"`
+
+	// RequireProviderVersionConstraints requires every provider used in the
+	// file to have a version constraint in required_providers.
+	// Default: false
+	RequireProviderVersionConstraints *bool `json:"require_provider_version_constraints,omitempty" koanf:"require_provider_version_constraints" toml:"require_provider_version_constraints,omitempty"`
+
+	// BlockOnMissingVersionConstraints blocks the operation instead of
+	// warning when a required version constraint is missing.
+	// Default: false
+	BlockOnMissingVersionConstraints *bool `json:"block_on_missing_version_constraints,omitempty" koanf:"block_on_missing_version_constraints" toml:"block_on_missing_version_constraints,omitempty"`
 }
 
 // WorkflowValidatorConfig configures the GitHub Actions workflow validator.
 type WorkflowValidatorConfig struct {
-	ValidatorConfig `koanf:",squash"`
+	ValidatorConfig  `koanf:",squash"`
+	PathFilterConfig `koanf:",squash"`
 
 	// Timeout is the maximum time allowed for actionlint operations.
 	// Default: "10s"
@@ -215,11 +247,21 @@ type WorkflowValidatorConfig struct {
 	// ActionlintPath is the path to the actionlint binary.
 	// Default: "" (use PATH)
 	ActionlintPath string `json:"actionlint_path,omitempty" koanf:"actionlint_path" toml:"actionlint_path,omitempty"`
+
+	// AllowedRunners lists the runs-on labels jobs may use. Every label of a
+	// job must be in the list. Matrix expressions are resolved to the matrix values.
+	// Default: [] (any runner)
+	AllowedRunners []string `json:"allowed_runners,omitempty" koanf:"allowed_runners" toml:"allowed_runners,omitempty"`
+
+	// BlockLatestRunners blocks floating runner labels such as "ubuntu-latest".
+	// Default: false
+	BlockLatestRunners *bool `json:"block_latest_runners,omitempty" koanf:"block_latest_runners" toml:"block_latest_runners,omitempty"`
 }
 
 // GofumptValidatorConfig configures the Go code formatter validator.
 type GofumptValidatorConfig struct {
-	ValidatorConfig `koanf:",squash"`
+	ValidatorConfig  `koanf:",squash"`
+	PathFilterConfig `koanf:",squash"`
 
 	// Timeout is the maximum time allowed for gofumpt operations.
 	// Default: "10s"
@@ -246,13 +288,15 @@ type GofumptValidatorConfig struct {
 
 // PythonValidatorConfig configures the Python file validator.
 type PythonValidatorConfig struct {
-	ValidatorConfig `koanf:",squash"`
+	ValidatorConfig  `koanf:",squash"`
+	PathFilterConfig `koanf:",squash"`
 
 	// Timeout is the maximum time allowed for ruff operations.
 	// Default: "10s"
 	Timeout Duration `json:"timeout,omitempty" koanf:"timeout" toml:"timeout,omitempty"`
 
 	// ContextLines is the number of lines before/after an edit to include for validation.
+	// It is also the number of lines shown around each reported line in error snippets.
 	// Default: 2
 	ContextLines *int `json:"context_lines,omitempty" koanf:"context_lines" toml:"context_lines,omitempty"`
 
@@ -275,13 +319,15 @@ type PythonValidatorConfig struct {
 
 // JavaScriptValidatorConfig configures the JavaScript/TypeScript file validator.
 type JavaScriptValidatorConfig struct {
-	ValidatorConfig `koanf:",squash"`
+	ValidatorConfig  `koanf:",squash"`
+	PathFilterConfig `koanf:",squash"`
 
 	// Timeout is the maximum time allowed for oxlint operations.
 	// Default: "10s"
 	Timeout Duration `json:"timeout,omitempty" koanf:"timeout" toml:"timeout,omitempty"`
 
 	// ContextLines is the number of lines before/after an edit to include for validation.
+	// It is also the number of lines shown around each reported line in error snippets.
 	// Default: 2
 	ContextLines *int `json:"context_lines,omitempty" koanf:"context_lines" toml:"context_lines,omitempty"`
 
@@ -304,7 +350,8 @@ type JavaScriptValidatorConfig struct {
 
 // RustValidatorConfig configures the Rust file validator.
 type RustValidatorConfig struct {
-	ValidatorConfig `koanf:",squash"`
+	ValidatorConfig  `koanf:",squash"`
+	PathFilterConfig `koanf:",squash"`
 
 	// Timeout is the maximum time allowed for rustfmt operations.
 	// Default: "10s"
@@ -334,7 +381,8 @@ type RustValidatorConfig struct {
 
 // LinterIgnoreValidatorConfig configures the linter ignore directive validator.
 type LinterIgnoreValidatorConfig struct {
-	ValidatorConfig `koanf:",squash"`
+	ValidatorConfig  `koanf:",squash"`
+	PathFilterConfig `koanf:",squash"`
 
 	// Patterns is a list of regex patterns to detect linter ignore directives.
 	// Default: built-in patterns for common languages (noqa, eslint-disable, nolint, etc.)
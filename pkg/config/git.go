@@ -40,6 +40,53 @@ type CommitValidatorConfig struct {
 	// Default: true
 	CheckStagingArea *bool `json:"check_staging_area,omitempty" koanf:"check_staging_area" toml:"check_staging_area,omitempty"`
 
+	// MaxDiffLines is the maximum number of added plus deleted lines in the
+	// staged diff. 0 disables the check.
+	// Default: 0
+	MaxDiffLines *int `json:"max_diff_lines,omitempty" koanf:"max_diff_lines" toml:"max_diff_lines,omitempty"`
+
+	// MaxDiffBytes is the maximum size in bytes of the added and deleted lines
+	// in the staged diff. 0 disables the check.
+	// Default: 0
+	MaxDiffBytes *int `json:"max_diff_bytes,omitempty" koanf:"max_diff_bytes" toml:"max_diff_bytes,omitempty"`
+
+	// DiffSizeExclude lists glob patterns for files not counted toward
+	// MaxDiffLines and MaxDiffBytes (e.g., "**/package-lock.json", "gen/**").
+	// Patterns without a "/" also match the file name in any directory.
+	// Default: []
+	DiffSizeExclude []string `json:"diff_size_exclude,omitempty" koanf:"diff_size_exclude" toml:"diff_size_exclude,omitempty"`
+
+	// BlockOnLargeDiff blocks commits exceeding MaxDiffLines or MaxDiffBytes
+	// instead of warning.
+	// Default: false
+	BlockOnLargeDiff *bool `json:"block_on_large_diff,omitempty" koanf:"block_on_large_diff" toml:"block_on_large_diff,omitempty"`
+
+	// BlockAmendPushed blocks "git commit --amend" when HEAD is already on the
+	// upstream branch. When false, the amend is allowed with a warning.
+	// Default: true
+	BlockAmendPushed *bool `json:"block_amend_pushed,omitempty" koanf:"block_amend_pushed" toml:"block_amend_pushed,omitempty"`
+
+	// RequireChangelogFragment blocks commits that don't stage a changelog
+	// fragment (a file under ChangelogDir matching ChangelogPattern), unless
+	// the commit type is listed in ExemptTypes.
+	// Default: false
+	RequireChangelogFragment *bool `json:"require_changelog_fragment,omitempty" koanf:"require_changelog_fragment" toml:"require_changelog_fragment,omitempty"`
+
+	// ChangelogDir is the directory, relative to the repository root, that
+	// holds changelog fragments.
+	// Default: "changelog.d"
+	ChangelogDir string `json:"changelog_dir,omitempty" koanf:"changelog_dir" toml:"changelog_dir,omitempty"`
+
+	// ChangelogPattern is a glob the fragment file name must match
+	// (e.g., "*.md", "*.{feature,bugfix}.md"). Empty matches any file.
+	// Default: ""
+	ChangelogPattern string `json:"changelog_pattern,omitempty" koanf:"changelog_pattern" toml:"changelog_pattern,omitempty"`
+
+	// ExemptTypes lists conventional commit types that don't need a changelog
+	// fragment.
+	// Default: ["chore", "docs"]
+	ExemptTypes []string `json:"exempt_types,omitempty" koanf:"exempt_types" toml:"exempt_types,omitempty"`
+
 	// Message contains commit message validation settings.
 	Message *CommitMessageConfig `json:"message,omitempty" koanf:"message" toml:"message,omitempty"`
 }
@@ -118,6 +165,26 @@ type CommitMessageConfig struct {
 	// Format: "Name <email@klaudiu.sh>"
 	// Default: "" (no signoff validation)
 	ExpectedSignoff string `json:"expected_signoff,omitempty" koanf:"expected_signoff" toml:"expected_signoff,omitempty"`
+
+	// RequiredTrailers is a list of git trailer tokens that must be present in the
+	// commit message trailer block (the last paragraph), e.g. "Signed-off-by".
+	// Unlike RequiredFlags, this checks the message content itself, so trailers added
+	// by git at commit time (e.g. via -s) are not seen.
+	// Identity trailers (Signed-off-by, Co-authored-by, ...) must use "Name <email>".
+	// Default: [] (no trailers required)
+	RequiredTrailers []string `json:"required_trailers,omitempty" koanf:"required_trailers" toml:"required_trailers,omitempty"`
+
+	// RequireGitmoji requires the commit title to start with a known gitmoji,
+	// either as the emoji itself or as its :shortcode:, e.g. ":sparkles: feat(api): add x".
+	// The gitmoji is stripped before the title format checks run.
+	// Mutually exclusive with BlockEmoji.
+	// Default: false
+	RequireGitmoji *bool `json:"require_gitmoji,omitempty" koanf:"require_gitmoji" toml:"require_gitmoji,omitempty"`
+
+	// BlockEmoji blocks emoji anywhere in the commit title.
+	// Mutually exclusive with RequireGitmoji.
+	// Default: false
+	BlockEmoji *bool `json:"block_emoji,omitempty" koanf:"block_emoji" toml:"block_emoji,omitempty"`
 }
 
 // PushValidatorConfig configures the git push validator.
@@ -137,9 +204,49 @@ type PushValidatorConfig struct {
 	// Default: ["origin", "upstream"]
 	AllowedRemotePriority []string `json:"allowed_remote_priority,omitempty" koanf:"allowed_remote_priority" toml:"allowed_remote_priority,omitempty"`
 
+	// AllowedRemotes is an allowlist of remote names for push operations, e.g.
+	// the fork and upstream of a project. Pushing to any other remote is
+	// rejected, including the tracking remote of a plain "git push". An empty
+	// list allows all remotes.
+	// Default: []
+	AllowedRemotes []string `json:"allowed_remotes,omitempty" koanf:"allowed_remotes" toml:"allowed_remotes,omitempty"`
+
 	// RequireTracking requires branches to have remote tracking configured before push.
 	// Default: true
 	RequireTracking *bool `json:"require_tracking,omitempty" koanf:"require_tracking" toml:"require_tracking,omitempty"`
+
+	// BlockedCommitMarkers are markers that must not appear in the subject of a
+	// commit being pushed (the commits in "git log @{u}..HEAD"). Matching is
+	// case-insensitive, and markers starting or ending with a letter or digit
+	// only match whole words, so "WIP" doesn't match "wipe". An empty list
+	// disables the check.
+	// Default: ["WIP", "DO NOT MERGE", "DONOTMERGE", "[ci skip]"]
+	BlockedCommitMarkers []string `json:"blocked_commit_markers,omitempty" koanf:"blocked_commit_markers" toml:"blocked_commit_markers,omitempty"`
+
+	// CommitMarkerBranches are the target branches for which pushed commits are
+	// checked against BlockedCommitMarkers. An empty list checks every branch.
+	// Default: ["main", "master"]
+	CommitMarkerBranches []string `json:"commit_marker_branches,omitempty" koanf:"commit_marker_branches" toml:"commit_marker_branches,omitempty"`
+
+	// CommitMarkerSeverity controls whether a pushed commit with a blocked
+	// marker blocks the push ("error") or only warns ("warning").
+	// Default: "error"
+	CommitMarkerSeverity Severity `json:"commit_marker_severity,omitempty" koanf:"commit_marker_severity" toml:"commit_marker_severity,omitempty"`
+
+	// BlockTagPush flags pushes that include tags ("--tags" or
+	// "--follow-tags"), so local tags aren't published by accident.
+	// Default: false
+	BlockTagPush *bool `json:"block_tag_push,omitempty" koanf:"block_tag_push" toml:"block_tag_push,omitempty"`
+
+	// TagPushRemotes are the remotes tags may be pushed to when BlockTagPush
+	// is enabled.
+	// Default: []
+	TagPushRemotes []string `json:"tag_push_remotes,omitempty" koanf:"tag_push_remotes" toml:"tag_push_remotes,omitempty"`
+
+	// TagPushSeverity controls whether a push including tags blocks the push
+	// ("error") or only warns ("warning").
+	// Default: "error"
+	TagPushSeverity Severity `json:"tag_push_severity,omitempty" koanf:"tag_push_severity" toml:"tag_push_severity,omitempty"`
 }
 
 // AddValidatorConfig configures the git add validator.
@@ -150,6 +257,23 @@ type AddValidatorConfig struct {
 	// Patterns use filepath.Match syntax (e.g., "tmp/*", "*.secret").
 	// Default: ["tmp/*"]
 	BlockedPatterns []string `json:"blocked_patterns,omitempty" koanf:"blocked_patterns" toml:"blocked_patterns,omitempty"`
+
+	// MaxAddFileSizeKB is the maximum size in KB of a new (untracked) file
+	// staged by git add. Files already tracked are not checked. 0 disables
+	// the check.
+	// Default: 0
+	MaxAddFileSizeKB *int `json:"max_add_file_size_kb,omitempty" koanf:"max_add_file_size_kb" toml:"max_add_file_size_kb,omitempty"`
+
+	// BlockedBinaryExtensions lists extensions (without the leading dot,
+	// e.g. "zip", "exe") of binary files that should not be staged as new
+	// files. Case-insensitive. Files already tracked are not checked.
+	// Default: []
+	BlockedBinaryExtensions []string `json:"blocked_binary_extensions,omitempty" koanf:"blocked_binary_extensions" toml:"blocked_binary_extensions,omitempty"`
+
+	// BlockOnLargeFiles makes oversized and binary files block the add
+	// instead of only warning.
+	// Default: false
+	BlockOnLargeFiles *bool `json:"block_on_large_files,omitempty" koanf:"block_on_large_files" toml:"block_on_large_files,omitempty"`
 }
 
 // PRValidatorConfig configures the GitHub PR (gh pr create) validator.
@@ -308,6 +432,12 @@ type BranchValidatorConfig struct {
 	// AllowUppercase allows uppercase letters in branch names.
 	// Default: false
 	AllowUppercase *bool `json:"allow_uppercase,omitempty" koanf:"allow_uppercase" toml:"allow_uppercase,omitempty"`
+
+	// RequireTicketPattern is a regex for a ticket reference (e.g., `[A-Z]+-\d+`)
+	// that new branch names must contain, such as "feat/PROJ-123-add-login".
+	// Uppercase ticket IDs also need AllowUppercase.
+	// Default: "" (no ticket required)
+	RequireTicketPattern string `json:"require_ticket_pattern,omitempty" koanf:"require_ticket_pattern" toml:"require_ticket_pattern,omitempty"`
 }
 
 // NoVerifyValidatorConfig configures the git commit --no-verify validator.
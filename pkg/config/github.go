@@ -5,6 +5,12 @@ package config
 type GitHubConfig struct {
 	// Issue validator configuration
 	Issue *IssueValidatorConfig `json:"issue,omitempty" koanf:"issue" toml:"issue,omitempty"`
+
+	// Release validator configuration
+	Release *ReleaseValidatorConfig `json:"release,omitempty" koanf:"release" toml:"release,omitempty"`
+
+	// PRMerge validator configuration
+	PRMerge *PRMergeValidatorConfig `json:"pr_merge,omitempty" koanf:"pr_merge" toml:"pr_merge,omitempty"`
 }
 
 // IssueValidatorConfig configures the gh issue create validator.
@@ -15,6 +21,12 @@ type IssueValidatorConfig struct {
 	// Default: false (body is optional for issues)
 	RequireBody *bool `json:"require_body,omitempty" koanf:"require_body" toml:"require_body,omitempty"`
 
+	// RequiredSections lists template sections the issue body must contain
+	// (e.g., "## Steps to reproduce"). A section matches a heading of any level
+	// with the same text, ignoring case. Missing sections block the issue.
+	// Default: [] (no required sections)
+	RequiredSections []string `json:"required_sections,omitempty" koanf:"required_sections" toml:"required_sections,omitempty"`
+
 	// MarkdownDisabledRules is a list of markdownlint rules to disable for issue body validation.
 	// Default: ["MD013", "MD034", "MD041", "MD047"]
 	// - MD013: Line length (issues often have long lines)
@@ -27,3 +39,53 @@ type IssueValidatorConfig struct {
 	// Default: 10s
 	Timeout Duration `json:"timeout,omitempty" koanf:"timeout" toml:"timeout,omitempty"`
 }
+
+// ReleaseValidatorConfig configures the gh release create validator.
+type ReleaseValidatorConfig struct {
+	ValidatorConfig `koanf:",squash"`
+
+	// RequireNotes requires release notes via --notes or --notes-file.
+	// --generate-notes also satisfies it, but generated notes are not checked
+	// against RequiredSections.
+	// Default: false
+	RequireNotes *bool `json:"require_notes,omitempty" koanf:"require_notes" toml:"require_notes,omitempty"`
+
+	// RequiredSections lists template sections the release notes must contain
+	// (e.g., "## Breaking changes"). A section matches a heading of any level
+	// with the same text, ignoring case.
+	// Default: [] (no required sections)
+	RequiredSections []string `json:"required_sections,omitempty" koanf:"required_sections" toml:"required_sections,omitempty"`
+
+	// TagPattern is a regex the release tag must match (e.g., `^v\d+\.\d+\.\d+$`).
+	// Default: "" (any tag)
+	TagPattern string `json:"tag_pattern,omitempty" koanf:"tag_pattern" toml:"tag_pattern,omitempty"`
+
+	// TitlePattern is a regex the --title of the release must match. Releases
+	// without --title are titled after the tag by GitHub and are not checked.
+	// Default: "" (any title)
+	TitlePattern string `json:"title_pattern,omitempty" koanf:"title_pattern" toml:"title_pattern,omitempty"`
+}
+
+// ValidMergeMethods are the valid values for PRMergeValidatorConfig.AllowedMethods.
+var ValidMergeMethods = []string{"squash", "merge", "rebase"}
+
+// PRMergeValidatorConfig configures the gh pr merge validator.
+type PRMergeValidatorConfig struct {
+	ValidatorConfig `koanf:",squash"`
+
+	// AllowedMethods lists the merge methods gh pr merge may use: "squash",
+	// "merge" or "rebase". A merge without --squash, --merge or --rebase is
+	// blocked, since gh can't prompt for one in a hook.
+	// Default: [] (any method)
+	AllowedMethods []string `json:"allowed_methods,omitempty" jsonschema:"enum=squash,enum=merge,enum=rebase" koanf:"allowed_methods" toml:"allowed_methods,omitempty"`
+
+	// RequireDeleteBranch requires --delete-branch, so merged branches
+	// don't pile up.
+	// Default: false
+	RequireDeleteBranch *bool `json:"require_delete_branch,omitempty" koanf:"require_delete_branch" toml:"require_delete_branch,omitempty"`
+
+	// SkipAutoMerge skips merges with --auto, which only queue the pull
+	// request to merge once its requirements pass.
+	// Default: false
+	SkipAutoMerge *bool `json:"skip_auto_merge,omitempty" koanf:"skip_auto_merge" toml:"skip_auto_merge,omitempty"`
+}
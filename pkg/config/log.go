@@ -0,0 +1,70 @@
+package config
+
+const (
+	// DefaultLogMaxSizeMB is the size of dispatcher.log before it is rotated.
+	DefaultLogMaxSizeMB = 10
+
+	// DefaultLogMaxBackups is the number of rotated dispatcher logs to keep.
+	DefaultLogMaxBackups = 3
+
+	// bytesPerMB converts MaxSizeMB to bytes.
+	bytesPerMB = 1024 * 1024
+)
+
+// LogConfig configures the klaudiush log file (dispatcher.log).
+//
+// Example configuration:
+//
+//	[global.log]
+//	max_size_mb = 10
+//	max_backups = 3
+//	compress = true
+type LogConfig struct {
+	// MaxSizeMB is the size of the log file, in megabytes, after which it is
+	// rotated. 0 disables rotation.
+	// Default: 10
+	MaxSizeMB *int `json:"max_size_mb,omitempty" koanf:"max_size_mb" toml:"max_size_mb,omitempty"`
+
+	// MaxBackups is the number of rotated log files to keep. 0 keeps none.
+	// Default: 3
+	MaxBackups *int `json:"max_backups,omitempty" koanf:"max_backups" toml:"max_backups,omitempty"`
+
+	// Compress gzips rotated log files.
+	// Default: false
+	Compress *bool `json:"compress,omitempty" koanf:"compress" toml:"compress,omitempty"`
+}
+
+// GetMaxSizeMB returns the rotation size in megabytes.
+// Returns DefaultLogMaxSizeMB if MaxSizeMB is nil (default).
+func (l *LogConfig) GetMaxSizeMB() int {
+	if l == nil || l.MaxSizeMB == nil {
+		return DefaultLogMaxSizeMB
+	}
+
+	return *l.MaxSizeMB
+}
+
+// GetMaxSizeBytes returns the rotation size in bytes, or 0 when rotation is disabled.
+func (l *LogConfig) GetMaxSizeBytes() int64 {
+	return int64(l.GetMaxSizeMB()) * bytesPerMB
+}
+
+// GetMaxBackups returns the number of rotated log files to keep.
+// Returns DefaultLogMaxBackups if MaxBackups is nil (default).
+func (l *LogConfig) GetMaxBackups() int {
+	if l == nil || l.MaxBackups == nil {
+		return DefaultLogMaxBackups
+	}
+
+	return *l.MaxBackups
+}
+
+// IsCompress returns whether rotated log files are gzipped.
+// Returns false if Compress is nil (default).
+func (l *LogConfig) IsCompress() bool {
+	if l == nil || l.Compress == nil {
+		return false
+	}
+
+	return *l.Compress
+}
@@ -47,16 +47,24 @@ var CodeToValidator = map[string]string{
 	"GIT014": "git.commit",
 	"GIT015": "git.commit",
 	"GIT016": "git.commit",
+	"GIT026": "git.commit",
+	"GIT027": "git.commit",
+	"GIT028": "git.commit",
+	"GIT029": "git.commit",
+	"GIT032": "git.commit",
 
 	// Git push codes
 	"GIT007": "git.push",
 	"GIT008": "git.push",
 	"GIT022": "git.push",
 	"GIT025": "git.push",
+	"GIT030": "git.push",
+	"GIT033": "git.push",
 
 	// Git add codes
 	"GIT009": "git.add",
 	"GIT019": "git.add",
+	"GIT031": "git.add",
 
 	// Git branch codes
 	"GIT020": "git.branch",
@@ -85,6 +93,9 @@ var CodeToValidator = map[string]string{
 	"FILE008": "file.javascript",
 	"FILE009": "file.rust",
 	"FILE010": "file.linter_ignore",
+	"FILE011": "file.terraform",
+	"FILE012": "file.markdown",
+	"FILE013": "file.workflow",
 
 	// Security codes
 	"SEC001": "secrets",
@@ -98,6 +109,8 @@ var CodeToValidator = map[string]string{
 
 	// GitHub CLI codes
 	"GH001": "github.issue",
+	"GH002": "github.release",
+	"GH003": "github.pr_merge",
 
 	// Plugin codes
 	"PLUG001": "plugins",
@@ -13,6 +13,10 @@ import (
 const (
 	// defaultPluginTimeout is the default timeout for plugin operations.
 	defaultPluginTimeout = 5 * time.Second
+
+	// DefaultPluginMaxConcurrency is the default number of plugins run at
+	// once for a hook event.
+	DefaultPluginMaxConcurrency = 4
 )
 
 // PluginConfig contains configuration for the plugin system.
@@ -31,6 +35,18 @@ type PluginConfig struct {
 	// DefaultTimeout is the default timeout for plugin operations.
 	// Default: "5s"
 	DefaultTimeout Duration `json:"default_timeout,omitempty" koanf:"default_timeout" toml:"default_timeout,omitempty"`
+
+	// RequireApproval refuses to load plugins that are not approved. Exec
+	// plugins are approved when ApprovedChecksum matches the SHA-256 of the
+	// plugin executable.
+	// Default: false
+	RequireApproval *bool `json:"require_approval,omitempty" koanf:"require_approval" toml:"require_approval,omitempty"`
+
+	// MaxConcurrency is the maximum number of matching plugins run at once.
+	// Plugins share the hook deadline, so a slow plugin does not hold back
+	// the others. Set to 1 to run plugins one at a time.
+	// Default: 4
+	MaxConcurrency int `json:"max_concurrency,omitempty" koanf:"max_concurrency" toml:"max_concurrency,omitempty"`
 }
 
 // PluginInstanceConfig configures a single plugin instance.
@@ -38,7 +54,7 @@ type PluginInstanceConfig struct {
 	// Name is the unique identifier for this plugin instance.
 	Name string `json:"name" koanf:"name" toml:"name,omitempty"`
 
-	// Type specifies the plugin type ("exec").
+	// Type specifies the plugin type ("exec" or "http").
 	Type PluginType `json:"type" koanf:"type" toml:"type,omitempty"`
 
 	// Enabled controls whether this plugin is enabled.
@@ -52,10 +68,21 @@ type PluginInstanceConfig struct {
 	// Args are command-line arguments for exec plugins.
 	Args []string `json:"args,omitempty" koanf:"args" toml:"args,omitempty"`
 
+	// URL is the endpoint for http plugins. Validate requests are POSTed to
+	// it and plugin info is fetched from <url>/info. Must use https unless
+	// the host is localhost.
+	// Example: "http://localhost:8080/validate"
+	URL string `json:"url,omitempty" koanf:"url" toml:"url,omitempty"`
+
 	// Timeout is the maximum time to wait for plugin operations.
 	// Default: inherited from PluginConfig.DefaultTimeout
 	Timeout Duration `json:"timeout,omitempty" koanf:"timeout" toml:"timeout,omitempty"`
 
+	// ApprovedChecksum is the hex-encoded SHA-256 of the approved plugin
+	// executable. Checked when PluginConfig.RequireApproval is set.
+	// Record it with "klaudiush plugins approve <name>".
+	ApprovedChecksum string `json:"approved_checksum,omitempty" koanf:"approved_checksum" toml:"approved_checksum,omitempty"`
+
 	// Predicate configures when this plugin should be invoked.
 	Predicate *PluginPredicate `json:"predicate,omitempty" koanf:"predicate" toml:"predicate,omitempty"`
 
@@ -74,13 +101,16 @@ type PluginType string
 const (
 	// PluginTypeExec executes plugins as subprocesses with JSON I/O.
 	PluginTypeExec PluginType = "exec"
+
+	// PluginTypeHTTP calls plugins served as HTTP endpoints with JSON I/O.
+	PluginTypeHTTP PluginType = "http"
 )
 
 // JSONSchema returns the JSON Schema for the PluginType type.
 func (PluginType) JSONSchema() *jsonschema.Schema {
 	return &jsonschema.Schema{
 		Type: "string",
-		Enum: []any{"exec"},
+		Enum: []any{"exec", "http"},
 	}
 }
 
@@ -127,6 +157,25 @@ func (p *PluginConfig) GetDefaultTimeout() time.Duration {
 	return time.Duration(p.DefaultTimeout)
 }
 
+// IsRequireApproval returns whether plugins must be approved before loading.
+func (p *PluginConfig) IsRequireApproval() bool {
+	if p == nil || p.RequireApproval == nil {
+		return false
+	}
+
+	return *p.RequireApproval
+}
+
+// GetMaxConcurrency returns the maximum number of plugins run at once.
+// Values below 1 use DefaultPluginMaxConcurrency.
+func (p *PluginConfig) GetMaxConcurrency() int {
+	if p == nil || p.MaxConcurrency < 1 {
+		return DefaultPluginMaxConcurrency
+	}
+
+	return p.MaxConcurrency
+}
+
 // GetDirectory returns the plugin directory from config, or empty string if not set.
 // Callers should use xdg.PluginDir() as default when this returns empty.
 func (p *PluginConfig) GetDirectory() string {
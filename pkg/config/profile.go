@@ -0,0 +1,26 @@
+package config
+
+// ProfileConfig holds partial configuration overrides applied when the profile is selected.
+// Profiles are selected via the --profile flag or the KLAUDIUSH_PROFILE environment variable
+// and are deep-merged on top of the file-based config, below env vars and CLI flags.
+//
+// Example:
+//
+//	[profiles.strict.validators.git.commit.message]
+//	title_max_length = 50
+//
+//	[profiles.relaxed.validators.file.markdown]
+//	enabled = false
+type ProfileConfig struct {
+	// Validators contains validator overrides for this profile.
+	Validators *ValidatorsConfig `json:"validators,omitempty" koanf:"validators" toml:"validators,omitempty"`
+
+	// Global contains global setting overrides for this profile.
+	Global *GlobalConfig `json:"global,omitempty" koanf:"global" toml:"global,omitempty"`
+
+	// Exceptions contains exception workflow overrides for this profile.
+	Exceptions *ExceptionsConfig `json:"exceptions,omitempty" koanf:"exceptions" toml:"exceptions,omitempty"`
+
+	// Patterns contains failure pattern tracking overrides for this profile.
+	Patterns *PatternsConfig `json:"patterns,omitempty" koanf:"patterns" toml:"patterns,omitempty"`
+}
@@ -1,11 +1,23 @@
 // Package config provides configuration schema types for klaudiush validators.
 package config
 
+import (
+	"slices"
+	"time"
+)
+
+// DefaultMaxFileContentSize is the default largest file, in bytes, loaded
+// from disk for rule content matching (1MB).
+const DefaultMaxFileContentSize = 1048576
+
 // Valid values for rules configuration.
 // These are exported for use by validation and doctor packages.
 var (
 	// ValidActionTypes are the valid action types for rules.
-	ValidActionTypes = []string{"allow", "block", "warn"}
+	ValidActionTypes = []string{"allow", "block", "warn", "log", "transform"}
+
+	// ValidTransformFields are the tool input fields a transform action can rewrite.
+	ValidTransformFields = []string{"command", "content"}
 
 	// ValidProviders are the valid provider filters for rules.
 	ValidProviders = []string{"claude", "codex", "gemini"}
@@ -24,6 +36,12 @@ var (
 		"Bash", "Write", "Edit", "MultiEdit", "Grep", "Read", "Glob",
 		"run_shell_command", "write_file", "replace", "read_file", "ls",
 	}
+
+	// ValidScopes are the valid operation scopes for rules.
+	ValidScopes = []string{"local", "remote"}
+
+	// ValidPathModes are the valid path modes for file patterns.
+	ValidPathModes = []string{"absolute", "relative", "both"}
 )
 
 // RulesConfig contains the dynamic rule configuration.
@@ -36,6 +54,42 @@ type RulesConfig struct {
 	// Default: true
 	StopOnFirstMatch *bool `json:"stop_on_first_match,omitempty" koanf:"stop_on_first_match" toml:"stop_on_first_match,omitempty"`
 
+	// AllowWins makes a matching allow rule permit the operation even when a
+	// higher-priority block or warn rule also matches.
+	// Default: false
+	AllowWins bool `json:"allow_wins,omitempty" koanf:"allow_wins" toml:"allow_wins,omitempty"`
+
+	// IndexByValidator indexes rules by validator_type so each validator
+	// only evaluates the rules that can apply to it. Speeds up large rule
+	// sets; results are unchanged.
+	// Default: false
+	IndexByValidator bool `json:"index_by_validator,omitempty" koanf:"index_by_validator" toml:"index_by_validator,omitempty"`
+
+	// LoadFileContent reads the target file from disk for content matching
+	// in every rule when the hook payload carries no content, as for Read
+	// and Edit. Rules can opt in individually with match.load_file_content.
+	// Default: false
+	LoadFileContent bool `json:"load_file_content,omitempty" koanf:"load_file_content" toml:"load_file_content,omitempty"`
+
+	// MaxFileContentSize is the largest file, in bytes, loaded from disk for
+	// content matching. Larger files are matched without content.
+	// Default: 1048576 (1MB)
+	MaxFileContentSize *int64 `json:"max_file_content_size,omitempty" koanf:"max_file_content_size" toml:"max_file_content_size,omitempty"`
+
+	// Patterns defines named pattern aliases. A rule pattern of "@name"
+	// (or "!@name") is replaced with the alias definition, which may itself
+	// reference another alias.
+	// Example: aws_key = "AKIA[0-9A-Z]{16}", used as content_pattern = "@aws_key"
+	Patterns map[string]string `json:"patterns,omitempty" koanf:"patterns" toml:"patterns,omitempty"`
+
+	// OnlyTagged loads only rules that have at least one of these tags.
+	// Set by the --only-tagged flag. Empty loads rules regardless of tags.
+	OnlyTagged []string `json:"only_tagged,omitempty" koanf:"only_tagged" toml:"only_tagged,omitempty"`
+
+	// SkipTagged excludes rules that have any of these tags.
+	// Set by the --skip-tagged flag. Takes precedence over OnlyTagged.
+	SkipTagged []string `json:"skip_tagged,omitempty" koanf:"skip_tagged" toml:"skip_tagged,omitempty"`
+
 	// Rules is the list of validation rules.
 	Rules []RuleConfig `json:"rules,omitempty" koanf:"rules" toml:"rules,omitempty"`
 }
@@ -56,18 +110,35 @@ type RuleConfig struct {
 	// Default: 0
 	Priority int `json:"priority,omitempty" koanf:"priority" toml:"priority,omitempty"`
 
+	// Tags group rules so subsets can be selected with only_tagged and
+	// skip_tagged.
+	// Example: ["security", "experimental"]
+	Tags []string `json:"tags,omitempty" koanf:"tags" toml:"tags,omitempty"`
+
 	// Match contains the conditions that must be satisfied.
 	Match *RuleMatchConfig `json:"match,omitempty" koanf:"match" toml:"match,omitempty"`
 
 	// Action specifies what happens when the rule matches.
 	Action *RuleActionConfig `json:"action,omitempty" koanf:"action" toml:"action,omitempty"`
+
+	// RateLimit caps how often a block or warn rule surfaces in each
+	// repository. After the rule fires, its matches within the window are
+	// only logged, as with a "log" action. Requires a rule name.
+	RateLimit *RuleRateLimitConfig `json:"rate_limit,omitempty" koanf:"rate_limit" toml:"rate_limit,omitempty"`
+}
+
+// RuleRateLimitConfig caps how often a rule surfaces.
+type RuleRateLimitConfig struct {
+	// Window is how long the rule stays quiet in a repository after it
+	// fires (e.g., "10m").
+	Window Duration `json:"window,omitempty" koanf:"window" toml:"window,omitempty"`
 }
 
 // RuleMatchConfig contains all conditions for a rule to match.
 // All non-empty conditions must be satisfied (AND logic).
 type RuleMatchConfig struct {
 	// ValidatorType filters by validator type (supports wildcards).
-	// Examples: "git.push", "git.*", "*"
+	// Examples: "git.push", "git.*", "file.*", "*"
 	ValidatorType string `json:"validator_type,omitempty" koanf:"validator_type" toml:"validator_type,omitempty"`
 
 	// Provider filters by hook provider.
@@ -81,7 +152,8 @@ type RuleMatchConfig struct {
 	// RepoPatterns allows multiple repository patterns (any/all based on PatternMode).
 	RepoPatterns []string `json:"repo_patterns,omitempty" koanf:"repo_patterns" toml:"repo_patterns,omitempty"`
 
-	// Remote matches against git remote name (exact match).
+	// Remote matches against git remote name (exact match). A "!" prefix
+	// matches any remote except the named one.
 	Remote string `json:"remote,omitempty" koanf:"remote" toml:"remote,omitempty"`
 
 	// BranchPattern matches against branch name.
@@ -98,6 +170,10 @@ type RuleMatchConfig struct {
 	// FilePatterns allows multiple file patterns (any/all based on PatternMode).
 	FilePatterns []string `json:"file_patterns,omitempty" koanf:"file_patterns" toml:"file_patterns,omitempty"`
 
+	// FileExtensions matches file extensions, case-insensitively (OR logic).
+	// Given without the leading dot (e.g., ["go", "ts"]); ".go" is also accepted.
+	FileExtensions []string `json:"file_extensions,omitempty" koanf:"file_extensions" toml:"file_extensions,omitempty"`
+
 	// ContentPattern matches against file content.
 	// Always treated as regex. Supports negation (! prefix).
 	ContentPattern string `json:"content_pattern,omitempty" koanf:"content_pattern" toml:"content_pattern,omitempty"`
@@ -105,6 +181,11 @@ type RuleMatchConfig struct {
 	// ContentPatterns allows multiple content patterns (any/all based on PatternMode).
 	ContentPatterns []string `json:"content_patterns,omitempty" koanf:"content_patterns" toml:"content_patterns,omitempty"`
 
+	// ContentInFiles applies content patterns only to files matching their
+	// paired file pattern. Each entry matches when both of its patterns
+	// match; the condition is satisfied when any entry matches.
+	ContentInFiles []ContentInFileConfig `json:"content_in_files,omitempty" koanf:"content_in_files" toml:"content_in_files,omitempty"`
+
 	// CommandPattern matches against bash command.
 	// Supports glob patterns, regex, and negation (! prefix).
 	CommandPattern string `json:"command_pattern,omitempty" koanf:"command_pattern" toml:"command_pattern,omitempty"`
@@ -112,6 +193,31 @@ type RuleMatchConfig struct {
 	// CommandPatterns allows multiple command patterns (any/all based on PatternMode).
 	CommandPatterns []string `json:"command_patterns,omitempty" koanf:"command_patterns" toml:"command_patterns,omitempty"`
 
+	// CommandContains matches when the bash command contains the literal
+	// substrings (any/all based on PatternMode, case per CaseInsensitive).
+	// No glob or regex syntax, so "rm -rf" needs no escaping.
+	CommandContains []string `json:"command_contains,omitempty" koanf:"command_contains" toml:"command_contains,omitempty"`
+
+	// PromptPattern matches the user prompt with a regex, e.g. "delete
+	// (everything|all)". The prompt comes from an optional "prompt" or
+	// "user_message" field of the hook payload; most tool events carry
+	// neither, and then the condition never matches.
+	PromptPattern string `json:"prompt_pattern,omitempty" koanf:"prompt_pattern" toml:"prompt_pattern,omitempty"`
+
+	// CommitMessagePattern matches the message of a git commit passed with
+	// -m/--message, read from the command without running git. Repeated -m
+	// flags are joined as paragraphs. Always treated as regex. Commits using
+	// -F, -C or the editor never match.
+	CommitMessagePattern string `json:"commit_message_pattern,omitempty" koanf:"commit_message_pattern" toml:"commit_message_pattern,omitempty"`
+
+	// MinSubjectLength matches when the subject line of such a commit
+	// message has at least this many characters, e.g. 73 to catch long titles.
+	MinSubjectLength int `json:"min_subject_length,omitempty" koanf:"min_subject_length" toml:"min_subject_length,omitempty"`
+
+	// MaxSubjectLength matches when the subject line of such a commit
+	// message has at most this many characters, e.g. 9 to catch short titles.
+	MaxSubjectLength int `json:"max_subject_length,omitempty" koanf:"max_subject_length" toml:"max_subject_length,omitempty"`
+
 	// ToolType matches against the hook tool type.
 	// Examples: "shell", "Bash", "Edit"
 	ToolType string `json:"tool_type,omitempty" jsonschema:"enum=shell,enum=write,enum=edit,enum=multiedit,enum=grep,enum=read,enum=glob,enum=Bash,enum=Write,enum=Edit,enum=MultiEdit,enum=Grep,enum=Read,enum=Glob" koanf:"tool_type" toml:"tool_type,omitempty"`
@@ -120,6 +226,77 @@ type RuleMatchConfig struct {
 	// Examples: "before_tool", "PreToolUse", "SessionStart"
 	EventType string `json:"event_type,omitempty" jsonschema:"enum=before_tool,enum=after_tool,enum=session_start,enum=turn_stop,enum=notification,enum=pre_compress,enum=PreToolUse,enum=PostToolUse,enum=Notification,enum=SessionStart,enum=Stop,enum=AfterToolUse,enum=BeforeTool,enum=AfterTool,enum=SessionEnd,enum=PreCompress" koanf:"event_type" toml:"event_type,omitempty"`
 
+	// Scope matches whether the git or GitHub operation only affects the local
+	// repository ("local": commit, add, branch, merge) or talks to a remote
+	// ("remote": push, fetch, pull, pull requests, issues).
+	Scope string `json:"scope,omitempty" jsonschema:"enum=local,enum=remote" koanf:"scope" toml:"scope,omitempty"`
+
+	// OS matches the operating system klaudiush runs on, by Go's GOOS names
+	// (e.g., ["darwin", "linux"]), case-insensitively (OR logic).
+	OS []string `json:"os,omitempty" koanf:"os" toml:"os,omitempty"`
+
+	// RequireUpstream matches only when the current branch tracks an upstream branch.
+	// Default: false
+	RequireUpstream bool `json:"require_upstream,omitempty" koanf:"require_upstream" toml:"require_upstream,omitempty"`
+
+	// MinAhead matches when the branch is at least this many commits ahead of its upstream.
+	// Implies require_upstream when positive.
+	MinAhead int `json:"min_ahead,omitempty" koanf:"min_ahead" toml:"min_ahead,omitempty"`
+
+	// MinBehind matches when the branch is at least this many commits behind its upstream.
+	// Implies require_upstream when positive.
+	MinBehind int `json:"min_behind,omitempty" koanf:"min_behind" toml:"min_behind,omitempty"`
+
+	// MinDaysSinceCommit matches when the last commit on the current branch is at
+	// least this many days old, e.g. to warn when pushing a stale branch.
+	MinDaysSinceCommit int `json:"min_days_since_commit,omitempty" koanf:"min_days_since_commit" toml:"min_days_since_commit,omitempty"`
+
+	// RequireDirty matches only when the working tree has uncommitted changes
+	// (staged, modified or untracked files), e.g. to block "git checkout -b"
+	// with pending work.
+	RequireDirty bool `json:"require_dirty,omitempty" koanf:"require_dirty" toml:"require_dirty,omitempty"`
+
+	// RequireClean matches only when the working tree has no uncommitted
+	// changes. Cannot be combined with require_dirty.
+	RequireClean bool `json:"require_clean,omitempty" koanf:"require_clean" toml:"require_clean,omitempty"`
+
+	// StagedPathPattern matches when any staged path (relative to the repository
+	// root) matches the pattern, e.g. "**/.env" to block committing .env files.
+	// A "!" prefix negates it: "!go.sum" matches when go.sum is not staged.
+	StagedPathPattern string `json:"staged_path_pattern,omitempty" koanf:"staged_path_pattern" toml:"staged_path_pattern,omitempty"`
+
+	// RequireStagedPath matches only when a staged path matches the pattern.
+	// Combined with a negated staged_path_pattern it catches e.g. go.mod
+	// committed without go.sum.
+	RequireStagedPath string `json:"require_staged_path,omitempty" koanf:"require_staged_path" toml:"require_staged_path,omitempty"`
+
+	// IsBinary matches only when the file content looks like binary data
+	// (null bytes or a high share of non-printable characters).
+	// Default: false
+	IsBinary bool `json:"is_binary,omitempty" koanf:"is_binary" toml:"is_binary,omitempty"`
+
+	// UsesSudo matches only when the command invokes sudo, including after
+	// environment assignments, in command chains and behind env.
+	// Default: false
+	UsesSudo bool `json:"uses_sudo,omitempty" koanf:"uses_sudo" toml:"uses_sudo,omitempty"`
+
+	// MinCommandPaths matches when a single command passes at least this many
+	// path-like arguments (non-flag arguments and everything after "--"),
+	// e.g. to warn on mass deletions with rm.
+	MinCommandPaths int `json:"min_command_paths,omitempty" koanf:"min_command_paths" toml:"min_command_paths,omitempty"`
+
+	// MinConsecutiveBlocks matches when the previous invocations of the same
+	// tool blocked at least this many times in a row on the same reference,
+	// e.g. 3 to escalate guidance for a stuck agent. Counts are only tracked
+	// when global.loop_detection_threshold is set.
+	MinConsecutiveBlocks int `json:"min_consecutive_blocks,omitempty" koanf:"min_consecutive_blocks" toml:"min_consecutive_blocks,omitempty"`
+
+	// LoadFileContent reads the target file from disk for content matching
+	// when the hook payload carries no content (e.g. Read and Edit), up to
+	// rules.max_file_content_size bytes. Missing files match without content.
+	// Default: false
+	LoadFileContent bool `json:"load_file_content,omitempty" koanf:"load_file_content" toml:"load_file_content,omitempty"`
+
 	// CaseInsensitive enables case-insensitive pattern matching for all patterns.
 	// Default: false
 	CaseInsensitive *bool `json:"case_insensitive,omitempty" koanf:"case_insensitive" toml:"case_insensitive,omitempty"`
@@ -127,6 +304,21 @@ type RuleMatchConfig struct {
 	// PatternMode specifies how multiple patterns are combined when using pattern lists.
 	// Values: "any" (OR logic, default), "all" (AND logic)
 	PatternMode string `json:"pattern_mode,omitempty" jsonschema:"enum=any,enum=all" koanf:"pattern_mode" toml:"pattern_mode,omitempty"`
+
+	// PathMode selects which form of the file path file patterns match.
+	// Values: "absolute", "relative" (to the repository root), "both" (default)
+	PathMode string `json:"path_mode,omitempty" jsonschema:"enum=absolute,enum=relative,enum=both" koanf:"path_mode" toml:"path_mode,omitempty"`
+}
+
+// ContentInFileConfig pairs a content pattern with the files it applies to.
+type ContentInFileConfig struct {
+	// FilePattern selects the files the content pattern is checked in.
+	// Supports glob patterns (e.g., "**/*.go"), regex, and negation (! prefix).
+	FilePattern string `json:"file_pattern" koanf:"file_pattern" toml:"file_pattern"`
+
+	// ContentPattern matches against the content of files selected by FilePattern.
+	// Always treated as regex. Supports negation (! prefix).
+	ContentPattern string `json:"content_pattern" koanf:"content_pattern" toml:"content_pattern"`
 }
 
 // IsCaseInsensitive returns true if case-insensitive matching is enabled.
@@ -148,6 +340,15 @@ func (m *RuleMatchConfig) GetPatternMode() string {
 	return m.PatternMode
 }
 
+// GetPathMode returns the path mode, defaulting to "both".
+func (m *RuleMatchConfig) GetPathMode() string {
+	if m == nil || m.PathMode == "" {
+		return "both"
+	}
+
+	return m.PathMode
+}
+
 // HasMatchConditions returns true if the match config has at least one condition defined.
 // This is used to validate that a rule will actually match something.
 func (m *RuleMatchConfig) HasMatchConditions() bool {
@@ -164,25 +365,77 @@ func (m *RuleMatchConfig) HasMatchConditions() bool {
 		len(m.BranchPatterns) > 0 ||
 		m.FilePattern != "" ||
 		len(m.FilePatterns) > 0 ||
+		len(m.FileExtensions) > 0 ||
 		m.ContentPattern != "" ||
 		len(m.ContentPatterns) > 0 ||
+		len(m.ContentInFiles) > 0 ||
 		m.CommandPattern != "" ||
 		len(m.CommandPatterns) > 0 ||
+		len(m.CommandContains) > 0 ||
+		m.PromptPattern != "" ||
+		m.CommitMessagePattern != "" ||
+		m.MinSubjectLength > 0 ||
+		m.MaxSubjectLength > 0 ||
 		m.ToolType != "" ||
-		m.EventType != ""
+		m.EventType != "" ||
+		m.Scope != "" ||
+		len(m.OS) > 0 ||
+		m.RequireUpstream ||
+		m.MinAhead > 0 ||
+		m.MinBehind > 0 ||
+		m.MinDaysSinceCommit > 0 ||
+		m.RequireDirty ||
+		m.RequireClean ||
+		m.StagedPathPattern != "" ||
+		m.RequireStagedPath != "" ||
+		m.IsBinary ||
+		m.UsesSudo ||
+		m.MinCommandPaths > 0 ||
+		m.MinConsecutiveBlocks > 0
 }
 
 // RuleActionConfig specifies what happens when a rule matches.
 type RuleActionConfig struct {
-	// Type is the action to take (block, warn, allow).
+	// Type is the action to take (block, warn, allow, log, transform).
+	// A "log" rule only records that it matched and does not affect the result.
+	// A "transform" rule rewrites the command or content with Find/Replace
+	// and lets the rewritten operation through; it only matches when Find does.
 	// Default: "block"
-	Type string `json:"type,omitempty" jsonschema:"enum=allow,enum=block,enum=warn" koanf:"type" toml:"type,omitempty"`
+	Type string `json:"type,omitempty" jsonschema:"enum=allow,enum=block,enum=warn,enum=log,enum=transform" koanf:"type" toml:"type,omitempty"`
 
 	// Message is the human-readable message to display.
 	Message string `json:"message,omitempty" koanf:"message" toml:"message,omitempty"`
 
 	// Reference is an optional error reference code (e.g., "GIT019").
 	Reference string `json:"reference,omitempty" koanf:"reference" toml:"reference,omitempty"`
+
+	// Severity overrides how hard a block or warn action is enforced, so a
+	// warn rule can block in strict setups ("error") and a block rule can
+	// only warn during a soft rollout ("warning") or just be recorded
+	// ("info"). The global max_severity cap still applies.
+	// Default: implied by Type ("error" for block, "warning" for warn)
+	Severity Severity `json:"severity,omitempty" koanf:"severity" toml:"severity,omitempty"`
+
+	// Field is the tool input field a transform action rewrites
+	// ("command" or "content").
+	// Default: "command"
+	Field string `json:"field,omitempty" jsonschema:"enum=command,enum=content" koanf:"field" toml:"field,omitempty"`
+
+	// Find is the regular expression a transform action replaces.
+	Find string `json:"find,omitempty" koanf:"find" toml:"find,omitempty"`
+
+	// Replace is the replacement for Find matches; it may reference capture
+	// groups ($1, ${name}).
+	Replace string `json:"replace,omitempty" koanf:"replace" toml:"replace,omitempty"`
+}
+
+// GetWindow returns the rate limit window, or zero when there is no limit.
+func (r *RuleRateLimitConfig) GetWindow() time.Duration {
+	if r == nil {
+		return 0
+	}
+
+	return r.Window.ToDuration()
 }
 
 // IsEnabled returns true if the rules engine is enabled.
@@ -205,6 +458,30 @@ func (r *RulesConfig) ShouldStopOnFirstMatch() bool {
 	return *r.StopOnFirstMatch
 }
 
+// GetMaxFileContentSize returns the largest file size in bytes loaded for
+// content matching, using the default if not set.
+func (r *RulesConfig) GetMaxFileContentSize() int64 {
+	if r == nil || r.MaxFileContentSize == nil {
+		return DefaultMaxFileContentSize
+	}
+
+	return *r.MaxFileContentSize
+}
+
+// IsRuleSelected returns true if the rule passes the only_tagged and
+// skip_tagged filters. It does not check whether the rule is enabled.
+func (r *RulesConfig) IsRuleSelected(rule *RuleConfig) bool {
+	if r == nil {
+		return true
+	}
+
+	if rule.HasAnyTag(r.SkipTagged) {
+		return false
+	}
+
+	return len(r.OnlyTagged) == 0 || rule.HasAnyTag(r.OnlyTagged)
+}
+
 // IsRuleEnabled returns true if the rule is enabled.
 // Returns true if Enabled is nil (default behavior).
 func (r *RuleConfig) IsRuleEnabled() bool {
@@ -215,6 +492,17 @@ func (r *RuleConfig) IsRuleEnabled() bool {
 	return *r.Enabled
 }
 
+// HasAnyTag returns true if the rule has at least one of tags.
+func (r *RuleConfig) HasAnyTag(tags []string) bool {
+	for _, tag := range tags {
+		if slices.Contains(r.Tags, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetActionType returns the action type, defaulting to "block" if not set.
 func (a *RuleActionConfig) GetActionType() string {
 	if a == nil || a.Type == "" {
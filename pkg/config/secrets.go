@@ -36,6 +36,31 @@ type SecretsValidatorConfig struct {
 	// Default: true
 	BlockOnDetection *bool `json:"block_on_detection,omitempty" koanf:"block_on_detection" toml:"block_on_detection,omitempty"`
 
+	// StopOnFirstMatch stops scanning at the first blocking finding instead of
+	// reporting every secret in the content. Content is scanned line by line,
+	// so large files with an early secret are not scanned to the end.
+	// Only applies when BlockOnDetection is enabled.
+	// Default: false
+	StopOnFirstMatch *bool `json:"stop_on_first_match,omitempty" koanf:"stop_on_first_match" toml:"stop_on_first_match,omitempty"`
+
+	// ScanPush also scans the commits being pushed by git push. The lines
+	// added by each pushed commit are checked against the same patterns,
+	// allow list and disabled patterns as file content. A push naming a
+	// remote scans its refspec source against the remote-tracking branch it
+	// is pushed to; other pushes scan PushRange.
+	// Default: false
+	ScanPush *bool `json:"scan_push,omitempty" koanf:"scan_push" toml:"scan_push,omitempty"`
+
+	// PushRange is the revision range scanned on a git push naming no remote.
+	// Default: "@{u}..HEAD"
+	PushRange string `json:"push_range,omitempty" koanf:"push_range" toml:"push_range,omitempty"`
+
+	// PushFallbackCommits is the number of most recent commits scanned on
+	// git push when the pushed range can't be resolved, e.g. the branch has
+	// no upstream or is new on the remote.
+	// Default: 10
+	PushFallbackCommits int `json:"push_fallback_commits,omitempty" koanf:"push_fallback_commits" toml:"push_fallback_commits,omitempty"`
+
 	// AllowList is a list of patterns that should be ignored even if they match.
 	// Useful for test fixtures, documentation examples, or known false positives.
 	// Each entry is a regex pattern that, if it matches the detected secret,
@@ -76,6 +101,15 @@ const (
 // DefaultMaxFileSize is the default maximum file size for secrets scanning.
 const DefaultMaxFileSize = MB
 
+const (
+	// DefaultSecretsPushRange is the default revision range scanned on git push.
+	DefaultSecretsPushRange = "@{u}..HEAD"
+
+	// DefaultSecretsPushFallbackCommits is the default number of commits
+	// scanned on git push for a branch without an upstream.
+	DefaultSecretsPushFallbackCommits = 10
+)
+
 // JSONSchema returns the JSON Schema for the ByteSize type.
 func (ByteSize) JSONSchema() *jsonschema.Schema {
 	return &jsonschema.Schema{
@@ -104,6 +138,15 @@ func (c *SecretsValidatorConfig) IsBlockOnDetectionEnabled() bool {
 	return *c.BlockOnDetection
 }
 
+// IsStopOnFirstMatchEnabled returns whether scanning stops at the first blocking finding.
+func (c *SecretsValidatorConfig) IsStopOnFirstMatchEnabled() bool {
+	if c == nil || c.StopOnFirstMatch == nil {
+		return false
+	}
+
+	return *c.StopOnFirstMatch
+}
+
 // GetMaxFileSize returns the configured max file size or the default.
 func (c *SecretsValidatorConfig) GetMaxFileSize() ByteSize {
 	if c == nil || c.MaxFileSize == 0 {
@@ -112,3 +155,31 @@ func (c *SecretsValidatorConfig) GetMaxFileSize() ByteSize {
 
 	return c.MaxFileSize
 }
+
+// IsScanPushEnabled returns whether commits being pushed are scanned.
+func (c *SecretsValidatorConfig) IsScanPushEnabled() bool {
+	if c == nil || c.ScanPush == nil {
+		return false
+	}
+
+	return *c.ScanPush
+}
+
+// GetPushRange returns the configured push range or the default.
+func (c *SecretsValidatorConfig) GetPushRange() string {
+	if c == nil || c.PushRange == "" {
+		return DefaultSecretsPushRange
+	}
+
+	return c.PushRange
+}
+
+// GetPushFallbackCommits returns the configured number of fallback commits or
+// the default.
+func (c *SecretsValidatorConfig) GetPushFallbackCommits() int {
+	if c == nil || c.PushFallbackCommits <= 0 {
+		return DefaultSecretsPushFallbackCommits
+	}
+
+	return c.PushFallbackCommits
+}
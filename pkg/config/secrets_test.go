@@ -82,4 +82,25 @@ var _ = Describe("SecretsValidatorConfig", func() {
 			Expect(cfg.GetMaxFileSize()).To(Equal(5 * config.MB))
 		})
 	})
+
+	Describe("push scanning", func() {
+		It("uses defaults for nil config", func() {
+			var cfg *config.SecretsValidatorConfig
+			Expect(cfg.IsScanPushEnabled()).To(BeFalse())
+			Expect(cfg.GetPushRange()).To(Equal(config.DefaultSecretsPushRange))
+			Expect(cfg.GetPushFallbackCommits()).
+				To(Equal(config.DefaultSecretsPushFallbackCommits))
+		})
+
+		It("returns configured values when set", func() {
+			cfg := &config.SecretsValidatorConfig{
+				ScanPush:            new(true),
+				PushRange:           "origin/main..HEAD",
+				PushFallbackCommits: 3,
+			}
+			Expect(cfg.IsScanPushEnabled()).To(BeTrue())
+			Expect(cfg.GetPushRange()).To(Equal("origin/main..HEAD"))
+			Expect(cfg.GetPushFallbackCommits()).To(Equal(3))
+		})
+	})
 })
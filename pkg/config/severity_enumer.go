@@ -10,11 +10,11 @@ import (
 	"github.com/cockroachdb/errors"
 )
 
-const _SeverityName = "unknownerrorwarning"
+const _SeverityName = "unknownerrorwarninginfo"
 
-var _SeverityIndex = [...]uint8{0, 7, 12, 19}
+var _SeverityIndex = [...]uint8{0, 7, 12, 19, 23}
 
-const _SeverityLowerName = "unknownerrorwarning"
+const _SeverityLowerName = "unknownerrorwarninginfo"
 
 func (i Severity) String() string {
 	if i < 0 || i >= Severity(len(_SeverityIndex)-1) {
@@ -30,9 +30,10 @@ func _SeverityNoOp() {
 	_ = x[SeverityUnknown-(0)]
 	_ = x[SeverityError-(1)]
 	_ = x[SeverityWarning-(2)]
+	_ = x[SeverityInfo-(3)]
 }
 
-var _SeverityValues = []Severity{SeverityUnknown, SeverityError, SeverityWarning}
+var _SeverityValues = []Severity{SeverityUnknown, SeverityError, SeverityWarning, SeverityInfo}
 
 var _SeverityNameToValueMap = map[string]Severity{
 	_SeverityName[0:7]:        SeverityUnknown,
@@ -41,12 +42,15 @@ var _SeverityNameToValueMap = map[string]Severity{
 	_SeverityLowerName[7:12]:  SeverityError,
 	_SeverityName[12:19]:      SeverityWarning,
 	_SeverityLowerName[12:19]: SeverityWarning,
+	_SeverityName[19:23]:      SeverityInfo,
+	_SeverityLowerName[19:23]: SeverityInfo,
 }
 
 var _SeverityNames = []string{
 	_SeverityName[0:7],
 	_SeverityName[7:12],
 	_SeverityName[12:19],
+	_SeverityName[19:23],
 }
 
 // SeverityString retrieves an enum value from the enum constants string name.
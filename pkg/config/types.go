@@ -31,13 +31,17 @@ const (
 
 	// SeverityWarning indicates a validation failure that only warns without blocking.
 	SeverityWarning
+
+	// SeverityInfo indicates a finding that is only recorded: it neither
+	// blocks nor warns, and is shown only in verbose output.
+	SeverityInfo
 )
 
 // JSONSchema returns the JSON Schema for the Severity type.
 func (Severity) JSONSchema() *jsonschema.Schema {
 	return &jsonschema.Schema{
 		Type:    "string",
-		Enum:    []any{"unknown", "error", "warning"},
+		Enum:    []any{"unknown", "error", "warning", "info"},
 		Default: "error",
 	}
 }
@@ -54,10 +58,11 @@ func ParseSeverity(s string) (Severity, error) {
 		return SeverityUnknown,
 			errors.Wrapf(
 				ErrInvalidSeverity,
-				"%q, must be %q or %q",
+				"%q, must be %q, %q or %q",
 				s,
 				SeverityError.String(),
 				SeverityWarning.String(),
+				SeverityInfo.String(),
 			)
 	}
 
@@ -48,3 +48,13 @@ func (c *ValidatorConfig) AreRulesEnabled() bool {
 
 	return *c.RulesEnabled
 }
+
+// PathFilterConfig holds the path filter shared by file validators.
+type PathFilterConfig struct {
+	// SkipPaths lists glob patterns of files the validator skips. A pattern
+	// matches the file path as given, the path relative to the working
+	// directory, or the file name, so "CHANGELOG.md" skips that file
+	// anywhere. Supports "**" for any number of directories.
+	// Default: [] (no files skipped)
+	SkipPaths []string `json:"skip_paths,omitempty" koanf:"skip_paths" toml:"skip_paths,omitempty"`
+}
@@ -80,10 +80,25 @@ type ToolInput struct {
 	// Pattern is the search pattern for Grep/Glob tools.
 	Pattern string `json:"pattern,omitempty"`
 
+	// Edits are the replacements for MultiEdit tool, applied in order.
+	Edits []Edit `json:"edits,omitempty"`
+
 	// Additional fields stored as raw JSON.
 	Additional map[string]json.RawMessage `json:"-"`
 }
 
+// Edit is a single replacement of a MultiEdit tool invocation.
+type Edit struct {
+	// OldString is the string to replace.
+	OldString string `json:"old_string"`
+
+	// NewString is the replacement string.
+	NewString string `json:"new_string"`
+
+	// ReplaceAll replaces every occurrence of OldString instead of the first.
+	ReplaceAll bool `json:"replace_all,omitempty"`
+}
+
 // ElicitationInput contains MCP elicitation event data.
 type ElicitationInput struct {
 	// MCPServerName is the MCP server requesting elicitation.
@@ -193,6 +208,16 @@ type Context struct {
 
 	// CompactTrigger is what triggered the compaction (PostCompact only).
 	CompactTrigger string
+
+	// Prompt is the user prompt or message, when the payload includes a
+	// "prompt" or "user_message" field. Most tool events carry neither, so
+	// it is usually empty.
+	Prompt string
+
+	// ConsecutiveBlocks is how many invocations of the same tool blocked in a
+	// row on the same reference right before this one. Only set when loop
+	// detection is enabled.
+	ConsecutiveBlocks int
 }
 
 // GetCommand returns the command from ToolInput.
@@ -213,9 +238,19 @@ func (c *Context) GetFilePath() string {
 	return c.ToolInput.Path
 }
 
-// GetContent returns the file content from ToolInput.
+// GetContent returns the file content from ToolInput. For MultiEdit, it
+// returns the new strings of all edits joined by newlines.
 func (c *Context) GetContent() string {
-	return c.ToolInput.Content
+	if c.ToolInput.Content != "" || len(c.ToolInput.Edits) == 0 {
+		return c.ToolInput.Content
+	}
+
+	newStrings := make([]string, 0, len(c.ToolInput.Edits))
+	for _, edit := range c.ToolInput.Edits {
+		newStrings = append(newStrings, edit.NewString)
+	}
+
+	return strings.Join(newStrings, "\n")
 }
 
 // IsBashTool returns true if the tool is Bash.
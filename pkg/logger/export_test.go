@@ -0,0 +1,11 @@
+package logger
+
+import "time"
+
+// SetNow overrides the clock used to name rotated files.
+func (f *RotatingFile) SetNow(now func() time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = now
+}
@@ -88,6 +88,21 @@ func NewFileLoggerWithLevel(path string, level Level) (*SlogAdapter, error) {
 	return NewSlogAdapter(slog.New(handler)), nil
 }
 
+// NewRotatingFileLogger creates a logger that writes to a file rotated per cfg.
+// The returned RotatingFile can be used to change the rotation settings later.
+func NewRotatingFileLogger(
+	path string,
+	level Level,
+	cfg RotateConfig,
+) (*SlogAdapter, *RotatingFile, error) {
+	file, err := OpenRotatingFile(path, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return NewSlogAdapter(slog.New(NewWriterHandler(file, level))), file, nil
+}
+
 // NewFileLoggerWithWriter creates a new SlogAdapter with a custom writer.
 // Uses the same custom formatting as NewFileLogger for consistency.
 func NewFileLoggerWithWriter(w io.Writer, debug, trace bool) *SlogAdapter {
@@ -0,0 +1,329 @@
+package logger
+
+import (
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+)
+
+// backupTimeFormat is the timestamp embedded in rotated file names,
+// e.g. dispatcher.20260102-150405.000.log.
+const backupTimeFormat = "20060102-150405.000"
+
+// gzipExt is the extension appended to compressed backups.
+const gzipExt = ".gz"
+
+// lockExt is the extension of the lock file that serializes rotation
+// between processes writing the same log.
+const lockExt = ".lock"
+
+// RotateConfig configures size-based rotation of a log file.
+type RotateConfig struct {
+	// MaxSize is the size in bytes after which the file is rotated.
+	// Zero disables rotation.
+	MaxSize int64
+
+	// MaxBackups is the number of rotated files to keep. Zero keeps none.
+	MaxBackups int
+
+	// Compress gzips rotated files.
+	Compress bool
+}
+
+// RotatingFile is an io.WriteCloser that appends to a file and rotates it
+// once it grows past the configured size. Rotated files are renamed to
+// base.TIMESTAMP.ext (optionally gzipped) next to the original, and the
+// oldest ones beyond MaxBackups are removed. Several processes may write
+// the same file: rotation holds a lock on path.lock, and a process whose
+// file was already rotated by another one reopens it instead of rotating
+// again.
+type RotatingFile struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File
+	size   int64
+	config RotateConfig
+	now    func() time.Time
+}
+
+// OpenRotatingFile opens (or creates) the file at path for appending.
+func OpenRotatingFile(path string, cfg RotateConfig) (*RotatingFile, error) {
+	f := &RotatingFile{
+		path:   path,
+		config: cfg,
+		now:    time.Now,
+	}
+
+	if err := f.openLocked(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// SetConfig replaces the rotation settings. It is used to apply settings
+// loaded after the file was opened.
+func (f *RotatingFile) SetConfig(cfg RotateConfig) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.config = cfg
+}
+
+// Write appends p to the file, rotating it first when p would push the
+// file past MaxSize. A single write is never split across files.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if f.config.MaxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.config.MaxSize {
+		if err := f.rotateLocked(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// Close closes the underlying file.
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file = nil
+
+	return err
+}
+
+// openLocked opens the log file for appending and records its size.
+// Must be called with mu held.
+func (f *RotatingFile) openLocked() error {
+	//nolint:gosec // File path is controlled and within user home directory
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, LogFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return errors.Wrap(err, "checking log file size")
+	}
+
+	f.file = file
+	f.size = info.Size()
+
+	return nil
+}
+
+// rotateLocked moves the current file to a backup, removes excess backups
+// and reopens an empty file. When another process already rotated the file,
+// it only reopens it. Must be called with mu held.
+func (f *RotatingFile) rotateLocked() (err error) {
+	lock, err := fileutil.LockFile(f.path + lockExt)
+	if err != nil {
+		return errors.Wrap(err, "locking log file for rotation")
+	}
+
+	defer func() {
+		err = errors.CombineErrors(err, lock.Unlock())
+	}()
+
+	replaced, err := f.replacedLocked()
+	if err != nil {
+		return err
+	}
+
+	if err := f.file.Close(); err != nil {
+		return errors.Wrap(err, "closing log file")
+	}
+
+	f.file = nil
+
+	if !replaced {
+		if err := f.moveToBackup(); err != nil {
+			return err
+		}
+	}
+
+	return f.openLocked()
+}
+
+// replacedLocked reports whether the file at path is no longer the one f
+// has open, because another process rotated it. Must be called with mu
+// held.
+func (f *RotatingFile) replacedLocked() (bool, error) {
+	opened, err := f.file.Stat()
+	if err != nil {
+		return false, errors.Wrap(err, "checking open log file")
+	}
+
+	current, err := os.Stat(f.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return true, nil
+	}
+
+	if err != nil {
+		return false, errors.Wrap(err, "checking log file")
+	}
+
+	return !os.SameFile(opened, current), nil
+}
+
+// moveToBackup renames the log file to a new backup, compresses it if
+// configured and removes excess backups. Must be called with the rotation
+// lock held.
+func (f *RotatingFile) moveToBackup() error {
+	backupPath := f.newBackupPath()
+
+	if err := os.Rename(f.path, backupPath); err != nil {
+		return errors.Wrap(err, "rotating log file")
+	}
+
+	if f.config.Compress {
+		if err := compressFile(backupPath); err != nil {
+			return err
+		}
+	}
+
+	return f.removeExcessBackups()
+}
+
+// newBackupPath returns an unused backup path stamped with the current time.
+// A backup made in the same millisecond, by this or another process, moves
+// the stamp forward a millisecond at a time so no backup is overwritten.
+func (f *RotatingFile) newBackupPath() string {
+	ext := filepath.Ext(f.path)
+	prefix := strings.TrimSuffix(f.path, ext) + "."
+
+	for stamp := f.now(); ; stamp = stamp.Add(time.Millisecond) {
+		candidate := prefix + stamp.Format(backupTimeFormat) + ext
+		if !fileExists(candidate) && !fileExists(candidate+gzipExt) {
+			return candidate
+		}
+	}
+}
+
+// fileExists reports whether anything exists at path. Paths that can't be
+// checked count as existing, so they are never overwritten.
+func fileExists(path string) bool {
+	_, err := os.Lstat(path)
+
+	return !errors.Is(err, fs.ErrNotExist)
+}
+
+// removeExcessBackups keeps the newest MaxBackups rotated files.
+func (f *RotatingFile) removeExcessBackups() error {
+	backups, err := f.backups()
+	if err != nil {
+		return err
+	}
+
+	for i := f.config.MaxBackups; i < len(backups); i++ {
+		if err := os.Remove(backups[i]); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "removing old log backup")
+		}
+	}
+
+	return nil
+}
+
+// backups returns the rotated files of this log, newest first.
+func (f *RotatingFile) backups() ([]string, error) {
+	dir := filepath.Dir(f.path)
+	ext := filepath.Ext(f.path)
+	base := filepath.Base(strings.TrimSuffix(f.path, ext))
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading log directory")
+	}
+
+	var backups []string
+
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), gzipExt)
+		if !strings.HasPrefix(name, base+".") || !strings.HasSuffix(name, ext) {
+			continue
+		}
+
+		stamp := strings.TrimSuffix(strings.TrimPrefix(name, base+"."), ext)
+		if _, err := time.Parse(backupTimeFormat, stamp); err != nil {
+			continue
+		}
+
+		backups = append(backups, filepath.Join(dir, entry.Name()))
+	}
+
+	// Timestamps sort lexically, so reverse name order is newest first.
+	slices.SortFunc(backups, func(a, b string) int {
+		return strings.Compare(
+			strings.TrimSuffix(filepath.Base(b), gzipExt),
+			strings.TrimSuffix(filepath.Base(a), gzipExt),
+		)
+	})
+
+	return backups, nil
+}
+
+// compressFile gzips path to path.gz and removes the original.
+func compressFile(path string) error {
+	src, err := os.Open(path) //nolint:gosec // path is a rotated log file
+	if err != nil {
+		return errors.Wrap(err, "opening log backup")
+	}
+	defer src.Close()
+
+	//nolint:gosec // path is a rotated log file
+	dst, err := os.OpenFile(
+		path+gzipExt,
+		os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
+		LogFilePermissions,
+	)
+	if err != nil {
+		return errors.Wrap(err, "creating compressed log backup")
+	}
+
+	zw := gzip.NewWriter(dst)
+
+	if _, err := io.Copy(zw, src); err != nil {
+		_ = dst.Close()
+
+		return errors.Wrap(err, "compressing log backup")
+	}
+
+	if err := zw.Close(); err != nil {
+		_ = dst.Close()
+
+		return errors.Wrap(err, "compressing log backup")
+	}
+
+	if err := dst.Close(); err != nil {
+		return errors.Wrap(err, "closing compressed log backup")
+	}
+
+	return os.Remove(path)
+}
@@ -0,0 +1,200 @@
+package logger_test
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+var _ = Describe("RotatingFile", func() {
+	var (
+		dir  string
+		path string
+		now  time.Time
+	)
+
+	// open opens a rotating file whose clock advances one second per rotation.
+	open := func(cfg logger.RotateConfig) *logger.RotatingFile {
+		f, err := logger.OpenRotatingFile(path, cfg)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(f.Close)
+
+		f.SetNow(func() time.Time {
+			now = now.Add(time.Second)
+
+			return now
+		})
+
+		return f
+	}
+
+	write := func(f *logger.RotatingFile, s string) {
+		_, err := f.Write([]byte(s))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	backups := func() []string {
+		matches, err := filepath.Glob(filepath.Join(dir, "dispatcher.*.log*"))
+		Expect(err).NotTo(HaveOccurred())
+
+		return matches
+	}
+
+	readFile := func(p string) string {
+		data, err := os.ReadFile(p)
+		Expect(err).NotTo(HaveOccurred())
+
+		return string(data)
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		path = filepath.Join(dir, "dispatcher.log")
+		now = time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	})
+
+	It("does not rotate below the size threshold", func() {
+		f := open(logger.RotateConfig{MaxSize: 20, MaxBackups: 3})
+		write(f, "0123456789\n")
+		write(f, "01234567\n")
+
+		Expect(backups()).To(BeEmpty())
+		Expect(readFile(path)).To(Equal("0123456789\n01234567\n"))
+	})
+
+	It("rotates when a write would exceed the size threshold", func() {
+		f := open(logger.RotateConfig{MaxSize: 20, MaxBackups: 3})
+		write(f, "first line\n")
+		write(f, "second line\n")
+
+		Expect(readFile(path)).To(Equal("second line\n"))
+		Expect(backups()).To(ConsistOf(filepath.Join(dir, "dispatcher.20260102-150406.000.log")))
+		Expect(readFile(backups()[0])).To(Equal("first line\n"))
+	})
+
+	It("counts the size of an existing file", func() {
+		Expect(
+			os.WriteFile(path, []byte("existing line\n"), logger.LogFilePermissions),
+		).To(Succeed())
+
+		f := open(logger.RotateConfig{MaxSize: 20, MaxBackups: 3})
+		write(f, "new line\n")
+
+		Expect(readFile(path)).To(Equal("new line\n"))
+		Expect(backups()).To(HaveLen(1))
+	})
+
+	It("prunes backups beyond MaxBackups, keeping the newest", func() {
+		f := open(logger.RotateConfig{MaxSize: 5, MaxBackups: 2})
+		for _, line := range []string{"one\n", "two\n", "three\n", "four\n"} {
+			write(f, line)
+		}
+
+		Expect(backups()).To(ConsistOf(
+			filepath.Join(dir, "dispatcher.20260102-150407.000.log"),
+			filepath.Join(dir, "dispatcher.20260102-150408.000.log"),
+		))
+		Expect(readFile(path)).To(Equal("four\n"))
+	})
+
+	It("keeps no backups when MaxBackups is zero", func() {
+		f := open(logger.RotateConfig{MaxSize: 5})
+		write(f, "one\n")
+		write(f, "two\n")
+
+		Expect(backups()).To(BeEmpty())
+		Expect(readFile(path)).To(Equal("two\n"))
+	})
+
+	It("gzips rotated files when Compress is set", func() {
+		f := open(logger.RotateConfig{MaxSize: 5, MaxBackups: 3, Compress: true})
+		write(f, "one\n")
+		write(f, "two\n")
+
+		Expect(backups()).To(ConsistOf(filepath.Join(dir, "dispatcher.20260102-150406.000.log.gz")))
+
+		gz, err := os.Open(backups()[0])
+		Expect(err).NotTo(HaveOccurred())
+		defer gz.Close()
+
+		zr, err := gzip.NewReader(gz)
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := io.ReadAll(zr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal("one\n"))
+	})
+
+	It("does not rotate when MaxSize is zero", func() {
+		f := open(logger.RotateConfig{MaxBackups: 3})
+		write(f, strings.Repeat("x", 1024))
+
+		Expect(backups()).To(BeEmpty())
+	})
+
+	It("applies settings changed with SetConfig", func() {
+		f := open(logger.RotateConfig{})
+		write(f, "one\n")
+
+		f.SetConfig(logger.RotateConfig{MaxSize: 5, MaxBackups: 1})
+		write(f, "two\n")
+
+		Expect(backups()).To(HaveLen(1))
+		Expect(readFile(path)).To(Equal("two\n"))
+	})
+
+	It("does not overwrite a backup made in the same millisecond", func() {
+		f := open(logger.RotateConfig{MaxSize: 5, MaxBackups: 3})
+		f.SetNow(func() time.Time { return now })
+
+		for _, line := range []string{"one\n", "two\n", "three\n"} {
+			write(f, line)
+		}
+
+		Expect(backups()).To(ConsistOf(
+			filepath.Join(dir, "dispatcher.20260102-150405.000.log"),
+			filepath.Join(dir, "dispatcher.20260102-150405.001.log"),
+		))
+		Expect(readFile(filepath.Join(dir, "dispatcher.20260102-150405.000.log"))).To(Equal("one\n"))
+		Expect(readFile(filepath.Join(dir, "dispatcher.20260102-150405.001.log"))).To(Equal("two\n"))
+	})
+
+	It("reopens a file another writer already rotated instead of rotating it again", func() {
+		first := open(logger.RotateConfig{MaxSize: 20, MaxBackups: 3})
+		second := open(logger.RotateConfig{MaxSize: 20, MaxBackups: 3})
+
+		write(first, "first line\n")
+		write(second, "second line\n")
+		write(first, "third line\n")
+		write(second, "fourth line\n")
+
+		Expect(backups()).To(HaveLen(1))
+		Expect(readFile(backups()[0])).To(Equal("first line\nsecond line\n"))
+		Expect(readFile(path)).To(Equal("third line\nfourth line\n"))
+	})
+
+	It("rotates log output written through NewRotatingFileLogger", func() {
+		log, f, err := logger.NewRotatingFileLogger(
+			path,
+			logger.LevelInfo,
+			logger.RotateConfig{MaxSize: 64, MaxBackups: 1},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(f.Close)
+
+		log.Info("first message", "key", strings.Repeat("a", 40))
+		log.Info("second message")
+
+		Expect(backups()).To(HaveLen(1))
+		Expect(readFile(path)).To(ContainSubstring("second message"))
+		Expect(readFile(path)).NotTo(ContainSubstring("first message"))
+	})
+})
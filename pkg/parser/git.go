@@ -19,6 +19,7 @@ type GitCommand struct {
 	Flags            []string          // Command flags
 	Args             []string          // Positional arguments
 	FlagMap          map[string]string // Flag values (e.g., "-m" -> "commit message")
+	Messages         []string          // Values of every -m/--message flag, in order
 	GlobalOptions    map[string]string // Global git options (e.g., "-C" -> "/path/to/repo")
 	WorkingDirectory string            // Working directory from preceding cd commands
 }
@@ -61,6 +62,12 @@ var flagsWithValues = map[string]bool{
 	"-b":              false, // -b for checkout is a boolean flag
 }
 
+// messageFlags are the flags whose values make up the commit message.
+var messageFlags = map[string]bool{
+	"-m":        true,
+	"--message": true,
+}
+
 // ParseGitCommand parses a Command into a GitCommand.
 func ParseGitCommand(cmd Command) (*GitCommand, error) {
 	if cmd.Name != "git" {
@@ -182,10 +189,7 @@ func addFlag(flag string, args []string, idx int, gitCmd *GitCommand) int {
 	// Check if this flag takes a value
 	if takesValue, exists := flagsWithValues[flag]; exists && takesValue {
 		if idx+1 < len(args) {
-			// Only store the first value for flags that can repeat (e.g., -m for title)
-			if _, alreadySet := gitCmd.FlagMap[flag]; !alreadySet {
-				gitCmd.FlagMap[flag] = args[idx+1]
-			}
+			setFlagValue(flag, args[idx+1], gitCmd)
 
 			return skipFlagAndValue
 		}
@@ -194,8 +198,29 @@ func addFlag(flag string, args []string, idx int, gitCmd *GitCommand) int {
 	return skipFlagOnly
 }
 
-// parseLongFlag handles long flags like --message, --signoff
+// setFlagValue stores the value of flag. Only the first value is kept in
+// FlagMap for flags that can repeat (e.g., -m for the title), while every
+// message value is appended to Messages.
+func setFlagValue(flag, value string, gitCmd *GitCommand) {
+	if _, alreadySet := gitCmd.FlagMap[flag]; !alreadySet {
+		gitCmd.FlagMap[flag] = value
+	}
+
+	if messageFlags[flag] {
+		gitCmd.Messages = append(gitCmd.Messages, value)
+	}
+}
+
+// parseLongFlag handles long flags like --message, --signoff. A message given
+// as --message=value is split into the flag and its value.
 func parseLongFlag(flag string, args []string, idx int, gitCmd *GitCommand) int {
+	if name, value, ok := strings.Cut(flag, "="); ok && messageFlags[name] {
+		gitCmd.Flags = append(gitCmd.Flags, name)
+		setFlagValue(name, value, gitCmd)
+
+		return idx + skipFlagOnly
+	}
+
 	return idx + addFlag(flag, args, idx, gitCmd)
 }
 
@@ -221,13 +246,13 @@ func parseCombinedFlags(combined string, args []string, idx int, gitCmd *GitComm
 		// This flag takes a value
 		if j != len(flags)-1 {
 			// Not last flag: rest of string is the inline value
-			gitCmd.FlagMap[flag] = flags[j+1:]
+			setFlagValue(flag, flags[j+1:], gitCmd)
 			return idx + skipFlagOnly
 		}
 
 		// Last flag: consume next arg if available
 		if idx+1 < len(args) {
-			gitCmd.FlagMap[flag] = args[idx+1]
+			setFlagValue(flag, args[idx+1], gitCmd)
 			return idx + skipFlagAndValue
 		}
 	}
@@ -277,6 +302,12 @@ func (g *GitCommand) ExtractCommitMessage() string {
 	return ""
 }
 
+// ExtractFullCommitMessage returns the commit message given with -m/--message.
+// Like git, repeated message flags are joined as separate paragraphs.
+func (g *GitCommand) ExtractFullCommitMessage() string {
+	return strings.Join(g.Messages, "\n\n")
+}
+
 // ExtractRemote extracts remote name from push/pull/fetch commands.
 func (g *GitCommand) ExtractRemote() string {
 	// For push/pull/fetch, first positional arg is usually the remote
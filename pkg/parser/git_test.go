@@ -89,6 +89,26 @@ var _ = Describe("GitCommand", func() {
 				Expect(gitCmd.Flags).To(ContainElements("-s", "-S", "-m", "-m"))
 			})
 
+			It("joins every message flag as paragraphs of the full message", func() {
+				cmd := parser.Command{
+					Name: "git",
+					Args: []string{
+						"commit",
+						"-sSm",
+						"feat: title",
+						"--message=body",
+						"-mfooter",
+					},
+				}
+
+				gitCmd, err := parser.ParseGitCommand(cmd)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gitCmd.Messages).To(Equal([]string{"feat: title", "body", "footer"}))
+				Expect(gitCmd.ExtractFullCommitMessage()).To(Equal("feat: title\n\nbody\n\nfooter"))
+				Expect(gitCmd.ExtractCommitMessage()).To(Equal("feat: title"))
+				Expect(gitCmd.GetFlagValue("--message")).To(Equal("body"))
+			})
+
 			It("extracts heredoc from command substitution in commit message", func() {
 				cmdStr := `git commit -sS -m "$(cat <<'EOF'
 feat(validators): add new validator
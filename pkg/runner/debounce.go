@@ -0,0 +1,365 @@
+package runner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/exceptions"
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+	"github.com/smykla-skalski/klaudiush/internal/rules"
+	"github.com/smykla-skalski/klaudiush/internal/xdg"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+const (
+	debounceFileMode   = 0o600
+	debounceFileSuffix = ".json"
+)
+
+// debounceCache stores the findings of PreToolUse invocations as one JSON
+// file per key, so that separate hook processes can share them. Entries
+// older than the window are treated as missing.
+type debounceCache struct {
+	dir    string
+	window time.Duration
+}
+
+// newDebounce returns the cache and key for hookCtx, or nil when debouncing
+// is disabled or doesn't apply to the invocation. Invocations carrying a
+// one-time exception grant are never debounced, so the grant is consumed
+// and charged by the run it was issued for.
+func newDebounce(
+	cfg *config.Config,
+	hookCtx *hook.Context,
+	workDir string,
+	dir string,
+) (*debounceCache, string) {
+	window := cfg.Global.GetDebounce()
+	if window <= 0 || !isPreToolUse(hookCtx) || os.Getenv(exceptions.GrantTokenEnvVar) != "" {
+		return nil, ""
+	}
+
+	key, ok := debounceKey(cfg, hookCtx, workDir)
+	if !ok {
+		return nil, ""
+	}
+
+	if dir == "" {
+		dir = xdg.DebounceCacheDir()
+	}
+
+	return &debounceCache{dir: dir, window: window}, key
+}
+
+// isPreToolUse reports whether hookCtx is a pre-tool event, the only kind
+// fired in bursts by bulk edits.
+func isPreToolUse(hookCtx *hook.Context) bool {
+	return hookCtx.Event == hook.CanonicalEventBeforeTool ||
+		hookCtx.EventType == hook.EventTypePreToolUse
+}
+
+// debounceKey hashes everything the decision for hookCtx depends on: the
+// provider, event and tool, the full tool input, the content of the target
+// file on disk, the working directory, the git index and HEAD, the config,
+// the open rule rate limit windows and the streak of consecutive blocks
+// rules can match. A rate-limited rule that fires opens a window, so the
+// next identical invocation runs the rules again and sees it suppressed.
+// Per-call identifiers such as the tool use ID are left out. ok is false
+// when a part can't be encoded.
+func debounceKey(cfg *config.Config, hookCtx *hook.Context, workDir string) (string, bool) {
+	input, err := json.Marshal(hookCtx.ToolInput)
+	if err != nil {
+		return "", false
+	}
+
+	additional, err := json.Marshal(hookCtx.ToolInput.Additional)
+	if err != nil {
+		return "", false
+	}
+
+	cfgData, err := configFingerprint(cfg)
+	if err != nil {
+		return "", false
+	}
+
+	h := sha256.New()
+
+	writeKeyPart(h,
+		hookCtx.ProviderName(),
+		hookCtx.EventName(),
+		hookCtx.ToolNameString(),
+		workDir,
+		hookCtx.GetWorkingDir(),
+		string(input),
+		string(additional),
+		fileDigest(hookCtx.GetFilePath()),
+		gitStateDigest(gitLookupDir(hookCtx, workDir)),
+		string(cfgData),
+		rules.NewRateLimitStore().Fingerprint(),
+		strconv.Itoa(hookCtx.ConsecutiveBlocks),
+	)
+
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// configFingerprint encodes cfg without empty sections, which getters create
+// on first use, so that a config reads the same before and after a run.
+func configFingerprint(cfg *config.Config) ([]byte, error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(dropEmptyObjects(value))
+}
+
+// dropEmptyObjects removes the objects left empty, recursively, from a
+// decoded JSON value.
+func dropEmptyObjects(value any) any {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+
+	for key, field := range obj {
+		field = dropEmptyObjects(field)
+		if nested, isObj := field.(map[string]any); isObj && len(nested) == 0 {
+			delete(obj, key)
+
+			continue
+		}
+
+		obj[key] = field
+	}
+
+	return obj
+}
+
+// fileDigest returns the SHA-256 of the file at path, or "-" when there is no
+// readable file.
+func fileDigest(path string) string {
+	if path == "" {
+		return "-"
+	}
+
+	f, err := os.Open(path) //nolint:gosec // G304: hashing the target file is intended
+	if err != nil {
+		return "-"
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "-"
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// gitLookupDir returns the directory the git state of hookCtx is read from:
+// the hook's working directory, then workDir, then the process's.
+func gitLookupDir(hookCtx *hook.Context, workDir string) string {
+	if dir := hookCtx.GetWorkingDir(); dir != "" {
+		return dir
+	}
+
+	if workDir != "" {
+		return workDir
+	}
+
+	dir, _ := os.Getwd()
+
+	return dir
+}
+
+// gitStateDigest returns the checksum of the index and the commit HEAD
+// points at for the repository containing dir, so that staging files or
+// committing invalidates a cached decision. It reads the git directory
+// directly instead of running git, and returns "-" outside a repository.
+func gitStateDigest(dir string) string {
+	gitDir, ok := findGitDir(dir)
+	if !ok {
+		return "-"
+	}
+
+	commonDir := gitDir
+	if data, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil { //nolint:gosec // G304: path is inside the git directory
+		commonDir = resolveGitPath(gitDir, strings.TrimSpace(string(data)))
+	}
+
+	head, _ := os.ReadFile(filepath.Join(gitDir, "HEAD")) //nolint:gosec // G304: path is inside the git directory
+	headRef := strings.TrimSpace(string(head))
+
+	return fileDigest(filepath.Join(gitDir, "index")) + ":" +
+		headRef + ":" + resolveGitRef(commonDir, headRef)
+}
+
+// findGitDir walks up from dir to the nearest .git entry and returns the git
+// directory it names, following the gitdir: line of linked worktrees and
+// submodules.
+func findGitDir(dir string) (string, bool) {
+	if dir == "" {
+		return "", false
+	}
+
+	for {
+		dotGit := filepath.Join(dir, ".git")
+
+		if info, err := os.Stat(dotGit); err == nil {
+			if info.IsDir() {
+				return dotGit, true
+			}
+
+			data, err := os.ReadFile(dotGit) //nolint:gosec // G304: path is a .git file
+			if err != nil {
+				return "", false
+			}
+
+			gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+			if !ok {
+				return "", false
+			}
+
+			return resolveGitPath(dir, strings.TrimSpace(gitDir)), true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+
+		dir = parent
+	}
+}
+
+// resolveGitRef returns the commit a symbolic HEAD of the form
+// "ref: refs/heads/x" points at, from a loose ref or packed-refs, or "" when
+// headRef is already a commit or the ref doesn't exist yet.
+func resolveGitRef(commonDir, headRef string) string {
+	ref, ok := strings.CutPrefix(headRef, "ref:")
+	if !ok {
+		return ""
+	}
+
+	ref = strings.TrimSpace(ref)
+
+	if data, err := os.ReadFile(filepath.Join(commonDir, filepath.FromSlash(ref))); err == nil { //nolint:gosec // G304: path is inside the git directory
+		return strings.TrimSpace(string(data))
+	}
+
+	packed, err := os.ReadFile(filepath.Join(commonDir, "packed-refs")) //nolint:gosec // G304: path is inside the git directory
+	if err != nil {
+		return ""
+	}
+
+	for line := range strings.SplitSeq(string(packed), "\n") {
+		if hash, name, ok := strings.Cut(strings.TrimSpace(line), " "); ok && name == ref {
+			return hash
+		}
+	}
+
+	return ""
+}
+
+func resolveGitPath(base, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(base, path)
+}
+
+func writeKeyPart(w io.Writer, parts ...string) {
+	for _, part := range parts {
+		_, _ = io.WriteString(w, part)
+		_, _ = w.Write([]byte{0})
+	}
+}
+
+// get returns the findings stored under key, if present and within the
+// window.
+func (c *debounceCache) get(key string) ([]*dispatcher.ValidationError, bool) {
+	path := c.path(key)
+
+	info, err := os.Stat(path)
+	if err != nil || c.expired(info) {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is built from a hex digest
+	if err != nil {
+		return nil, false
+	}
+
+	var errs []*dispatcher.ValidationError
+	if err := json.Unmarshal(data, &errs); err != nil {
+		return nil, false
+	}
+
+	return errs, true
+}
+
+// put stores errs under key and removes expired entries. Findings bypassed
+// by an exception are not stored: replaying them would skip the exception's
+// rate limits and reuse one-time grants. Failures are ignored; debouncing is
+// an optimization only.
+func (c *debounceCache) put(key string, errs []*dispatcher.ValidationError) {
+	for _, e := range errs {
+		if e.Bypassed {
+			return
+		}
+	}
+
+	data, err := json.Marshal(errs)
+	if err != nil {
+		return
+	}
+
+	if err := xdg.EnsureDir(c.dir); err != nil {
+		return
+	}
+
+	_ = fileutil.WriteFileAtomic(c.path(key), data, debounceFileMode)
+
+	c.prune()
+}
+
+// prune removes the entries that are past the window.
+func (c *debounceCache) prune() {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), debounceFileSuffix) {
+			continue
+		}
+
+		if info, err := entry.Info(); err == nil && c.expired(info) {
+			_ = os.Remove(filepath.Join(c.dir, entry.Name()))
+		}
+	}
+}
+
+func (c *debounceCache) expired(info os.FileInfo) bool {
+	return time.Since(info.ModTime()) > c.window
+}
+
+func (c *debounceCache) path(key string) string {
+	return filepath.Join(c.dir, key+debounceFileSuffix)
+}
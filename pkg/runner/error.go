@@ -0,0 +1,94 @@
+package runner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+)
+
+// Error is a single validation finding.
+type Error struct {
+	// Validator is the name of the validator that reported the finding.
+	Validator string
+
+	// Message is the error message.
+	Message string
+
+	// Details contains additional error details.
+	Details map[string]string
+
+	// ShouldBlock indicates whether this finding blocks the operation.
+	ShouldBlock bool
+
+	// Informational marks a non-blocking finding that is only recorded.
+	Informational bool
+
+	// Reference is the URL that uniquely identifies this error type
+	// (e.g., https://klaudiu.sh/e/GIT001).
+	Reference string
+
+	// FixHint provides a short suggestion for fixing the issue.
+	FixHint string
+
+	// PluginDetails contains structured key/value context reported by an
+	// external plugin.
+	PluginDetails map[string]string
+
+	// UpdatedInput holds tool input fields (e.g. "command") to replace
+	// before the tool runs. Set by rule transform actions.
+	UpdatedInput map[string]string
+
+	// Bypassed indicates this finding was bypassed via an exception token.
+	// When true, ShouldBlock is false.
+	Bypassed bool
+
+	// BypassReason is the justification from the exception token.
+	BypassReason string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s: %s", e.Validator, e.Message)
+	}
+
+	return e.Validator
+}
+
+// Code returns the error code at the end of the reference URL (e.g.,
+// "GIT001"), or an empty string if the finding has no reference.
+func (e *Error) Code() string {
+	if idx := strings.LastIndex(e.Reference, "/"); idx != -1 {
+		return e.Reference[idx+1:]
+	}
+
+	return e.Reference
+}
+
+// newErrors converts dispatcher findings to the public Error type.
+func newErrors(errs []*dispatcher.ValidationError) []*Error {
+	if errs == nil {
+		return nil
+	}
+
+	result := make([]*Error, len(errs))
+
+	for i, e := range errs {
+		result[i] = &Error{
+			Validator:     e.Validator,
+			Message:       e.Message,
+			Details:       e.Details,
+			ShouldBlock:   e.ShouldBlock,
+			Informational: e.Informational,
+			Reference:     string(e.Reference),
+			FixHint:       e.FixHint,
+			PluginDetails: e.PluginDetails,
+			UpdatedInput:  e.UpdatedInput,
+			Bypassed:      e.Bypassed,
+			BypassReason:  e.BypassReason,
+		}
+	}
+
+	return result
+}
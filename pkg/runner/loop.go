@@ -0,0 +1,238 @@
+package runner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/fileutil"
+	"github.com/smykla-skalski/klaudiush/internal/xdg"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+)
+
+const (
+	loopFileMode   = 0o600
+	loopFileSuffix = ".json"
+
+	// loopWindow is how long a streak of blocks is kept without another
+	// block.
+	loopWindow = 30 * time.Minute
+)
+
+// loopStreak is the stored streak of consecutive blocks of one tool.
+type loopStreak struct {
+	// Reference identifies what blocked: the reference URL, or the
+	// validator name for findings without one.
+	Reference string `json:"reference"`
+
+	// Count is the number of consecutive blocks on Reference.
+	Count int `json:"count"`
+}
+
+// loopDetector tracks consecutive blocks of the same tool in the same
+// session and project as one JSON file per key, so that separate hook
+// processes can share them.
+type loopDetector struct {
+	dir        string
+	key        string
+	threshold  int
+	exceptions bool
+	streak     loopStreak
+}
+
+// newLoopDetector returns the detector for hookCtx with its current streak
+// loaded, or nil when loop detection is disabled or doesn't apply to the
+// invocation.
+func newLoopDetector(
+	cfg *config.Config,
+	hookCtx *hook.Context,
+	workDir string,
+	dir string,
+) *loopDetector {
+	threshold := cfg.Global.GetLoopDetectionThreshold()
+	if threshold <= 0 || !isPreToolUse(hookCtx) {
+		return nil
+	}
+
+	if dir == "" {
+		dir = xdg.LoopStateDir()
+	}
+
+	h := sha256.New()
+
+	writeKeyPart(h,
+		hookCtx.ProviderName(),
+		hookCtx.SessionID,
+		workDir,
+		hookCtx.GetWorkingDir(),
+		hookCtx.ToolNameString(),
+	)
+
+	d := &loopDetector{
+		dir:        dir,
+		key:        hex.EncodeToString(h.Sum(nil)),
+		threshold:  threshold,
+		exceptions: cfg.GetExceptions().IsEnabled(),
+	}
+
+	d.streak = d.load()
+
+	return d
+}
+
+// record updates the streak with the findings errs of this invocation. A
+// block on the streak's reference extends it, another block starts a new
+// one and a passing invocation ends it. Once the streak reaches the
+// threshold, the blocking findings for its reference say so. Failures to
+// store the streak are ignored; loop detection is advisory only.
+func (d *loopDetector) record(errs []*dispatcher.ValidationError) {
+	refs := blockingReferences(errs)
+	if len(refs) == 0 {
+		_ = os.Remove(d.path())
+
+		return
+	}
+
+	if d.streak.Count > 0 && slices.Contains(refs, d.streak.Reference) {
+		d.streak.Count++
+	} else {
+		d.streak = loopStreak{Reference: refs[0], Count: 1}
+	}
+
+	d.save()
+
+	if d.streak.Count >= d.threshold {
+		d.annotate(errs)
+	}
+}
+
+// annotate prepends a loop notice to the fix hint of the blocking findings
+// for the streak's reference.
+func (d *loopDetector) annotate(errs []*dispatcher.ValidationError) {
+	notice := fmt.Sprintf("This has blocked %d times in a row", d.streak.Count)
+
+	var guidance []string
+
+	if strings.Contains(d.streak.Reference, "://") {
+		guidance = append(guidance, "see "+d.streak.Reference)
+	}
+
+	if d.exceptions {
+		guidance = append(guidance, "use an exception token")
+	}
+
+	if len(guidance) > 0 {
+		notice += " - " + strings.Join(guidance, " or ")
+	}
+
+	notice += "."
+
+	for _, e := range errs {
+		if !e.ShouldBlock || blockReference(e) != d.streak.Reference {
+			continue
+		}
+
+		if e.FixHint == "" {
+			e.FixHint = notice
+		} else {
+			e.FixHint = notice + " " + e.FixHint
+		}
+	}
+}
+
+// load returns the stored streak, or an empty one when there is none or it
+// is past the window.
+func (d *loopDetector) load() loopStreak {
+	path := d.path()
+
+	info, err := os.Stat(path)
+	if err != nil || expiredLoop(info) {
+		return loopStreak{}
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is built from a hex digest
+	if err != nil {
+		return loopStreak{}
+	}
+
+	var streak loopStreak
+	if err := json.Unmarshal(data, &streak); err != nil {
+		return loopStreak{}
+	}
+
+	return streak
+}
+
+// save stores the streak and removes expired streaks of other keys.
+func (d *loopDetector) save() {
+	data, err := json.Marshal(d.streak)
+	if err != nil {
+		return
+	}
+
+	if err := xdg.EnsureDir(d.dir); err != nil {
+		return
+	}
+
+	_ = fileutil.WriteFileAtomic(d.path(), data, loopFileMode)
+
+	d.prune()
+}
+
+// prune removes the streaks that are past the window.
+func (d *loopDetector) prune() {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), loopFileSuffix) {
+			continue
+		}
+
+		if info, err := entry.Info(); err == nil && expiredLoop(info) {
+			_ = os.Remove(filepath.Join(d.dir, entry.Name()))
+		}
+	}
+}
+
+func (d *loopDetector) path() string {
+	return filepath.Join(d.dir, d.key+loopFileSuffix)
+}
+
+func expiredLoop(info os.FileInfo) bool {
+	return time.Since(info.ModTime()) > loopWindow
+}
+
+// blockingReferences returns the references of the blocking findings in errs,
+// in order.
+func blockingReferences(errs []*dispatcher.ValidationError) []string {
+	var refs []string
+
+	for _, e := range errs {
+		if e.ShouldBlock {
+			refs = append(refs, blockReference(e))
+		}
+	}
+
+	return refs
+}
+
+// blockReference identifies the block reported by e: its reference, or the
+// validator name when it has none.
+func blockReference(e *dispatcher.ValidationError) string {
+	if e.Reference != "" {
+		return string(e.Reference)
+	}
+
+	return e.Validator
+}
@@ -0,0 +1,358 @@
+// Package runner runs klaudiush validation for a single hook invocation.
+// It is the library entry point for embedding klaudiush; the CLI is a thin
+// wrapper that parses input, loads configuration and writes the response.
+package runner
+
+import (
+	"context"
+	"os"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/smykla-skalski/klaudiush/internal/config/factory"
+	"github.com/smykla-skalski/klaudiush/internal/dispatcher"
+	"github.com/smykla-skalski/klaudiush/internal/exceptions"
+	"github.com/smykla-skalski/klaudiush/internal/validator"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+)
+
+// ExitCodeAllow is the exit code the CLI uses for both allowed and blocked
+// operations. A block is communicated through the hook response, not the
+// exit code.
+const ExitCodeAllow = 0
+
+// ValidatorResult is the result of a single validator.
+type ValidatorResult struct {
+	// Passed indicates whether the validation passed.
+	Passed bool
+
+	// Message is the human-readable message.
+	Message string
+
+	// Details contains additional details about the validation.
+	Details map[string]string
+
+	// ShouldBlock indicates whether this failure blocks the operation.
+	ShouldBlock bool
+
+	// Informational marks a non-blocking finding that is only recorded.
+	Informational bool
+
+	// Reference is the URL that uniquely identifies this error type
+	// (e.g., https://klaudiu.sh/e/GIT001).
+	Reference string
+
+	// FixHint provides a short suggestion for fixing the issue.
+	FixHint string
+
+	// PluginDetails contains structured key/value context reported by an
+	// external plugin.
+	PluginDetails map[string]string
+
+	// UpdatedInput holds tool input fields (e.g. "command") to replace
+	// before the tool runs. Set by rule transform actions.
+	UpdatedInput map[string]string
+}
+
+// newValidatorResult converts a validator result to the public type.
+func newValidatorResult(result *validator.Result) *ValidatorResult {
+	if result == nil {
+		return nil
+	}
+
+	return &ValidatorResult{
+		Passed:        result.Passed,
+		Message:       result.Message,
+		Details:       result.Details,
+		ShouldBlock:   result.ShouldBlock,
+		Informational: result.Informational,
+		Reference:     string(result.Reference),
+		FixHint:       result.FixHint,
+		PluginDetails: result.PluginDetails,
+		UpdatedInput:  result.UpdatedInput,
+	}
+}
+
+// Decision is the outcome of validating a hook invocation.
+type Decision struct {
+	// Block is true when at least one finding blocks the operation.
+	Block bool
+
+	// Errors lists all findings, blocking and non-blocking.
+	Errors []*Error
+
+	// ExitCode is the exit code the CLI uses for this decision.
+	ExitCode int
+}
+
+// Option configures RunValidation.
+type Option func(*options)
+
+type options struct {
+	workDir        string
+	debounceDir    string
+	loopDir        string
+	onPhase        func(phase string)
+	dispatcherOpts []dispatcher.DispatcherOption
+}
+
+// WithWorkDir sets the project directory used to scope exception state.
+// Defaults to the current working directory.
+func WithWorkDir(dir string) Option {
+	return func(o *options) {
+		o.workDir = dir
+	}
+}
+
+// WithDebounceDir sets the directory debounced decisions are stored in when
+// the global debounce setting is enabled. Defaults to
+// $XDG_CACHE_HOME/klaudiush/debounce.
+func WithDebounceDir(dir string) Option {
+	return func(o *options) {
+		o.debounceDir = dir
+	}
+}
+
+// WithLoopStateDir sets the directory streaks of consecutive blocks are
+// stored in when the global loop_detection_threshold setting is enabled.
+// Defaults to $XDG_STATE_HOME/klaudiush/loops.
+func WithLoopStateDir(dir string) Option {
+	return func(o *options) {
+		o.loopDir = dir
+	}
+}
+
+// WithPhaseCallback registers a function called after each phase of the
+// run ("registry", then "dispatch"). Useful for timing.
+func WithPhaseCallback(fn func(phase string)) Option {
+	return func(o *options) {
+		o.onPhase = fn
+	}
+}
+
+// WithOnStart registers a function called before any validator runs.
+func WithOnStart(fn func(ctx context.Context, hookCtx *hook.Context)) Option {
+	return func(o *options) {
+		o.dispatcherOpts = append(o.dispatcherOpts, dispatcher.WithOnStart(fn))
+	}
+}
+
+// WithOnValidatorResult registers a function called with the result of each
+// validator as it finishes.
+func WithOnValidatorResult(
+	fn func(ctx context.Context, name string, result *ValidatorResult),
+) Option {
+	return func(o *options) {
+		o.dispatcherOpts = append(o.dispatcherOpts, dispatcher.WithOnValidatorResult(
+			func(ctx context.Context, name string, result *validator.Result) {
+				fn(ctx, name, newValidatorResult(result))
+			},
+		))
+	}
+}
+
+// WithOnComplete registers a function called with the decision once
+// validation finishes, before exception state is saved.
+func WithOnComplete(fn func(ctx context.Context, decision *Decision)) Option {
+	return func(o *options) {
+		o.dispatcherOpts = append(o.dispatcherOpts, dispatcher.WithOnComplete(
+			func(
+				ctx context.Context,
+				_ *hook.Context,
+				errs []*dispatcher.ValidationError,
+				blocked bool,
+			) {
+				fn(ctx, &Decision{Block: blocked, Errors: newErrors(errs), ExitCode: ExitCodeAllow})
+			},
+		))
+	}
+}
+
+// RunValidation builds the validators configured in cfg, runs them against
+// hookCtx and returns the decision. Exception state is loaded before and
+// saved after dispatch when exceptions are enabled. Dispatch is bounded by
+// the global hook_timeout setting, and findings are capped at the global
+// max_severity. With the global debounce setting, an identical PreToolUse
+// invocation within the window reuses the earlier findings without building
+// or running any validator. With the global loop_detection_threshold setting,
+// the validators see ConsecutiveBlocks set from the stored streak of blocks,
+// and blocking findings that continue a long enough streak get a notice in
+// their fix hint. hookCtx itself is not modified.
+func RunValidation(
+	ctx context.Context,
+	cfg *config.Config,
+	hookCtx *hook.Context,
+	log logger.Logger,
+	opts ...Option,
+) (*Decision, error) {
+	if cfg == nil {
+		return nil, errors.New("config cannot be nil")
+	}
+
+	if hookCtx == nil {
+		return nil, errors.New("hook context cannot be nil")
+	}
+
+	o := &options{onPhase: func(string) {}}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if log == nil {
+		log = logger.NewNoOpLogger()
+	}
+
+	// Validate a copy, so the caller's context is left as it was passed
+	hookCtxCopy := *hookCtx
+	hookCtx = &hookCtxCopy
+
+	loop := newLoopDetector(cfg, hookCtx, o.workDir, o.loopDir)
+	if loop != nil {
+		hookCtx.ConsecutiveBlocks = loop.streak.Count
+	}
+
+	debounce, debounceKey := newDebounce(cfg, hookCtx, o.workDir, o.debounceDir)
+	if debounce != nil {
+		if errs, ok := debounce.get(debounceKey); ok {
+			log.Info("reusing debounced decision", "window", debounce.window)
+
+			recordLoop(loop, errs, log)
+
+			return newDecision(errs), nil
+		}
+	}
+
+	builder := factory.NewRegistryBuilder(log)
+
+	registry, _, err := builder.BuildWithRuleEngine(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build validator registry")
+	}
+
+	defer func() {
+		if closeErr := builder.Close(); closeErr != nil {
+			log.Debug("failed to close validators", "error", closeErr)
+		}
+	}()
+
+	o.onPhase("registry")
+
+	exceptionHandler, exceptionChecker := initExceptionChecker(cfg, o.workDir, log)
+
+	dispatcherOpts := append([]dispatcher.DispatcherOption{
+		dispatcher.WithExceptionChecker(exceptionChecker),
+		dispatcher.WithOverrides(cfg.Overrides),
+		dispatcher.WithTimeout(
+			cfg.Global.GetHookTimeout(),
+			cfg.Global.IsFailClosedOnTimeout(),
+		),
+		dispatcher.WithMaxSeverity(cfg.Global.GetMaxSeverity()),
+	}, o.dispatcherOpts...)
+
+	disp := dispatcher.NewDispatcherWithOptions(
+		registry,
+		log,
+		dispatcher.NewSequentialExecutor(log),
+		dispatcherOpts...,
+	)
+
+	errs := disp.Dispatch(ctx, hookCtx)
+
+	o.onPhase("dispatch")
+
+	savePersistentState(exceptionHandler, log)
+
+	// A cancelled or timed-out run didn't validate everything, so it isn't
+	// reused
+	if debounce != nil && ctx.Err() == nil && !dispatcher.TimedOut(errs) {
+		debounce.put(debounceKey, errs)
+	}
+
+	recordLoop(loop, errs, log)
+
+	return newDecision(errs), nil
+}
+
+// recordLoop updates the streak of blocks tracked by loop, if any, with the
+// findings errs.
+func recordLoop(loop *loopDetector, errs []*dispatcher.ValidationError, log logger.Logger) {
+	if loop == nil {
+		return
+	}
+
+	loop.record(errs)
+
+	if loop.streak.Count >= loop.threshold {
+		log.Info("repeated block detected",
+			"reference", loop.streak.Reference,
+			"count", loop.streak.Count,
+		)
+	}
+}
+
+// newDecision returns the decision for the findings errs.
+func newDecision(errs []*dispatcher.ValidationError) *Decision {
+	return &Decision{
+		Block:    dispatcher.ShouldBlock(errs),
+		Errors:   newErrors(errs),
+		ExitCode: ExitCodeAllow,
+	}
+}
+
+// initExceptionChecker creates and initializes an exception checker if enabled in the config.
+func initExceptionChecker(
+	cfg *config.Config,
+	workDir string,
+	log logger.Logger,
+) (*exceptions.Handler, dispatcher.ExceptionChecker) {
+	exCfg := cfg.GetExceptions()
+	if !exCfg.IsEnabled() {
+		return nil, nil
+	}
+
+	// Resolve project directory for per-project state scoping
+	projectDir := workDir
+	if projectDir == "" {
+		var err error
+
+		projectDir, err = os.Getwd()
+		if err != nil {
+			log.Info("failed to get working directory for exceptions", "error", err)
+
+			return nil, nil
+		}
+	}
+
+	handler := exceptions.NewHandler(exCfg,
+		exceptions.WithHandlerLogger(log),
+		exceptions.WithHandlerProjectDir(projectDir),
+		exceptions.WithGrantToken(os.Getenv(exceptions.GrantTokenEnvVar)),
+	)
+
+	if err := handler.LoadState(); err != nil {
+		log.Info("failed to load exception state, starting fresh", "error", err)
+	}
+
+	checker := dispatcher.NewExceptionChecker(handler,
+		dispatcher.WithExceptionCheckerLogger(log),
+	)
+
+	log.Debug("exception checker initialized")
+
+	return handler, checker
+}
+
+// savePersistentState saves exception state after dispatch.
+func savePersistentState(
+	exceptionHandler *exceptions.Handler,
+	log logger.Logger,
+) {
+	if exceptionHandler != nil {
+		if err := exceptionHandler.SaveState(); err != nil {
+			log.Info("failed to save exception state", "error", err)
+		}
+	}
+}
@@ -0,0 +1,522 @@
+package runner_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v6"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	internalconfig "github.com/smykla-skalski/klaudiush/internal/config"
+	"github.com/smykla-skalski/klaudiush/internal/exceptions"
+	"github.com/smykla-skalski/klaudiush/pkg/config"
+	"github.com/smykla-skalski/klaudiush/pkg/hook"
+	"github.com/smykla-skalski/klaudiush/pkg/logger"
+	"github.com/smykla-skalski/klaudiush/pkg/runner"
+)
+
+var _ = Describe("RunValidation", func() {
+	var cfg *config.Config
+
+	bashContext := func(command string) *hook.Context {
+		return &hook.Context{
+			EventType: hook.EventTypePreToolUse,
+			ToolName:  hook.ToolTypeBash,
+			ToolInput: hook.ToolInput{Command: command},
+		}
+	}
+
+	BeforeEach(func() {
+		disabled := false
+
+		cfg = internalconfig.DefaultConfig()
+		cfg.Exceptions = &config.ExceptionsConfig{Enabled: &disabled}
+	})
+
+	It("should allow a passing command", func() {
+		decision, err := runner.RunValidation(
+			context.Background(),
+			cfg,
+			bashContext("ls -la"),
+			logger.NewNoOpLogger(),
+		)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decision.Block).To(BeFalse())
+		Expect(decision.Errors).To(BeEmpty())
+		Expect(decision.ExitCode).To(Equal(runner.ExitCodeAllow))
+	})
+
+	It("should block a failing command", func() {
+		decision, err := runner.RunValidation(
+			context.Background(),
+			cfg,
+			bashContext("gh pr create --body \"Updated `config.toml` handling\""),
+			nil,
+		)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decision.Block).To(BeTrue())
+		Expect(decision.Errors).To(ContainElement(HaveField("ShouldBlock", BeTrue())))
+		Expect(decision.ExitCode).To(Equal(runner.ExitCodeAllow))
+	})
+
+	It("should report findings with their reference and code", func() {
+		decision, err := runner.RunValidation(
+			context.Background(),
+			cfg,
+			bashContext("gh pr create --body \"Updated `config.toml` handling\""),
+			nil,
+		)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decision.Errors).NotTo(BeEmpty())
+
+		for _, e := range decision.Errors {
+			Expect(e.Reference).To(HavePrefix("https://klaudiu.sh/e/"))
+			Expect(e.Reference).To(HaveSuffix("/" + e.Code()))
+			Expect(e.Error()).To(HavePrefix(e.Validator + ": "))
+		}
+	})
+
+	It("should downgrade blocks to warnings with max_severity warning", func() {
+		cfg.Global.MaxSeverity = config.SeverityWarning
+
+		decision, err := runner.RunValidation(
+			context.Background(),
+			cfg,
+			bashContext("gh pr create --body \"Updated `config.toml` handling\""),
+			nil,
+		)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decision.Block).To(BeFalse())
+		Expect(decision.Errors).NotTo(BeEmpty())
+		Expect(decision.Errors).To(HaveEach(HaveField("ShouldBlock", BeFalse())))
+		Expect(decision.ExitCode).To(Equal(runner.ExitCodeAllow))
+	})
+
+	It("should report phases", func() {
+		var phases []string
+
+		_, err := runner.RunValidation(
+			context.Background(),
+			cfg,
+			bashContext("ls"),
+			nil,
+			runner.WithPhaseCallback(func(phase string) { phases = append(phases, phase) }),
+		)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(phases).To(Equal([]string{"registry", "dispatch"}))
+	})
+
+	It("should report lifecycle callbacks", func() {
+		var (
+			events   []string
+			results  int
+			complete *runner.Decision
+		)
+
+		decision, err := runner.RunValidation(
+			context.Background(),
+			cfg,
+			bashContext("gh pr create --body \"Updated `config.toml` handling\""),
+			nil,
+			runner.WithOnStart(func(context.Context, *hook.Context) {
+				events = append(events, "start")
+			}),
+			runner.WithOnValidatorResult(
+				func(_ context.Context, name string, result *runner.ValidatorResult) {
+					Expect(name).NotTo(BeEmpty())
+					Expect(result).NotTo(BeNil())
+
+					results++
+				},
+			),
+			runner.WithOnComplete(func(_ context.Context, d *runner.Decision) {
+				events = append(events, "complete")
+				complete = d
+			}),
+		)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events).To(Equal([]string{"start", "complete"}))
+		Expect(results).To(BeNumerically(">", 0))
+		Expect(complete.Block).To(Equal(decision.Block))
+		Expect(complete.Errors).To(Equal(decision.Errors))
+	})
+
+	It("should bypass blocks once with a granted exception token", func() {
+		dir := GinkgoT().TempDir()
+		enabled := true
+
+		cfg.Exceptions = &config.ExceptionsConfig{
+			Enabled: &enabled,
+			RateLimit: &config.ExceptionRateLimitConfig{
+				StateFile: filepath.Join(dir, "state.json"),
+			},
+			Audit: &config.ExceptionAuditConfig{LogFile: filepath.Join(dir, "audit.jsonl")},
+		}
+
+		token, _, err := exceptions.NewHandler(cfg.Exceptions).Grant("GIT023", "hotfix", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		GinkgoT().Setenv(exceptions.GrantTokenEnvVar, token)
+
+		command := bashContext("gh pr create --body \"Updated `config.toml` handling\"")
+
+		decision, err := runner.RunValidation(context.Background(), cfg, command, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decision.Block).To(BeFalse())
+		Expect(decision.Errors).To(ContainElement(HaveField("Bypassed", BeTrue())))
+
+		decision, err = runner.RunValidation(context.Background(), cfg, command, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decision.Block).To(BeTrue())
+	})
+
+	Describe("debounce", func() {
+		var (
+			dir  string
+			runs int
+		)
+
+		validate := func(hookCtx *hook.Context) *runner.Decision {
+			decision, err := runner.RunValidation(
+				context.Background(),
+				cfg,
+				hookCtx,
+				nil,
+				runner.WithDebounceDir(dir),
+				runner.WithOnStart(func(context.Context, *hook.Context) { runs++ }),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			return decision
+		}
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+			runs = 0
+			cfg.Global.Debounce = config.Duration(time.Minute)
+		})
+
+		It("should reuse the decision of an identical invocation", func() {
+			command := "gh pr create --body \"Updated `config.toml` handling\""
+
+			first := validate(bashContext(command))
+			Expect(first.Block).To(BeTrue())
+
+			second := validate(bashContext(command))
+			Expect(runs).To(Equal(1))
+			Expect(second.Block).To(BeTrue())
+			Expect(second.Errors).To(Equal(first.Errors))
+		})
+
+		It("should validate a changed invocation", func() {
+			validate(bashContext("ls"))
+			validate(bashContext("ls -la"))
+
+			Expect(runs).To(Equal(2))
+		})
+
+		It("should validate again when the target file changes", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "notes.txt")
+			Expect(os.WriteFile(path, []byte("one"), 0o600)).To(Succeed())
+
+			read := &hook.Context{
+				EventType: hook.EventTypePreToolUse,
+				ToolName:  hook.ToolTypeRead,
+				ToolInput: hook.ToolInput{FilePath: path},
+			}
+
+			validate(read)
+			validate(read)
+			Expect(runs).To(Equal(1))
+
+			Expect(os.WriteFile(path, []byte("two"), 0o600)).To(Succeed())
+
+			validate(read)
+			Expect(runs).To(Equal(2))
+		})
+
+		It("should validate a retried git command again after staging", func() {
+			repoDir := GinkgoT().TempDir()
+
+			repo, err := git.PlainInit(repoDir, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.WriteFile(filepath.Join(repoDir, "main.go"), []byte("package main\n"), 0o600)).
+				To(Succeed())
+
+			commit := bashContext("git commit -sS -m \"feat: add main\"")
+			commit.WorkingDir = repoDir
+
+			validate(commit)
+			validate(commit)
+			Expect(runs).To(Equal(1))
+
+			worktree, err := repo.Worktree()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worktree.Add("main.go")
+			Expect(err).NotTo(HaveOccurred())
+
+			validate(commit)
+			Expect(runs).To(Equal(2))
+		})
+
+		It("should validate again once the window has passed", func() {
+			cfg.Global.Debounce = config.Duration(time.Nanosecond)
+
+			validate(bashContext("ls"))
+			time.Sleep(time.Millisecond)
+			validate(bashContext("ls"))
+
+			Expect(runs).To(Equal(2))
+		})
+
+		It("should validate again after a run that timed out", func() {
+			cfg.Global.HookTimeout = config.Duration(20 * time.Millisecond)
+
+			// A result callback that stalls past the hook timeout keeps
+			// dispatch from finishing in time, like a slow validator
+			stall := runner.WithOnValidatorResult(
+				func(ctx context.Context, _ string, _ *runner.ValidatorResult) {
+					<-ctx.Done()
+				},
+			)
+
+			command := "gh pr create --body \"Updated `config.toml` handling\""
+
+			for range 2 {
+				decision, err := runner.RunValidation(
+					context.Background(),
+					cfg,
+					bashContext(command),
+					nil,
+					runner.WithDebounceDir(dir),
+					runner.WithOnStart(func(context.Context, *hook.Context) { runs++ }),
+					stall,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision.Errors).To(ContainElement(HaveField("Validator", "timeout")))
+			}
+
+			Expect(runs).To(Equal(2))
+		})
+
+		It("should validate again after a rate-limited rule fired", func() {
+			GinkgoT().Setenv("XDG_STATE_HOME", GinkgoT().TempDir())
+
+			cfg.Rules = &config.RulesConfig{
+				Rules: []config.RuleConfig{{
+					Name: "upstream-push",
+					Match: &config.RuleMatchConfig{
+						ValidatorType:  "git.push",
+						CommandPattern: "git push upstream*",
+					},
+					Action: &config.RuleActionConfig{
+						Type:    "warn",
+						Message: "Pushing to upstream",
+					},
+					RateLimit: &config.RuleRateLimitConfig{Window: config.Duration(time.Hour)},
+				}},
+			}
+
+			warning := HaveField("Message", "Pushing to upstream")
+
+			first := validate(bashContext("git push upstream main"))
+			Expect(first.Errors).To(ContainElement(warning))
+
+			// The rule is suppressed now, which only the rules can tell
+			second := validate(bashContext("git push upstream main"))
+			Expect(runs).To(Equal(2))
+			Expect(second.Errors).NotTo(ContainElement(warning))
+
+			third := validate(bashContext("git push upstream main"))
+			Expect(runs).To(Equal(2))
+			Expect(third.Errors).To(Equal(second.Errors))
+		})
+
+		It("should not debounce other events", func() {
+			post := bashContext("ls")
+			post.EventType = hook.EventTypePostToolUse
+
+			validate(post)
+			validate(post)
+
+			Expect(runs).To(Equal(2))
+		})
+
+		It("should not replay a decision bypassed by a granted exception", func() {
+			stateDir := GinkgoT().TempDir()
+			enabled := true
+
+			cfg.Exceptions = &config.ExceptionsConfig{
+				Enabled: &enabled,
+				RateLimit: &config.ExceptionRateLimitConfig{
+					StateFile: filepath.Join(stateDir, "state.json"),
+				},
+				Audit: &config.ExceptionAuditConfig{
+					LogFile: filepath.Join(stateDir, "audit.jsonl"),
+				},
+			}
+
+			token, _, err := exceptions.NewHandler(cfg.Exceptions).Grant("GIT023", "hotfix", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			GinkgoT().Setenv(exceptions.GrantTokenEnvVar, token)
+
+			command := "gh pr create --body \"Updated `config.toml` handling\""
+
+			Expect(validate(bashContext(command)).Block).To(BeFalse())
+			Expect(validate(bashContext(command)).Block).To(BeTrue())
+			Expect(runs).To(Equal(2))
+
+			GinkgoT().Setenv(exceptions.GrantTokenEnvVar, "")
+
+			Expect(validate(bashContext(command)).Block).To(BeTrue())
+			Expect(runs).To(Equal(3))
+		})
+
+		It("should be disabled by default", func() {
+			cfg.Global.Debounce = 0
+
+			validate(bashContext("ls"))
+			validate(bashContext("ls"))
+
+			Expect(runs).To(Equal(2))
+		})
+	})
+
+	Describe("loop detection", func() {
+		const blockedCommand = "gh pr create --body \"Updated `config.toml` handling\""
+
+		var dir string
+
+		// seenBlocks is the ConsecutiveBlocks the validators of the last
+		// validate call saw.
+		var seenBlocks int
+
+		validate := func(hookCtx *hook.Context) *runner.Decision {
+			decision, err := runner.RunValidation(
+				context.Background(),
+				cfg,
+				hookCtx,
+				nil,
+				runner.WithLoopStateDir(dir),
+				runner.WithOnStart(func(_ context.Context, validated *hook.Context) {
+					seenBlocks = validated.ConsecutiveBlocks
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			return decision
+		}
+
+		loopNotices := func(decision *runner.Decision) []string {
+			var notices []string
+
+			for _, e := range decision.Errors {
+				if strings.HasPrefix(e.FixHint, "This has blocked") {
+					notices = append(notices, e.FixHint)
+				}
+			}
+
+			return notices
+		}
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+			cfg.Global.LoopDetectionThreshold = 3
+		})
+
+		It("should point to the reference once the same block repeats", func() {
+			Expect(loopNotices(validate(bashContext(blockedCommand)))).To(BeEmpty())
+			Expect(loopNotices(validate(bashContext(blockedCommand)))).To(BeEmpty())
+
+			hookCtx := bashContext(blockedCommand)
+			decision := validate(hookCtx)
+			Expect(decision.Block).To(BeTrue())
+			Expect(seenBlocks).To(Equal(2))
+			Expect(hookCtx.ConsecutiveBlocks).To(BeZero(), "the caller's context is not modified")
+			Expect(loopNotices(decision)).To(ConsistOf(
+				MatchRegexp(`^This has blocked 3 times in a row - see https://klaudiu\.sh/e/\w+\.`),
+			))
+		})
+
+		It("should offer an exception token when exceptions are enabled", func() {
+			cfg.Exceptions = nil
+
+			for range 2 {
+				validate(bashContext(blockedCommand))
+			}
+
+			Expect(loopNotices(validate(bashContext(blockedCommand)))).To(ConsistOf(
+				ContainSubstring("or use an exception token."),
+			))
+		})
+
+		It("should restart counting after the tool passes", func() {
+			validate(bashContext(blockedCommand))
+			validate(bashContext(blockedCommand))
+			Expect(validate(bashContext("ls")).Block).To(BeFalse())
+
+			Expect(loopNotices(validate(bashContext(blockedCommand)))).To(BeEmpty())
+			Expect(seenBlocks).To(BeZero())
+		})
+
+		It("should let rules match the number of consecutive blocks", func() {
+			cfg.Global.LoopDetectionThreshold = 10
+			cfg.Rules = &config.RulesConfig{Rules: []config.RuleConfig{{
+				Name:  "stuck",
+				Match: &config.RuleMatchConfig{MinConsecutiveBlocks: 2},
+				Action: &config.RuleActionConfig{
+					Type:    "warn",
+					Message: "Stop retrying the same command",
+				},
+			}}}
+
+			stuckWarnings := func(decision *runner.Decision) []*runner.Error {
+				var warnings []*runner.Error
+
+				for _, e := range decision.Errors {
+					if strings.Contains(e.Message, "Stop retrying") {
+						warnings = append(warnings, e)
+					}
+				}
+
+				return warnings
+			}
+
+			Expect(stuckWarnings(validate(bashContext(blockedCommand)))).To(BeEmpty())
+			Expect(stuckWarnings(validate(bashContext(blockedCommand)))).To(BeEmpty())
+			Expect(stuckWarnings(validate(bashContext(blockedCommand)))).NotTo(BeEmpty())
+		})
+
+		It("should be disabled by default", func() {
+			cfg.Global.LoopDetectionThreshold = 0
+
+			for range 3 {
+				Expect(loopNotices(validate(bashContext(blockedCommand)))).To(BeEmpty())
+				Expect(seenBlocks).To(BeZero())
+			}
+
+			Expect(os.ReadDir(dir)).To(BeEmpty())
+		})
+	})
+
+	It("should reject missing arguments", func() {
+		_, err := runner.RunValidation(context.Background(), nil, bashContext("ls"), nil)
+		Expect(err).To(HaveOccurred())
+
+		_, err = runner.RunValidation(context.Background(), cfg, nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})